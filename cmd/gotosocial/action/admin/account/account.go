@@ -386,3 +386,44 @@ func stopState(state *state.State) error {
 		"encrypted_password",
 	)
 }
+
+// ResetTwoFactor disables two-factor authentication for
+// the target account, removing its secret and recovery codes.
+var ResetTwoFactor action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	user.TwoFactorSecret = ""
+	user.TwoFactorRecoveryCodes = nil
+	user.TwoFactorEnabledAt = time.Time{}
+	return state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+		"two_factor_recovery_codes",
+		"two_factor_enabled_at",
+	)
+}
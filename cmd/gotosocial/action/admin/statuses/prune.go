@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
+)
+
+// Prune prunes stale remote statuses that no local account
+// has interacted with, followed by remote accounts left
+// unused, older than the configured number of days.
+var Prune action.GTSAction = func(ctx context.Context) error {
+	days := config.GetStatusesRemotePruneDays()
+	if days <= 0 {
+		return fmt.Errorf("%s must be set to a value greater than 0 to prune statuses", config.StatusesRemotePruneDaysFlag())
+	}
+
+	var state state.State
+
+	state.Caches.Init()
+	state.Caches.Start()
+
+	// Scheduler is required for the
+	// cleaner, but no other workers
+	// are needed for this CLI action.
+	state.Workers.StartScheduler()
+
+	dbService, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %w", err)
+	}
+	state.DB = dbService
+
+	defer func() {
+		// Ensure everything gets shutdown on exit.
+		if err := dbService.Close(); err != nil {
+			log.Errorf(ctx, "error stopping database: %v", err)
+		}
+		state.Workers.Scheduler.Stop()
+		state.Caches.Stop()
+	}()
+
+	//nolint:contextcheck
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return fmt.Errorf("error creating storage backend: %w", err)
+	}
+	state.Storage = storage
+
+	if config.GetAdminMediaPruneDryRun() {
+		log.Info(ctx, "prune DRY RUN")
+		ctx = gtscontext.SetDryRun(ctx)
+	}
+
+	//nolint:contextcheck
+	cleaner := cleaner.New(&state)
+
+	// Perform the actual pruning with logging.
+	cleaner.Statuses().All(ctx, days)
+	cleaner.Accounts().All(ctx, days)
+
+	// Perform a cleanup of storage (for removed local dirs).
+	if err := storage.Storage.Clean(ctx); err != nil {
+		log.Errorf(ctx, "error cleaning storage: %v", err)
+	}
+
+	return nil
+}
@@ -63,6 +63,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
 )
@@ -174,6 +175,9 @@
 	if err := dbService.CreateInstanceApplication(ctx); err != nil {
 		return fmt.Errorf("error creating instance application: %s", err)
 	}
+	if _, err := dbService.GetVAPIDKeyPair(ctx); err != nil {
+		return fmt.Errorf("error creating instance vapid key pair: %s", err)
+	}
 
 	// Get the instance account (we'll need this later).
 	instanceAccount, err := dbService.GetInstanceAccount(ctx, "")
@@ -280,6 +284,40 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling cache sweep: %w", err)
 	}
 
+	// Add a task to the scheduler to refresh
+	// recently created link preview cards.
+	// Frequency = 6 * hour
+	if !state.Workers.Scheduler.AddRecurring(
+		"@cardrefresh", // id
+		time.Time{},    // start
+		6*time.Hour,    // freq
+		func(ctx context.Context, _ time.Time) {
+			federator.RefreshStaleCards(ctx)
+		},
+	) {
+		return errors.New("error scheduling card refresh")
+	}
+
+	// Add a task to the scheduler to prune
+	// delivery failures older than 30 days.
+	// Frequency = 24 * hour
+	if !state.Workers.Scheduler.AddRecurring(
+		"@deliveryfailureprune", // id
+		time.Time{},             // start
+		24*time.Hour,            // freq
+		func(ctx context.Context, _ time.Time) {
+			olderThan := time.Now().Add(-30 * 24 * time.Hour)
+			n, err := state.DB.DeleteDeliveryFailuresOlderThan(ctx, olderThan)
+			if err != nil {
+				log.Errorf(ctx, "error pruning delivery failures: %v", err)
+				return
+			}
+			log.Infof(ctx, "pruned %d delivery failures", n)
+		},
+	) {
+		return errors.New("error scheduling delivery failure prune")
+	}
+
 	// Create background cleaner.
 	cleaner := cleaner.New(state)
 
@@ -306,8 +344,15 @@ func(context.Context, time.Time) {
 	state.Workers.Client.Init(messages.ClientMsgIndices())
 	state.Workers.Federator.Init(messages.FederatorMsgIndices())
 	state.Workers.Delivery.Init(client)
+	state.Workers.WebPush.Init(client)
 	state.Workers.Client.Process = process.Workers().ProcessFromClientAPI
 	state.Workers.Federator.Process = process.Workers().ProcessFromFediAPI
+	state.Workers.Delivery.Delivered = federator.Delivered
+	state.Workers.Delivery.Failed = func(ctx context.Context, dlv *delivery.Delivery, code int, err error) {
+		federator.DeliveryFailed(ctx, dlv, code, err)
+		process.Admin().DeliveryFailed(ctx, dlv, code, err)
+	}
+	state.Workers.WebPush.Failed = process.Push().DeliveryFailed
 
 	// Now start workers!
 	state.Workers.Start()
@@ -317,6 +362,44 @@ func(context.Context, time.Time) {
 		return fmt.Errorf("error scheduling poll expiries: %w", err)
 	}
 
+	// Schedule publication of all existing scheduled statuses.
+	if err := process.Status().ScheduledStatusesScheduleAll(ctx); err != nil {
+		return fmt.Errorf("error scheduling scheduled statuses: %w", err)
+	}
+
+	// Schedule publication of all existing scheduled announcements.
+	if err := process.Announcements().ScheduleAll(ctx); err != nil {
+		return fmt.Errorf("error scheduling announcements: %w", err)
+	}
+
+	// Add a task to the scheduler to fetch and
+	// process domain permission subscriptions.
+	// Frequency = 24 * hour
+	if !state.Workers.Scheduler.AddRecurring(
+		"@domainpermsubs", // id
+		time.Time{},       // start
+		24*time.Hour,      // freq
+		func(ctx context.Context, _ time.Time) {
+			process.Admin().DomainPermissionSubscriptionsProcess(ctx)
+		},
+	) {
+		return errors.New("error scheduling domain permission subscriptions")
+	}
+
+	// Add a task to the scheduler to prune
+	// trend uses older than the trend history.
+	// Frequency = 24 * hour
+	if !state.Workers.Scheduler.AddRecurring(
+		"@trendsprune", // id
+		time.Time{},    // start
+		24*time.Hour,   // freq
+		func(ctx context.Context, _ time.Time) {
+			process.Trends().Prune(ctx)
+		},
+	) {
+		return errors.New("error scheduling trends prune")
+	}
+
 	// Initialize metrics.
 	if err := metrics.Initialize(state.DB); err != nil {
 		return fmt.Errorf("error initializing metrics: %w", err)
@@ -22,6 +22,7 @@
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media/prune"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/statuses"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
@@ -146,6 +147,19 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountResetTwoFactorCmd := &cobra.Command{
+		Use:   "reset-2fa",
+		Short: "disable two-factor authentication for the given local account, eg., if the user has lost access to their authenticator app and recovery codes",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.ResetTwoFactor)
+		},
+	}
+	config.AddAdminAccount(adminAccountResetTwoFactorCmd)
+	adminAccountCmd.AddCommand(adminAccountResetTwoFactorCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -268,5 +282,28 @@ func adminCommands() *cobra.Command {
 
 	adminCmd.AddCommand(adminMediaCmd)
 
+	/*
+		ADMIN STATUSES COMMANDS
+	*/
+	adminStatusesCmd := &cobra.Command{
+		Use:   "statuses",
+		Short: "admin commands related to statuses",
+	}
+
+	adminStatusesPruneCmd := &cobra.Command{
+		Use:   "prune",
+		Short: "prune stale remote statuses and accounts that no local account has interacted with, older than the configured number of days",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), statuses.Prune)
+		},
+	}
+	config.AddAdminMediaPrune(adminStatusesPruneCmd)
+	adminStatusesCmd.AddCommand(adminStatusesPruneCmd)
+
+	adminCmd.AddCommand(adminStatusesCmd)
+
 	return adminCmd
 }
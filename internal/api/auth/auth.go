@@ -43,6 +43,8 @@
 	AuthAccountDisabledPath = "/account_disabled"
 	// AuthCallbackPath is the API path for receiving callback tokens from external OIDC providers
 	AuthCallbackPath = "/callback"
+	// AuthTwoFactorPath is the API path for users to enter a two-factor authentication code after signing in with their password
+	AuthTwoFactorPath = "/2fa"
 
 	/*
 		paths prefixed with 'oauth'
@@ -50,6 +52,8 @@
 
 	// OauthTokenPath is the API path to use for granting token requests to users with valid credentials
 	OauthTokenPath = "/token" // #nosec G101 else we get a hardcoded credentials warning
+	// OauthRevokePath is the API path to use for revoking access tokens issued to a client
+	OauthRevokePath = "/revoke"
 	// OauthAuthorizePath is the API path for authorization requests (eg., authorize this app to act on my behalf as a user)
 	OauthAuthorizePath = "/authorize"
 	// OauthFinalizePath is the API path for completing user registration with additional user details
@@ -73,6 +77,9 @@
 	sessionClientState   = "client_state"
 	sessionClaims        = "claims"
 	sessionAppID         = "app_id"
+
+	sessionTwoFactorUserID   = "two_factor_userid"
+	sessionTwoFactorFailures = "two_factor_failures"
 )
 
 type Module struct {
@@ -97,11 +104,14 @@ func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...g
 	attachHandler(http.MethodGet, AuthSignInPath, m.SignInGETHandler)
 	attachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
 	attachHandler(http.MethodGet, AuthCallbackPath, m.CallbackGETHandler)
+	attachHandler(http.MethodGet, AuthTwoFactorPath, m.TwoFactorGETHandler)
+	attachHandler(http.MethodPost, AuthTwoFactorPath, m.TwoFactorPOSTHandler)
 }
 
 // RouteOauth routes all paths that should have an 'oauth' prefix
 func (m *Module) RouteOauth(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, OauthTokenPath, m.TokenPOSTHandler)
+	attachHandler(http.MethodPost, OauthRevokePath, m.TokenRevokePOSTHandler)
 	attachHandler(http.MethodGet, OauthAuthorizePath, m.AuthorizeGETHandler)
 	attachHandler(http.MethodPost, OauthAuthorizePath, m.AuthorizePOSTHandler)
 	attachHandler(http.MethodPost, OauthFinalizePath, m.FinalizePOSTHandler)
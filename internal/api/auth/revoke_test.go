@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RevokeTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *RevokeTestSuite) revoke(
+	clientID string,
+	clientSecret string,
+	token string,
+	expectedHTTPStatus int,
+) string {
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		nil,
+		map[string][]string{
+			"client_id":     {clientID},
+			"client_secret": {clientSecret},
+			"token":         {token},
+		})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/revoke", requestBody.Bytes(), w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.authModule.TokenRevokePOSTHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+	return string(b)
+}
+
+func (suite *RevokeTestSuite) TestRevokeOK() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	b := suite.revoke(testClient.ID, testClient.Secret, testToken.Access, http.StatusOK)
+	suite.Equal(`{}`, b)
+
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RevokeTestSuite) TestRevokeWrongSecret() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	b := suite.revoke(testClient.ID, "nope", testToken.Access, http.StatusForbidden)
+	suite.Equal(`{"error":"unauthorized_client","error_description":"Forbidden: client authentication failed: client_id or client_secret incorrect"}`, b)
+
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeOtherClientsToken() {
+	testClient := suite.testClients["local_account_2"]
+	testToken := suite.testTokens["local_account_1"]
+
+	// Should look like a success, but
+	// the token should not be touched.
+	suite.revoke(testClient.ID, testClient.Secret, testToken.Access, http.StatusOK)
+
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeEmptyForm() {
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/revoke", []byte{}, "")
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.authModule.TokenRevokePOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestRevokeTestSuite(t *testing.T) {
+	suite.Run(t, &RevokeTestSuite{})
+}
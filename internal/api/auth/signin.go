@@ -100,6 +100,30 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	user, err := m.db.GetUserByID(c.Request.Context(), userid)
+	if err != nil {
+		err := fmt.Errorf("error getting user %s: %w", userid, err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	if user.TwoFactorEnabled() {
+		// User has 2FA enabled, so they're not signed in
+		// yet: store the user id under a separate key, and
+		// send them to enter their code before continuing.
+		s.Delete(sessionUserID)
+		s.Delete(sessionTwoFactorFailures)
+		s.Set(sessionTwoFactorUserID, userid)
+		if err := s.Save(); err != nil {
+			err := fmt.Errorf("error saving two-factor user id onto session: %s", err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+			return
+		}
+
+		c.Redirect(http.StatusFound, "/auth"+AuthTwoFactorPath)
+		return
+	}
+
 	s.Set(sessionUserID, userid)
 	if err := s.Save(); err != nil {
 		err := fmt.Errorf("error saving user id onto session: %s", err)
@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// twoFactorMaxFailures is the number of incorrect codes
+// that may be entered before the sign in is abandoned,
+// and the user has to start again with their password.
+const twoFactorMaxFailures = 5
+
+// twoFactor just wraps a form-submitted two-factor code.
+type twoFactor struct {
+	Code string `form:"code"`
+}
+
+// TwoFactorGETHandler should be served at https://example.org/auth/2fa.
+// Users who have signed in with their password, and who have two-factor
+// authentication enabled, land here to enter their TOTP or recovery code.
+// The form will then POST to the same path, handled by TwoFactorPOSTHandler.
+func (m *Module) TwoFactorGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	s := sessions.Default(c)
+	if _, ok := s.Get(sessionTwoFactorUserID).(string); !ok {
+		// No password sign in yet,
+		// so send them back there.
+		c.Redirect(http.StatusFound, "/auth"+AuthSignInPath)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	page := apiutil.WebPage{
+		Template: "sign-in-2fa.tmpl",
+		Instance: instance,
+	}
+
+	apiutil.TemplateWebPage(c, page)
+}
+
+// TwoFactorPOSTHandler should be served at https://example.org/auth/2fa.
+// It checks the submitted two-factor code for the user who signed in with
+// their password, and on success redirects to the authorize handler.
+func (m *Module) TwoFactorPOSTHandler(c *gin.Context) {
+	s := sessions.Default(c)
+
+	userID, ok := s.Get(sessionTwoFactorUserID).(string)
+	if !ok {
+		m.clearSession(s)
+		err := fmt.Errorf("key %s was not found in session", sessionTwoFactorUserID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &twoFactor{}
+	if err := c.ShouldBind(form); err != nil {
+		m.clearSession(s)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	user, err := m.db.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		m.clearSession(s)
+		err := fmt.Errorf("error getting user %s: %w", userID, err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.User().TwoFactorCheck(c.Request.Context(), user, form.Code); errWithCode != nil {
+		if errWithCode.Code() != http.StatusUnauthorized {
+			m.clearSession(s)
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		// Incorrect code, count the failure.
+		failures, _ := s.Get(sessionTwoFactorFailures).(int)
+		failures++
+
+		if failures >= twoFactorMaxFailures {
+			// Too many failures, make
+			// them start from scratch.
+			m.clearSession(s)
+			const help = "too many incorrect two-factor authentication codes, please sign in again"
+			apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(errors.New(help), help), m.processor.InstanceGetV1)
+			return
+		}
+
+		// Don't clear session here, so the user
+		// can just press back and try again.
+		s.Set(sessionTwoFactorFailures, failures)
+		if err := s.Save(); err != nil {
+			err := fmt.Errorf("error saving two-factor failures onto session: %s", err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+			return
+		}
+
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Code OK, user is now signed in.
+	s.Delete(sessionTwoFactorUserID)
+	s.Delete(sessionTwoFactorFailures)
+	s.Set(sessionUserID, userID)
+	if err := s.Save(); err != nil {
+		err := fmt.Errorf("error saving user id onto session: %s", err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
+}
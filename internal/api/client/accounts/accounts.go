@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -77,60 +78,60 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// create account
-	attachHandler(http.MethodPost, BasePath, m.AccountCreatePOSTHandler)
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountCreatePOSTHandler)
 
 	// get account
-	attachHandler(http.MethodGet, BasePathWithID, m.AccountGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountGETHandler)
 
 	// delete account
-	attachHandler(http.MethodPost, DeletePath, m.AccountDeletePOSTHandler)
+	attachHandler(http.MethodPost, DeletePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountDeletePOSTHandler)
 
 	// verify account
-	attachHandler(http.MethodGet, VerifyPath, m.AccountVerifyGETHandler)
+	attachHandler(http.MethodGet, VerifyPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountVerifyGETHandler)
 
 	// modify account
-	attachHandler(http.MethodPatch, UpdatePath, m.AccountUpdateCredentialsPATCHHandler)
+	attachHandler(http.MethodPatch, UpdatePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountUpdateCredentialsPATCHHandler)
 
 	// modify account profile media
-	attachHandler(http.MethodDelete, AvatarPath, m.AccountAvatarDELETEHandler)
-	attachHandler(http.MethodDelete, HeaderPath, m.AccountHeaderDELETEHandler)
+	attachHandler(http.MethodDelete, AvatarPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountAvatarDELETEHandler)
+	attachHandler(http.MethodDelete, HeaderPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountHeaderDELETEHandler)
 
 	// get account's statuses
-	attachHandler(http.MethodGet, StatusesPath, m.AccountStatusesGETHandler)
+	attachHandler(http.MethodGet, StatusesPath, oauth.RequireScope(oauth.ScopeReadStatuses), m.AccountStatusesGETHandler)
 
 	// get following or followers
-	attachHandler(http.MethodGet, FollowersPath, m.AccountFollowersGETHandler)
-	attachHandler(http.MethodGet, FollowingPath, m.AccountFollowingGETHandler)
+	attachHandler(http.MethodGet, FollowersPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountFollowersGETHandler)
+	attachHandler(http.MethodGet, FollowingPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountFollowingGETHandler)
 
 	// get relationship with account
-	attachHandler(http.MethodGet, RelationshipsPath, m.AccountRelationshipsGETHandler)
+	attachHandler(http.MethodGet, RelationshipsPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountRelationshipsGETHandler)
 
 	// follow or unfollow account
-	attachHandler(http.MethodPost, FollowPath, m.AccountFollowPOSTHandler)
-	attachHandler(http.MethodPost, UnfollowPath, m.AccountUnfollowPOSTHandler)
+	attachHandler(http.MethodPost, FollowPath, oauth.RequireScope(oauth.ScopeWriteFollows), m.AccountFollowPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, oauth.RequireScope(oauth.ScopeWriteFollows), m.AccountUnfollowPOSTHandler)
 
 	// block or unblock account
-	attachHandler(http.MethodPost, BlockPath, m.AccountBlockPOSTHandler)
-	attachHandler(http.MethodPost, UnblockPath, m.AccountUnblockPOSTHandler)
+	attachHandler(http.MethodPost, BlockPath, oauth.RequireScope(oauth.ScopeWriteBlocks), m.AccountBlockPOSTHandler)
+	attachHandler(http.MethodPost, UnblockPath, oauth.RequireScope(oauth.ScopeWriteBlocks), m.AccountUnblockPOSTHandler)
 
 	// account lists
-	attachHandler(http.MethodGet, ListsPath, m.AccountListsGETHandler)
+	attachHandler(http.MethodGet, ListsPath, oauth.RequireScope(oauth.ScopeReadLists), m.AccountListsGETHandler)
 
 	// account note
-	attachHandler(http.MethodPost, NotePath, m.AccountNotePOSTHandler)
+	attachHandler(http.MethodPost, NotePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountNotePOSTHandler)
 
 	// mute or unmute account
-	attachHandler(http.MethodPost, MutePath, m.AccountMutePOSTHandler)
-	attachHandler(http.MethodPost, UnmutePath, m.AccountUnmutePOSTHandler)
+	attachHandler(http.MethodPost, MutePath, oauth.RequireScope(oauth.ScopeWriteMutes), m.AccountMutePOSTHandler)
+	attachHandler(http.MethodPost, UnmutePath, oauth.RequireScope(oauth.ScopeWriteMutes), m.AccountUnmutePOSTHandler)
 
 	// search for accounts
-	attachHandler(http.MethodGet, SearchPath, m.AccountSearchGETHandler)
-	attachHandler(http.MethodGet, LookupPath, m.AccountLookupGETHandler)
+	attachHandler(http.MethodGet, SearchPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountSearchGETHandler)
+	attachHandler(http.MethodGet, LookupPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountLookupGETHandler)
 
 	// migration handlers
-	attachHandler(http.MethodPost, AliasPath, m.AccountAliasPOSTHandler)
-	attachHandler(http.MethodPost, MovePath, m.AccountMovePOSTHandler)
+	attachHandler(http.MethodPost, AliasPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountAliasPOSTHandler)
+	attachHandler(http.MethodPost, MovePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AccountMovePOSTHandler)
 
 	// account themes
-	attachHandler(http.MethodGet, ThemesPath, m.AccountThemesGETHandler)
+	attachHandler(http.MethodGet, ThemesPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.AccountThemesGETHandler)
 }
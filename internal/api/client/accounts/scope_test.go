@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package accounts_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScopeTestSuite struct {
+	AccountStandardTestSuite
+}
+
+// newScopedEngine returns an engine serving the accounts and
+// user modules, as though the request was authorized by the
+// local_account_1 token granted the given scope.
+func (suite *ScopeTestSuite) newScopedEngine(scope string) *gin.Engine {
+	token := *suite.testTokens["local_account_1"]
+	token.Scope = scope
+
+	_, engine := testrig.CreateGinTestContext(httptest.NewRecorder(), nil)
+	group := engine.Group("/api", func(c *gin.Context) {
+		c.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+		c.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(&token))
+		c.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+		c.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	})
+
+	suite.accountsModule.Route(group.Handle)
+	user.New(suite.processor).Route(group.Handle)
+
+	return engine
+}
+
+func (suite *ScopeTestSuite) do(engine *gin.Engine, method string, path string, form url.Values) int {
+	var body *strings.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	} else {
+		body = strings.NewReader("")
+	}
+
+	request := httptest.NewRequest(method, "http://localhost:8080/api"+path, body)
+	request.Header.Set("accept", "application/json")
+	if form != nil {
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, request)
+	return recorder.Code
+}
+
+func (suite *ScopeTestSuite) TestSettingsPanelScopes() {
+	statusesPath := strings.Replace(
+		accounts.StatusesPath,
+		":"+accounts.IDKey,
+		suite.testAccounts["local_account_1"].ID, 1,
+	)
+
+	for _, scope := range []string{
+		// Scope requested by the settings panel.
+		"read write admin",
+
+		// Scope requested by older versions
+		// of the settings panel, still stored
+		// on tokens issued before the change.
+		"user admin",
+	} {
+		engine := suite.newScopedEngine(scope)
+
+		suite.Equal(http.StatusOK, suite.do(engine, http.MethodGet, accounts.VerifyPath, nil), scope)
+		suite.Equal(http.StatusOK, suite.do(engine, http.MethodPatch, accounts.UpdatePath, url.Values{"note": {"hello"}}), scope)
+		suite.Equal(http.StatusOK, suite.do(engine, http.MethodGet, user.BasePath, nil), scope)
+
+		// The 2FA routes should be reachable; we don't care
+		// about the outcome beyond the scope being accepted.
+		suite.NotEqual(http.StatusForbidden, suite.do(engine, http.MethodPost, user.TwoFactorEnrollPath, url.Values{}), scope)
+		suite.NotEqual(http.StatusForbidden, suite.do(engine, http.MethodPost, user.TwoFactorDisablePath, url.Values{}), scope)
+	}
+
+	// Account statuses require read:statuses,
+	// which the panel's scope grants via "read".
+	suite.Equal(http.StatusOK, suite.do(suite.newScopedEngine("read write admin"), http.MethodGet, statusesPath, nil))
+	suite.Equal(http.StatusForbidden, suite.do(suite.newScopedEngine("read:accounts"), http.MethodGet, statusesPath, nil))
+	suite.Equal(http.StatusOK, suite.do(suite.newScopedEngine("read:statuses"), http.MethodGet, statusesPath, nil))
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeTestSuite))
+}
@@ -53,7 +53,15 @@
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: >-
+//			Type of action to be taken. One of:
+//			`disable` (local accounts only): prevent the account from logging in or using the API,
+//			`reenable` (local accounts only): undo `disable`,
+//			`silence`: hide the account's posts from public timelines and from accounts that don't follow it,
+//			`unsilence`: undo `silence`,
+//			`sensitive`: mark all of the account's media as sensitive,
+//			`unsensitive`: undo `sensitive`,
+//			`suspend`: delete the account and all of its content (cannot be undone).
 //		type: string
 //		required: true
 //	-
@@ -64,7 +72,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:accounts
 //
 //	responses:
 //		'200':
@@ -81,6 +81,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
       "username": "1happyturtle",
@@ -140,6 +141,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
       "username": "admin",
@@ -195,6 +197,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01AY6P665V14JJR0AFVRT7311Y",
       "username": "localhost:8080",
@@ -241,6 +244,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH1H7YV1Z7D2C8K2730QBF",
       "username": "the_mighty_zork",
@@ -292,6 +296,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH0BBE4FHXPH513MBVFHB0",
       "username": "weed_lord420",
@@ -339,6 +344,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01FHMQX3GAABWSM0S2VZEC2SWC",
       "username": "Some_User",
@@ -385,6 +391,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
       "username": "foss_satan",
@@ -431,6 +438,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "062G5WYKY35KKD12EMSM3F8PJ8",
       "username": "her_fuckin_maj",
@@ -478,6 +486,7 @@ func (suite *AccountsGetTestSuite) TestAccountsGetFromTop() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "07GZRBAEMBNKGZ8Z9VSKSXKR98",
       "username": "üser",
@@ -563,6 +572,7 @@ func (suite *AccountsGetTestSuite) TestAccountsMinID() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01AY6P665V14JJR0AFVRT7311Y",
       "username": "localhost:8080",
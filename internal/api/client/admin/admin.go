@@ -23,6 +23,7 @@
 	"codeberg.org/gruf/go-debug"
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 )
@@ -36,6 +37,8 @@
 	DomainBlocksPathWithID  = DomainBlocksPath + "/:" + apiutil.IDKey
 	DomainAllowsPath        = BasePath + "/domain_allows"
 	DomainAllowsPathWithID  = DomainAllowsPath + "/:" + apiutil.IDKey
+	DomainLimitsPath        = BasePath + "/domain_limits"
+	DomainLimitsPathWithID  = DomainLimitsPath + "/:" + apiutil.IDKey
 	DomainKeysExpirePath    = BasePath + "/domain_keys_expire"
 	HeaderAllowsPath        = BasePath + "/header_allows"
 	HeaderAllowsPathWithID  = HeaderAllowsPath + "/:" + apiutil.IDKey
@@ -49,6 +52,7 @@
 	AccountsRejectPath      = AccountsPathWithID + "/reject"
 	MediaCleanupPath        = BasePath + "/media_cleanup"
 	MediaRefetchPath        = BasePath + "/media_refetch"
+	StatusesCleanupPath     = BasePath + "/statuses_cleanup"
 	ReportsPath             = BasePath + "/reports"
 	ReportsPathWithID       = ReportsPath + "/:" + apiutil.IDKey
 	ReportsResolvePath      = ReportsPathWithID + "/resolve"
@@ -60,10 +64,49 @@
 	DebugAPUrlPath          = DebugPath + "/apurl"
 	DebugClearCachesPath    = DebugPath + "/caches/clear"
 
+	DeliveryFailuresPath       = BasePath + "/delivery_failures"
+	DeliveryFailuresPathWithID = DeliveryFailuresPath + "/:" + apiutil.IDKey
+	DeliveryFailuresRetryPath  = DeliveryFailuresPathWithID + "/retry"
+
+	DomainPermissionSubscriptionsPath       = BasePath + "/domain_permission_subscriptions"
+	DomainPermissionSubscriptionsPathWithID = DomainPermissionSubscriptionsPath + "/:" + apiutil.IDKey
+	DomainPermissionSubscriptionsTestPath   = DomainPermissionSubscriptionsPathWithID + "/test"
+
+	DomainPermissionDraftsPath         = BasePath + "/domain_permission_drafts"
+	DomainPermissionDraftsPathWithID   = DomainPermissionDraftsPath + "/:" + apiutil.IDKey
+	DomainPermissionDraftsAcceptPath   = DomainPermissionDraftsPathWithID + "/accept"
+	DomainPermissionDraftsRejectPath   = DomainPermissionDraftsPathWithID + "/reject"
+	DomainPermissionExcludesPath       = BasePath + "/domain_permission_excludes"
+	DomainPermissionExcludesPathWithID = DomainPermissionExcludesPath + "/:" + apiutil.IDKey
+	RelaysPath                         = BasePath + "/relays"
+	RelaysPathWithID                   = RelaysPath + "/:" + apiutil.IDKey
+	FeaturedAccountsPath               = BasePath + "/featured_accounts"
+	FeaturedAccountsPathWithID         = FeaturedAccountsPath + "/:" + apiutil.IDKey
+	TrendsPath                         = BasePath + "/trends"
+	TrendsPathWithID                   = TrendsPath + "/:" + apiutil.IDKey
+	TrendsApprovePath                  = TrendsPathWithID + "/approve"
+	TrendsRejectPath                   = TrendsPathWithID + "/reject"
+	AnnouncementsPath                  = BasePath + "/announcements"
+	AnnouncementsPathWithID            = AnnouncementsPath + "/:" + apiutil.IDKey
+	AnnouncementsEndPath               = AnnouncementsPathWithID + "/end"
+	GroupsPath                         = BasePath + "/groups"
+	GroupsPathWithID                   = GroupsPath + "/:" + apiutil.IDKey
+	GroupMembersPath                   = GroupsPathWithID + "/members"
+	GroupMembersPathWithAccountID      = GroupMembersPath + "/:" + apiutil.AccountIDKey
+	GroupRequestsPath                  = GroupsPathWithID + "/requests"
+	GroupRequestsAuthorizePath         = GroupRequestsPath + "/:" + apiutil.AccountIDKey + "/authorize"
+	GroupRequestsRejectPath            = GroupRequestsPath + "/:" + apiutil.AccountIDKey + "/reject"
+	GroupBansPath                      = GroupsPathWithID + "/bans"
+	GroupBansPathWithAccountID         = GroupBansPath + "/:" + apiutil.AccountIDKey
+	GroupModeratorsPath                = GroupsPathWithID + "/moderators"
+	GroupModeratorsPathWithAccountID   = GroupModeratorsPath + "/:" + apiutil.AccountIDKey
+
 	FilterQueryKey        = "filter"
 	MaxShortcodeDomainKey = "max_shortcode_domain"
 	MinShortcodeDomainKey = "min_shortcode_domain"
 	DomainQueryKey        = "domain"
+	TrendTypeQueryKey     = "type"
+	TrendStateQueryKey    = "state"
 )
 
 type Module struct {
@@ -80,68 +123,145 @@ func New(state *state.State, processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// emoji stuff
-	attachHandler(http.MethodPost, EmojiPath, m.EmojiCreatePOSTHandler)
-	attachHandler(http.MethodGet, EmojiPath, m.EmojisGETHandler)
-	attachHandler(http.MethodDelete, EmojiPathWithID, m.EmojiDELETEHandler)
-	attachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
-	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
-	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	attachHandler(http.MethodPost, EmojiPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.EmojiCreatePOSTHandler)
+	attachHandler(http.MethodGet, EmojiPath, oauth.RequireScope(oauth.ScopeAdminRead), m.EmojisGETHandler)
+	attachHandler(http.MethodDelete, EmojiPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.EmojiDELETEHandler)
+	attachHandler(http.MethodGet, EmojiPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.EmojiGETHandler)
+	attachHandler(http.MethodPatch, EmojiPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.EmojiPATCHHandler)
+	attachHandler(http.MethodGet, EmojiCategoriesPath, oauth.RequireScope(oauth.ScopeAdminRead), m.EmojiCategoriesGETHandler)
 
 	// domain block stuff
-	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
-	attachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
-	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
-	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
+	attachHandler(http.MethodPost, DomainBlocksPath, oauth.RequireScope(oauth.ScopeAdminWriteDomainBlocks), m.DomainBlocksPOSTHandler)
+	attachHandler(http.MethodGet, DomainBlocksPath, oauth.RequireScope(oauth.ScopeAdminReadDomainBlocks), m.DomainBlocksGETHandler)
+	attachHandler(http.MethodGet, DomainBlocksPathWithID, oauth.RequireScope(oauth.ScopeAdminReadDomainBlocks), m.DomainBlockGETHandler)
+	attachHandler(http.MethodDelete, DomainBlocksPathWithID, oauth.RequireScope(oauth.ScopeAdminWriteDomainBlocks), m.DomainBlockDELETEHandler)
 
 	// domain allow stuff
-	attachHandler(http.MethodPost, DomainAllowsPath, m.DomainAllowsPOSTHandler)
-	attachHandler(http.MethodGet, DomainAllowsPath, m.DomainAllowsGETHandler)
-	attachHandler(http.MethodGet, DomainAllowsPathWithID, m.DomainAllowGETHandler)
-	attachHandler(http.MethodDelete, DomainAllowsPathWithID, m.DomainAllowDELETEHandler)
+	attachHandler(http.MethodPost, DomainAllowsPath, oauth.RequireScope(oauth.ScopeAdminWriteDomainAllows), m.DomainAllowsPOSTHandler)
+	attachHandler(http.MethodGet, DomainAllowsPath, oauth.RequireScope(oauth.ScopeAdminReadDomainAllows), m.DomainAllowsGETHandler)
+	attachHandler(http.MethodGet, DomainAllowsPathWithID, oauth.RequireScope(oauth.ScopeAdminReadDomainAllows), m.DomainAllowGETHandler)
+	attachHandler(http.MethodDelete, DomainAllowsPathWithID, oauth.RequireScope(oauth.ScopeAdminWriteDomainAllows), m.DomainAllowDELETEHandler)
+
+	// domain limit stuff
+	attachHandler(http.MethodPost, DomainLimitsPath, oauth.RequireScope(oauth.ScopeAdminWriteDomainLimits), m.DomainLimitsPOSTHandler)
+	attachHandler(http.MethodGet, DomainLimitsPath, oauth.RequireScope(oauth.ScopeAdminReadDomainLimits), m.DomainLimitsGETHandler)
+	attachHandler(http.MethodGet, DomainLimitsPathWithID, oauth.RequireScope(oauth.ScopeAdminReadDomainLimits), m.DomainLimitGETHandler)
+	attachHandler(http.MethodPatch, DomainLimitsPathWithID, oauth.RequireScope(oauth.ScopeAdminWriteDomainLimits), m.DomainLimitPATCHHandler)
+	attachHandler(http.MethodDelete, DomainLimitsPathWithID, oauth.RequireScope(oauth.ScopeAdminWriteDomainLimits), m.DomainLimitDELETEHandler)
 
 	// header filtering administration routes
-	attachHandler(http.MethodGet, HeaderAllowsPathWithID, m.HeaderFilterAllowGET)
-	attachHandler(http.MethodGet, HeaderBlocksPathWithID, m.HeaderFilterBlockGET)
-	attachHandler(http.MethodGet, HeaderAllowsPath, m.HeaderFilterAllowsGET)
-	attachHandler(http.MethodGet, HeaderBlocksPath, m.HeaderFilterBlocksGET)
-	attachHandler(http.MethodPost, HeaderAllowsPath, m.HeaderFilterAllowPOST)
-	attachHandler(http.MethodPost, HeaderBlocksPath, m.HeaderFilterBlockPOST)
-	attachHandler(http.MethodDelete, HeaderAllowsPathWithID, m.HeaderFilterAllowDELETE)
-	attachHandler(http.MethodDelete, HeaderBlocksPathWithID, m.HeaderFilterBlockDELETE)
+	attachHandler(http.MethodGet, HeaderAllowsPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.HeaderFilterAllowGET)
+	attachHandler(http.MethodGet, HeaderBlocksPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.HeaderFilterBlockGET)
+	attachHandler(http.MethodGet, HeaderAllowsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.HeaderFilterAllowsGET)
+	attachHandler(http.MethodGet, HeaderBlocksPath, oauth.RequireScope(oauth.ScopeAdminRead), m.HeaderFilterBlocksGET)
+	attachHandler(http.MethodPost, HeaderAllowsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.HeaderFilterAllowPOST)
+	attachHandler(http.MethodPost, HeaderBlocksPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.HeaderFilterBlockPOST)
+	attachHandler(http.MethodDelete, HeaderAllowsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.HeaderFilterAllowDELETE)
+	attachHandler(http.MethodDelete, HeaderBlocksPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.HeaderFilterBlockDELETE)
 
 	// domain maintenance stuff
-	attachHandler(http.MethodPost, DomainKeysExpirePath, m.DomainKeysExpirePOSTHandler)
+	attachHandler(http.MethodPost, DomainKeysExpirePath, oauth.RequireScope(oauth.ScopeAdminWriteDomainBlocks), m.DomainKeysExpirePOSTHandler)
 
 	// accounts stuff
-	attachHandler(http.MethodGet, AccountsV1Path, m.AccountsGETV1Handler)
-	attachHandler(http.MethodGet, AccountsV2Path, m.AccountsGETV2Handler)
-	attachHandler(http.MethodGet, AccountsPathWithID, m.AccountGETHandler)
-	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
-	attachHandler(http.MethodPost, AccountsApprovePath, m.AccountApprovePOSTHandler)
-	attachHandler(http.MethodPost, AccountsRejectPath, m.AccountRejectPOSTHandler)
+	attachHandler(http.MethodGet, AccountsV1Path, oauth.RequireScope(oauth.ScopeAdminReadAccounts), m.AccountsGETV1Handler)
+	attachHandler(http.MethodGet, AccountsV2Path, oauth.RequireScope(oauth.ScopeAdminReadAccounts), m.AccountsGETV2Handler)
+	attachHandler(http.MethodGet, AccountsPathWithID, oauth.RequireScope(oauth.ScopeAdminReadAccounts), m.AccountGETHandler)
+	attachHandler(http.MethodPost, AccountsActionPath, oauth.RequireScope(oauth.ScopeAdminWriteAccounts), m.AccountActionPOSTHandler)
+	attachHandler(http.MethodPost, AccountsApprovePath, oauth.RequireScope(oauth.ScopeAdminWriteAccounts), m.AccountApprovePOSTHandler)
+	attachHandler(http.MethodPost, AccountsRejectPath, oauth.RequireScope(oauth.ScopeAdminWriteAccounts), m.AccountRejectPOSTHandler)
 
 	// media stuff
-	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
-	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
+	attachHandler(http.MethodPost, MediaCleanupPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.MediaCleanupPOSTHandler)
+	attachHandler(http.MethodPost, MediaRefetchPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.MediaRefetchPOSTHandler)
+
+	// statuses stuff
+	attachHandler(http.MethodPost, StatusesCleanupPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.StatusesCleanupPOSTHandler)
 
 	// reports stuff
-	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
-	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
-	attachHandler(http.MethodPost, ReportsResolvePath, m.ReportResolvePOSTHandler)
+	attachHandler(http.MethodGet, ReportsPath, oauth.RequireScope(oauth.ScopeAdminReadReports), m.ReportsGETHandler)
+	attachHandler(http.MethodGet, ReportsPathWithID, oauth.RequireScope(oauth.ScopeAdminReadReports), m.ReportGETHandler)
+	attachHandler(http.MethodPost, ReportsResolvePath, oauth.RequireScope(oauth.ScopeAdminWriteReports), m.ReportResolvePOSTHandler)
 
 	// email stuff
-	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
+	attachHandler(http.MethodPost, EmailTestPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.EmailTestPOSTHandler)
 
 	// instance rules stuff
-	attachHandler(http.MethodGet, InstanceRulesPath, m.RulesGETHandler)
-	attachHandler(http.MethodGet, InstanceRulesPathWithID, m.RuleGETHandler)
-	attachHandler(http.MethodPost, InstanceRulesPath, m.RulePOSTHandler)
-	attachHandler(http.MethodPatch, InstanceRulesPathWithID, m.RulePATCHHandler)
-	attachHandler(http.MethodDelete, InstanceRulesPathWithID, m.RuleDELETEHandler)
+	attachHandler(http.MethodGet, InstanceRulesPath, oauth.RequireScope(oauth.ScopeAdminRead), m.RulesGETHandler)
+	attachHandler(http.MethodGet, InstanceRulesPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.RuleGETHandler)
+	attachHandler(http.MethodPost, InstanceRulesPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.RulePOSTHandler)
+	attachHandler(http.MethodPatch, InstanceRulesPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.RulePATCHHandler)
+	attachHandler(http.MethodDelete, InstanceRulesPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.RuleDELETEHandler)
+
+	// delivery failures stuff
+	attachHandler(http.MethodGet, DeliveryFailuresPath, oauth.RequireScope(oauth.ScopeAdminRead), m.DeliveryFailuresGETHandler)
+	attachHandler(http.MethodDelete, DeliveryFailuresPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DeliveryFailuresDELETEHandler)
+	attachHandler(http.MethodGet, DeliveryFailuresPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.DeliveryFailureGETHandler)
+	attachHandler(http.MethodDelete, DeliveryFailuresPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.DeliveryFailureDELETEHandler)
+	attachHandler(http.MethodPost, DeliveryFailuresRetryPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DeliveryFailureRetryPOSTHandler)
+
+	// domain permission subscriptions stuff
+	attachHandler(http.MethodGet, DomainPermissionSubscriptionsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.DomainPermissionSubscriptionsGETHandler)
+	attachHandler(http.MethodPost, DomainPermissionSubscriptionsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionSubscriptionPOSTHandler)
+	attachHandler(http.MethodGet, DomainPermissionSubscriptionsPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.DomainPermissionSubscriptionGETHandler)
+	attachHandler(http.MethodPatch, DomainPermissionSubscriptionsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionSubscriptionPATCHHandler)
+	attachHandler(http.MethodDelete, DomainPermissionSubscriptionsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionSubscriptionDELETEHandler)
+	attachHandler(http.MethodPost, DomainPermissionSubscriptionsTestPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionSubscriptionTestPOSTHandler)
+
+	// domain permission drafts stuff
+	attachHandler(http.MethodGet, DomainPermissionDraftsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.DomainPermissionDraftsGETHandler)
+	attachHandler(http.MethodPost, DomainPermissionDraftsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionDraftPOSTHandler)
+	attachHandler(http.MethodGet, DomainPermissionDraftsPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.DomainPermissionDraftGETHandler)
+	attachHandler(http.MethodPost, DomainPermissionDraftsAcceptPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionDraftAcceptPOSTHandler)
+	attachHandler(http.MethodPost, DomainPermissionDraftsRejectPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionDraftRejectPOSTHandler)
+
+	// domain permission excludes stuff
+	attachHandler(http.MethodGet, DomainPermissionExcludesPath, oauth.RequireScope(oauth.ScopeAdminRead), m.DomainPermissionExcludesGETHandler)
+	attachHandler(http.MethodPost, DomainPermissionExcludesPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionExcludePOSTHandler)
+	attachHandler(http.MethodGet, DomainPermissionExcludesPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.DomainPermissionExcludeGETHandler)
+	attachHandler(http.MethodDelete, DomainPermissionExcludesPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.DomainPermissionExcludeDELETEHandler)
+
+	// relay stuff
+	attachHandler(http.MethodGet, RelaysPath, oauth.RequireScope(oauth.ScopeAdminRead), m.RelaysGETHandler)
+	attachHandler(http.MethodPost, RelaysPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.RelayPOSTHandler)
+	attachHandler(http.MethodGet, RelaysPathWithID, oauth.RequireScope(oauth.ScopeAdminRead), m.RelayGETHandler)
+	attachHandler(http.MethodDelete, RelaysPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.RelayDELETEHandler)
+
+	// featured accounts stuff
+	attachHandler(http.MethodGet, FeaturedAccountsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.FeaturedAccountsGETHandler)
+	attachHandler(http.MethodPost, FeaturedAccountsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.FeaturedAccountPOSTHandler)
+	attachHandler(http.MethodDelete, FeaturedAccountsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.FeaturedAccountDELETEHandler)
+
+	// trends stuff
+	attachHandler(http.MethodGet, TrendsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.TrendsGETHandler)
+	attachHandler(http.MethodPost, TrendsApprovePath, oauth.RequireScope(oauth.ScopeAdminWrite), m.TrendApprovePOSTHandler)
+	attachHandler(http.MethodPost, TrendsRejectPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.TrendRejectPOSTHandler)
+
+	// announcements stuff
+	attachHandler(http.MethodGet, AnnouncementsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.AnnouncementsGETHandler)
+	attachHandler(http.MethodPost, AnnouncementsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementPOSTHandler)
+	attachHandler(http.MethodPatch, AnnouncementsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementPATCHHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementDELETEHandler)
+	attachHandler(http.MethodPost, AnnouncementsEndPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementEndPOSTHandler)
+
+	// group stuff
+	attachHandler(http.MethodGet, GroupsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.GroupsGETHandler)
+	attachHandler(http.MethodPost, GroupsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupPOSTHandler)
+	attachHandler(http.MethodPatch, GroupsPathWithID, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupPATCHHandler)
+	attachHandler(http.MethodGet, GroupMembersPath, oauth.RequireScope(oauth.ScopeAdminRead), m.GroupMembersGETHandler)
+	attachHandler(http.MethodDelete, GroupMembersPathWithAccountID, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupMemberDELETEHandler)
+	attachHandler(http.MethodGet, GroupRequestsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.GroupRequestsGETHandler)
+	attachHandler(http.MethodPost, GroupRequestsAuthorizePath, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupRequestAuthorizePOSTHandler)
+	attachHandler(http.MethodPost, GroupRequestsRejectPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, GroupBansPath, oauth.RequireScope(oauth.ScopeAdminRead), m.GroupBansGETHandler)
+	attachHandler(http.MethodPost, GroupBansPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupBanPOSTHandler)
+	attachHandler(http.MethodDelete, GroupBansPathWithAccountID, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupBanDELETEHandler)
+	attachHandler(http.MethodGet, GroupModeratorsPath, oauth.RequireScope(oauth.ScopeAdminRead), m.GroupModeratorsGETHandler)
+	attachHandler(http.MethodPost, GroupModeratorsPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupModeratorPOSTHandler)
+	attachHandler(http.MethodDelete, GroupModeratorsPathWithAccountID, oauth.RequireScope(oauth.ScopeAdminWrite), m.GroupModeratorDELETEHandler)
 
 	// debug stuff
 	if debug.DEBUG {
-		attachHandler(http.MethodGet, DebugAPUrlPath, m.DebugAPUrlHandler)
-		attachHandler(http.MethodPost, DebugClearCachesPath, m.DebugClearCachesHandler)
+		attachHandler(http.MethodGet, DebugAPUrlPath, oauth.RequireScope(oauth.ScopeAdminRead), m.DebugAPUrlHandler)
+		attachHandler(http.MethodPost, DebugClearCachesPath, oauth.RequireScope(oauth.ScopeAdminWrite), m.DebugClearCachesHandler)
 	}
 }
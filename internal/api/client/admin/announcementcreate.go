@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// AnnouncementPOSTHandler swagger:operation POST /api/v1/admin/announcements adminAnnouncementCreate
+//
+// Create a new instance announcement.
+//
+// The announcement will be published immediately if publish is true,
+// or else at scheduled_at, if set. Otherwise, it will be kept as a draft
+// visible only to admins, until published by an update.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/x-www-form-urlencoded
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: text
+//		in: formData
+//		description: Text of the announcement. Will be parsed as markdown.
+//		required: true
+//		type: string
+//	-
+//		name: starts_at
+//		in: formData
+//		description: ISO 8601 Datetime at which the event the announcement refers to starts.
+//		type: string
+//	-
+//		name: ends_at
+//		in: formData
+//		description: ISO 8601 Datetime at which the announcement should stop being shown to users.
+//		type: string
+//	-
+//		name: all_day
+//		in: formData
+//		description: starts_at and ends_at refer to whole days, rather than times.
+//		type: boolean
+//	-
+//		name: scheduled_at
+//		in: formData
+//		description: ISO 8601 Datetime at which to publish the announcement.
+//		type: string
+//	-
+//		name: publish
+//		in: formData
+//		description: Publish the announcement now.
+//		type: boolean
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write
+//
+//	responses:
+//		'200':
+//			description: The newly created announcement.
+//			schema:
+//				"$ref": "#/definitions/announcement"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: unprocessable content
+//		'500':
+//			description: internal server error
+func (m *Module) AnnouncementPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Parse + validate form.
+	form := new(apimodel.AdminAnnouncementRequest)
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	announcement, errWithCode := m.processor.Admin().AnnouncementCreate(
+		c.Request.Context(),
+		authed.Account,
+		form,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, announcement)
+}
@@ -60,6 +60,16 @@
 //			This is only used if `import` is set to `true`.
 //		type: file
 //	-
+//		name: as_draft
+//		in: formData
+//		description: >-
+//			If set to `true` (the default), imported domain blocks will be created
+//			as drafts, which must be accepted by an admin before they take effect.
+//			Set to `false` to create the imported domain blocks directly.
+//			This is only used if `import` is set to `true`.
+//		type: boolean
+//		default: true
+//	-
 //		name: domain
 //		in: formData
 //		description: >-
@@ -93,7 +103,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:domain_blocks
 //
 //	responses:
 //		'200':
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DomainBlockCreateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainBlockCreateTestSuite) importDomainBlocks(domain string, extraFields map[string][]string) int {
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		testrig.StringToDataF("domains", "domains.json", `[{"domain":"`+domain+`"}]`),
+		extraFields,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), admin.DomainBlocksPath+"?import=true", w.FormDataContentType())
+	suite.adminModule.DomainBlocksPOSTHandler(ctx)
+	return recorder.Code
+}
+
+func (suite *DomainBlockCreateTestSuite) TestDomainBlocksImportDraftByDefault() {
+	var (
+		ctx    = context.Background()
+		domain = "imported-as-draft.example.org"
+	)
+
+	// Import without specifying as_draft.
+	suite.Equal(http.StatusOK, suite.importDomainBlocks(domain, nil))
+
+	// A draft should have been
+	// created, but not a block.
+	draft, err := suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(domain, draft.Domain)
+
+	_, err = suite.db.GetDomainBlock(ctx, domain)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *DomainBlockCreateTestSuite) TestDomainBlocksImportNotAsDraft() {
+	var (
+		ctx    = context.Background()
+		domain = "imported-directly.example.org"
+	)
+
+	// Opt out of drafts.
+	suite.Equal(http.StatusOK, suite.importDomainBlocks(domain, map[string][]string{
+		"as_draft": {"false"},
+	}))
+
+	// The block should be in
+	// force, without a draft.
+	block, err := suite.db.GetDomainBlock(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(domain, block.Domain)
+
+	_, err = suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, domain)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestDomainBlockCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainBlockCreateTestSuite))
+}
@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DomainLimitsPOSTHandler swagger:operation POST /api/v1/admin/domain_limits domainLimitCreate
+//
+// Create a domain limit.
+//
+// A domain limit doesn't block a domain, but limits how content from that domain
+// (and its subdomains) is shown on this instance: media can be marked sensitive,
+// a content warning can be prepended to each status, and statuses can be hidden
+// from the public and tag timelines.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- multipart/form-data
+//	- application/json
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: domain
+//		in: formData
+//		description: Domain to limit.
+//		type: string
+//		required: true
+//	-
+//		name: obfuscate
+//		in: formData
+//		description: >-
+//			Obfuscate the name of the domain when serving it publicly.
+//			Eg., `example.org` becomes something like `ex***e.org`.
+//		type: boolean
+//	-
+//		name: public_comment
+//		in: formData
+//		description: >-
+//			Public comment about this domain limit.
+//			This will be displayed alongside the domain limit if you choose to share limits.
+//		type: string
+//	-
+//		name: private_comment
+//		in: formData
+//		description: >-
+//			Private comment about this domain limit. Will only be shown to other admins, so this
+//			is a useful way of internally keeping track of why a certain domain ended up limited.
+//		type: string
+//	-
+//		name: media_sensitive
+//		in: formData
+//		description: Mark all media attached to statuses from this domain as sensitive.
+//		type: boolean
+//	-
+//		name: content_warning
+//		in: formData
+//		description: >-
+//			Content warning to prepend to all statuses from this domain.
+//			Set to an empty string to not add a content warning.
+//		type: string
+//	-
+//		name: hide_from_public
+//		in: formData
+//		description: Hide statuses from this domain from the public and tag timelines.
+//		type: boolean
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:domain_limits
+//
+//	responses:
+//		'200':
+//			description: The newly created domain limit.
+//			schema:
+//				"$ref": "#/definitions/domainPermission"
+
+// '409':
+//
+//	description: >-
+//		Conflict: There is already an admin action running that conflicts with this action.
+//		Check the error message in the response body for more information. This is a temporary
+//		error; it should be possible to process this action if you try again in a bit.
+//
+// '500':
+//
+//	description: internal server error
+func (m *Module) DomainLimitsPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.DomainLimitRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	domainLimit, _, errWithCode := m.processor.Admin().DomainLimitCreate(
+		c.Request.Context(),
+		authed.Account,
+		form,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, domainLimit)
+}
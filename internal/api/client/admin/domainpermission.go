@@ -30,6 +30,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type singleDomainPermCreate func(
@@ -48,6 +49,7 @@
 	gtsmodel.DomainPermissionType, // block/allow
 	*gtsmodel.Account, // admin account
 	*multipart.FileHeader, // domains
+	bool, // asDraft
 ) (*apimodel.MultiStatus, gtserror.WithCode)
 
 // createDomainPemissions either creates a single domain
@@ -138,6 +140,7 @@ func (m *Module) createDomainPermissions(
 		permType,
 		authed.Account,
 		form.Domains, // Pass the file through.
+		util.PtrOrValue(form.AsDraft, true),
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -166,7 +169,7 @@ func (m *Module) createDomainPermissions(
 	apiutil.JSON(c, http.StatusOK, domainPerms)
 }
 
-// deleteDomainPermission deletes a single domain permission (block or allow).
+// deleteDomainPermission deletes a single domain permission (block, allow, or limit).
 func (m *Module) deleteDomainPermission(
 	c *gin.Context,
 	permType gtsmodel.DomainPermissionType, // block/allow
@@ -213,7 +216,7 @@ func (m *Module) deleteDomainPermission(
 	apiutil.JSON(c, http.StatusOK, domainPerm)
 }
 
-// getDomainPermission gets a single domain permission (block or allow).
+// getDomainPermission gets a single domain permission (block, allow, or limit).
 func (m *Module) getDomainPermission(
 	c *gin.Context,
 	permType gtsmodel.DomainPermissionType,
@@ -261,7 +264,7 @@ func (m *Module) getDomainPermission(
 	apiutil.JSON(c, http.StatusOK, domainPerm)
 }
 
-// getDomainPermissions gets all domain permissions of the given type (block, allow).
+// getDomainPermissions gets all domain permissions of the given type (block, allow, limit).
 func (m *Module) getDomainPermissions(
 	c *gin.Context,
 	permType gtsmodel.DomainPermissionType,
@@ -168,6 +168,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
         "username": "foss_satan",
@@ -214,6 +215,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
         "username": "1happyturtle",
@@ -273,6 +275,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH17FWEB39HZJ76B6VXSKF",
         "username": "admin",
@@ -328,6 +331,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH17FWEB39HZJ76B6VXSKF",
         "username": "admin",
@@ -396,6 +400,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
         "username": "1happyturtle",
@@ -455,6 +460,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
         "username": "foss_satan",
@@ -485,6 +491,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -649,6 +656,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
         "username": "1happyturtle",
@@ -708,6 +716,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
         "username": "foss_satan",
@@ -738,6 +747,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -902,6 +912,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
         "username": "1happyturtle",
@@ -961,6 +972,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       "disabled": false,
       "silenced": false,
       "suspended": false,
+      "sensitized": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
         "username": "foss_satan",
@@ -991,6 +1003,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
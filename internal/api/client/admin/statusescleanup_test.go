@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusesCleanupTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *StatusesCleanupTestSuite) TestStatusesCleanup() {
+	// This account has no statuses
+	// and no relationships to locals.
+	testAccount := suite.testAccounts["remote_account_4"]
+
+	// set up the request
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte("{\"remote_prune_days\": 1}"), admin.StatusesCleanupPath, "application/json")
+
+	// call the handler
+	suite.adminModule.StatusesCleanupPOSTHandler(ctx)
+
+	// we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// the account should be deleted from the database
+	if !testrig.WaitFor(func() bool {
+		_, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+		return errors.Is(err, db.ErrNoEntries)
+	}) {
+		suite.FailNow("timed out waiting for account to be pruned")
+	}
+}
+
+func (suite *StatusesCleanupTestSuite) TestStatusesCleanupNoArg() {
+	testAccount := suite.testAccounts["remote_account_4"]
+
+	// set up the request
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte("{}"), admin.StatusesCleanupPath, "application/json")
+
+	// call the handler
+	suite.adminModule.StatusesCleanupPOSTHandler(ctx)
+
+	// we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// statuses-remote-prune-days
+	// is 0 in test config, so
+	// nothing should be pruned.
+	suite.Equal("0", recorder.Body.String())
+
+	// Wait for async task to finish
+	time.Sleep(1 * time.Second)
+
+	// the account should still be there
+	_, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+}
+
+func TestStatusesCleanupTestSuite(t *testing.T) {
+	suite.Run(t, &StatusesCleanupTestSuite{})
+}
@@ -0,0 +1,165 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// TrendsGETHandler swagger:operation GET /api/v1/admin/trends adminTrendsGet
+//
+// View items of the given type that have recently been trending on this instance, for review.
+//
+// Items are ordered by the number of accounts that recently used them.
+// Newly trending items are pending review, and will only be shown
+// to users via the `/api/v1/trends` endpoints once approved.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: type
+//		type: string
+//		description: Type of trending item to show.
+//		enum:
+//			- tag
+//			- status
+//			- link
+//		in: query
+//		required: true
+//	-
+//		name: state
+//		type: string
+//		description: Show only items in the given review state. If not set, items in any state are shown.
+//		enum:
+//			- pending
+//			- approved
+//			- rejected
+//		in: query
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of items to return.
+//		default: 20
+//		minimum: 1
+//		maximum: 100
+//		in: query
+//	-
+//		name: offset
+//		type: integer
+//		description: Skip the first n items.
+//		default: 0
+//		minimum: 0
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:read
+//
+//	responses:
+//		'200':
+//			description: Recently trending items.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/adminTrend"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) TrendsGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	trendTypeStr := c.Query(TrendTypeQueryKey)
+	trendType := gtsmodel.NewTrendType(trendTypeStr)
+	if trendType == gtsmodel.TrendTypeUnknown {
+		err := fmt.Errorf("type %s not recognized, valid values are: tag, status, link", trendTypeStr)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	state := gtsmodel.TrendStateUnknown
+	if stateStr := c.Query(TrendStateQueryKey); stateStr != "" {
+		state = gtsmodel.NewTrendState(stateStr)
+		if state == gtsmodel.TrendStateUnknown {
+			err := fmt.Errorf("state %s not recognized, valid values are: pending, approved, rejected", stateStr)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 20, 100, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	offset, errWithCode := apiutil.ParseTrendsOffset(c.Query(apiutil.TrendsOffsetKey), 0, 1000, 0)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	resp, errWithCode := m.processor.Admin().TrendsGet(
+		c.Request.Context(),
+		authed.Account,
+		trendType,
+		state,
+		limit,
+		offset,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp)
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// NameKey is the url key for the name of an announcement reaction.
+	NameKey = "name"
+	// BasePath is the base API path for instance announcements.
+	BasePath = "/v1/announcements"
+	// BasePathWithID is the path for a single announcement.
+	BasePathWithID = BasePath + "/:" + apiutil.IDKey
+	// DismissPath is the path for marking an announcement as read.
+	DismissPath = BasePathWithID + "/dismiss"
+	// ReactionPath is the path for adding or removing a reaction to an announcement.
+	ReactionPath = BasePathWithID + "/reactions/:" + NameKey
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeRead), m.AnnouncementsGETHandler)
+	attachHandler(http.MethodPost, DismissPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.AnnouncementDismissPOSTHandler)
+	attachHandler(http.MethodPut, ReactionPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.AnnouncementReactionPUTHandler)
+	attachHandler(http.MethodDelete, ReactionPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.AnnouncementReactionDELETEHandler)
+}
@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -49,5 +50,5 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.BlocksGETHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadBlocks), m.BlocksGETHandler)
 }
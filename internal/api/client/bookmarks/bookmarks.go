@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -40,5 +41,5 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.BookmarksGETHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadBookmarks), m.BookmarksGETHandler)
 }
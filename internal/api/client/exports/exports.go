@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -45,10 +46,10 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, StatsPath, m.ExportStatsGETHandler)
-	attachHandler(http.MethodGet, FollowingPath, m.ExportFollowingGETHandler)
-	attachHandler(http.MethodGet, FollowersPath, m.ExportFollowersGETHandler)
-	attachHandler(http.MethodGet, ListsPath, m.ExportListsGETHandler)
-	attachHandler(http.MethodGet, BlocksPath, m.ExportBlocksGETHandler)
-	attachHandler(http.MethodGet, MutesPath, m.ExportMutesGETHandler)
+	attachHandler(http.MethodGet, StatsPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.ExportStatsGETHandler)
+	attachHandler(http.MethodGet, FollowingPath, oauth.RequireScope(oauth.ScopeReadFollows), m.ExportFollowingGETHandler)
+	attachHandler(http.MethodGet, FollowersPath, oauth.RequireScope(oauth.ScopeReadFollows), m.ExportFollowersGETHandler)
+	attachHandler(http.MethodGet, ListsPath, oauth.RequireScope(oauth.ScopeReadLists), m.ExportListsGETHandler)
+	attachHandler(http.MethodGet, BlocksPath, oauth.RequireScope(oauth.ScopeReadBlocks), m.ExportBlocksGETHandler)
+	attachHandler(http.MethodGet, MutesPath, oauth.RequireScope(oauth.ScopeReadMutes), m.ExportMutesGETHandler)
 }
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package featuredtags_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+func (suite *FeaturedTagsTestSuite) featureTag(
+	accountFixtureName string,
+	name string,
+	expectedHTTPStatus int,
+) (*apimodel.FeaturedTag, error) {
+	b, err := suite.featuredTagsAction(
+		accountFixtureName,
+		http.MethodPost,
+		featuredtags.BasePath,
+		"",
+		url.Values{"name": {name}},
+		suite.featuredTagsModule.FeaturedTagPOSTHandler,
+		expectedHTTPStatus,
+	)
+	if err != nil || expectedHTTPStatus != http.StatusOK {
+		return nil, err
+	}
+
+	featuredTag := &apimodel.FeaturedTag{}
+	if err := json.Unmarshal(b, featuredTag); err != nil {
+		return nil, err
+	}
+
+	return featuredTag, nil
+}
+
+// Feature an existing tag.
+func (suite *FeaturedTagsTestSuite) TestFeatureTag() {
+	featuredTag, err := suite.featureTag("local_account_1", "#Welcome", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(featuredTag.ID)
+	suite.Equal("welcome", featuredTag.Name)
+	suite.Zero(featuredTag.StatusesCount)
+	suite.Nil(featuredTag.LastStatusAt)
+
+	featuredTags, err := suite.getFeaturedTags("local_account_1")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(featuredTags, 1) {
+		suite.Equal(featuredTag.ID, featuredTags[0].ID)
+	}
+}
+
+// Feature a tag that doesn't exist yet.
+func (suite *FeaturedTagsTestSuite) TestFeatureNewTag() {
+	featuredTag, err := suite.featureTag("local_account_1", "gotosocial", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("gotosocial", featuredTag.Name)
+	suite.Equal("http://localhost:8080/tags/gotosocial", featuredTag.URL)
+}
+
+// Featuring an already featured tag should fail.
+func (suite *FeaturedTagsTestSuite) TestFeatureTagAlreadyFeatured() {
+	if _, err := suite.featureTag("admin_account", "welcome", http.StatusUnprocessableEntity); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+// Featuring an invalid tag should fail.
+func (suite *FeaturedTagsTestSuite) TestFeatureTagInvalid() {
+	if _, err := suite.featureTag("local_account_1", "not a tag!", http.StatusUnprocessableEntity); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package featuredtags_test
+
+import (
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
+)
+
+func (suite *FeaturedTagsTestSuite) unfeatureTag(
+	accountFixtureName string,
+	id string,
+	expectedHTTPStatus int,
+) error {
+	_, err := suite.featuredTagsAction(
+		accountFixtureName,
+		http.MethodDelete,
+		featuredtags.BasePathWithID,
+		id,
+		nil,
+		suite.featuredTagsModule.FeaturedTagDELETEHandler,
+		expectedHTTPStatus,
+	)
+	return err
+}
+
+// Unfeature a featured tag.
+func (suite *FeaturedTagsTestSuite) TestUnfeatureTag() {
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	if err := suite.unfeatureTag("admin_account", testFeaturedTag.ID, http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	featuredTags, err := suite.getFeaturedTags("admin_account")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(featuredTags)
+}
+
+// Unfeaturing another account's featured tag should 404.
+func (suite *FeaturedTagsTestSuite) TestUnfeatureTagNotOwned() {
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	if err := suite.unfeatureTag("local_account_1", testFeaturedTag.ID, http.StatusNotFound); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
@@ -21,11 +21,15 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
-	BasePath = "/v1/featured_tags"
+	BasePath        = "/v1/featured_tags"
+	BasePathWithID  = BasePath + "/:" + apiutil.IDKey
+	SuggestionsPath = BasePath + "/suggestions"
 )
 
 type Module struct {
@@ -39,5 +43,8 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FeaturedTagsGETHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadAccounts), m.FeaturedTagsGETHandler)
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.FeaturedTagPOSTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteAccounts), m.FeaturedTagDELETEHandler)
+	attachHandler(http.MethodGet, SuggestionsPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.FeaturedTagSuggestionsGETHandler)
 }
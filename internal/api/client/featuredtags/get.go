@@ -30,8 +30,6 @@
 //
 // Get an array of all hashtags that you currently have featured on your profile.
 //
-// THIS ENDPOINT IS CURRENTLY NOT FULLY IMPLEMENTED: it will always return an empty array.
-//
 //	---
 //	tags:
 //	- tags
@@ -45,10 +43,11 @@
 //
 //	responses:
 //		'200':
+//			description: Array of featured tags.
 //			schema:
 //				type: array
 //				items:
-//					type: object
+//					"$ref": "#/definitions/featuredTag"
 //		'400':
 //			description: bad request
 //		'401':
@@ -60,7 +59,7 @@
 //		'500':
 //			description: internal server error
 func (m *Module) FeaturedTagsGETHandler(c *gin.Context) {
-	_, err := oauth.Authed(c, true, true, true, true)
+	authed, err := oauth.Authed(c, true, true, true, true)
 	if err != nil {
 		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
 		return
@@ -71,5 +70,11 @@ func (m *Module) FeaturedTagsGETHandler(c *gin.Context) {
 		return
 	}
 
-	apiutil.Data(c, http.StatusOK, apiutil.AppJSON, apiutil.EmptyJSONArray)
+	featuredTags, errWithCode := m.processor.Tags().FeaturedTagsGet(c.Request.Context(), authed.Account.ID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, featuredTags)
 }
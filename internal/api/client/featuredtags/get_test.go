@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package featuredtags_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+func (suite *FeaturedTagsTestSuite) getFeaturedTags(accountFixtureName string) ([]*apimodel.FeaturedTag, error) {
+	b, err := suite.featuredTagsAction(
+		accountFixtureName,
+		http.MethodGet,
+		featuredtags.BasePath,
+		"",
+		nil,
+		suite.featuredTagsModule.FeaturedTagsGETHandler,
+		http.StatusOK,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var featuredTags []*apimodel.FeaturedTag
+	if err := json.Unmarshal(b, &featuredTags); err != nil {
+		return nil, err
+	}
+
+	return featuredTags, nil
+}
+
+// Get tags featured by an account with a featured tag.
+func (suite *FeaturedTagsTestSuite) TestGetFeaturedTags() {
+	featuredTags, err := suite.getFeaturedTags("admin_account")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(featuredTags, 1) {
+		suite.FailNow("")
+	}
+
+	featuredTag := featuredTags[0]
+	suite.Equal(suite.testFeaturedTags["admin_account_welcome"].ID, featuredTag.ID)
+	suite.Equal("welcome", featuredTag.Name)
+	suite.Equal("http://localhost:8080/tags/welcome", featuredTag.URL)
+	suite.Equal(1, featuredTag.StatusesCount)
+	if suite.NotNil(featuredTag.LastStatusAt) {
+		suite.Equal("2021-10-20", *featuredTag.LastStatusAt)
+	}
+}
+
+// Get tags featured by an account without featured tags.
+func (suite *FeaturedTagsTestSuite) TestGetFeaturedTagsEmpty() {
+	featuredTags, err := suite.getFeaturedTags("local_account_1")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(featuredTags)
+}
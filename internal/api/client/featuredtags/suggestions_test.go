@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package featuredtags_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+func (suite *FeaturedTagsTestSuite) getSuggestions(accountFixtureName string) ([]*apimodel.Tag, error) {
+	b, err := suite.featuredTagsAction(
+		accountFixtureName,
+		http.MethodGet,
+		featuredtags.SuggestionsPath,
+		"",
+		nil,
+		suite.featuredTagsModule.FeaturedTagSuggestionsGETHandler,
+		http.StatusOK,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*apimodel.Tag
+	if err := json.Unmarshal(b, &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// Suggestions should only include used tags that aren't yet featured.
+func (suite *FeaturedTagsTestSuite) TestGetSuggestions() {
+	// Admin account's only used tag is already featured.
+	tags, err := suite.getSuggestions("admin_account")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(tags)
+
+	// Unfeature it, it should now be suggested.
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+	if err := suite.unfeatureTag("admin_account", testFeaturedTag.ID, http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tags, err = suite.getSuggestions("admin_account")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(tags, 1) {
+		suite.Equal("welcome", tags[0].Name)
+	}
+}
@@ -20,6 +20,7 @@
 import (
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"net/http"
 )
@@ -43,9 +44,9 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FiltersGETHandler)
-	attachHandler(http.MethodPost, BasePath, m.FilterPOSTHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.FilterGETHandler)
-	attachHandler(http.MethodPut, BasePathWithID, m.FilterPUTHandler)
-	attachHandler(http.MethodDelete, BasePathWithID, m.FilterDELETEHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadFilters), m.FiltersGETHandler)
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadFilters), m.FilterGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterPUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterDELETEHandler)
 }
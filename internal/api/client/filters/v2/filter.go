@@ -22,6 +22,7 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -58,23 +59,23 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FiltersGETHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadFilters), m.FiltersGETHandler)
 
-	attachHandler(http.MethodPost, BasePath, m.FilterPOSTHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.FilterGETHandler)
-	attachHandler(http.MethodPut, BasePathWithID, m.FilterPUTHandler)
-	attachHandler(http.MethodDelete, BasePathWithID, m.FilterDELETEHandler)
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadFilters), m.FilterGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterPUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterDELETEHandler)
 
-	attachHandler(http.MethodGet, FilterKeywordsPathWithID, m.FilterKeywordsGETHandler)
-	attachHandler(http.MethodPost, FilterKeywordsPathWithID, m.FilterKeywordPOSTHandler)
+	attachHandler(http.MethodGet, FilterKeywordsPathWithID, oauth.RequireScope(oauth.ScopeReadFilters), m.FilterKeywordsGETHandler)
+	attachHandler(http.MethodPost, FilterKeywordsPathWithID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterKeywordPOSTHandler)
 
-	attachHandler(http.MethodGet, KeywordPathWithKeywordID, m.FilterKeywordGETHandler)
-	attachHandler(http.MethodPut, KeywordPathWithKeywordID, m.FilterKeywordPUTHandler)
-	attachHandler(http.MethodDelete, KeywordPathWithKeywordID, m.FilterKeywordDELETEHandler)
+	attachHandler(http.MethodGet, KeywordPathWithKeywordID, oauth.RequireScope(oauth.ScopeReadFilters), m.FilterKeywordGETHandler)
+	attachHandler(http.MethodPut, KeywordPathWithKeywordID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterKeywordPUTHandler)
+	attachHandler(http.MethodDelete, KeywordPathWithKeywordID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterKeywordDELETEHandler)
 
-	attachHandler(http.MethodGet, FilterStatusesPathWithID, m.FilterStatusesGETHandler)
-	attachHandler(http.MethodPost, FilterStatusesPathWithID, m.FilterStatusPOSTHandler)
+	attachHandler(http.MethodGet, FilterStatusesPathWithID, oauth.RequireScope(oauth.ScopeReadFilters), m.FilterStatusesGETHandler)
+	attachHandler(http.MethodPost, FilterStatusesPathWithID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterStatusPOSTHandler)
 
-	attachHandler(http.MethodGet, StatusPathWithStatusID, m.FilterStatusGETHandler)
-	attachHandler(http.MethodDelete, StatusPathWithStatusID, m.FilterStatusDELETEHandler)
+	attachHandler(http.MethodGet, StatusPathWithStatusID, oauth.RequireScope(oauth.ScopeReadFilters), m.FilterStatusGETHandler)
+	attachHandler(http.MethodDelete, StatusPathWithStatusID, oauth.RequireScope(oauth.ScopeWriteFilters), m.FilterStatusDELETEHandler)
 }
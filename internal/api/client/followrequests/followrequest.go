@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -49,7 +50,7 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FollowRequestGETHandler)
-	attachHandler(http.MethodPost, AuthorizePath, m.FollowRequestAuthorizePOSTHandler)
-	attachHandler(http.MethodPost, RejectPath, m.FollowRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadFollows), m.FollowRequestGETHandler)
+	attachHandler(http.MethodPost, AuthorizePath, oauth.RequireScope(oauth.ScopeWriteFollows), m.FollowRequestAuthorizePOSTHandler)
+	attachHandler(http.MethodPost, RejectPath, oauth.RequireScope(oauth.ScopeWriteFollows), m.FollowRequestRejectPOSTHandler)
 }
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base API path for moderating local groups.
+	BasePath = "/v1/groups"
+	// BasePathWithID is the path for a single group.
+	BasePathWithID = BasePath + "/:" + apiutil.IDKey
+	// MembersPath is the path for a group's members.
+	MembersPath = BasePathWithID + "/members"
+	// MembersPathWithAccountID is the path for a single member of a group.
+	MembersPathWithAccountID = MembersPath + "/:" + apiutil.AccountIDKey
+	// RequestsPath is the path for pending requests to join a group.
+	RequestsPath = BasePathWithID + "/requests"
+	// RequestsAuthorizePath is the path for accepting a request to join a group.
+	RequestsAuthorizePath = RequestsPath + "/:" + apiutil.AccountIDKey + "/authorize"
+	// RequestsRejectPath is the path for rejecting a request to join a group.
+	RequestsRejectPath = RequestsPath + "/:" + apiutil.AccountIDKey + "/reject"
+	// BansPath is the path for accounts banned from a group.
+	BansPath = BasePathWithID + "/bans"
+	// BansPathWithAccountID is the path for a single ban from a group.
+	BansPathWithAccountID = BansPath + "/:" + apiutil.AccountIDKey
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadAccounts), m.GroupsGETHandler)
+	attachHandler(http.MethodGet, MembersPath, oauth.RequireScope(oauth.ScopeReadFollows), m.GroupMembersGETHandler)
+	attachHandler(http.MethodDelete, MembersPathWithAccountID, oauth.RequireScope(oauth.ScopeWriteFollows), m.GroupMemberDELETEHandler)
+	attachHandler(http.MethodGet, RequestsPath, oauth.RequireScope(oauth.ScopeReadFollows), m.GroupRequestsGETHandler)
+	attachHandler(http.MethodPost, RequestsAuthorizePath, oauth.RequireScope(oauth.ScopeWriteFollows), m.GroupRequestAuthorizePOSTHandler)
+	attachHandler(http.MethodPost, RequestsRejectPath, oauth.RequireScope(oauth.ScopeWriteFollows), m.GroupRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, BansPath, oauth.RequireScope(oauth.ScopeReadBlocks), m.GroupBansGETHandler)
+	attachHandler(http.MethodPost, BansPath, oauth.RequireScope(oauth.ScopeWriteBlocks), m.GroupBanPOSTHandler)
+	attachHandler(http.MethodDelete, BansPathWithAccountID, oauth.RequireScope(oauth.ScopeWriteBlocks), m.GroupBanDELETEHandler)
+}
@@ -33,12 +33,17 @@
 )
 
 const (
-	BasePath = "/v1/import"
+	BasePath       = "/v1/import"
+	BasePathWithID = BasePath + "/:" + apiutil.IDKey
+	FailuresPath   = BasePathWithID + "/failures.csv"
 )
 
 var types = []string{
 	"following",
 	"blocks",
+	"mutes",
+	"lists",
+	"bookmarks",
 }
 
 var modes = []string{
@@ -57,7 +62,9 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodPost, BasePath, m.ImportPOSTHandler)
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.ImportPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadAccounts), m.ImportGETHandler)
+	attachHandler(http.MethodGet, FailuresPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.ImportFailuresGETHandler)
 }
 
 // ImportPOSTHandler swagger:operation POST /api/v1/import importData
@@ -69,6 +76,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 // Uploaded data will be processed asynchronously, and not all entries may be processed depending
 // on domain blocks, user-level blocks, network availability of referenced accounts and statuses, etc.
 //
+// The returned import job can be polled at /api/v1/import/{id} to check progress,
+// and rows that failed to import can be downloaded from /api/v1/import/{id}/failures.csv.
+//
 //	---
 //	tags:
 //	- import-export
@@ -94,6 +104,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //
 //			- `following` - accounts to follow.
 //			- `blocks` - accounts to block.
+//			- `mutes` - accounts to mute.
+//			- `lists` - lists of accounts; accounts not yet followed will be followed.
+//			- `bookmarks` - statuses to bookmark.
 //		type: string
 //		required: true
 //	-
@@ -114,6 +127,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //	responses:
 //		'202':
 //			description: Upload accepted.
+//			schema:
+//				"$ref": "#/definitions/importJob"
 //		'400':
 //			description: bad request
 //		'401':
@@ -179,7 +194,7 @@ func (m *Module) ImportPOSTHandler(c *gin.Context) {
 	overwrite := form.Mode == "overwrite"
 
 	// Trigger the import.
-	errWithCode := m.processor.Account().ImportData(
+	job, errWithCode := m.processor.Account().ImportData(
 		c.Request.Context(),
 		authed.Account,
 		form.Data,
@@ -191,5 +206,5 @@ func (m *Module) ImportPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiutil.JSON(c, http.StatusAccepted, gin.H{"status": "accepted"})
+	apiutil.JSON(c, http.StatusAccepted, job)
 }
@@ -20,6 +20,8 @@
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -27,11 +29,16 @@
 
 	"github.com/stretchr/testify/suite"
 	importdata "github.com/superseriousbusiness/gotosocial/internal/api/client/import"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -46,8 +53,10 @@ type ImportTestSuite struct {
 	testApplications map[string]*gtsmodel.Application
 	testUsers        map[string]*gtsmodel.User
 	testAccounts     map[string]*gtsmodel.Account
+	testStatuses     map[string]*gtsmodel.Status
 
 	// module being tested
+	processor    *processing.Processor
 	importModule *importdata.Module
 }
 
@@ -57,6 +66,7 @@ func (suite *ImportTestSuite) SetupSuite() {
 	suite.testApplications = testrig.NewTestApplications()
 	suite.testUsers = testrig.NewTestUsers()
 	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
 }
 
 func (suite *ImportTestSuite) SetupTest() {
@@ -96,6 +106,7 @@ func (suite *ImportTestSuite) SetupTest() {
 	)
 	testrig.StartWorkers(&suite.state, processor.Workers())
 
+	suite.processor = processor
 	suite.importModule = importdata.New(processor)
 }
 
@@ -103,7 +114,7 @@ func (suite *ImportTestSuite) TriggerHandler(
 	importData string,
 	importType string,
 	importMode string,
-) {
+) *apimodel.ImportJob {
 	// Set up request.
 	recorder := httptest.NewRecorder()
 	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
@@ -142,6 +153,35 @@ func (suite *ImportTestSuite) TriggerHandler(
 		}
 		suite.FailNow("", "expected 202, got %d: %s", code, string(b))
 	}
+
+	job := new(apimodel.ImportJob)
+	if err := json.NewDecoder(recorder.Body).Decode(job); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return job
+}
+
+func (suite *ImportTestSuite) WaitForJob(jobID string) *apimodel.ImportJob {
+	var job *apimodel.ImportJob
+
+	if !testrig.WaitFor(func() bool {
+		var errWithCode gtserror.WithCode
+		job, errWithCode = suite.processor.Account().ImportJobGet(
+			context.Background(),
+			suite.testAccounts["local_account_1"],
+			jobID,
+		)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		return job.State == "finished" || job.State == "failed"
+	}) {
+		suite.FailNow("timed out waiting for import job to finish")
+	}
+
+	return job
 }
 
 func (suite *ImportTestSuite) TearDownTest() {
@@ -205,6 +245,217 @@ func (suite *ImportTestSuite) TestImportFollows() {
 	}
 }
 
+func (suite *ImportTestSuite) TestImportMutes() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Have zork mute admin, without
+	// hiding notifications from admin.
+	data := `Account address,Hide notifications
+admin@localhost:8080,false
+`
+
+	// Trigger the import handler.
+	suite.TriggerHandler(data, "mutes", "merge")
+
+	// Wait for zork to
+	// be muting admin.
+	var mute *gtsmodel.UserMute
+	if !testrig.WaitFor(func() bool {
+		var err error
+		mute, err = suite.state.DB.GetMute(
+			ctx,
+			testAccount.ID,
+			suite.testAccounts["admin_account"].ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+
+		return mute != nil
+	}) {
+		suite.FailNow("timed out waiting for zork to mute admin")
+	}
+
+	suite.False(*mute.Notifications)
+}
+
+func (suite *ImportTestSuite) TestImportListsOverwrite() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		adminID     = suite.testAccounts["admin_account"].ID
+	)
+
+	// Replace zork's existing list with
+	// a new one containing only admin.
+	data := `Imported list,admin@localhost:8080
+`
+
+	// Trigger the import handler.
+	suite.TriggerHandler(data, "lists", "overwrite")
+
+	// Wait for zork to have only
+	// the new list, containing admin.
+	if !testrig.WaitFor(func() bool {
+		lists, err := suite.state.DB.GetListsByAccountID(ctx, testAccount.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		if len(lists) != 1 || lists[0].Title != "Imported list" {
+			return false
+		}
+
+		in, err := suite.state.DB.IsAccountInList(ctx, lists[0].ID, adminID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		return in
+	}) {
+		suite.FailNow("timed out waiting for zork's lists to be overwritten")
+	}
+}
+
+func (suite *ImportTestSuite) TestImportBookmarksOverwrite() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		newStatus   = suite.testStatuses["local_account_2_status_1"]
+		oldStatus   = suite.testStatuses["admin_account_status_1"]
+	)
+
+	// Replace zork's existing bookmark
+	// with a bookmark of turtle's status.
+	data := newStatus.URI + "\n"
+
+	// Trigger the import handler.
+	suite.TriggerHandler(data, "bookmarks", "overwrite")
+
+	// Wait for zork to have bookmarked
+	// turtle's status, and for the old
+	// bookmark to be removed.
+	if !testrig.WaitFor(func() bool {
+		bookmarked, err := suite.state.DB.IsStatusBookmarkedBy(ctx, testAccount.ID, newStatus.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		stillBookmarked, err := suite.state.DB.IsStatusBookmarkedBy(ctx, testAccount.ID, oldStatus.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		return bookmarked && !stillBookmarked
+	}) {
+		suite.FailNow("timed out waiting for zork's bookmarks to be overwritten")
+	}
+}
+
+func (suite *ImportTestSuite) TestImportJobFailures() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Have zork block admin, with a
+	// badly formatted row in the middle.
+	data := `admin@localhost:8080
+not an account address!
+`
+
+	// Trigger the import handler.
+	job := suite.TriggerHandler(data, "blocks", "merge")
+	suite.Equal("blocks", job.Type)
+	suite.Equal("merge", job.Mode)
+	suite.Equal(2, job.TotalRows)
+
+	// Wait for the job to finish.
+	job = suite.WaitForJob(job.ID)
+	suite.Equal(2, job.ProcessedRows)
+	suite.Equal(1, job.Successes)
+	suite.Equal(1, job.Failures)
+	suite.Equal([]apimodel.ImportJobFailure{{
+		Row:    2,
+		Reason: "badly formatted row",
+	}}, job.FailedRows)
+	suite.NotNil(job.FinishedAt)
+
+	// Zork should now be blocking admin.
+	blocking, err := suite.state.DB.IsBlocked(
+		ctx,
+		testAccount.ID,
+		suite.testAccounts["admin_account"].ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(blocking)
+
+	// Failed rows should be downloadable as they were uploaded.
+	records, errWithCode := suite.processor.Account().ImportJobFailuresGet(ctx, testAccount, job.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal([][]string{{"not an account address!"}}, records)
+
+	// Other accounts shouldn't be able to see the job.
+	_, errWithCode = suite.processor.Account().ImportJobGet(ctx, suite.testAccounts["admin_account"], job.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *ImportTestSuite) TestImportJobFailed() {
+	ctx := context.Background()
+
+	// Put a job in the database with data
+	// that can't be parsed, as though it
+	// was persisted across a restart.
+	job := &gtsmodel.ImportJob{
+		ID:        "01JD2K7QZ3T4X8S6V0R9W1Y5BN",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+		Type:      "blocks",
+		Overwrite: util.Ptr(false),
+		Data:      []byte("\"admin@localhost:8080\n"),
+		State:     gtsmodel.ImportJobStateProcessing,
+		TotalRows: 1,
+	}
+	if err := suite.state.DB.PutImportJob(ctx, job); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the job.
+	suite.processor.Account().QueueImportJob(job)
+
+	// Job should end up failed, not stuck processing.
+	apiJob := suite.WaitForJob(job.ID)
+	suite.Equal("failed", apiJob.State)
+	suite.NotNil(apiJob.FinishedAt)
+	if suite.NotNil(apiJob.Error) {
+		suite.Contains(*apiJob.Error, "error parsing import data")
+	}
+
+	// Failed job should not be persisted
+	// to be restarted on next startup.
+	jobs, err := suite.state.DB.GetUnfinishedImportJobs(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(jobs)
+
+	if err := suite.processor.Admin().PersistWorkerQueues(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tasks, err := suite.state.DB.GetWorkerTasks(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(tasks)
+}
+
 func TestImportTestSuite(t *testing.T) {
 	suite.Run(t, new(ImportTestSuite))
 }
@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -40,6 +41,6 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, DefaultsPath, m.PoliciesDefaultsGETHandler)
-	attachHandler(http.MethodPatch, DefaultsPath, m.PoliciesDefaultsPATCHHandler)
+	attachHandler(http.MethodGet, DefaultsPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.PoliciesDefaultsGETHandler)
+	attachHandler(http.MethodPatch, DefaultsPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.PoliciesDefaultsPATCHHandler)
 }
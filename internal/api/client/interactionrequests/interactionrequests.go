@@ -22,6 +22,7 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -43,8 +44,8 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.InteractionRequestsGETHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.InteractionRequestGETHandler)
-	attachHandler(http.MethodPost, AuthorizePath, m.InteractionRequestAuthorizePOSTHandler)
-	attachHandler(http.MethodPost, RejectPath, m.InteractionRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadNotifications), m.InteractionRequestsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadNotifications), m.InteractionRequestGETHandler)
+	attachHandler(http.MethodPost, AuthorizePath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.InteractionRequestAuthorizePOSTHandler)
+	attachHandler(http.MethodPost, RejectPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.InteractionRequestRejectPOSTHandler)
 }
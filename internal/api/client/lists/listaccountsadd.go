@@ -66,7 +66,7 @@
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:lists
+//		- write:lists
 //
 //	responses:
 //		'200':
@@ -22,6 +22,7 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -42,7 +43,7 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodPost, BasePath, m.MediaCreatePOSTHandler)
-	attachHandler(http.MethodGet, AttachmentWithID, m.MediaGETHandler)
-	attachHandler(http.MethodPut, AttachmentWithID, m.MediaPUTHandler)
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteMedia), m.MediaCreatePOSTHandler)
+	attachHandler(http.MethodGet, AttachmentWithID, oauth.RequireScope(oauth.ScopeReadMedia), m.MediaGETHandler)
+	attachHandler(http.MethodPut, AttachmentWithID, oauth.RequireScope(oauth.ScopeWriteMedia), m.MediaPUTHandler)
 }
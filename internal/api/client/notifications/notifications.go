@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -55,7 +56,7 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.NotificationGETHandler)
-	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadNotifications), m.NotificationsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadNotifications), m.NotificationGETHandler)
+	attachHandler(http.MethodPost, BasePathWithClear, oauth.RequireScope(oauth.ScopeWriteNotifications), m.NotificationsClearPOSTHandler)
 }
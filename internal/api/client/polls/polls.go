@@ -22,6 +22,7 @@
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -43,6 +44,6 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, PollWithID, m.PollGETHandler)
-	attachHandler(http.MethodPost, PollVotesWithID, m.PollVotePOSTHandler)
+	attachHandler(http.MethodGet, PollWithID, oauth.RequireScope(oauth.ScopeReadStatuses), m.PollGETHandler)
+	attachHandler(http.MethodPost, PollVotesWithID, oauth.RequireScope(oauth.ScopeWriteStatuses), m.PollVotePOSTHandler)
 }
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// BasePath is the base path for serving the push API, minus the 'api' prefix.
+	BasePath = "/v1/push"
+	// SubscriptionPath is the path for serving the push subscription of the current token.
+	SubscriptionPath = BasePath + "/subscription"
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, SubscriptionPath, oauth.RequireScope(oauth.ScopePush), m.PushSubscriptionGETHandler)
+	attachHandler(http.MethodPost, SubscriptionPath, oauth.RequireScope(oauth.ScopePush), m.PushSubscriptionPOSTHandler)
+	attachHandler(http.MethodPut, SubscriptionPath, oauth.RequireScope(oauth.ScopePush), m.PushSubscriptionPUTHandler)
+	attachHandler(http.MethodDelete, SubscriptionPath, oauth.RequireScope(oauth.ScopePush), m.PushSubscriptionDELETEHandler)
+}
+
+// normalizeData populates the JSON shape of the "data" part
+// of a push subscription request from its form fields, if
+// the request was not made with a JSON body.
+func normalizeData(form *apimodel.PushSubscriptionRequestDataForm) {
+	if form.Data != nil {
+		return
+	}
+
+	alerts := []*bool{
+		form.DataAlertsFollow,
+		form.DataAlertsFollowRequest,
+		form.DataAlertsFavourite,
+		form.DataAlertsMention,
+		form.DataAlertsReblog,
+		form.DataAlertsPoll,
+		form.DataAlertsStatus,
+		form.DataAlertsAdminSignup,
+		form.DataAlertsPendingFavourite,
+		form.DataAlertsPendingReply,
+		form.DataAlertsPendingReblog,
+	}
+
+	data := &apimodel.PushSubscriptionRequestData{
+		Policy: form.DataPolicy,
+	}
+
+	if slices.ContainsFunc(alerts, func(b *bool) bool { return b != nil }) {
+		data.Alerts = &apimodel.PushSubscriptionAlerts{
+			Follow:           util.PtrOrZero(form.DataAlertsFollow),
+			FollowRequest:    util.PtrOrZero(form.DataAlertsFollowRequest),
+			Favourite:        util.PtrOrZero(form.DataAlertsFavourite),
+			Mention:          util.PtrOrZero(form.DataAlertsMention),
+			Reblog:           util.PtrOrZero(form.DataAlertsReblog),
+			Poll:             util.PtrOrZero(form.DataAlertsPoll),
+			Status:           util.PtrOrZero(form.DataAlertsStatus),
+			AdminSignup:      util.PtrOrZero(form.DataAlertsAdminSignup),
+			PendingFavourite: util.PtrOrZero(form.DataAlertsPendingFavourite),
+			PendingReply:     util.PtrOrZero(form.DataAlertsPendingReply),
+			PendingReblog:    util.PtrOrZero(form.DataAlertsPendingReblog),
+		}
+	}
+
+	form.Data = data
+}
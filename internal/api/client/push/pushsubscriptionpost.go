@@ -0,0 +1,239 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// PushSubscriptionPOSTHandler swagger:operation POST /api/v1/push/subscription pushSubscriptionPost
+//
+// Create a Web Push subscription for the current access token.
+//
+// Any existing Web Push subscription of the current access token will be replaced.
+// Alerts which are not set to true will be disabled.
+//
+// Pushed messages are encrypted as per RFC 8291, and authorized using the
+// instance VAPID key (RFC 8292), which is available as `server_key` in the
+// response, `vapid_key` on application creation, and `configuration.vapid.public_key`
+// in the v2 instance response.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// In that case, the form field names correspond to nested JSON objects, eg., `{"subscription":{"endpoint":"..."}}`.
+//
+//	---
+//	tags:
+//	- push
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: subscription[endpoint]
+//		type: string
+//		description: Endpoint URL of the push service, as provided by the user agent.
+//		in: formData
+//		required: true
+//	-
+//		name: subscription[keys][p256dh]
+//		type: string
+//		description: Base64url-encoded P-256 ECDH public key of the user agent.
+//		in: formData
+//		required: true
+//	-
+//		name: subscription[keys][auth]
+//		type: string
+//		description: Base64url-encoded authentication secret of the user agent.
+//		in: formData
+//		required: true
+//	-
+//		name: data[alerts][follow]
+//		type: boolean
+//		description: Receive a push notification when someone followed you.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][follow_request]
+//		type: boolean
+//		description: Receive a push notification when someone requested to follow you.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][favourite]
+//		type: boolean
+//		description: Receive a push notification when one of your statuses was favourited.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][mention]
+//		type: boolean
+//		description: Receive a push notification when someone mentioned you.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][reblog]
+//		type: boolean
+//		description: Receive a push notification when one of your statuses was boosted.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][poll]
+//		type: boolean
+//		description: Receive a push notification when a poll you voted in or created has ended.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][status]
+//		type: boolean
+//		description: Receive a push notification when an account you enabled notifications for posted a status.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][admin.sign_up]
+//		type: boolean
+//		description: Receive a push notification when a new user signed up (admins and moderators only).
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][pending.favourite]
+//		type: boolean
+//		description: Receive a push notification when a fave of one of your statuses is pending your approval.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][pending.reply]
+//		type: boolean
+//		description: Receive a push notification when a reply to one of your statuses is pending your approval.
+//		in: formData
+//		default: false
+//	-
+//		name: data[alerts][pending.reblog]
+//		type: boolean
+//		description: Receive a push notification when a boost of one of your statuses is pending your approval.
+//		in: formData
+//		default: false
+//	-
+//		name: data[policy]
+//		type: string
+//		description: |-
+//			Which accounts to receive push notifications from.
+//			One of "all", "followed" (accounts you follow), "follower" (accounts that follow you), or "none".
+//		in: formData
+//		default: all
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- push
+//
+//	responses:
+//		'200':
+//			description: The newly created Web Push subscription.
+//			schema:
+//				"$ref": "#/definitions/webPushSubscription"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: unprocessable entity, invalid endpoint, keys, or policy
+//		'500':
+//			description: internal server error
+func (m *Module) PushSubscriptionPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.PushSubscriptionCreateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := normalizeCreate(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	subscription, errWithCode := m.processor.Push().CreateOrReplace(
+		c.Request.Context(),
+		authed.Account,
+		authed.Token.GetAccess(),
+		form,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, subscription)
+}
+
+// normalizeCreate populates the JSON shape of a push subscription
+// create request from its form fields, and checks required fields.
+func normalizeCreate(form *apimodel.PushSubscriptionCreateRequest) error {
+	if form.Subscription == nil {
+		form.Subscription = &apimodel.PushSubscriptionRequestSubscription{
+			Endpoint: util.PtrOrZero(form.SubscriptionEndpoint),
+			Keys: &apimodel.PushSubscriptionKeys{
+				Auth:   util.PtrOrZero(form.SubscriptionKeysAuth),
+				P256dh: util.PtrOrZero(form.SubscriptionKeysP256dh),
+			},
+		}
+	}
+
+	switch sub := form.Subscription; {
+	case sub.Endpoint == "":
+		return errors.New("subscription[endpoint] must be set")
+	case sub.Keys == nil || sub.Keys.P256dh == "":
+		return errors.New("subscription[keys][p256dh] must be set")
+	case sub.Keys.Auth == "":
+		return errors.New("subscription[keys][auth] must be set")
+	}
+
+	normalizeData(&form.PushSubscriptionRequestDataForm)
+	return nil
+}
@@ -0,0 +1,205 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/push"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const (
+	testEndpoint = "https://push.example.org/send/abcdef"
+	testP256dh   = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	testAuth     = "BTBZMqHH6r4Tts7J_aSIgg"
+)
+
+type PushSubscriptionPostTestSuite struct {
+	PushStandardTestSuite
+}
+
+func (suite *PushSubscriptionPostTestSuite) request(
+	method string,
+	contentType string,
+	body io.Reader,
+	handler func(*gin.Context),
+	expectedHTTPStatus int,
+) *apimodel.PushSubscription {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+push.SubscriptionPath, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if contentType != "" {
+		ctx.Request.Header.Set("content-type", contentType)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Equal(expectedHTTPStatus, recorder.Code, string(b)) ||
+		expectedHTTPStatus != http.StatusOK {
+		return nil
+	}
+
+	resp := &apimodel.PushSubscription{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return resp
+}
+
+func (suite *PushSubscriptionPostTestSuite) TestPostForm() {
+	form := url.Values{
+		"subscription[endpoint]":       {testEndpoint},
+		"subscription[keys][p256dh]":   {testP256dh},
+		"subscription[keys][auth]":     {testAuth},
+		"data[alerts][mention]":        {"true"},
+		"data[alerts][follow_request]": {"true"},
+		"data[policy]":                 {"follower"},
+	}
+
+	sub := suite.request(
+		http.MethodPost,
+		"application/x-www-form-urlencoded",
+		strings.NewReader(form.Encode()),
+		suite.pushModule.PushSubscriptionPOSTHandler,
+		http.StatusOK,
+	)
+	suite.Equal(testEndpoint, sub.Endpoint)
+	suite.Equal("follower", sub.Policy)
+	suite.Equal(testrig.NewTestVAPIDKeyPair().PublicKey, sub.ServerKey)
+	suite.True(sub.Alerts.Mention)
+	suite.True(sub.Alerts.FollowRequest)
+	suite.False(sub.Alerts.Follow)
+
+	// Subscription should now be gettable.
+	got := suite.request(
+		http.MethodGet,
+		"",
+		nil,
+		suite.pushModule.PushSubscriptionGETHandler,
+		http.StatusOK,
+	)
+	suite.Equal(sub, got)
+}
+
+func (suite *PushSubscriptionPostTestSuite) TestPostJSONThenUpdate() {
+	b, err := json.Marshal(map[string]any{
+		"subscription": map[string]any{
+			"endpoint": testEndpoint,
+			"keys": map[string]any{
+				"p256dh": testP256dh,
+				"auth":   testAuth,
+			},
+		},
+		"data": map[string]any{
+			"alerts": map[string]any{
+				"favourite": true,
+			},
+		},
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	sub := suite.request(
+		http.MethodPost,
+		"application/json",
+		bytes.NewReader(b),
+		suite.pushModule.PushSubscriptionPOSTHandler,
+		http.StatusOK,
+	)
+	suite.Equal("all", sub.Policy)
+	suite.True(sub.Alerts.Favourite)
+	suite.False(sub.Alerts.Mention)
+
+	// Update only the policy; alerts stay as they were.
+	sub = suite.request(
+		http.MethodPut,
+		"application/x-www-form-urlencoded",
+		strings.NewReader(url.Values{"data[policy]": {"none"}}.Encode()),
+		suite.pushModule.PushSubscriptionPUTHandler,
+		http.StatusOK,
+	)
+	suite.Equal("none", sub.Policy)
+	suite.True(sub.Alerts.Favourite)
+
+	// Delete, after which get should 404.
+	suite.request(
+		http.MethodDelete,
+		"",
+		nil,
+		suite.pushModule.PushSubscriptionDELETEHandler,
+		http.StatusOK,
+	)
+	suite.request(
+		http.MethodGet,
+		"",
+		nil,
+		suite.pushModule.PushSubscriptionGETHandler,
+		http.StatusNotFound,
+	)
+}
+
+func (suite *PushSubscriptionPostTestSuite) TestPostMissingKeys() {
+	form := url.Values{
+		"subscription[endpoint]": {testEndpoint},
+	}
+
+	suite.request(
+		http.MethodPost,
+		"application/x-www-form-urlencoded",
+		strings.NewReader(form.Encode()),
+		suite.pushModule.PushSubscriptionPOSTHandler,
+		http.StatusBadRequest,
+	)
+}
+
+func TestPushSubscriptionPostTestSuite(t *testing.T) {
+	suite.Run(t, new(PushSubscriptionPostTestSuite))
+}
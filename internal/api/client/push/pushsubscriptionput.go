@@ -0,0 +1,174 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// PushSubscriptionPUTHandler swagger:operation PUT /api/v1/push/subscription pushSubscriptionPut
+//
+// Update the alerts and / or policy of the Web Push subscription of the current access token.
+//
+// If any alert is given, alerts which are not set to true will be disabled.
+// If no alerts are given, the existing alerts will be left unchanged.
+// If no policy is given, the existing policy will be left unchanged.
+//
+// The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
+// In that case, the form field names correspond to nested JSON objects, eg., `{"data":{"alerts":{"mention":true}}}`.
+//
+//	---
+//	tags:
+//	- push
+//
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: data[alerts][follow]
+//		type: boolean
+//		description: Receive a push notification when someone followed you.
+//		in: formData
+//	-
+//		name: data[alerts][follow_request]
+//		type: boolean
+//		description: Receive a push notification when someone requested to follow you.
+//		in: formData
+//	-
+//		name: data[alerts][favourite]
+//		type: boolean
+//		description: Receive a push notification when one of your statuses was favourited.
+//		in: formData
+//	-
+//		name: data[alerts][mention]
+//		type: boolean
+//		description: Receive a push notification when someone mentioned you.
+//		in: formData
+//	-
+//		name: data[alerts][reblog]
+//		type: boolean
+//		description: Receive a push notification when one of your statuses was boosted.
+//		in: formData
+//	-
+//		name: data[alerts][poll]
+//		type: boolean
+//		description: Receive a push notification when a poll you voted in or created has ended.
+//		in: formData
+//	-
+//		name: data[alerts][status]
+//		type: boolean
+//		description: Receive a push notification when an account you enabled notifications for posted a status.
+//		in: formData
+//	-
+//		name: data[alerts][admin.sign_up]
+//		type: boolean
+//		description: Receive a push notification when a new user signed up (admins and moderators only).
+//		in: formData
+//	-
+//		name: data[alerts][pending.favourite]
+//		type: boolean
+//		description: Receive a push notification when a fave of one of your statuses is pending your approval.
+//		in: formData
+//	-
+//		name: data[alerts][pending.reply]
+//		type: boolean
+//		description: Receive a push notification when a reply to one of your statuses is pending your approval.
+//		in: formData
+//	-
+//		name: data[alerts][pending.reblog]
+//		type: boolean
+//		description: Receive a push notification when a boost of one of your statuses is pending your approval.
+//		in: formData
+//	-
+//		name: data[policy]
+//		type: string
+//		description: |-
+//			Which accounts to receive push notifications from.
+//			One of "all", "followed" (accounts you follow), "follower" (accounts that follow you), or "none".
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- push
+//
+//	responses:
+//		'200':
+//			description: The updated Web Push subscription.
+//			schema:
+//				"$ref": "#/definitions/webPushSubscription"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found, the current access token has no Web Push subscription
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: unprocessable entity, invalid policy
+//		'500':
+//			description: internal server error
+func (m *Module) PushSubscriptionPUTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if authed.Account.IsMoving() {
+		apiutil.ForbiddenAfterMove(c)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.PushSubscriptionUpdateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+	normalizeData(&form.PushSubscriptionRequestDataForm)
+
+	subscription, errWithCode := m.processor.Push().Update(
+		c.Request.Context(),
+		authed.Token.GetAccess(),
+		form,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, subscription)
+}
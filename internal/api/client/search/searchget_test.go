@@ -916,7 +916,7 @@ func (suite *SearchGetTestSuite) TestSearchAAny() {
 	}
 
 	suite.Len(searchResult.Accounts, 5)
-	suite.Len(searchResult.Statuses, 7)
+	suite.Len(searchResult.Statuses, 10)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -959,7 +959,7 @@ func (suite *SearchGetTestSuite) TestSearchAAnyFollowingOnly() {
 	}
 
 	suite.Len(searchResult.Accounts, 2)
-	suite.Len(searchResult.Statuses, 7)
+	suite.Len(searchResult.Statuses, 10)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -1002,7 +1002,7 @@ func (suite *SearchGetTestSuite) TestSearchAStatuses() {
 	}
 
 	suite.Len(searchResult.Accounts, 0)
-	suite.Len(searchResult.Statuses, 7)
+	suite.Len(searchResult.Statuses, 10)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -1045,7 +1045,7 @@ func (suite *SearchGetTestSuite) TestSearchHiStatusesWithAccountIDInQueryParam()
 	}
 
 	suite.Len(searchResult.Accounts, 0)
-	suite.Len(searchResult.Statuses, 1)
+	suite.Len(searchResult.Statuses, 4)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
@@ -1088,10 +1088,189 @@ func (suite *SearchGetTestSuite) TestSearchHiStatusesWithAccountIDInQueryText()
 	}
 
 	suite.Len(searchResult.Accounts, 0)
-	suite.Len(searchResult.Statuses, 1)
+	suite.Len(searchResult.Statuses, 4)
 	suite.Len(searchResult.Hashtags, 0)
 }
 
+func (suite *SearchGetTestSuite) TestSearchCowStatusesWithHasMediaOperator() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = func() *bool { i := true; return &i }()
+		query                      = "cow has:media"
+		queryType          *string = func() *string { i := "statuses"; return &i }() // Only statuses.
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(searchResult.Accounts, 0)
+	suite.Len(searchResult.Hashtags, 0)
+	if suite.Len(searchResult.Statuses, 1) {
+		suite.NotEmpty(searchResult.Statuses[0].MediaAttachments)
+	}
+}
+
+func (suite *SearchGetTestSuite) TestSearchTurtlesStatusesWithInLibraryOperator() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = func() *bool { i := true; return &i }()
+		query                      = "turtles in:library"
+		queryType          *string = func() *string { i := "statuses"; return &i }() // Only statuses.
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(searchResult.Accounts, 0)
+	suite.Len(searchResult.Hashtags, 0)
+	if suite.Len(searchResult.Statuses, 1) {
+		// Not our own status, but one we've faved.
+		suite.True(searchResult.Statuses[0].Favourited)
+	}
+}
+
+func (suite *SearchGetTestSuite) TestSearchHiStatusesWithDateOperators() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = func() *bool { i := true; return &i }()
+		query                      = "hi after:2021-08-31 before:2022-01-01"
+		queryType          *string = func() *string { i := "statuses"; return &i }() // Only statuses.
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(searchResult.Accounts, 0)
+	suite.Len(searchResult.Hashtags, 0)
+	suite.Len(searchResult.Statuses, 2)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesWithOnlyOperators() {
+	var (
+		requestingAccount          = suite.testAccounts["local_account_1"]
+		token                      = suite.testTokens["local_account_1"]
+		user                       = suite.testUsers["local_account_1"]
+		maxID              *string = nil
+		minID              *string = nil
+		limit              *int    = nil
+		offset             *int    = nil
+		resolve            *bool   = func() *bool { i := true; return &i }()
+		query                      = "from:admin has:media"
+		queryType          *string = func() *string { i := "statuses"; return &i }() // Only statuses.
+		following          *bool   = nil
+		fromAccountID      *string = nil
+		expectedHTTPStatus         = http.StatusOK
+		expectedBody               = ""
+	)
+
+	searchResult, err := suite.getSearch(
+		requestingAccount,
+		token,
+		apiutil.APIv2,
+		user,
+		maxID,
+		minID,
+		limit,
+		offset,
+		query,
+		queryType,
+		resolve,
+		following,
+		fromAccountID,
+		expectedHTTPStatus,
+		expectedBody)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(searchResult.Accounts, 0)
+	suite.Len(searchResult.Hashtags, 0)
+	if suite.Len(searchResult.Statuses, 1) {
+		suite.Equal("01F8MH75CBF9JFX4ZAD54N0W0R", searchResult.Statuses[0].ID)
+	}
+}
+
 func (suite *SearchGetTestSuite) TestSearchAAccounts() {
 	var (
 		requestingAccount          = suite.testAccounts["local_account_1"]
@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -70,6 +71,9 @@
 
 	// SourcePath is used for fetching source of a post.
 	SourcePath = BasePathWithID + "/source"
+
+	// TranslatePath is used for translating a post into another language.
+	TranslatePath = BasePathWithID + "/translate"
 )
 
 type Module struct {
@@ -83,35 +87,39 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	// create / get / delete status
-	attachHandler(http.MethodPost, BasePath, m.StatusCreatePOSTHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.StatusGETHandler)
-	attachHandler(http.MethodDelete, BasePathWithID, m.StatusDELETEHandler)
+	// create / get / edit / delete status
+	attachHandler(http.MethodPost, BasePath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, oauth.RequireScope(oauth.ScopeReadStatuses), m.StatusGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusEditPUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusDELETEHandler)
 
 	// fave stuff
-	attachHandler(http.MethodPost, FavouritePath, m.StatusFavePOSTHandler)
-	attachHandler(http.MethodPost, UnfavouritePath, m.StatusUnfavePOSTHandler)
-	attachHandler(http.MethodGet, FavouritedPath, m.StatusFavedByGETHandler)
+	attachHandler(http.MethodPost, FavouritePath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusFavePOSTHandler)
+	attachHandler(http.MethodPost, UnfavouritePath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusUnfavePOSTHandler)
+	attachHandler(http.MethodGet, FavouritedPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.StatusFavedByGETHandler)
 
 	// pin stuff
-	attachHandler(http.MethodPost, PinPath, m.StatusPinPOSTHandler)
-	attachHandler(http.MethodPost, UnpinPath, m.StatusUnpinPOSTHandler)
+	attachHandler(http.MethodPost, PinPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.StatusPinPOSTHandler)
+	attachHandler(http.MethodPost, UnpinPath, oauth.RequireScope(oauth.ScopeWriteAccounts), m.StatusUnpinPOSTHandler)
 
 	// mute stuff
-	attachHandler(http.MethodPost, MutePath, m.StatusMutePOSTHandler)
-	attachHandler(http.MethodPost, UnmutePath, m.StatusUnmutePOSTHandler)
+	attachHandler(http.MethodPost, MutePath, oauth.RequireScope(oauth.ScopeWriteMutes), m.StatusMutePOSTHandler)
+	attachHandler(http.MethodPost, UnmutePath, oauth.RequireScope(oauth.ScopeWriteMutes), m.StatusUnmutePOSTHandler)
 
 	// reblog stuff
-	attachHandler(http.MethodPost, ReblogPath, m.StatusBoostPOSTHandler)
-	attachHandler(http.MethodPost, UnreblogPath, m.StatusUnboostPOSTHandler)
-	attachHandler(http.MethodGet, RebloggedPath, m.StatusBoostedByGETHandler)
-	attachHandler(http.MethodPost, BookmarkPath, m.StatusBookmarkPOSTHandler)
-	attachHandler(http.MethodPost, UnbookmarkPath, m.StatusUnbookmarkPOSTHandler)
+	attachHandler(http.MethodPost, ReblogPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusBoostPOSTHandler)
+	attachHandler(http.MethodPost, UnreblogPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusUnboostPOSTHandler)
+	attachHandler(http.MethodGet, RebloggedPath, oauth.RequireScope(oauth.ScopeReadAccounts), m.StatusBoostedByGETHandler)
+	attachHandler(http.MethodPost, BookmarkPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusBookmarkPOSTHandler)
+	attachHandler(http.MethodPost, UnbookmarkPath, oauth.RequireScope(oauth.ScopeWriteStatuses), m.StatusUnbookmarkPOSTHandler)
 
 	// context / status thread
-	attachHandler(http.MethodGet, ContextPath, m.StatusContextGETHandler)
+	attachHandler(http.MethodGet, ContextPath, oauth.RequireScope(oauth.ScopeReadStatuses), m.StatusContextGETHandler)
 
 	// history/edit stuff
-	attachHandler(http.MethodGet, HistoryPath, m.StatusHistoryGETHandler)
-	attachHandler(http.MethodGet, SourcePath, m.StatusSourceGETHandler)
+	attachHandler(http.MethodGet, HistoryPath, oauth.RequireScope(oauth.ScopeReadStatuses), m.StatusHistoryGETHandler)
+	attachHandler(http.MethodGet, SourcePath, oauth.RequireScope(oauth.ScopeReadStatuses), m.StatusSourceGETHandler)
+
+	// translation stuff
+	attachHandler(http.MethodPost, TranslatePath, oauth.RequireScope(oauth.ScopeReadStatuses), m.StatusTranslatePOSTHandler)
 }
@@ -24,6 +24,7 @@
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
@@ -102,6 +103,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatus() {
   "card": null,
   "content": "<p>this is a brand new status! <a href=\"http://localhost:8080/tags/helloworld\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>helloworld</span></a></p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -187,6 +189,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusIntPolicy() {
   "card": null,
   "content": "<p>this is a brand new status! <a href=\"http://localhost:8080/tags/helloworld\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>helloworld</span></a></p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -282,6 +285,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusIntPolicyJSON() {
   "card": null,
   "content": "<p>this is a brand new status! <a href=\"http://localhost:8080/tags/helloworld\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>helloworld</span></a></p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -374,13 +378,45 @@ func (suite *StatusCreateTestSuite) TestPostNewScheduledStatus() {
 		"scheduled_at": {"2080-10-04T15:32:02.018Z"},
 	}, "")
 
-	// We should have 501 from
+	// We should have OK from
+	// our call to the function.
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// We should have a scheduled status back.
+	suite.Equal(`{
+  "id": "ZZZZZZZZZZZZZZZZZZZZZZZZZZ",
+  "media_attachments": [],
+  "params": {
+    "application_id": "01F8MGY43H3N2C8EWPR2FPYEXG",
+    "content_type": null,
+    "in_reply_to_id": null,
+    "language": null,
+    "local_only": false,
+    "media_ids": null,
+    "poll": null,
+    "scheduled_at": "2080-10-04T15:32:02.018Z",
+    "sensitive": true,
+    "spoiler_text": "hello hello",
+    "text": "this is a brand new status! #helloworld",
+    "visibility": "private"
+  },
+  "scheduled_at": "2080-10-04T15:32:02.018Z"
+}`, out)
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewScheduledStatusTooSoon() {
+	out, recorder := suite.postStatus(map[string][]string{
+		"status":       {"this is a brand new status! #helloworld"},
+		"scheduled_at": {time.Now().Add(time.Minute).UTC().Format(time.RFC3339)},
+	}, "")
+
+	// We should have 422 from
 	// our call to the function.
-	suite.Equal(http.StatusNotImplemented, recorder.Code)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
 
 	// We should have a helpful error message.
 	suite.Equal(`{
-  "error": "Not Implemented: scheduled_at is not yet implemented"
+  "error": "Unprocessable Entity: scheduled_at must be at least 5 minutes in the future"
 }`, out)
 }
 
@@ -407,6 +443,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusMarkdown() {
   "card": null,
   "content": "<h1>Title</h1><h2>Smaller title</h2><p>This is a post written in <a href=\"https://www.markdownguide.org/\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">markdown</a></p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -490,6 +527,7 @@ func (suite *StatusCreateTestSuite) TestMentionUnknownAccount() {
   "card": null,
   "content": "<p>hello <span class=\"h-card\"><a href=\"https://unknown-instance.com/@brand_new_person\" class=\"u-url mention\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">@<span>brand_new_person</span></a></span></p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -567,6 +605,7 @@ func (suite *StatusCreateTestSuite) TestPostStatusWithLinksAndTags() {
   "card": null,
   "content": "<p><a href=\"http://localhost:8080/tags/test\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>test</span></a> alright, should be able to post <a href=\"http://localhost:8080/tags/links\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>links</span></a> with fragments in them now, let's see........<br><br><a href=\"https://docs.gotosocial.org/en/latest/user_guide/posts/#links\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">https://docs.gotosocial.org/en/latest/user_guide/posts/#links</a><br><br><a href=\"http://localhost:8080/tags/gotosocial\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>gotosocial</span></a><br><br>(tobi remember to pull the docker image challenge)</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -650,6 +689,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithEmoji() {
   "card": null,
   "content": "<p>here is a rainbow emoji a few times! :rainbow: :rainbow: :rainbow:<br>here's an emoji that isn't in the db: :test_emoji:</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [
     {
       "category": "reactions",
@@ -747,6 +787,7 @@ func (suite *StatusCreateTestSuite) TestReplyToLocalStatus() {
   "card": null,
   "content": "<p>hello <span class=\"h-card\"><a href=\"http://localhost:8080/@1happyturtle\" class=\"u-url mention\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">@<span>1happyturtle</span></a></span> this reply should work!</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -829,6 +870,7 @@ func (suite *StatusCreateTestSuite) TestAttachNewMediaSuccess() {
   "card": null,
   "content": "<p>here's an image attachment</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -933,6 +975,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithNoncanonicalLanguageTag
   "card": null,
   "content": "<p>English? what's English? i speak American</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -1007,6 +1050,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithPollForm() {
   "card": null,
   "content": "<p>this is a status with a poll!</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -1103,6 +1147,7 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusWithPollJSON() {
   "card": null,
   "content": "<p>this is a status with a poll!</p>",
   "created_at": "right the hell just now babyee",
+  "edited_at": null,
   "emojis": [],
   "favourited": false,
   "favourites_count": 0,
@@ -0,0 +1,137 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) editStatus(
+	account string,
+	targetStatusID string,
+	form url.Values,
+	expectedHTTPStatus int,
+) *apimodel.Status {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts[account]
+		testUser        = suite.testUsers[account]
+		testToken       = oauth.DBTokenToToken(suite.testTokens[account])
+		target          = fmt.Sprintf("http://localhost:8080%s", strings.ReplaceAll(statuses.BasePathWithID, ":id", targetStatusID))
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPut, target, strings.NewReader(form.Encode()))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx, _ := testrig.CreateGinTestContext(recorder, request)
+
+	// Set auth + path params.
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatusID,
+		},
+	}
+
+	// Call the handler.
+	suite.statusModule.StatusEditPUTHandler(ctx)
+
+	// Check code.
+	if code := recorder.Code; code != expectedHTTPStatus {
+		suite.FailNow("", "expected http code %d, got %d", expectedHTTPStatus, code)
+	}
+
+	if expectedHTTPStatus != http.StatusOK {
+		return nil
+	}
+
+	// Read body.
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus := new(apimodel.Status)
+	if err := json.Unmarshal(b, apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiStatus
+}
+
+func (suite *StatusEditTestSuite) TestEditStatus() {
+	targetStatusID := suite.testStatuses["local_account_1_status_1"].ID
+
+	apiStatus := suite.editStatus("local_account_1", targetStatusID, url.Values{
+		"status":       {"hello everyone! this post has been #edited"},
+		"spoiler_text": {"introduction post"},
+		"sensitive":    {"true"},
+	}, http.StatusOK)
+
+	suite.Equal(`<p>hello everyone! this post has been <a href="http://localhost:8080/tags/edited" class="mention hashtag" rel="tag nofollow noreferrer noopener" target="_blank">#<span>edited</span></a></p>`, apiStatus.Content)
+	suite.Equal("introduction post", apiStatus.SpoilerText)
+	suite.NotNil(apiStatus.EditedAt)
+	suite.Len(apiStatus.Tags, 1)
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusNoContent() {
+	targetStatusID := suite.testStatuses["local_account_1_status_1"].ID
+
+	suite.editStatus("local_account_1", targetStatusID, url.Values{
+		"status": {""},
+	}, http.StatusBadRequest)
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusNotOwned() {
+	targetStatusID := suite.testStatuses["admin_account_status_1"].ID
+
+	suite.editStatus("local_account_1", targetStatusID, url.Values{
+		"status": {"this isn't my status"},
+	}, http.StatusNotFound)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusTranslateTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusTranslateTestSuite) translate(targetStatusID string, lang string) (int, string) {
+	var (
+		testApplication = suite.testApplications["application_1"]
+		testAccount     = suite.testAccounts["local_account_1"]
+		testUser        = suite.testUsers["local_account_1"]
+		testToken       = oauth.DBTokenToToken(suite.testTokens["local_account_1"])
+		target          = fmt.Sprintf("http://localhost:8080%s", strings.ReplaceAll(statuses.TranslatePath, ":id", targetStatusID))
+		form            = url.Values{"lang": {lang}}
+	)
+
+	// Setup request.
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx, _ := testrig.CreateGinTestContext(recorder, request)
+
+	// Set auth + path params.
+	ctx.Set(oauth.SessionAuthorizedApplication, testApplication)
+	ctx.Set(oauth.SessionAuthorizedToken, testToken)
+	ctx.Set(oauth.SessionAuthorizedUser, testUser)
+	ctx.Set(oauth.SessionAuthorizedAccount, testAccount)
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatusID,
+		},
+	}
+
+	// Call the handler.
+	suite.statusModule.StatusTranslatePOSTHandler(ctx)
+
+	// Read body.
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, string(b)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateNotEnabled() {
+	targetStatusID := suite.testStatuses["admin_account_status_1"].ID
+
+	code, body := suite.translate(targetStatusID, "de")
+	suite.Equal(http.StatusNotImplemented, code)
+	suite.Equal(`{"error":"Not Implemented: translation is not enabled on this instance"}`, body)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateLibreTranslate() {
+	// Start a fake LibreTranslate
+	// server that just shouts.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Q []string `json:"q"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for i, q := range req.Q {
+			req.Q[i] = strings.ToUpper(q)
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"translatedText": req.Q,
+		})
+	}))
+	defer server.Close()
+
+	// Enable translation and recreate
+	// processor to pick up the backend.
+	config.SetTranslationBackend(config.TranslationBackendLibreTranslate)
+	config.SetTranslationLibreTranslateURL(server.URL)
+	suite.processor = testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, suite.mediaManager)
+	suite.statusModule = statuses.New(suite.processor)
+
+	targetStatusID := suite.testStatuses["admin_account_status_1"].ID
+
+	code, body := suite.translate(targetStatusID, "de")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{"content":"HELLO WORLD! #WELCOME ! FIRST POST ON THE INSTANCE :RAINBOW: !","spoiler_text":"","media_attachments":[{"id":"01F8MH6NEM8D7527KZAECTCR76","description":"BLACK AND WHITE IMAGE OF SOME 50'S STYLE TEXT SAYING: WELCOME ON BOARD"}],"detected_source_language":"en","language":"de","provider":"LibreTranslate"}`, body)
+}
+
+func TestStatusTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTranslateTestSuite))
+}
@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// AccountIDKey is the url key for the ID of a suggested account.
+	AccountIDKey = "account_id"
+	// BasePath is the base API path for follow suggestions, through v1 or v2 of the api.
+	BasePath = "/:" + apiutil.APIVersionKey + "/suggestions"
+	// BasePathWithAccountID is the path for dismissing the suggestion of a single account.
+	BasePathWithAccountID = BasePath + "/:" + AccountIDKey
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadAccounts), m.SuggestionsGETHandler)
+	attachHandler(http.MethodDelete, BasePathWithAccountID, oauth.RequireScope(oauth.ScopeReadAccounts), m.SuggestionDELETEHandler)
+}
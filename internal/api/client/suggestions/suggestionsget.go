@@ -0,0 +1,133 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// SuggestionsGETHandler swagger:operation GET /api/{api_version}/suggestions suggestionsGet
+//
+// Get accounts suggested for the requester to follow.
+//
+// Accounts featured by an admin are suggested first, followed by accounts that
+// are followed by accounts the requester follows, then discoverable accounts which
+// have recently been active on this instance. Accounts already followed by the
+// requester, or which the requester has dismissed, are never suggested.
+//
+//	---
+//	tags:
+//	- suggestions
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: api_version
+//		type: string
+//		in: path
+//		description: >-
+//			Version of the API to use. Must be either `v1` or `v2`.
+//			If v1 is used, results will be a slice of accounts.
+//			If v2 is used, results will be a slice of suggestions.
+//		required: true
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of suggestions to return.
+//		default: 40
+//		maximum: 80
+//		minimum: 1
+//		in: query
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:accounts
+//
+//	responses:
+//		'200':
+//			description: Array of suggestions.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/suggestion"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) SuggestionsGETHandler(c *gin.Context) {
+	apiVersion, errWithCode := apiutil.ParseAPIVersion(
+		c.Param(apiutil.APIVersionKey),
+		[]string{apiutil.APIv1, apiutil.APIv2}...,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	limit, errWithCode := apiutil.ParseLimit(c.Query(apiutil.LimitKey), 40, 80, 1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	suggestions, errWithCode := m.processor.Suggestions().Get(
+		c.Request.Context(),
+		authed.Account,
+		limit,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if apiVersion == apiutil.APIv1 {
+		// v1 returns just the suggested accounts.
+		accounts := make([]*apimodel.Account, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			accounts = append(accounts, suggestion.Account)
+		}
+		apiutil.JSON(c, http.StatusOK, accounts)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, suggestions)
+}
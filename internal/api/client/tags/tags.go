@@ -22,6 +22,7 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -43,7 +44,7 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, TagPath, m.TagGETHandler)
-	attachHandler(http.MethodPost, FollowPath, m.FollowTagPOSTHandler)
-	attachHandler(http.MethodPost, UnfollowPath, m.UnfollowTagPOSTHandler)
+	attachHandler(http.MethodGet, TagPath, oauth.RequireScope(oauth.ScopeReadFollows), m.TagGETHandler)
+	attachHandler(http.MethodPost, FollowPath, oauth.RequireScope(oauth.ScopeWriteFollows), m.FollowTagPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, oauth.RequireScope(oauth.ScopeWriteFollows), m.UnfollowTagPOSTHandler)
 }
@@ -22,6 +22,7 @@
 
 	"github.com/gin-gonic/gin"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -44,8 +45,8 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
-	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
-	attachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
-	attachHandler(http.MethodGet, TagTimeline, m.TagTimelineGETHandler)
+	attachHandler(http.MethodGet, HomeTimeline, oauth.RequireScope(oauth.ScopeReadStatuses), m.HomeTimelineGETHandler)
+	attachHandler(http.MethodGet, PublicTimeline, oauth.RequireScope(oauth.ScopeReadStatuses), m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, ListTimeline, oauth.RequireScope(oauth.ScopeReadLists), m.ListTimelineGETHandler)
+	attachHandler(http.MethodGet, TagTimeline, oauth.RequireScope(oauth.ScopeReadStatuses), m.TagTimelineGETHandler)
 }
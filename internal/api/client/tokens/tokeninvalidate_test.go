@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tokens_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/tokens"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+func (suite *TokensTestSuite) invalidateToken(
+	accountFixtureName string,
+	id string,
+	expectedHTTPStatus int,
+) error {
+	_, err := suite.tokensAction(
+		accountFixtureName,
+		http.MethodPost,
+		tokens.InvalidateTokenPath,
+		id,
+		nil,
+		suite.tokensModule.TokenInvalidatePOSTHandler,
+		expectedHTTPStatus,
+	)
+	return err
+}
+
+// Invalidate a token owned by the requesting user.
+func (suite *TokensTestSuite) TestInvalidateToken() {
+	testToken := suite.testTokens["local_account_1"]
+
+	if err := suite.invalidateToken("local_account_1", testToken.ID, http.StatusOK); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Token should be gone
+	// from the database.
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+// Invalidating another user's token should 404,
+// and leave the token intact.
+func (suite *TokensTestSuite) TestInvalidateTokenNotOwned() {
+	testToken := suite.testTokens["local_account_2"]
+
+	if err := suite.invalidateToken("local_account_1", testToken.ID, http.StatusNotFound); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.NoError(err)
+}
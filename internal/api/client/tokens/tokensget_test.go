@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tokens_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/tokens"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+func (suite *TokensTestSuite) getTokens(
+	accountFixtureName string,
+	expectedHTTPStatus int,
+) ([]apimodel.TokenInfo, error) {
+	b, err := suite.tokensAction(
+		accountFixtureName,
+		http.MethodGet,
+		tokens.BasePath,
+		"",
+		nil,
+		suite.tokensModule.TokensInfoGETHandler,
+		expectedHTTPStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenInfos []apimodel.TokenInfo
+	if err := json.Unmarshal(b, &tokenInfos); err != nil {
+		return nil, err
+	}
+
+	return tokenInfos, nil
+}
+
+// List tokens owned by the requesting user.
+// Tokens without an access code, or tokens
+// owned by other users, should not be included.
+func (suite *TokensTestSuite) TestGetTokens() {
+	testToken := suite.testTokens["local_account_1"]
+
+	tokenInfos, err := suite.getTokens("local_account_1", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(tokenInfos, 1) {
+		suite.FailNow("")
+	}
+
+	tokenInfo := tokenInfos[0]
+	suite.Equal(testToken.ID, tokenInfo.ID)
+	suite.Equal(testToken.Scope, tokenInfo.Scope)
+	suite.NotEmpty(tokenInfo.CreatedAt)
+	suite.Empty(tokenInfo.LastUsed)
+	if suite.NotNil(tokenInfo.Application) {
+		suite.Equal("really cool gts application", tokenInfo.Application.Name)
+		suite.Equal("https://reallycool.app", tokenInfo.Application.Website)
+
+		// Client credentials must never be exposed.
+		suite.Empty(tokenInfo.Application.ClientID)
+		suite.Empty(tokenInfo.Application.ClientSecret)
+	}
+}
+
+// Get one token owned by the requesting user.
+func (suite *TokensTestSuite) TestGetToken() {
+	testToken := suite.testTokens["local_account_1"]
+
+	b, err := suite.tokensAction(
+		"local_account_1",
+		http.MethodGet,
+		tokens.BasePathWithID,
+		testToken.ID,
+		nil,
+		suite.tokensModule.TokenInfoGETHandler,
+		http.StatusOK,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tokenInfo := &apimodel.TokenInfo{}
+	if err := json.Unmarshal(b, tokenInfo); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testToken.ID, tokenInfo.ID)
+}
+
+// Getting another user's token should 404.
+func (suite *TokensTestSuite) TestGetTokenNotOwned() {
+	testToken := suite.testTokens["local_account_2"]
+
+	if _, err := suite.tokensAction(
+		"local_account_1",
+		http.MethodGet,
+		tokens.BasePathWithID,
+		testToken.ID,
+		nil,
+		suite.tokensModule.TokenInfoGETHandler,
+		http.StatusNotFound,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	BasePath     = "/v1/trends"
+	TagsPath     = BasePath + "/tags"
+	StatusesPath = BasePath + "/statuses"
+	LinksPath    = BasePath + "/links"
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// Mastodon serves trending tags at the
+	// base path too, for older clients.
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadStatuses), m.TrendsTagsGETHandler)
+	attachHandler(http.MethodGet, TagsPath, oauth.RequireScope(oauth.ScopeReadStatuses), m.TrendsTagsGETHandler)
+	attachHandler(http.MethodGet, StatusesPath, oauth.RequireScope(oauth.ScopeReadStatuses), m.TrendsStatusesGETHandler)
+	attachHandler(http.MethodGet, LinksPath, oauth.RequireScope(oauth.ScopeReadStatuses), m.TrendsLinksGETHandler)
+}
+
+// authenticate checks authorization of the given trends request.
+// Like the public timeline, trends may be viewed without
+// being logged in if the instance exposes its public timeline.
+func authenticate(c *gin.Context) (*oauth.Auth, error) {
+	if config.GetInstanceExposePublicTimeline() {
+		// Still check if we can extract various
+		// authentication properties, but don't
+		// require them.
+		return oauth.Authed(c, false, false, false, false)
+	}
+
+	return oauth.Authed(c, true, true, true, true)
+}
@@ -21,6 +21,7 @@
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -31,6 +32,14 @@
 	PasswordChangePath = BasePath + "/password_change"
 	// EmailChangePath is the path for POSTing an email address change request.
 	EmailChangePath = BasePath + "/email_change"
+	// TwoFactorPath is the base path for two-factor authentication requests.
+	TwoFactorPath = BasePath + "/2fa"
+	// TwoFactorEnrollPath is the path for POSTing a two-factor enrollment request.
+	TwoFactorEnrollPath = TwoFactorPath + "/enroll"
+	// TwoFactorConfirmPath is the path for POSTing a two-factor enrollment confirmation.
+	TwoFactorConfirmPath = TwoFactorPath + "/confirm"
+	// TwoFactorDisablePath is the path for POSTing a two-factor disable request.
+	TwoFactorDisablePath = TwoFactorPath + "/disable"
 )
 
 type Module struct {
@@ -44,7 +53,10 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.UserGETHandler)
-	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
-	attachHandler(http.MethodPost, EmailChangePath, m.EmailChangePOSTHandler)
+	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadUser), m.UserGETHandler)
+	attachHandler(http.MethodPost, PasswordChangePath, oauth.RequireScope(oauth.ScopeWriteUser), m.PasswordChangePOSTHandler)
+	attachHandler(http.MethodPost, EmailChangePath, oauth.RequireScope(oauth.ScopeWriteUser), m.EmailChangePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorEnrollPath, oauth.RequireScope(oauth.ScopeWriteUser), m.TwoFactorEnrollPOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorConfirmPath, oauth.RequireScope(oauth.ScopeWriteUser), m.TwoFactorConfirmPOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorDisablePath, oauth.RequireScope(oauth.ScopeWriteUser), m.TwoFactorDisablePOSTHandler)
 }
@@ -50,6 +50,9 @@ type Account struct {
 	Discoverable bool `json:"discoverable"`
 	// Account identifies as a bot.
 	Bot bool `json:"bot"`
+	// Account is a group actor, which boosts
+	// posts that mention it to its followers.
+	Group bool `json:"group,omitempty"`
 	// When the account was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at"`
@@ -191,6 +194,11 @@ type AccountCreateRequest struct {
 	// example: en
 	// Required: true
 	Locale string `form:"locale" json:"locale" xml:"locale" binding:"required"`
+	// Code of an invite link to use for this sign-up.
+	// If valid, this allows sign-up even if registration is closed.
+	// swagger:parameters
+	// example: 3vVuQGtGoLXkbD5cTGNeuA
+	InviteCode string `form:"invite_code" json:"invite_code" xml:"invite_code"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -66,6 +66,8 @@ type AdminAccountInfo struct {
 	Silenced bool `json:"silenced"`
 	// Whether the account is currently suspended.
 	Suspended bool `json:"suspended"`
+	// Whether the account currently has all its media marked as sensitive.
+	Sensitized bool `json:"sensitized"`
 	// User-level information about the account.
 	Account *Account `json:"account"`
 	// The ID of the application that created this account.
@@ -170,7 +172,8 @@ type AdminEmoji struct {
 type AdminActionRequest struct {
 	// Category of the target entity.
 	Category string `form:"-" json:"-" xml:"-"`
-	// Type of admin action to take. One of disable, silence, suspend.
+	// Type of admin action to take. One of disable, reenable,
+	// silence, unsilence, sensitive, unsensitive, suspend.
 	Type string `form:"type" json:"type" xml:"type"`
 	// Text describing why an action was taken.
 	Text string `form:"text" json:"text" xml:"text"`
@@ -189,6 +192,147 @@ type AdminActionResponse struct {
 	ActionID string `json:"action_id"`
 }
 
+// AdminDeliveryFailure models the admin view of an
+// outgoing ActivityPub delivery that could not be completed.
+//
+// swagger:model adminDeliveryFailure
+type AdminDeliveryFailure struct {
+	// The ID of the delivery failure.
+	// example: 01J9Y4N5C6HZ2N7TRZSVCQ9R4X
+	ID string `json:"id"`
+	// The date when the delivery failed (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Host of the remote instance the delivery was addressed to.
+	// example: example.org
+	TargetHost string `json:"target_host"`
+	// URL the delivery was POSTed to.
+	// example: https://example.org/users/someone/inbox
+	RequestURL string `json:"request_url"`
+	// ActivityPub URI of the actor that sent the activity, if known.
+	// example: https://our.instance/users/admin
+	ActorID string `json:"actor_id,omitempty"`
+	// ActivityPub URI of the activity object, if known.
+	// example: https://our.instance/users/admin/statuses/01J9Y4N5C6HZ2N7TRZSVCQ9R4X
+	ObjectID string `json:"object_id,omitempty"`
+	// ActivityPub URI of the activity target, if known.
+	TargetID string `json:"target_id,omitempty"`
+	// HTTP status code of the last delivery attempt.
+	// Will be 0 if no response was received.
+	// example: 410
+	StatusCode int `json:"status_code"`
+	// Error message from the last delivery attempt.
+	// example: http response: 410 Gone
+	Error string `json:"error"`
+	// Number of delivery attempts made before giving up.
+	// example: 5
+	Attempts int `json:"attempts"`
+}
+
+// AdminDeliveryFailuresPurgeResponse models the server
+// response to a bulk deletion of delivery failures.
+//
+// swagger:model adminDeliveryFailuresPurgeResponse
+type AdminDeliveryFailuresPurgeResponse struct {
+	// Number of delivery failures that were deleted.
+	// example: 12
+	Deleted int `json:"deleted"`
+}
+
+// AdminRelay models an ActivityPub relay
+// that this instance is subscribed to.
+//
+// swagger:model adminRelay
+type AdminRelay struct {
+	// The ID of the relay.
+	// example: 01JBMZ9ZVJ5KX3QYHRN0T7F6CW
+	ID string `json:"id"`
+	// The date when the relay was added (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Inbox URL of the relay.
+	// example: https://relay.example.org/inbox
+	InboxURL string `json:"inbox_url"`
+	// ActivityPub URI of the relay actor.
+	// Only set once the relay has accepted the subscription.
+	// example: https://relay.example.org/actor
+	ActorURI string `json:"actor_uri,omitempty"`
+	// State of the subscription to the relay.
+	// One of "pending", "accepted", or "rejected".
+	// example: accepted
+	State string `json:"state"`
+}
+
+// AdminRelayCreateRequest models a request
+// to subscribe to a new ActivityPub relay.
+//
+// swagger:ignore
+type AdminRelayCreateRequest struct {
+	// Inbox URL of the relay.
+	InboxURL string `form:"inbox_url" json:"inbox_url"`
+}
+
+// AdminFeaturedAccountCreateRequest models a request
+// to feature an account in follow suggestions.
+//
+// swagger:ignore
+type AdminFeaturedAccountCreateRequest struct {
+	// ID of the account to feature.
+	AccountID string `form:"account_id" json:"account_id"`
+}
+
+// AdminAnnouncementRequest models a request to
+// create or update an instance announcement.
+//
+// When updating an announcement, only the
+// fields that are set will be changed.
+//
+// swagger:ignore
+type AdminAnnouncementRequest struct {
+	// Text of the announcement.
+	// Will be parsed as markdown.
+	Text *string `form:"text" json:"text"`
+	// When the event the announcement
+	// refers to starts (ISO 8601 Datetime).
+	// Set to an empty string to clear.
+	StartsAt *string `form:"starts_at" json:"starts_at"`
+	// When the announcement should stop
+	// being displayed (ISO 8601 Datetime).
+	// Set to an empty string to clear.
+	EndsAt *string `form:"ends_at" json:"ends_at"`
+	// StartsAt and EndsAt refer
+	// to whole days, not times.
+	AllDay *bool `form:"all_day" json:"all_day"`
+	// When to publish the announcement (ISO 8601 Datetime).
+	// Only applies to announcements not yet published.
+	// Set to an empty string to clear.
+	ScheduledAt *string `form:"scheduled_at" json:"scheduled_at"`
+	// Publish the announcement now.
+	// Only applies to announcements
+	// not yet published.
+	Publish *bool `form:"publish" json:"publish"`
+}
+
+// AdminGroupRequest models a request to
+// create or update a local group account.
+//
+// When updating a group, only the
+// fields that are set will be changed.
+//
+// swagger:ignore
+type AdminGroupRequest struct {
+	// Username of the group.
+	// Only used when creating.
+	Username string `form:"username" json:"username"`
+	// Display name of the group.
+	DisplayName *string `form:"display_name" json:"display_name"`
+	// Description of the group.
+	Note *string `form:"note" json:"note"`
+	// Require moderator approval
+	// of new group members.
+	Locked *bool `form:"locked" json:"locked"`
+}
+
 // MediaCleanupRequest models admin media cleanup parameters
 //
 // swagger:parameters mediaCleanup
@@ -198,6 +342,16 @@ type MediaCleanupRequest struct {
 	RemoteCacheDays *int `form:"remote_cache_days" json:"remote_cache_days" xml:"remote_cache_days"`
 }
 
+// StatusesCleanupRequest models admin statuses cleanup parameters
+//
+// swagger:parameters statusesCleanup
+type StatusesCleanupRequest struct {
+	// Number of days of stale remote statuses and accounts to keep. Negative values will be treated as 0.
+	// If value is not specified, the value of statuses-remote-prune-days in the server config will be used.
+	// If the resulting value is 0, nothing will be pruned.
+	RemotePruneDays *int `form:"remote_prune_days" json:"remote_prune_days" xml:"remote_prune_days"`
+}
+
 // AdminSendTestEmailRequest models a test email send request (woah).
 type AdminSendTestEmailRequest struct {
 	// Email address to send the test email to.
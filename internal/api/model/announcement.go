@@ -19,7 +19,7 @@
 
 // Announcement models an admin announcement for the instance.
 //
-// TODO: swagger:model announcement once announcement API is supported
+// swagger:model announcement
 type Announcement struct {
 	// The ID of the announcement.
 	// example: 01FC30T7X4TNCZK0TH90QYF3M4
@@ -47,6 +47,10 @@ type Announcement struct {
 	// Announcement is 'published', ie., visible to users.
 	// Announcements that are not published should be shown only to admins.
 	Published bool `json:"published"`
+	// When the announcement is scheduled to be published (ISO 8601 Datetime).
+	// Only shown to admins, for announcements that are not yet published.
+	// example: 2021-07-30T09:20:25+00:00
+	ScheduledAt string `json:"scheduled_at,omitempty"`
 	// Requesting account has seen this announcement.
 	Read bool `json:"read"`
 	// Mentions this announcement contains.
@@ -37,7 +37,7 @@ type Domain struct {
 	PublicComment string `form:"public_comment" json:"public_comment,omitempty"`
 }
 
-// DomainPermission represents a permission applied to one domain (explicit block/allow).
+// DomainPermission represents a permission applied to one domain (explicit block/allow, or limit).
 //
 // swagger:model domainPermission
 type DomainPermission struct {
@@ -61,6 +61,22 @@ type DomainPermission struct {
 	// Time at which the permission entry was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at,omitempty"`
+	// Permission type of this entry (block, allow).
+	// Only set for domain permission drafts.
+	// example: block
+	PermissionType string `json:"permission_type,omitempty"`
+	// Mark all media from this domain as sensitive.
+	// Only set for domain limits.
+	// example: true
+	MediaSensitive bool `json:"media_sensitive,omitempty"`
+	// Content warning prepended to all statuses from this domain.
+	// Only set for domain limits.
+	// example: from a limited instance
+	ContentWarning string `json:"content_warning,omitempty"`
+	// Hide statuses from this domain from the public and tag timelines.
+	// Only set for domain limits.
+	// example: true
+	HideFromPublic bool `json:"hide_from_public,omitempty"`
 }
 
 // DomainPermissionRequest is the form submitted as a POST to create a new domain permission entry (allow/block).
@@ -85,6 +101,57 @@ type DomainPermissionRequest struct {
 	// Will be visible to requesters at /api/v1/instance/peers if this endpoint is exposed.
 	// example: foss dorks 😫
 	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+	// Create domain permissions as drafts, which must be accepted
+	// by an admin before they take effect. Only used if import=true.
+	// Defaults to true, so that a large import can be reviewed before
+	// it comes into force; set to false to import permissions directly.
+	// example: true
+	AsDraft *bool `form:"as_draft" json:"as_draft" xml:"as_draft"`
+	// Permission type of the entry (block, allow).
+	// Only used when creating domain permission drafts.
+	// example: block
+	PermissionType string `form:"permission_type" json:"permission_type" xml:"permission_type"`
+}
+
+// DomainLimitRequest is the form submitted as a POST to create
+// a new domain limit, or as a PATCH to update an existing one.
+//
+// swagger:ignore
+type DomainLimitRequest struct {
+	// Domain to limit. Only used when creating a domain limit.
+	// example: example.org
+	Domain *string `form:"domain" json:"domain" xml:"domain"`
+	// Obfuscate the domain name when displaying this limit publicly.
+	// example: false
+	Obfuscate *bool `form:"obfuscate" json:"obfuscate" xml:"obfuscate"`
+	// Private comment for other admins on why this domain was limited.
+	// example: don't like 'em!!!!
+	PrivateComment *string `form:"private_comment" json:"private_comment" xml:"private_comment"`
+	// Public comment on why this domain was limited.
+	// example: foss dorks 😫
+	PublicComment *string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+	// Mark all media from this domain as sensitive.
+	// example: true
+	MediaSensitive *bool `form:"media_sensitive" json:"media_sensitive" xml:"media_sensitive"`
+	// Content warning to prepend to all statuses from this domain.
+	// example: from a limited instance
+	ContentWarning *string `form:"content_warning" json:"content_warning" xml:"content_warning"`
+	// Hide statuses from this domain from the public and tag timelines.
+	// example: true
+	HideFromPublic *bool `form:"hide_from_public" json:"hide_from_public" xml:"hide_from_public"`
+}
+
+// DomainPermissionExcludeRequest is the form submitted as a POST
+// to create a new domain permission exclude entry.
+//
+// swagger:ignore
+type DomainPermissionExcludeRequest struct {
+	// Domain to exclude from domain permission imports and subscriptions.
+	// example: example.org
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+	// Private comment for other admins on why this domain was excluded.
+	// example: our mates
+	PrivateComment string `form:"private_comment" json:"private_comment" xml:"private_comment"`
 }
 
 // DomainKeysExpireRequest is the form submitted as a POST to /api/v1/admin/domain_keys_expire to expire a domain's public keys.
@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// DomainPermissionSubscription represents an auto-refreshing subscription to a list of domain permissions (allows, blocks).
+//
+// swagger:model domainPermissionSubscription
+type DomainPermissionSubscription struct {
+	// The ID of the domain permission subscription.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// Priority of this subscription compared to others of the same permission type. 0-255 (higher = higher priority).
+	// example: 100
+	Priority uint8 `json:"priority"`
+	// Moderator-set title for this list.
+	// example: Some Bad Guys
+	Title string `json:"title"`
+	// The type of domain permission subscription (allow, block).
+	// example: block
+	PermissionType string `json:"permission_type"`
+	// If true, domain permissions arising from this subscription will be created as drafts that must be approved by a moderator to take effect.
+	// If false, domain permissions from this subscription will come into force immediately.
+	// example: true
+	AsDraft bool `json:"as_draft"`
+	// If true, this domain permission subscription will "adopt" domain permissions which already exist on the instance, and which meet the following conditions:
+	// 1) they have no subscription ID (ie., they're "orphaned") and 2) they are present in the subscribed list.
+	// Such orphaned domain permissions will be given this subscription's subscription ID value.
+	// example: false
+	AdoptOrphans bool `json:"adopt_orphans"`
+	// Time at which the subscription was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// ID of the account that created this subscription.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	CreatedBy string `json:"created_by"`
+	// URI to call in order to fetch the permissions list.
+	// example: https://www.example.org/blocklists/list1.csv
+	URI string `json:"uri"`
+	// MIME content type to use when parsing the permissions list.
+	// example: text/csv
+	ContentType string `json:"content_type"`
+	// (Optional) username to set for basic auth when doing a fetch of URI.
+	// example: admin123
+	FetchUsername string `json:"fetch_username,omitempty"`
+	// (Optional) password to set for basic auth when doing a fetch of URI.
+	// example: admin123
+	FetchPassword string `json:"fetch_password,omitempty"`
+	// Time of the most recent fetch attempt (successful or otherwise) (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	FetchedAt string `json:"fetched_at,omitempty"`
+	// Time of the most recent successful fetch (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// readonly: true
+	SuccessfullyFetchedAt string `json:"successfully_fetched_at,omitempty"`
+	// If most recent fetch attempt failed, this field will contain an error message related to the fetch attempt.
+	// example: unexpected response status 404 Not Found
+	// readonly: true
+	Error string `json:"error,omitempty"`
+}
+
+// DomainPermissionSubscriptionRequest is the form submitted as a POST or PATCH
+// to create or update a domain permission subscription.
+//
+// swagger:ignore
+type DomainPermissionSubscriptionRequest struct {
+	// Priority of this subscription compared to others of the same permission type. 0-255 (higher = higher priority).
+	Priority *int `form:"priority" json:"priority" xml:"priority"`
+	// Moderator-set title for this list.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// The type of domain permission subscription (allow, block).
+	PermissionType *string `form:"permission_type" json:"permission_type" xml:"permission_type"`
+	// Create domain permissions arising from this subscription as drafts.
+	AsDraft *bool `form:"as_draft" json:"as_draft" xml:"as_draft"`
+	// Adopt orphaned domain permissions present in the subscribed list.
+	AdoptOrphans *bool `form:"adopt_orphans" json:"adopt_orphans" xml:"adopt_orphans"`
+	// URI to call in order to fetch the permissions list.
+	URI *string `form:"uri" json:"uri" xml:"uri"`
+	// MIME content type to use when parsing the permissions list.
+	ContentType *string `form:"content_type" json:"content_type" xml:"content_type"`
+	// (Optional) username to set for basic auth when doing a fetch of URI.
+	FetchUsername *string `form:"fetch_username" json:"fetch_username" xml:"fetch_username"`
+	// (Optional) password to set for basic auth when doing a fetch of URI.
+	FetchPassword *string `form:"fetch_password" json:"fetch_password" xml:"fetch_password"`
+}
@@ -80,3 +80,60 @@ type ImportRequest struct {
 	//	- `overwrite` to replace existing entries with entries in file.
 	Mode string `form:"mode"`
 }
+
+// ImportJob models the progress of a CSV data import.
+//
+// swagger:model importJob
+type ImportJob struct {
+	// The ID of the import job.
+	// example: 01JC3Y8S5AQ0B8SZ7MMEKSZB8K
+	ID string `json:"id"`
+	// When the import job was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// When the import job was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// Type of entries contained in the data file.
+	// example: following
+	Type string `json:"type"`
+	// Mode used when creating entries from the data file.
+	// example: merge
+	Mode string `json:"mode"`
+	// Processing state of the import job, one of `queued`, `processing`, `finished`, or `failed`.
+	// example: processing
+	State string `json:"state"`
+	// Number of rows in the data file, not including any header row.
+	// example: 100
+	TotalRows int `json:"total_rows"`
+	// Number of rows processed so far.
+	// example: 50
+	ProcessedRows int `json:"processed_rows"`
+	// Number of rows processed successfully so far.
+	// example: 48
+	Successes int `json:"successes"`
+	// Number of rows that failed to process so far.
+	// example: 2
+	Failures int `json:"failures"`
+	// Rows that failed to process so far.
+	FailedRows []ImportJobFailure `json:"failed_rows"`
+	// When the import job finished processing (ISO 8601 Datetime), if finished or failed.
+	// example: 2021-07-30T09:20:25+00:00
+	FinishedAt *string `json:"finished_at"`
+	// Reason the import job as a whole failed to process, if failed.
+	// example: error parsing import data: record on line 1: wrong number of fields
+	Error *string `json:"error,omitempty"`
+}
+
+// ImportJobFailure models a single row
+// of a CSV data import that failed.
+//
+// swagger:model importJobFailure
+type ImportJobFailure struct {
+	// 1-indexed number of the row in the data file.
+	// example: 3
+	Row int `json:"row"`
+	// Reason the row failed to process.
+	// example: could not retrieve account
+	Reason string `json:"reason"`
+}
@@ -18,15 +18,31 @@
 package model
 
 // FeaturedTag represents a hashtag that is featured on a profile.
+//
+// swagger:model featuredTag
 type FeaturedTag struct {
 	// The internal ID of the featured tag in the database.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
 	ID string `json:"id"`
 	// The name of the hashtag being featured.
+	// example: helloworld
 	Name string `json:"name"`
 	// A link to all statuses by a user that contain this hashtag.
+	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
 	// The number of authored statuses containing this hashtag.
+	// example: 3
 	StatusesCount int `json:"statuses_count"`
-	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Datetime)
-	LastStatusAt string `json:"last_status_at"`
+	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Date)
+	// Null if no statuses have been authored containing this hashtag.
+	// example: 2021-07-30
+	LastStatusAt *string `json:"last_status_at"`
+}
+
+// FeaturedTagRequest models a request to feature a hashtag.
+//
+// swagger:ignore
+type FeaturedTagRequest struct {
+	// The hashtag to be featured, without the hash sign.
+	Name string `form:"name" json:"name"`
 }
@@ -52,7 +52,7 @@ type InstanceConfigurationAccounts struct {
 	// example: false
 	AllowCustomCSS bool `json:"allow_custom_css"`
 	// The maximum number of featured tags allowed for each account.
-	// Currently not implemented, so this is hardcoded to 10.
+	// Currently not configurable, so this is hardcoded to 10.
 	MaxFeaturedTags int `json:"max_featured_tags"`
 	// The maximum number of profile fields allowed for each account.
 	// Currently not configurable, so this is hardcoded to 6. (https://github.com/superseriousbusiness/gotosocial/issues/1876)
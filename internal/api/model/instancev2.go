@@ -148,10 +148,20 @@ type InstanceV2URLs struct {
 // swagger:model instanceV2ConfigurationTranslation
 type InstanceV2ConfigurationTranslation struct {
 	// Whether the Translations API is available on this instance.
-	// Not implemented so this value is always false.
+	// True if a translation backend has been configured.
 	Enabled bool `json:"enabled"`
 }
 
+// Hints related to Web Push.
+//
+// swagger:model instanceV2ConfigurationVAPID
+type InstanceV2ConfigurationVAPID struct {
+	// The instance's VAPID public key, base64url-encoded,
+	// for use when creating Web Push subscriptions.
+	// example: BCk-QqERU0q-CfYZjcuB6lnyyOYfJ2AifKqfeGIm7Z-HiTU5T9eTG5GxVA0_OH5mMlI4UkkDTpaZwozy0TzdZ2M
+	PublicKey string `json:"public_key"`
+}
+
 // Configured values and limits for this instance.
 //
 // swagger:model instanceV2Configuration
@@ -168,6 +178,8 @@ type InstanceV2Configuration struct {
 	Polls InstanceConfigurationPolls `json:"polls"`
 	// Hints related to translation.
 	Translation InstanceV2ConfigurationTranslation `json:"translation"`
+	// Hints related to Web Push.
+	VAPID InstanceV2ConfigurationVAPID `json:"vapid"`
 	// Instance configuration pertaining to emojis.
 	Emojis InstanceConfigurationEmojis `json:"emojis"`
 	// True if instance is running with OIDC as auth/identity backend, else omitted.
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Invite represents an invite link that can be
+// used to sign up to this instance, even when
+// registration is closed.
+//
+// swagger:model invite
+type Invite struct {
+	// ID of the invite.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Code of the invite, as used in the invite link.
+	// example: 3vVuQGtGoLXkbD5cTGNeuA
+	Code string `json:"code"`
+	// Link that can be shared with people to let them sign up.
+	// example: https://example.org/invite/3vVuQGtGoLXkbD5cTGNeuA
+	URL string `json:"url"`
+	// When the invite was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Maximum number of sign-ups permitted with this invite.
+	// Null if there is no maximum.
+	// nullable: true
+	MaxUses *int `json:"max_uses"`
+	// Number of times this invite has been used to sign up.
+	Uses int `json:"uses"`
+	// When the invite expires (ISO 8601 Datetime).
+	// Null if the invite doesn't expire.
+	// nullable: true
+	// example: 2021-08-06T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+	// When the invite was revoked (ISO 8601 Datetime).
+	// Null if the invite has not been revoked.
+	// nullable: true
+	RevokedAt *string `json:"revoked_at"`
+	// Invite can still be used to sign up,
+	// ie., it's not expired, revoked, or used up.
+	Usable bool `json:"usable"`
+}
+
+// InviteCreateRequest models a request to create an invite.
+//
+// swagger:ignore
+type InviteCreateRequest struct {
+	// Maximum number of sign-ups permitted with this invite.
+	// 0 or not set means no maximum, which is only
+	// permitted if the instance allows it.
+	MaxUses int `form:"max_uses" json:"max_uses"`
+	// Number of seconds from now after which this invite
+	// expires. 0 or not set means the invite never expires,
+	// which is only permitted if the instance allows it.
+	ExpiresIn int `form:"expires_in" json:"expires_in"`
+}
@@ -17,7 +17,9 @@
 
 package model
 
-// PushSubscription represents a subscription to the push streaming server.
+// PushSubscription represents a subscription to Web Push notifications.
+//
+// swagger:model webPushSubscription
 type PushSubscription struct {
 	// The id of the push subscription in the database.
 	ID string `json:"id"`
@@ -27,12 +29,19 @@ type PushSubscription struct {
 	ServerKey string `json:"server_key"`
 	// Which alerts should be delivered to the endpoint.
 	Alerts *PushSubscriptionAlerts `json:"alerts"`
+	// Which accounts to receive push notifications from.
+	// One of "all", "followed", "follower", or "none".
+	Policy string `json:"policy"`
 }
 
 // PushSubscriptionAlerts represents the specific alerts that this push subscription will give.
+//
+// swagger:model webPushSubscriptionAlerts
 type PushSubscriptionAlerts struct {
 	// Receive a push notification when someone has followed you?
 	Follow bool `json:"follow"`
+	// Receive a push notification when someone has requested to follow you?
+	FollowRequest bool `json:"follow_request"`
 	// Receive a push notification when a status you created has been favourited by someone else?
 	Favourite bool `json:"favourite"`
 	// Receive a push notification when someone else has mentioned you in a status?
@@ -41,4 +50,120 @@ type PushSubscriptionAlerts struct {
 	Reblog bool `json:"reblog"`
 	// Receive a push notification when a poll you voted in or created has ended?
 	Poll bool `json:"poll"`
+	// Receive a push notification when a subscribed account posts a status?
+	Status bool `json:"status"`
+	// Receive a push notification when a new user has signed up?
+	AdminSignup bool `json:"admin.sign_up"`
+	// Receive a push notification when a fave is pending?
+	PendingFavourite bool `json:"pending.favourite"`
+	// Receive a push notification when a reply is pending?
+	PendingReply bool `json:"pending.reply"`
+	// Receive a push notification when a boost is pending?
+	PendingReblog bool `json:"pending.reblog"`
+}
+
+// PushSubscriptionCreateRequest models a request to
+// create (or replace) a Web Push subscription.
+//
+// swagger:ignore
+type PushSubscriptionCreateRequest struct {
+	// Push subscription details, from the user agent.
+	Subscription *PushSubscriptionRequestSubscription `form:"-" json:"subscription" xml:"subscription"`
+	// Form data version of Subscription.Endpoint.
+	SubscriptionEndpoint *string `form:"subscription[endpoint]" json:"-" xml:"-"`
+	// Form data version of Subscription.Keys.Auth.
+	SubscriptionKeysAuth *string `form:"subscription[keys][auth]" json:"-" xml:"-"`
+	// Form data version of Subscription.Keys.P256dh.
+	SubscriptionKeysP256dh *string `form:"subscription[keys][p256dh]" json:"-" xml:"-"`
+
+	PushSubscriptionRequestDataForm
+}
+
+// PushSubscriptionUpdateRequest models a request
+// to update the data of a Web Push subscription.
+//
+// swagger:ignore
+type PushSubscriptionUpdateRequest struct {
+	PushSubscriptionRequestDataForm
+}
+
+// PushSubscriptionRequestSubscription models the
+// user agent part of a push subscription request.
+//
+// swagger:ignore
+type PushSubscriptionRequestSubscription struct {
+	// Endpoint URL of the push service.
+	Endpoint string `json:"endpoint"`
+	// Keys used to encrypt pushed messages.
+	Keys *PushSubscriptionKeys `json:"keys"`
+}
+
+// PushSubscriptionKeys models the encryption
+// keys of a user agent's push subscription.
+//
+// swagger:ignore
+type PushSubscriptionKeys struct {
+	// Base64url-encoded authentication secret.
+	Auth string `json:"auth"`
+	// Base64url-encoded P-256 ECDH public key.
+	P256dh string `json:"p256dh"`
+}
+
+// PushSubscriptionRequestData models which notifications
+// should be pushed to a push subscription.
+//
+// swagger:ignore
+type PushSubscriptionRequestData struct {
+	// Which alerts should be delivered to the endpoint.
+	Alerts *PushSubscriptionAlerts `json:"alerts"`
+	// Which accounts to receive push notifications from.
+	Policy *string `json:"policy"`
+}
+
+// PushSubscriptionRequestDataForm contains the "data"
+// part of push subscription create / update requests,
+// both in its JSON shape and as flattened form fields.
+//
+// swagger:ignore
+type PushSubscriptionRequestDataForm struct {
+	// Which notifications should be pushed.
+	Data *PushSubscriptionRequestData `form:"-" json:"data" xml:"data"`
+	// Form data version of Data.Policy.
+	DataPolicy *string `form:"data[policy]" json:"-" xml:"-"`
+	// Form data versions of Data.Alerts.
+	DataAlertsFollow           *bool `form:"data[alerts][follow]" json:"-" xml:"-"`
+	DataAlertsFollowRequest    *bool `form:"data[alerts][follow_request]" json:"-" xml:"-"`
+	DataAlertsFavourite        *bool `form:"data[alerts][favourite]" json:"-" xml:"-"`
+	DataAlertsMention          *bool `form:"data[alerts][mention]" json:"-" xml:"-"`
+	DataAlertsReblog           *bool `form:"data[alerts][reblog]" json:"-" xml:"-"`
+	DataAlertsPoll             *bool `form:"data[alerts][poll]" json:"-" xml:"-"`
+	DataAlertsStatus           *bool `form:"data[alerts][status]" json:"-" xml:"-"`
+	DataAlertsAdminSignup      *bool `form:"data[alerts][admin.sign_up]" json:"-" xml:"-"`
+	DataAlertsPendingFavourite *bool `form:"data[alerts][pending.favourite]" json:"-" xml:"-"`
+	DataAlertsPendingReply     *bool `form:"data[alerts][pending.reply]" json:"-" xml:"-"`
+	DataAlertsPendingReblog    *bool `form:"data[alerts][pending.reblog]" json:"-" xml:"-"`
+}
+
+// WebPushNotification is the JSON payload of a
+// notification delivered to a push subscription,
+// once decrypted by the receiving user agent.
+//
+// swagger:model webPushNotification
+type WebPushNotification struct {
+	// Access token of the push subscription, so
+	// that the client can identify the account.
+	AccessToken string `json:"access_token"`
+	// Preferred language of the receiving account.
+	PreferredLocale string `json:"preferred_locale"`
+	// ID of the notification that was pushed.
+	NotificationID string `json:"notification_id"`
+	// Type of the notification that was pushed.
+	NotificationType string `json:"notification_type"`
+	// URL of the avatar of the account that
+	// caused the notification, if any.
+	Icon string `json:"icon"`
+	// Short, plaintext title of the notification.
+	Title string `json:"title"`
+	// Plaintext body of the notification.
+	Body string `json:"body"`
 }
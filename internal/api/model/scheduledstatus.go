@@ -18,21 +18,83 @@
 package model
 
 // ScheduledStatus represents a status that will be published at a future scheduled date.
+//
+// swagger:model scheduledStatus
 type ScheduledStatus struct {
-	ID               string        `json:"id"`
-	ScheduledAt      string        `json:"scheduled_at"`
-	Params           *StatusParams `json:"params"`
-	MediaAttachments []Attachment  `json:"media_attachments"`
+	// ID of the scheduled status.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Timestamp at which the status will be published (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	ScheduledAt string `json:"scheduled_at"`
+	// Parameters that will be used to create the status.
+	Params *StatusParams `json:"params"`
+	// Media that will be attached to the status.
+	MediaAttachments []*Attachment `json:"media_attachments"`
+	// Reason the status could not be published at its scheduled time, if failed.
+	// A failed scheduled status is kept until deleted, or rescheduled to try again.
+	// example: Not Found: target status not found
+	Error *string `json:"error,omitempty"`
 }
 
 // StatusParams represents parameters for a scheduled status.
+//
+// swagger:model statusParams
 type StatusParams struct {
-	Text          string   `json:"text"`
-	InReplyToID   string   `json:"in_reply_to_id,omitempty"`
-	MediaIDs      []string `json:"media_ids,omitempty"`
-	Sensitive     bool     `json:"sensitive,omitempty"`
-	SpoilerText   string   `json:"spoiler_text,omitempty"`
-	Visibility    string   `json:"visibility"`
-	ScheduledAt   string   `json:"scheduled_at,omitempty"`
-	ApplicationID string   `json:"application_id"`
+	// Text content of the status.
+	Text string `json:"text"`
+	// ID of the status being replied to, if any.
+	// nullable: true
+	InReplyToID *string `json:"in_reply_to_id"`
+	// IDs of media that will be attached to the status.
+	// nullable: true
+	MediaIDs []string `json:"media_ids"`
+	// Status and attached media will be marked as sensitive.
+	Sensitive bool `json:"sensitive"`
+	// Text to be shown as a warning or subject before the actual content.
+	// nullable: true
+	SpoilerText *string `json:"spoiler_text"`
+	// Visibility of the status.
+	// nullable: true
+	Visibility *Visibility `json:"visibility"`
+	// Status will be local-only.
+	LocalOnly bool `json:"local_only"`
+	// ISO 639 language code for the status.
+	// nullable: true
+	Language *string `json:"language"`
+	// Content type to use when parsing the status.
+	// nullable: true
+	ContentType *StatusContentType `json:"content_type"`
+	// Poll that will be attached to the status.
+	// nullable: true
+	Poll *ScheduledStatusParamsPoll `json:"poll"`
+	// Timestamp at which the status will be published (ISO 8601 Datetime).
+	// nullable: true
+	ScheduledAt *string `json:"scheduled_at"`
+	// ID of the application used to schedule the status.
+	ApplicationID string `json:"application_id"`
+}
+
+// ScheduledStatusParamsPoll represents the poll parameters of a scheduled status.
+//
+// swagger:model scheduledStatusParamsPoll
+type ScheduledStatusParamsPoll struct {
+	// Possible answers to the poll.
+	Options []string `json:"options"`
+	// Duration the poll should be open, in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// Poll allows multiple choices.
+	Multiple bool `json:"multiple"`
+	// Poll hides vote counts until it ends.
+	HideTotals bool `json:"hide_totals"`
+}
+
+// ScheduledStatusUpdateRequest models a request
+// to update the publication time of a scheduled status.
+//
+// swagger:ignore
+type ScheduledStatusUpdateRequest struct {
+	// ISO 8601 Datetime at which the status will be published.
+	// Must be at least 5 minutes into the future.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at"`
 }
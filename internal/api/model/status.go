@@ -29,6 +29,10 @@ type Status struct {
 	// The date when this status was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at"`
+	// Timestamp of when the status was last edited (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	// nullable: true
+	EditedAt *string `json:"edited_at"`
 	// ID of the status being replied to.
 	// example: 01FBVD42CQ3ZEEVMW180SBX03B
 	// nullable: true
@@ -227,6 +231,55 @@ type StatusCreateRequest struct {
 	InteractionPolicy *InteractionPolicy `form:"-" json:"interaction_policy"`
 }
 
+// StatusEditRequest models status edit parameters.
+//
+// swagger:ignore
+type StatusEditRequest struct {
+	// Text content of the status.
+	// If media_ids is provided, this becomes optional.
+	// Attaching a poll is optional while status is provided.
+	Status string `form:"status" json:"status"`
+	// Text to be shown as a warning or subject before the actual content.
+	// Statuses are generally collapsed behind this field.
+	SpoilerText string `form:"spoiler_text" json:"spoiler_text"`
+	// Content type to use when parsing this status.
+	ContentType StatusContentType `form:"content_type" json:"content_type"`
+	// Status and attached media should be marked as sensitive.
+	Sensitive bool `form:"sensitive" json:"sensitive"`
+	// ISO 639 language code for this status.
+	Language string `form:"language" json:"language"`
+	// Array of Attachment ids to be attached as media.
+	// If provided, status becomes optional, and poll cannot be used.
+	MediaIDs []string `form:"media_ids[]" json:"media_ids"`
+	// Array of Attachment attributes to be updated in attached media.
+	MediaAttributes []AttachmentAttributesRequest `form:"-" json:"media_attributes"`
+	// Poll to include with this status.
+	Poll *PollRequest `form:"poll" json:"poll"`
+}
+
+// AttachmentAttributesRequest models an edit
+// request for attributes of attached media.
+//
+// swagger:ignore
+type AttachmentAttributesRequest struct {
+	// ID of the attached media to update.
+	ID string `form:"id" json:"id"`
+	// Description of the media file.
+	Description string `form:"description" json:"description"`
+	// Focus of the media file, in the form
+	// of two comma-separated floats between -1 and 1.
+	Focus string `form:"focus" json:"focus"`
+}
+
+// Separate form for parsing attached media
+// attributes on status edit requests.
+//
+// swagger:ignore
+type StatusEditMediaAttributesForm struct {
+	// Array of Attachment attributes to be updated in attached media.
+	MediaAttributes []AttachmentAttributesRequest `form:"media_attributes" json:"-"`
+}
+
 // Separate form for parsing interaction
 // policy on status create requests.
 //
@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// TokenInfo represents metadata about one oauth
+// access token owned by the requesting user.
+//
+// swagger:model tokenInfo
+type TokenInfo struct {
+	// Database ID of this token.
+	// example: 01JMW7QBAZYZ8T8H73PCEX12F3
+	ID string `json:"id"`
+	// When the token was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Approximate time (accurate to within an hour) when the token was last used (ISO 8601 Datetime).
+	// Omitted if token has never been used, or it is not known when it was last used.
+	// example: 2021-07-30T09:20:25+00:00
+	LastUsed string `json:"last_used,omitempty"`
+	// OAuth scopes granted by the token, space-separated.
+	// example: read write admin
+	Scope string `json:"scope"`
+	// Application used to create this token.
+	Application *Application `json:"application"`
+}
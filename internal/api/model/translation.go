@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents the translation of
+// a status's content into another language.
+//
+// swagger:model translation
+type Translation struct {
+	// Translated HTML content of the status.
+	// example: <p>Hello world!</p>
+	Content string `json:"content"`
+	// Translated content warning / spoiler text of the status.
+	// example: Interesting stuff
+	SpoilerText string `json:"spoiler_text"`
+	// Translated descriptions of media attached to the status.
+	MediaAttachments []TranslationAttachment `json:"media_attachments"`
+	// Translated poll options, if the status has a poll.
+	Poll *TranslationPoll `json:"poll,omitempty"`
+	// Language that the status was translated from,
+	// as an ISO 639 language code.
+	// example: de
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	// Language that the status was translated into,
+	// as an ISO 639 language code.
+	// example: en
+	Language string `json:"language"`
+	// Name of the translation service used.
+	// example: LibreTranslate
+	Provider string `json:"provider"`
+}
+
+// TranslationAttachment represents the translated
+// description of a status's media attachment.
+//
+// swagger:model translationAttachment
+type TranslationAttachment struct {
+	// ID of the attachment.
+	// example: 01FC31DZT1AYWDZ8XTCRWRBYRK
+	ID string `json:"id"`
+	// Translated description of the attachment.
+	Description string `json:"description"`
+}
+
+// TranslationPoll represents the translated
+// options of a status's poll.
+//
+// swagger:model translationPoll
+type TranslationPoll struct {
+	// ID of the poll.
+	// example: 01FBYKMD1KBMJ0W6JF1YZ3VY5D
+	ID string `json:"id"`
+	// Translated options of the poll.
+	Options []TranslationPollOption `json:"options"`
+}
+
+// TranslationPollOption represents the
+// translated title of a poll option.
+//
+// swagger:model translationPollOption
+type TranslationPollOption struct {
+	// Translated title of the option.
+	Title string `json:"title"`
+}
+
+// TranslationRequest models a status translation request.
+//
+// swagger:ignore
+type TranslationRequest struct {
+	// Language to translate into, as an ISO 639 language code.
+	// If not set, the requester's default posting language
+	// will be used, falling back to the instance language.
+	Language string `form:"lang" json:"lang"`
+}
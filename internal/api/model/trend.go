@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// TrendsLink represents a link which is trending on this instance,
+// as a preview card of the linked page along with its usage history.
+//
+// swagger:model trendsLink
+type TrendsLink struct {
+	Card
+	// Daily usage history of this link, newest day first.
+	History []History `json:"history"`
+}
+
+// AdminTrend represents a trending tag, status or link, for admin review.
+//
+// swagger:model adminTrend
+type AdminTrend struct {
+	// The ID of the trend.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// Type of the trending item.
+	// example: tag
+	Type string `json:"type"`
+	// Review state of the trend. Only approved trends are shown to users.
+	// example: pending
+	State string `json:"state"`
+	// Time at which the item first trended (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the trend was last reviewed (ISO 8601 Datetime), if at all.
+	// example: 2021-07-30T09:20:25+00:00
+	ReviewedAt *string `json:"reviewed_at"`
+	// ID of the admin account that last reviewed the trend, if any.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// Daily usage history of the trending item, newest day first.
+	History []History `json:"history"`
+	// The trending tag. Only set for tag trends.
+	Tag *Tag `json:"tag,omitempty"`
+	// The trending status. Only set for status trends.
+	Status *Status `json:"status,omitempty"`
+	// The trending link. Only set for link trends.
+	Link *Card `json:"link,omitempty"`
+}
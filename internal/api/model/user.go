@@ -60,6 +60,9 @@ type User struct {
 	// Time when the last "please reset your password" email was sent, if at all. (ISO 8601 Datetime)
 	// example: 2021-07-30T09:20:25+00:00
 	ResetPasswordSentAt string `json:"reset_password_sent_at,omitempty"`
+	// Time at which this user enabled two-factor authentication, if at all. (ISO 8601 Datetime)
+	// example: 2021-07-30T09:20:25+00:00
+	TwoFactorEnabledAt string `json:"two_factor_enabled_at,omitempty"`
 }
 
 // PasswordChangeRequest models user password change parameters.
@@ -95,3 +98,65 @@ type EmailChangeRequest struct {
 	// required: true
 	NewEmail string `form:"new_email" json:"new_email" xml:"new_email" validation:"required"`
 }
+
+// TwoFactorEnrollment models a new, not yet confirmed,
+// two-factor authentication (TOTP) secret for a user.
+//
+// swagger:model twoFactorEnrollment
+type TwoFactorEnrollment struct {
+	// Base32-encoded TOTP secret, for manual
+	// entry into an authenticator app.
+	// example: JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	Secret string `json:"secret"`
+	// otpauth:// URI containing the secret, suitable
+	// for rendering as a QR code for authenticator apps.
+	// example: otpauth://totp/example.org:someone@example.org?algorithm=SHA1&digits=6&issuer=example.org&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	URI string `json:"uri"`
+}
+
+// TwoFactorRecoveryCodes models a set of single-use recovery
+// codes, which can be used instead of a TOTP code to sign in.
+//
+// swagger:model twoFactorRecoveryCodes
+type TwoFactorRecoveryCodes struct {
+	// Recovery codes. These will only be shown once.
+	// example: ["5ho2-zeq3","mjtq-zzdq"]
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorConfirmRequest models two-factor
+// authentication enrollment confirmation parameters.
+//
+// swagger:parameters userTwoFactorConfirm
+type TwoFactorConfirmRequest struct {
+	// 6-digit code from the user's authenticator app,
+	// generated using the secret returned on enrollment.
+	//
+	// in: formData
+	// required: true
+	Code string `form:"code" json:"code" xml:"code" validation:"required"`
+}
+
+// TwoFactorEnrollRequest models two-factor
+// authentication enrollment parameters.
+//
+// swagger:parameters userTwoFactorEnroll
+type TwoFactorEnrollRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+}
+
+// TwoFactorDisableRequest models two-factor
+// authentication disable parameters.
+//
+// swagger:parameters userTwoFactorDisable
+type TwoFactorDisableRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+}
@@ -63,14 +63,24 @@
 
 	TagNameKey = "tag_name"
 
+	/* Trends keys */
+
+	TrendsOffsetKey = "offset"
+
 	/* Web endpoint keys */
 
-	WebStatusIDKey = "status"
+	WebStatusIDKey   = "status"
+	WebInviteCodeKey = "invite_code"
 
 	/* Domain permission keys */
 
 	DomainPermissionExportKey = "export"
 	DomainPermissionImportKey = "import"
+	DomainPermissionTypeKey   = "permission_type"
+
+	DomainPermissionSubscriptionIDKey = "subscription_id"
+	DomainPermissionOverwriteKey      = "overwrite"
+	DomainPermissionExcludeTargetKey  = "exclude_target"
 
 	/* Admin query keys */
 
@@ -91,6 +101,7 @@
 	AdminPermissionsKey = "permissions"
 	AdminRoleIDsKey     = "role_ids[]"
 	AdminInvitedByKey   = "invited_by"
+	AdminTargetHostKey  = "target_host"
 
 	/* Interaction policy + request keys */
 
@@ -169,6 +180,18 @@ func ParseDomainPermissionImport(value string, defaultValue bool) (bool, gtserro
 	return parseBool(value, defaultValue, DomainPermissionImportKey)
 }
 
+func ParseDomainPermissionOverwrite(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, DomainPermissionOverwriteKey)
+}
+
+func ParseDomainPermissionExcludeTarget(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, DomainPermissionExcludeTargetKey)
+}
+
+func ParseTrendsOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, TrendsOffsetKey)
+}
+
 func ParseOnlyOtherAccounts(value string, defaultValue bool) (bool, gtserror.WithCode) {
 	return parseBool(value, defaultValue, OnlyOtherAccountsKey)
 }
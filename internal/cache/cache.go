@@ -21,6 +21,7 @@
 	"time"
 
 	"codeberg.org/gruf/go-cache/v3/ttl"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/cache/headerfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -52,6 +53,11 @@ type Caches struct {
 	// `[status.ID][status.UpdatedAt.Unix()]`
 	StatusesFilterableFields *ttl.Cache[string, []string]
 
+	// TTL cache of statuses -> translations.
+	// To ensure up-to-date translations, cache is keyed as:
+	// `[status.ID][status.UpdatedAt.Unix()][targetLang]`
+	StatusesTranslations *ttl.Cache[string, *apimodel.Translation]
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -69,13 +75,17 @@ func (c *Caches) Init() {
 	c.initBlock()
 	c.initBlockIDs()
 	c.initBoostOfIDs()
+	c.initCard()
 	c.initClient()
 	c.initConversation()
 	c.initConversationLastStatusIDs()
 	c.initDomainAllow()
 	c.initDomainBlock()
+	c.initDomainLimit()
+	c.initDomainPermissionExclude()
 	c.initEmoji()
 	c.initEmojiCategory()
+	c.initFeaturedTag()
 	c.initFilter()
 	c.initFilterKeyword()
 	c.initFilterStatus()
@@ -99,10 +109,12 @@ func (c *Caches) Init() {
 	c.initPollVote()
 	c.initPollVoteIDs()
 	c.initReport()
+	c.initScheduledStatus()
 	c.initSinBinStatus()
 	c.initStatus()
 	c.initStatusBookmark()
 	c.initStatusBookmarkIDs()
+	c.initStatusEdit()
 	c.initStatusFave()
 	c.initStatusFaveIDs()
 	c.initTag()
@@ -115,6 +127,7 @@ func (c *Caches) Init() {
 	c.initWebfinger()
 	c.initVisibility()
 	c.initStatusesFilterableFields()
+	c.initStatusesTranslations()
 }
 
 // Start will start any caches that require a background
@@ -129,6 +142,10 @@ func (c *Caches) Start() {
 	tryUntil("starting statusesFilterableFields cache", 5, func() bool {
 		return c.StatusesFilterableFields.Start(5 * time.Minute)
 	})
+
+	tryUntil("starting statusesTranslations cache", 5, func() bool {
+		return c.StatusesTranslations.Start(5 * time.Minute)
+	})
 }
 
 // Stop will stop any caches that require a background
@@ -138,6 +155,7 @@ func (c *Caches) Stop() {
 
 	tryUntil("stopping webfinger cache", 5, c.Webfinger.Stop)
 	tryUntil("stopping statusesFilterableFields cache", 5, c.StatusesFilterableFields.Stop)
+	tryUntil("stopping statusesTranslations cache", 5, c.StatusesTranslations.Stop)
 }
 
 // Sweep will sweep all the available caches to ensure none
@@ -155,11 +173,13 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.Block.Trim(threshold)
 	c.DB.BlockIDs.Trim(threshold)
 	c.DB.BoostOfIDs.Trim(threshold)
+	c.DB.Card.Trim(threshold)
 	c.DB.Client.Trim(threshold)
 	c.DB.Conversation.Trim(threshold)
 	c.DB.ConversationLastStatusIDs.Trim(threshold)
 	c.DB.Emoji.Trim(threshold)
 	c.DB.EmojiCategory.Trim(threshold)
+	c.DB.FeaturedTag.Trim(threshold)
 	c.DB.Filter.Trim(threshold)
 	c.DB.FilterKeyword.Trim(threshold)
 	c.DB.FilterStatus.Trim(threshold)
@@ -183,10 +203,12 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.PollVote.Trim(threshold)
 	c.DB.PollVoteIDs.Trim(threshold)
 	c.DB.Report.Trim(threshold)
+	c.DB.ScheduledStatus.Trim(threshold)
 	c.DB.SinBinStatus.Trim(threshold)
 	c.DB.Status.Trim(threshold)
 	c.DB.StatusBookmark.Trim(threshold)
 	c.DB.StatusBookmarkIDs.Trim(threshold)
+	c.DB.StatusEdit.Trim(threshold)
 	c.DB.StatusFave.Trim(threshold)
 	c.DB.StatusFaveIDs.Trim(threshold)
 	c.DB.Tag.Trim(threshold)
@@ -224,3 +246,12 @@ func (c *Caches) initStatusesFilterableFields() {
 		1*time.Hour,
 	)
 }
+
+func (c *Caches) initStatusesTranslations() {
+	c.StatusesTranslations = new(ttl.Cache[string, *apimodel.Translation])
+	c.StatusesTranslations.Init(
+		0,
+		512,
+		24*time.Hour,
+	)
+}
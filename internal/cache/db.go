@@ -52,6 +52,9 @@ type DBCaches struct {
 	// BoostOfIDs provides access to the boost of IDs list database cache.
 	BoostOfIDs SliceCache[string]
 
+	// Card provides access to the gtsmodel Card database cache.
+	Card StructCache[*gtsmodel.Card]
+
 	// Client provides access to the gtsmodel Client database cache.
 	Client StructCache[*gtsmodel.Client]
 
@@ -67,12 +70,21 @@ type DBCaches struct {
 	// DomainBlock provides access to the domain block database cache.
 	DomainBlock *domain.Cache
 
+	// DomainLimit provides access to the domain limit database cache.
+	DomainLimit *domain.Cache
+
+	// DomainPermissionExclude provides access to the domain permission exclude database cache.
+	DomainPermissionExclude *domain.Cache
+
 	// Emoji provides access to the gtsmodel Emoji database cache.
 	Emoji StructCache[*gtsmodel.Emoji]
 
 	// EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
 	EmojiCategory StructCache[*gtsmodel.EmojiCategory]
 
+	// FeaturedTag provides access to the gtsmodel FeaturedTag database cache.
+	FeaturedTag StructCache[*gtsmodel.FeaturedTag]
+
 	// Filter provides access to the gtsmodel Filter database cache.
 	Filter StructCache[*gtsmodel.Filter]
 
@@ -208,6 +220,9 @@ type DBCaches struct {
 	// Report provides access to the gtsmodel Report database cache.
 	Report StructCache[*gtsmodel.Report]
 
+	// ScheduledStatus provides access to the gtsmodel ScheduledStatus database cache.
+	ScheduledStatus StructCache[*gtsmodel.ScheduledStatus]
+
 	// SinBinStatus provides access to the gtsmodel SinBinStatus database cache.
 	SinBinStatus StructCache[*gtsmodel.SinBinStatus]
 
@@ -220,6 +235,9 @@ type DBCaches struct {
 	// StatusBookmarkIDs provides access to the status bookmark IDs list database cache.
 	StatusBookmarkIDs SliceCache[string]
 
+	// StatusEdit provides access to the gtsmodel StatusEdit database cache.
+	StatusEdit StructCache[*gtsmodel.StatusEdit]
+
 	// StatusFave provides access to the gtsmodel StatusFave database cache.
 	StatusFave StructCache[*gtsmodel.StatusFave]
 
@@ -468,6 +486,39 @@ func (c *Caches) initBoostOfIDs() {
 	c.DB.BoostOfIDs.Init(0, cap)
 }
 
+func (c *Caches) initCard() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofCard(), // model in-mem size.
+		config.GetCacheCardMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(c1 *gtsmodel.Card) *gtsmodel.Card {
+		c2 := new(gtsmodel.Card)
+		*c2 = *c1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/card.go.
+		c2.Image = nil
+
+		return c2
+	}
+
+	c.DB.Card.Init(structr.CacheConfig[*gtsmodel.Card]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "URL"},
+			{Fields: "ImageID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initClient() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -548,6 +599,14 @@ func (c *Caches) initDomainBlock() {
 	c.DB.DomainBlock = new(domain.Cache)
 }
 
+func (c *Caches) initDomainLimit() {
+	c.DB.DomainLimit = new(domain.Cache)
+}
+
+func (c *Caches) initDomainPermissionExclude() {
+	c.DB.DomainPermissionExclude = new(domain.Cache)
+}
+
 func (c *Caches) initEmoji() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -610,6 +669,40 @@ func (c *Caches) initEmojiCategory() {
 	})
 }
 
+func (c *Caches) initFeaturedTag() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofFeaturedTag(), // model in-mem size.
+		config.GetCacheFeaturedTagMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(f1 *gtsmodel.FeaturedTag) *gtsmodel.FeaturedTag {
+		f2 := new(gtsmodel.FeaturedTag)
+		*f2 = *f1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/featuredtag.go.
+		f2.Account = nil
+		f2.Tag = nil
+
+		return f2
+	}
+
+	c.DB.FeaturedTag.Init(structr.CacheConfig[*gtsmodel.FeaturedTag]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "AccountID,TagID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initFilter() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1222,6 +1315,40 @@ func (c *Caches) initReport() {
 	})
 }
 
+func (c *Caches) initScheduledStatus() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofScheduledStatus(), // model in-mem size.
+		config.GetCacheScheduledStatusMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(s1 *gtsmodel.ScheduledStatus) *gtsmodel.ScheduledStatus {
+		s2 := new(gtsmodel.ScheduledStatus)
+		*s2 = *s1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/scheduledstatus.go.
+		s2.Account = nil
+		s2.MediaAttachments = nil
+		s2.Application = nil
+
+		return s2
+	}
+
+	c.DB.ScheduledStatus.Init(structr.CacheConfig[*gtsmodel.ScheduledStatus]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+			{Fields: "AccountID", Multiple: true},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initSinBinStatus() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1274,6 +1401,8 @@ func (c *Caches) initStatus() {
 		s2.Tags = nil
 		s2.Mentions = nil
 		s2.Emojis = nil
+		s2.Edits = nil
+		s2.Card = nil
 		s2.CreatedWithApplication = nil
 
 		return s2
@@ -1343,6 +1472,37 @@ func (c *Caches) initStatusBookmarkIDs() {
 	c.DB.StatusBookmarkIDs.Init(0, cap)
 }
 
+func (c *Caches) initStatusEdit() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofStatusEdit(), // model in-mem size.
+		config.GetCacheStatusEditMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	copyF := func(s1 *gtsmodel.StatusEdit) *gtsmodel.StatusEdit {
+		s2 := new(gtsmodel.StatusEdit)
+		*s2 = *s1
+
+		// Don't include ptr fields that
+		// will be populated separately.
+		// See internal/db/bundb/statusedit.go.
+		s2.Attachments = nil
+
+		return s2
+	}
+
+	c.DB.StatusEdit.Init(structr.CacheConfig[*gtsmodel.StatusEdit]{
+		Indices: []structr.IndexConfig{
+			{Fields: "ID"},
+		},
+		MaxSize:   cap,
+		IgnoreErr: ignoreErrors,
+		Copy:      copyF,
+	})
+}
+
 func (c *Caches) initStatusFave() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -180,9 +180,11 @@ func totalOfRatios() float64 {
 		config.GetCacheBlockMemRatio() +
 		config.GetCacheBlockIDsMemRatio() +
 		config.GetCacheBoostOfIDsMemRatio() +
+		config.GetCacheCardMemRatio() +
 		config.GetCacheClientMemRatio() +
 		config.GetCacheEmojiMemRatio() +
 		config.GetCacheEmojiCategoryMemRatio() +
+		config.GetCacheFeaturedTagMemRatio() +
 		config.GetCacheFilterMemRatio() +
 		config.GetCacheFilterKeywordMemRatio() +
 		config.GetCacheFilterStatusMemRatio() +
@@ -206,10 +208,12 @@ func totalOfRatios() float64 {
 		config.GetCachePollVoteMemRatio() +
 		config.GetCachePollVoteIDsMemRatio() +
 		config.GetCacheReportMemRatio() +
+		config.GetCacheScheduledStatusMemRatio() +
 		config.GetCacheSinBinStatusMemRatio() +
 		config.GetCacheStatusMemRatio() +
 		config.GetCacheStatusBookmarkMemRatio() +
 		config.GetCacheStatusBookmarkIDsMemRatio() +
+		config.GetCacheStatusEditMemRatio() +
 		config.GetCacheStatusFaveMemRatio() +
 		config.GetCacheStatusFaveIDsMemRatio() +
 		config.GetCacheTagMemRatio() +
@@ -317,6 +321,23 @@ func sizeofBlock() uintptr {
 	}))
 }
 
+func sizeofCard() uintptr {
+	return uintptr(size.Of(&gtsmodel.Card{
+		ID:             exampleID,
+		CreatedAt:      exampleTime,
+		UpdatedAt:      exampleTime,
+		FetchedAt:      exampleTime,
+		URL:            exampleURI,
+		Title:          exampleUsername, // similar length
+		Description:    exampleText,
+		Type:           gtsmodel.CardTypeLink,
+		ProviderName:   exampleUsername, // similar length
+		ProviderURL:    exampleURI,
+		ImageRemoteURL: exampleURI,
+		ImageID:        exampleID,
+	}))
+}
+
 func sizeofClient() uintptr {
 	return uintptr(size.Of(&gtsmodel.Client{
 		ID:        exampleID,
@@ -374,6 +395,16 @@ func sizeofEmojiCategory() uintptr {
 	}))
 }
 
+func sizeofFeaturedTag() uintptr {
+	return uintptr(size.Of(&gtsmodel.FeaturedTag{
+		ID:        exampleID,
+		CreatedAt: exampleTime,
+		UpdatedAt: exampleTime,
+		AccountID: exampleID,
+		TagID:     exampleID,
+	}))
+}
+
 func sizeofFilter() uintptr {
 	return uintptr(size.Of(&gtsmodel.Filter{
 		ID:        exampleID,
@@ -445,6 +476,9 @@ func sizeofInstance() uintptr {
 		ContactEmail:           exampleUsername,
 		ContactAccountUsername: exampleUsername,
 		ContactAccountID:       exampleID,
+		DeliveryFailures:       1,
+		DeliveryFailingSince:   exampleTime,
+		UnavailableAt:          exampleTime,
 	}))
 }
 
@@ -590,6 +624,26 @@ func sizeofReport() uintptr {
 	}))
 }
 
+func sizeofScheduledStatus() uintptr {
+	return uintptr(size.Of(&gtsmodel.ScheduledStatus{
+		ID:             exampleID,
+		CreatedAt:      exampleTime,
+		UpdatedAt:      exampleTime,
+		AccountID:      exampleID,
+		ScheduledAt:    exampleTime,
+		Text:           exampleText,
+		ContentWarning: exampleUsername, // similar length
+		ContentType:    "text/markdown",
+		Sensitive:      func() *bool { ok := false; return &ok }(),
+		Visibility:     gtsmodel.VisibilityPublic,
+		LocalOnly:      func() *bool { ok := false; return &ok }(),
+		Language:       "en",
+		InReplyToID:    exampleID,
+		MediaIDs:       []string{exampleID, exampleID, exampleID},
+		ApplicationID:  exampleID,
+	}))
+}
+
 func sizeofSinBinStatus() uintptr {
 	return uintptr(size.Of(&gtsmodel.SinBinStatus{
 		ID:                  exampleID,
@@ -640,6 +694,7 @@ func sizeofStatus() uintptr {
 		Sensitive:                func() *bool { ok := false; return &ok }(),
 		Language:                 "en",
 		CreatedWithApplicationID: exampleID,
+		CardID:                   exampleID,
 		Federated:                func() *bool { ok := true; return &ok }(),
 		ActivityStreamsType:      ap.ObjectNote,
 	}))
@@ -659,6 +714,23 @@ func sizeofStatusBookmark() uintptr {
 	}))
 }
 
+func sizeofStatusEdit() uintptr {
+	return uintptr(size.Of(&gtsmodel.StatusEdit{
+		ID:             exampleID,
+		Content:        exampleText,
+		ContentWarning: exampleUsername, // similar length
+		Text:           exampleText,
+		Language:       "en",
+		Sensitive:      func() *bool { ok := false; return &ok }(),
+		AttachmentIDs:  []string{exampleID, exampleID, exampleID},
+		Attachments:    nil,
+		PollOptions:    []string{exampleTextSmall, exampleTextSmall, exampleTextSmall, exampleTextSmall},
+		PollVotes:      []int{69, 420, 1337, 1969},
+		StatusID:       exampleID,
+		CreatedAt:      exampleTime,
+	}))
+}
+
 func sizeofStatusFave() uintptr {
 	return uintptr(size.Of(&gtsmodel.StatusFave{
 		ID:              exampleID,
@@ -711,6 +783,7 @@ func sizeofToken() uintptr {
 		Refresh:             "", // TODO: clients don't really support this very well yet
 		RefreshCreateAt:     exampleTime,
 		RefreshExpiresAt:    exampleTime,
+		LastUsed:            exampleTime,
 	}))
 }
 
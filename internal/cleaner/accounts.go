@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Accounts encompasses a set of
+// account cleanup / admin utils.
+type Accounts struct{ *Cleaner }
+
+// All will execute all cleaner.Accounts utilities synchronously, including output logging.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (a *Accounts) All(ctx context.Context, maxRemoteDays int) {
+	if maxRemoteDays <= 0 {
+		// Remote accounts
+		// are kept forever.
+		return
+	}
+	t := time.Now().Add(-24 * time.Hour * time.Duration(maxRemoteDays))
+	a.LogPruneStale(ctx, t)
+}
+
+// LogPruneStale performs Accounts.PruneStale(...), logging the start and outcome.
+func (a *Accounts) LogPruneStale(ctx context.Context, olderThan time.Time) {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	if n, err := a.PruneStale(ctx, olderThan); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "pruned: %d", n)
+	}
+}
+
+// PruneStale will delete all remote accounts created and last fetched before given input
+// time, that have no statuses left and aren't related to any local accounts. See the
+// documentation of db.Account{}.GetStaleRemoteAccounts() for exactly what is checked.
+// This should be run after Statuses.PruneStale(), which may leave more accounts unused.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (a *Accounts) PruneStale(ctx context.Context, olderThan time.Time) (int, error) {
+	var (
+		total int
+		maxID string
+	)
+
+	for {
+		// Fetch the next batch of stale remote accounts to next max ID.
+		accounts, err := a.state.DB.GetStaleRemoteAccounts(
+			gtscontext.SetBarebones(ctx),
+			olderThan,
+			maxID,
+			selectLimit,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting stale remote accounts: %w", err)
+		}
+
+		// If no accounts are returned, we reached the end.
+		if len(accounts) == 0 {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = accounts[len(accounts)-1].ID
+
+		for _, account := range accounts {
+			// Delete each stale account.
+			if err := a.delete(ctx, account); err != nil {
+				return total, err
+			}
+
+			// Update
+			// count.
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// delete will totally delete the given remote
+// account from the database, along with its
+// stats, and its avatar and header media.
+func (a *Accounts) delete(ctx context.Context, account *gtsmodel.Account) error {
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return nil
+	}
+
+	for _, id := range []string{
+		account.AvatarMediaAttachmentID,
+		account.HeaderMediaAttachmentID,
+	} {
+		if id == "" {
+			// Not set.
+			continue
+		}
+
+		// Fetch the account media attachment.
+		media, err := a.state.DB.GetAttachmentByID(
+			gtscontext.SetBarebones(ctx),
+			id,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting account media: %w", err)
+		}
+
+		if media != nil {
+			// Delete media files and database entry.
+			if err := a.media.delete(ctx, media); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Delete stats for this account, if any.
+	if err := a.state.DB.DeleteAccountStats(ctx, account.ID); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting account stats: %w", err)
+	}
+
+	// Finally delete the account itself.
+	log.WithContext(ctx).
+		WithField("account", account.ID).
+		Debug("deleting stale remote account")
+	if err := a.state.DB.DeleteAccount(ctx, account.ID); err != nil {
+		return gtserror.Newf("error deleting account: %w", err)
+	}
+
+	return nil
+}
@@ -34,9 +34,11 @@
 )
 
 type Cleaner struct {
-	state *state.State
-	emoji Emoji
-	media Media
+	state    *state.State
+	emoji    Emoji
+	media    Media
+	statuses Statuses
+	accounts Accounts
 }
 
 func New(state *state.State) *Cleaner {
@@ -44,6 +46,8 @@ func New(state *state.State) *Cleaner {
 	c.state = state
 	c.emoji.Cleaner = c
 	c.media.Cleaner = c
+	c.statuses.Cleaner = c
+	c.accounts.Cleaner = c
 	return c
 }
 
@@ -57,6 +61,16 @@ func (c *Cleaner) Media() *Media {
 	return &c.media
 }
 
+// Statuses returns the status set of cleaner utilities.
+func (c *Cleaner) Statuses() *Statuses {
+	return &c.statuses
+}
+
+// Accounts returns the account set of cleaner utilities.
+func (c *Cleaner) Accounts() *Accounts {
+	return &c.accounts
+}
+
 // haveFiles returns whether all of the provided files exist within current storage.
 func (c *Cleaner) haveFiles(ctx context.Context, files ...string) (bool, error) {
 	for _, path := range files {
@@ -161,6 +175,13 @@ func (c *Cleaner) ScheduleJobs() error {
 
 	fn := func(ctx context.Context, start time.Time) {
 		log.Info(ctx, "starting media clean")
+
+		// Prune stale statuses and accounts first,
+		// so that media left unused by them will be
+		// cleaned up in the same run, by Media.All().
+		c.Statuses().All(ctx, config.GetStatusesRemotePruneDays())
+		c.Accounts().All(ctx, config.GetStatusesRemotePruneDays())
+
 		c.Media().All(ctx, config.GetMediaRemoteCacheDays())
 		c.Emoji().All(ctx, config.GetMediaRemoteCacheDays())
 		log.Infof(ctx, "finished media clean after %s", time.Since(start))
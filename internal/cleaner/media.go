@@ -374,6 +374,17 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 		}
 	}
 
+	// Check whether media is the image of a link preview card.
+	card, err := m.state.DB.GetCardByImageID(gtscontext.SetBarebones(ctx), media.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error fetching card for media: %w", err)
+	}
+
+	if card != nil {
+		l.Debug("skipping as card image")
+		return false, nil
+	}
+
 	// Media totally unused, delete it.
 	l.Debug("deleting unused media")
 	return true, m.delete(ctx, media)
@@ -0,0 +1,236 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Statuses encompasses a set of
+// status cleanup / admin utils.
+type Statuses struct{ *Cleaner }
+
+// All will execute all cleaner.Statuses utilities synchronously, including output logging.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (s *Statuses) All(ctx context.Context, maxRemoteDays int) {
+	if maxRemoteDays <= 0 {
+		// Remote statuses
+		// are kept forever.
+		return
+	}
+	t := time.Now().Add(-24 * time.Hour * time.Duration(maxRemoteDays))
+	s.LogPruneStale(ctx, t)
+}
+
+// LogPruneStale performs Statuses.PruneStale(...), logging the start and outcome.
+func (s *Statuses) LogPruneStale(ctx context.Context, olderThan time.Time) {
+	log.Infof(ctx, "start older than: %s", olderThan.Format(time.Stamp))
+	if n, err := s.PruneStale(ctx, olderThan); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "pruned: %d", n)
+	}
+}
+
+// PruneStale will delete all remote statuses older than given input time that no local
+// account has interacted with, along with their media, mentions, boosts etc. See the
+// documentation of db.Status{}.GetStaleRemoteStatuses() for what counts as interaction.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (s *Statuses) PruneStale(ctx context.Context, olderThan time.Time) (int, error) {
+	var (
+		total int
+		maxID string
+	)
+
+	for {
+		// Fetch the next batch of stale remote statuses to next max ID.
+		statuses, err := s.state.DB.GetStaleRemoteStatuses(
+			gtscontext.SetBarebones(ctx),
+			olderThan,
+			maxID,
+			selectLimit,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting stale remote statuses: %w", err)
+		}
+
+		// If no statuses are returned, we reached the end.
+		if len(statuses) == 0 {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			// Delete each stale status.
+			if err := s.delete(ctx, status); err != nil {
+				return total, err
+			}
+
+			// Update
+			// count.
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+// delete will totally delete the given remote status from the database,
+// along with its attachments, edits, mentions, notifications, faves, poll,
+// and boosts. This is similar to the status wiping done when processing a
+// status delete, but assumes no local account has interacted with it.
+func (s *Statuses) delete(ctx context.Context, status *gtsmodel.Status) error {
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return nil
+	}
+
+	// Start a log entry for status.
+	l := log.WithContext(ctx).
+		WithField("status", status.ID)
+
+	// Gather IDs of all media attached to this
+	// status, and to any previous versions of it.
+	mediaIDs := slices.Clone(status.AttachmentIDs)
+
+	if len(status.EditIDs) > 0 {
+		// Fetch any historical edits of this status.
+		edits, err := s.state.DB.GetStatusEditsByIDs(
+			gtscontext.SetBarebones(ctx),
+			status.EditIDs,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting status edits: %w", err)
+		}
+
+		for _, edit := range edits {
+			for _, id := range edit.AttachmentIDs {
+				if !slices.Contains(mediaIDs, id) {
+					mediaIDs = append(mediaIDs, id)
+				}
+			}
+		}
+
+		// Delete all the historical edits of this status.
+		if err := s.state.DB.DeleteStatusEdits(ctx, status.EditIDs); err != nil {
+			return gtserror.Newf("error deleting status edits: %w", err)
+		}
+	}
+
+	if len(mediaIDs) > 0 {
+		// Fetch all media attachments of this status.
+		attachments, err := s.state.DB.GetAttachmentsByIDs(
+			gtscontext.SetBarebones(ctx),
+			mediaIDs,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting status media: %w", err)
+		}
+
+		for _, media := range attachments {
+			// Delete media files and database entry.
+			if err := s.media.delete(ctx, media); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Delete all mentions generated by this status.
+	for _, id := range status.MentionIDs {
+		if err := s.state.DB.DeleteMentionByID(ctx, id); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error deleting status mention: %w", err)
+		}
+	}
+
+	// Delete all notifications generated by this status.
+	if err := s.state.DB.DeleteNotificationsForStatus(ctx, status.ID); err != nil {
+		return gtserror.Newf("error deleting status notifications: %w", err)
+	}
+
+	// Delete all (remote) faves of this status.
+	if err := s.state.DB.DeleteStatusFavesForStatus(ctx, status.ID); err != nil {
+		return gtserror.Newf("error deleting status faves: %w", err)
+	}
+
+	if pollID := status.PollID; pollID != "" {
+		// Delete this poll (and its votes) from the database.
+		if err := s.state.DB.DeletePollByID(ctx, pollID); err != nil {
+			return gtserror.Newf("error deleting status poll: %w", err)
+		}
+
+		// Cancel any scheduled expiry task for poll.
+		_ = s.state.Workers.Scheduler.Cancel(pollID)
+	}
+
+	// Get all (remote) boosts of this status.
+	boosts, err := s.state.DB.GetStatusBoosts(
+		gtscontext.SetBarebones(ctx),
+		status.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting status boosts: %w", err)
+	}
+
+	for _, boost := range boosts {
+		// Delete the boost itself.
+		if err := s.deleteStatus(ctx, boost.ID); err != nil {
+			return err
+		}
+	}
+
+	// Finally delete the status itself.
+	l.Debug("deleting stale remote status")
+	return s.deleteStatus(ctx, status.ID)
+}
+
+// deleteStatus deletes the status with given ID from
+// the database, and removes it from any timelines.
+func (s *Statuses) deleteStatus(ctx context.Context, id string) error {
+	if err := s.state.DB.DeleteStatusByID(ctx, id); err != nil {
+		return gtserror.Newf("error deleting status %s: %w", id, err)
+	}
+
+	// Timelines are only
+	// available when running
+	// as a server, not CLI.
+	if s.state.Timelines.Home != nil {
+		if err := s.state.Timelines.Home.WipeItemFromAllTimelines(ctx, id); err != nil {
+			return gtserror.Newf("error wiping status %s from home timelines: %w", id, err)
+		}
+	}
+
+	if s.state.Timelines.List != nil {
+		if err := s.state.Timelines.List.WipeItemFromAllTimelines(ctx, id); err != nil {
+			return gtserror.Newf("error wiping status %s from list timelines: %w", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestStatusesPruneStale() {
+	suite.testStatusesPruneStale(context.Background())
+}
+
+func (suite *CleanerTestSuite) TestStatusesPruneStaleDryRun() {
+	suite.testStatusesPruneStale(gtscontext.SetDryRun(context.Background()))
+}
+
+func (suite *CleanerTestSuite) testStatusesPruneStale(ctx context.Context) {
+	var (
+		remoteAccount = testrig.NewTestAccounts()["remote_account_2"]
+		localAccount  = testrig.NewTestAccounts()["local_account_1"]
+		createdAt     = testrig.TimeMustParse("2020-01-01T12:00:00+02:00")
+	)
+
+	// Put two old remote statuses, one of
+	// which has been faved by a local account.
+	staleStatus := suite.newRemoteStatus(remoteAccount, "01DXJ7WWQP8MXFSPRHE4R5PRYB", createdAt)
+	favedStatus := suite.newRemoteStatus(remoteAccount, "01DXJ7WWQPYNBCH0FD0Z4CXS3P", createdAt)
+	for _, status := range []*gtsmodel.Status{staleStatus, favedStatus} {
+		if err := suite.state.DB.PutStatus(context.Background(), status); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	if err := suite.state.DB.PutStatusFave(context.Background(), &gtsmodel.StatusFave{
+		ID:              "01DXJ7WWQPWNWVDJ4BHXM9ZD5J",
+		AccountID:       localAccount.ID,
+		TargetAccountID: remoteAccount.ID,
+		StatusID:        favedStatus.ID,
+		URI:             "http://localhost:8080/users/the_mighty_zork/liked/01DXJ7WWQPWNWVDJ4BHXM9ZD5J",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Prune statuses older than a day. Only the stale
+	// status should be pruned, as test model statuses
+	// are all interacted with or by reported accounts.
+	pruned, err := suite.cleaner.Statuses().PruneStale(ctx, time.Now().Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Equal(1, pruned)
+
+	// The faved status should always be kept.
+	_, err = suite.state.DB.GetStatusByID(context.Background(), favedStatus.ID)
+	suite.NoError(err)
+
+	// The stale status should only be kept on dry run.
+	_, err = suite.state.DB.GetStatusByID(context.Background(), staleStatus.ID)
+	if gtscontext.DryRun(ctx) {
+		suite.NoError(err)
+	} else {
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+}
+
+func (suite *CleanerTestSuite) TestAccountsPruneStale() {
+	suite.testAccountsPruneStale(context.Background())
+}
+
+func (suite *CleanerTestSuite) TestAccountsPruneStaleDryRun() {
+	suite.testAccountsPruneStale(gtscontext.SetDryRun(context.Background()))
+}
+
+func (suite *CleanerTestSuite) testAccountsPruneStale(ctx context.Context) {
+	testAccounts := testrig.NewTestAccounts()
+
+	// These test model remote accounts have no
+	// statuses, and no relationships to locals.
+	staleAccounts := []*gtsmodel.Account{
+		testAccounts["remote_account_3"],
+		testAccounts["remote_account_4"],
+	}
+
+	pruned, err := suite.cleaner.Accounts().PruneStale(ctx, time.Now().Add(-24*time.Hour))
+	suite.NoError(err)
+	suite.Equal(len(staleAccounts), pruned)
+
+	for _, account := range staleAccounts {
+		// Stale accounts should only be kept on dry run.
+		_, err := suite.state.DB.GetAccountByID(context.Background(), account.ID)
+		if gtscontext.DryRun(ctx) {
+			suite.NoError(err)
+		} else {
+			suite.ErrorIs(err, db.ErrNoEntries)
+		}
+	}
+
+	// Remote accounts with statuses should always be kept.
+	_, err = suite.state.DB.GetAccountByID(context.Background(), testAccounts["remote_account_1"].ID)
+	suite.NoError(err)
+}
+
+// newRemoteStatus returns a new remote public status
+// by account, with the given ID and creation time.
+func (suite *CleanerTestSuite) newRemoteStatus(account *gtsmodel.Account, id string, createdAt time.Time) *gtsmodel.Status {
+	return &gtsmodel.Status{
+		ID:                  id,
+		URI:                 account.URI + "/statuses/" + id,
+		URL:                 account.URL + "/statuses/" + id,
+		Content:             "nobody will ever see this",
+		CreatedAt:           createdAt,
+		UpdatedAt:           createdAt,
+		Local:               util.Ptr(false),
+		AccountURI:          account.URI,
+		AccountID:           account.ID,
+		Visibility:          gtsmodel.VisibilityPublic,
+		Sensitive:           util.Ptr(false),
+		Federated:           util.Ptr(true),
+		ActivityStreamsType: ap.ObjectNote,
+		PendingApproval:     util.Ptr(false),
+	}
+}
@@ -85,6 +85,8 @@ type Configuration struct {
 	InstanceExposeSuspendedWeb     bool               `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
 	InstanceExposePublicTimeline   bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
 	InstanceDeliverToSharedInboxes bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceUnavailableFailures    int                `name:"instance-unavailable-failures" usage:"Number of consecutive failed deliveries after which a remote instance is marked as unavailable, and deliveries to it are suspended until it is reachable again. Set to 0 to disable."`
+	InstanceUnavailableAfter       time.Duration      `name:"instance-unavailable-after" usage:"Minimum duration that deliveries to a remote instance must have been failing for before it is marked as unavailable."`
 	InstanceInjectMastodonVersion  bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
 	InstanceLanguages              language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
 
@@ -93,6 +95,12 @@ type Configuration struct {
 	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
 	AccountsCustomCSSLength  int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
 
+	AccountsInvitesEnabled  bool          `name:"accounts-invites-enabled" usage:"Allow users who aren't admins to create invite links, which let people sign up even if registration is closed. Admins can always create invite links."`
+	AccountsInviteMaxUses   int           `name:"accounts-invite-max-uses" usage:"Maximum number of sign-ups permitted per invite link created by a user who isn't an admin. 0 means no maximum."`
+	AccountsInviteMaxExpiry time.Duration `name:"accounts-invite-max-expiry" usage:"Maximum duration for which an invite link created by a user who isn't an admin can be used. 0 means no maximum, ie., invite links may never expire."`
+
+	AccountsTwoFactorEncryptionKey string `name:"accounts-two-factor-encryption-key" usage:"Secret key used to encrypt two-factor authentication secrets stored in the database. Two-factor authentication cannot be enabled by users if this is not set."`
+
 	MediaDescriptionMinChars int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
 	MediaDescriptionMaxChars int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
 	MediaRemoteCacheDays     int           `name:"media-remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
@@ -118,6 +126,7 @@ type Configuration struct {
 	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
 	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
 	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesRemotePruneDays    int `name:"statuses-remote-prune-days" usage:"Number of days after which remote statuses that no local account has interacted with, and remote accounts left unused, are deleted during media cleanup. If set to 0, remote statuses and accounts will be kept indefinitely."`
 
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
@@ -159,6 +168,12 @@ type Configuration struct {
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
+	TranslationBackend              string        `name:"translation-backend" usage:"Backend to use for translating statuses: ['libretranslate', 'command'], or leave empty to disable translation."`
+	TranslationLibreTranslateURL    string        `name:"translation-libretranslate-url" usage:"Base URL of a LibreTranslate-compatible API to use for translations. Eg., 'http://localhost:5000'"`
+	TranslationLibreTranslateAPIKey string        `name:"translation-libretranslate-api-key" usage:"API key to send to the LibreTranslate-compatible API, if required."`
+	TranslationCommand              []string      `name:"translation-command" usage:"Command and arguments to run for each translation. The command reads a LibreTranslate-style JSON request on stdin, and must write a LibreTranslate-style JSON response to stdout."`
+	TranslationTimeout              time.Duration `name:"translation-timeout" usage:"Maximum time to wait for the translation backend to translate one status."`
+
 	AdvancedCookiesSamesite      string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
 	AdvancedRateLimitRequests    int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
 	AdvancedRateLimitExceptions  []string      `name:"advanced-rate-limit-exceptions" usage:"Slice of CIDRs to exclude from rate limit restrictions."`
@@ -203,11 +218,13 @@ type CacheConfiguration struct {
 	BlockMemRatio                     float64       `name:"block-mem-ratio"`
 	BlockIDsMemRatio                  float64       `name:"block-ids-mem-ratio"`
 	BoostOfIDsMemRatio                float64       `name:"boost-of-ids-mem-ratio"`
+	CardMemRatio                      float64       `name:"card-mem-ratio"`
 	ClientMemRatio                    float64       `name:"client-mem-ratio"`
 	ConversationMemRatio              float64       `name:"conversation-mem-ratio"`
 	ConversationLastStatusIDsMemRatio float64       `name:"conversation-last-status-ids-mem-ratio"`
 	EmojiMemRatio                     float64       `name:"emoji-mem-ratio"`
 	EmojiCategoryMemRatio             float64       `name:"emoji-category-mem-ratio"`
+	FeaturedTagMemRatio               float64       `name:"featured-tag-mem-ratio"`
 	FilterMemRatio                    float64       `name:"filter-mem-ratio"`
 	FilterKeywordMemRatio             float64       `name:"filter-keyword-mem-ratio"`
 	FilterStatusMemRatio              float64       `name:"filter-status-mem-ratio"`
@@ -231,10 +248,12 @@ type CacheConfiguration struct {
 	PollVoteMemRatio                  float64       `name:"poll-vote-mem-ratio"`
 	PollVoteIDsMemRatio               float64       `name:"poll-vote-ids-mem-ratio"`
 	ReportMemRatio                    float64       `name:"report-mem-ratio"`
+	ScheduledStatusMemRatio           float64       `name:"scheduled-status-mem-ratio"`
 	SinBinStatusMemRatio              float64       `name:"sin-bin-status-mem-ratio"`
 	StatusMemRatio                    float64       `name:"status-mem-ratio"`
 	StatusBookmarkMemRatio            float64       `name:"status-bookmark-mem-ratio"`
 	StatusBookmarkIDsMemRatio         float64       `name:"status-bookmark-ids-mem-ratio"`
+	StatusEditMemRatio                float64       `name:"status-edit-mem-ratio"`
 	StatusFaveMemRatio                float64       `name:"status-fave-mem-ratio"`
 	StatusFaveIDsMemRatio             float64       `name:"status-fave-ids-mem-ratio"`
 	TagMemRatio                       float64       `name:"tag-mem-ratio"`
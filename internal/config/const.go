@@ -29,4 +29,10 @@
 	RequestHeaderFilterModeAllow    = "allow"
 	RequestHeaderFilterModeBlock    = "block"
 	RequestHeaderFilterModeDisabled = ""
+
+	// Translation backend determines which service (if
+	// any) this instance will use to translate statuses.
+	TranslationBackendLibreTranslate = "libretranslate"
+	TranslationBackendCommand        = "command"
+	TranslationBackendDisabled       = ""
 )
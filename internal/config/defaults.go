@@ -64,6 +64,8 @@
 	InstanceExposeSuspended:        false,
 	InstanceExposeSuspendedWeb:     false,
 	InstanceDeliverToSharedInboxes: true,
+	InstanceUnavailableFailures:    10,
+	InstanceUnavailableAfter:       24 * time.Hour,
 	InstanceLanguages:              make(language.Languages, 0),
 
 	AccountsRegistrationOpen: false,
@@ -71,6 +73,12 @@
 	AccountsAllowCustomCSS:   false,
 	AccountsCustomCSSLength:  10000,
 
+	AccountsInvitesEnabled:  false,
+	AccountsInviteMaxUses:   10,
+	AccountsInviteMaxExpiry: 7 * 24 * time.Hour,
+
+	AccountsTwoFactorEncryptionKey: "",
+
 	MediaDescriptionMinChars: 0,
 	MediaDescriptionMaxChars: 1500,
 	MediaRemoteCacheDays:     7,
@@ -92,6 +100,7 @@
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesRemotePruneDays:    0,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
@@ -129,6 +138,12 @@
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	TranslationBackend:              "",
+	TranslationLibreTranslateURL:    "",
+	TranslationLibreTranslateAPIKey: "",
+	TranslationCommand:              []string{},
+	TranslationTimeout:              30 * time.Second,
+
 	AdvancedCookiesSamesite:      "lax",
 	AdvancedRateLimitRequests:    300, // 1 per second per 5 minutes
 	AdvancedRateLimitExceptions:  []string{},
@@ -166,11 +181,13 @@
 		BlockMemRatio:                     2,
 		BlockIDsMemRatio:                  3,
 		BoostOfIDsMemRatio:                3,
+		CardMemRatio:                      0.5,
 		ClientMemRatio:                    0.1,
 		ConversationMemRatio:              1,
 		ConversationLastStatusIDsMemRatio: 2,
 		EmojiMemRatio:                     3,
 		EmojiCategoryMemRatio:             0.1,
+		FeaturedTagMemRatio:               0.5,
 		FilterMemRatio:                    0.5,
 		FilterKeywordMemRatio:             0.5,
 		FilterStatusMemRatio:              0.5,
@@ -194,10 +211,12 @@
 		PollVoteMemRatio:                  2,
 		PollVoteIDsMemRatio:               2,
 		ReportMemRatio:                    1,
+		ScheduledStatusMemRatio:           0.5,
 		SinBinStatusMemRatio:              0.5,
 		StatusMemRatio:                    5,
 		StatusBookmarkMemRatio:            0.5,
 		StatusBookmarkIDsMemRatio:         2,
+		StatusEditMemRatio:                2,
 		StatusFaveMemRatio:                2,
 		StatusFaveIDsMemRatio:             3,
 		TagMemRatio:                       2,
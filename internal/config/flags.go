@@ -89,12 +89,18 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().Int(InstanceUnavailableFailuresFlag(), cfg.InstanceUnavailableFailures, fieldtag("InstanceUnavailableFailures", "usage"))
+		cmd.Flags().Duration(InstanceUnavailableAfterFlag(), cfg.InstanceUnavailableAfter, fieldtag("InstanceUnavailableAfter", "usage"))
 		cmd.Flags().StringSlice(InstanceLanguagesFlag(), cfg.InstanceLanguages.TagStrs(), fieldtag("InstanceLanguages", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Bool(AccountsInvitesEnabledFlag(), cfg.AccountsInvitesEnabled, fieldtag("AccountsInvitesEnabled", "usage"))
+		cmd.Flags().Int(AccountsInviteMaxUsesFlag(), cfg.AccountsInviteMaxUses, fieldtag("AccountsInviteMaxUses", "usage"))
+		cmd.Flags().Duration(AccountsInviteMaxExpiryFlag(), cfg.AccountsInviteMaxExpiry, fieldtag("AccountsInviteMaxExpiry", "usage"))
+		cmd.Flags().String(AccountsTwoFactorEncryptionKeyFlag(), cfg.AccountsTwoFactorEncryptionKey, fieldtag("AccountsTwoFactorEncryptionKey", "usage"))
 
 		// Media
 		cmd.Flags().Int(MediaDescriptionMinCharsFlag(), cfg.MediaDescriptionMinChars, fieldtag("MediaDescriptionMinChars", "usage"))
@@ -116,6 +122,7 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
+		cmd.Flags().Int(StatusesRemotePruneDaysFlag(), cfg.StatusesRemotePruneDays, fieldtag("StatusesRemotePruneDays", "usage"))
 
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
@@ -149,6 +156,13 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
 		cmd.Flags().String(SyslogAddressFlag(), cfg.SyslogAddress, fieldtag("SyslogAddress", "usage"))
 
+		// Translation
+		cmd.Flags().String(TranslationBackendFlag(), cfg.TranslationBackend, fieldtag("TranslationBackend", "usage"))
+		cmd.Flags().String(TranslationLibreTranslateURLFlag(), cfg.TranslationLibreTranslateURL, fieldtag("TranslationLibreTranslateURL", "usage"))
+		cmd.Flags().String(TranslationLibreTranslateAPIKeyFlag(), cfg.TranslationLibreTranslateAPIKey, fieldtag("TranslationLibreTranslateAPIKey", "usage"))
+		cmd.Flags().StringSlice(TranslationCommandFlag(), cfg.TranslationCommand, fieldtag("TranslationCommand", "usage"))
+		cmd.Flags().Duration(TranslationTimeoutFlag(), cfg.TranslationTimeout, fieldtag("TranslationTimeout", "usage"))
+
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
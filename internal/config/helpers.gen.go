@@ -950,6 +950,56 @@ func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliver
 // SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
 func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
 
+// GetInstanceUnavailableFailures safely fetches the Configuration value for state's 'InstanceUnavailableFailures' field
+func (st *ConfigState) GetInstanceUnavailableFailures() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceUnavailableFailures
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceUnavailableFailures safely sets the Configuration value for state's 'InstanceUnavailableFailures' field
+func (st *ConfigState) SetInstanceUnavailableFailures(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceUnavailableFailures = v
+	st.reloadToViper()
+}
+
+// InstanceUnavailableFailuresFlag returns the flag name for the 'InstanceUnavailableFailures' field
+func InstanceUnavailableFailuresFlag() string { return "instance-unavailable-failures" }
+
+// GetInstanceUnavailableFailures safely fetches the value for global configuration 'InstanceUnavailableFailures' field
+func GetInstanceUnavailableFailures() int { return global.GetInstanceUnavailableFailures() }
+
+// SetInstanceUnavailableFailures safely sets the value for global configuration 'InstanceUnavailableFailures' field
+func SetInstanceUnavailableFailures(v int) { global.SetInstanceUnavailableFailures(v) }
+
+// GetInstanceUnavailableAfter safely fetches the Configuration value for state's 'InstanceUnavailableAfter' field
+func (st *ConfigState) GetInstanceUnavailableAfter() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceUnavailableAfter
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceUnavailableAfter safely sets the Configuration value for state's 'InstanceUnavailableAfter' field
+func (st *ConfigState) SetInstanceUnavailableAfter(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceUnavailableAfter = v
+	st.reloadToViper()
+}
+
+// InstanceUnavailableAfterFlag returns the flag name for the 'InstanceUnavailableAfter' field
+func InstanceUnavailableAfterFlag() string { return "instance-unavailable-after" }
+
+// GetInstanceUnavailableAfter safely fetches the value for global configuration 'InstanceUnavailableAfter' field
+func GetInstanceUnavailableAfter() time.Duration { return global.GetInstanceUnavailableAfter() }
+
+// SetInstanceUnavailableAfter safely sets the value for global configuration 'InstanceUnavailableAfter' field
+func SetInstanceUnavailableAfter(v time.Duration) { global.SetInstanceUnavailableAfter(v) }
+
 // GetInstanceInjectMastodonVersion safely fetches the Configuration value for state's 'InstanceInjectMastodonVersion' field
 func (st *ConfigState) GetInstanceInjectMastodonVersion() (v bool) {
 	st.mutex.RLock()
@@ -1100,6 +1150,106 @@ func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength
 // SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
 func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
+// GetAccountsInvitesEnabled safely fetches the Configuration value for state's 'AccountsInvitesEnabled' field
+func (st *ConfigState) GetAccountsInvitesEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsInvitesEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsInvitesEnabled safely sets the Configuration value for state's 'AccountsInvitesEnabled' field
+func (st *ConfigState) SetAccountsInvitesEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInvitesEnabled = v
+	st.reloadToViper()
+}
+
+// AccountsInvitesEnabledFlag returns the flag name for the 'AccountsInvitesEnabled' field
+func AccountsInvitesEnabledFlag() string { return "accounts-invites-enabled" }
+
+// GetAccountsInvitesEnabled safely fetches the value for global configuration 'AccountsInvitesEnabled' field
+func GetAccountsInvitesEnabled() bool { return global.GetAccountsInvitesEnabled() }
+
+// SetAccountsInvitesEnabled safely sets the value for global configuration 'AccountsInvitesEnabled' field
+func SetAccountsInvitesEnabled(v bool) { global.SetAccountsInvitesEnabled(v) }
+
+// GetAccountsInviteMaxUses safely fetches the Configuration value for state's 'AccountsInviteMaxUses' field
+func (st *ConfigState) GetAccountsInviteMaxUses() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsInviteMaxUses
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsInviteMaxUses safely sets the Configuration value for state's 'AccountsInviteMaxUses' field
+func (st *ConfigState) SetAccountsInviteMaxUses(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInviteMaxUses = v
+	st.reloadToViper()
+}
+
+// AccountsInviteMaxUsesFlag returns the flag name for the 'AccountsInviteMaxUses' field
+func AccountsInviteMaxUsesFlag() string { return "accounts-invite-max-uses" }
+
+// GetAccountsInviteMaxUses safely fetches the value for global configuration 'AccountsInviteMaxUses' field
+func GetAccountsInviteMaxUses() int { return global.GetAccountsInviteMaxUses() }
+
+// SetAccountsInviteMaxUses safely sets the value for global configuration 'AccountsInviteMaxUses' field
+func SetAccountsInviteMaxUses(v int) { global.SetAccountsInviteMaxUses(v) }
+
+// GetAccountsInviteMaxExpiry safely fetches the Configuration value for state's 'AccountsInviteMaxExpiry' field
+func (st *ConfigState) GetAccountsInviteMaxExpiry() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsInviteMaxExpiry
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsInviteMaxExpiry safely sets the Configuration value for state's 'AccountsInviteMaxExpiry' field
+func (st *ConfigState) SetAccountsInviteMaxExpiry(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInviteMaxExpiry = v
+	st.reloadToViper()
+}
+
+// AccountsInviteMaxExpiryFlag returns the flag name for the 'AccountsInviteMaxExpiry' field
+func AccountsInviteMaxExpiryFlag() string { return "accounts-invite-max-expiry" }
+
+// GetAccountsInviteMaxExpiry safely fetches the value for global configuration 'AccountsInviteMaxExpiry' field
+func GetAccountsInviteMaxExpiry() time.Duration { return global.GetAccountsInviteMaxExpiry() }
+
+// SetAccountsInviteMaxExpiry safely sets the value for global configuration 'AccountsInviteMaxExpiry' field
+func SetAccountsInviteMaxExpiry(v time.Duration) { global.SetAccountsInviteMaxExpiry(v) }
+
+// GetAccountsTwoFactorEncryptionKey safely fetches the Configuration value for state's 'AccountsTwoFactorEncryptionKey' field
+func (st *ConfigState) GetAccountsTwoFactorEncryptionKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.AccountsTwoFactorEncryptionKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsTwoFactorEncryptionKey safely sets the Configuration value for state's 'AccountsTwoFactorEncryptionKey' field
+func (st *ConfigState) SetAccountsTwoFactorEncryptionKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsTwoFactorEncryptionKey = v
+	st.reloadToViper()
+}
+
+// AccountsTwoFactorEncryptionKeyFlag returns the flag name for the 'AccountsTwoFactorEncryptionKey' field
+func AccountsTwoFactorEncryptionKeyFlag() string { return "accounts-two-factor-encryption-key" }
+
+// GetAccountsTwoFactorEncryptionKey safely fetches the value for global configuration 'AccountsTwoFactorEncryptionKey' field
+func GetAccountsTwoFactorEncryptionKey() string { return global.GetAccountsTwoFactorEncryptionKey() }
+
+// SetAccountsTwoFactorEncryptionKey safely sets the value for global configuration 'AccountsTwoFactorEncryptionKey' field
+func SetAccountsTwoFactorEncryptionKey(v string) { global.SetAccountsTwoFactorEncryptionKey(v) }
+
 // GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
 func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
 	st.mutex.RLock()
@@ -1675,6 +1825,31 @@ func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
 // SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
 func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
 
+// GetStatusesRemotePruneDays safely fetches the Configuration value for state's 'StatusesRemotePruneDays' field
+func (st *ConfigState) GetStatusesRemotePruneDays() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesRemotePruneDays
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesRemotePruneDays safely sets the Configuration value for state's 'StatusesRemotePruneDays' field
+func (st *ConfigState) SetStatusesRemotePruneDays(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesRemotePruneDays = v
+	st.reloadToViper()
+}
+
+// StatusesRemotePruneDaysFlag returns the flag name for the 'StatusesRemotePruneDays' field
+func StatusesRemotePruneDaysFlag() string { return "statuses-remote-prune-days" }
+
+// GetStatusesRemotePruneDays safely fetches the value for global configuration 'StatusesRemotePruneDays' field
+func GetStatusesRemotePruneDays() int { return global.GetStatusesRemotePruneDays() }
+
+// SetStatusesRemotePruneDays safely sets the value for global configuration 'StatusesRemotePruneDays' field
+func SetStatusesRemotePruneDays(v int) { global.SetStatusesRemotePruneDays(v) }
+
 // GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
 func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
 	st.mutex.RLock()
@@ -2500,6 +2675,131 @@ func GetSyslogAddress() string { return global.GetSyslogAddress() }
 // SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
 func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
+// GetTranslationBackend safely fetches the Configuration value for state's 'TranslationBackend' field
+func (st *ConfigState) GetTranslationBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationBackend safely sets the Configuration value for state's 'TranslationBackend' field
+func (st *ConfigState) SetTranslationBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationBackend = v
+	st.reloadToViper()
+}
+
+// TranslationBackendFlag returns the flag name for the 'TranslationBackend' field
+func TranslationBackendFlag() string { return "translation-backend" }
+
+// GetTranslationBackend safely fetches the value for global configuration 'TranslationBackend' field
+func GetTranslationBackend() string { return global.GetTranslationBackend() }
+
+// SetTranslationBackend safely sets the value for global configuration 'TranslationBackend' field
+func SetTranslationBackend(v string) { global.SetTranslationBackend(v) }
+
+// GetTranslationLibreTranslateURL safely fetches the Configuration value for state's 'TranslationLibreTranslateURL' field
+func (st *ConfigState) GetTranslationLibreTranslateURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationLibreTranslateURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationLibreTranslateURL safely sets the Configuration value for state's 'TranslationLibreTranslateURL' field
+func (st *ConfigState) SetTranslationLibreTranslateURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationLibreTranslateURL = v
+	st.reloadToViper()
+}
+
+// TranslationLibreTranslateURLFlag returns the flag name for the 'TranslationLibreTranslateURL' field
+func TranslationLibreTranslateURLFlag() string { return "translation-libretranslate-url" }
+
+// GetTranslationLibreTranslateURL safely fetches the value for global configuration 'TranslationLibreTranslateURL' field
+func GetTranslationLibreTranslateURL() string { return global.GetTranslationLibreTranslateURL() }
+
+// SetTranslationLibreTranslateURL safely sets the value for global configuration 'TranslationLibreTranslateURL' field
+func SetTranslationLibreTranslateURL(v string) { global.SetTranslationLibreTranslateURL(v) }
+
+// GetTranslationLibreTranslateAPIKey safely fetches the Configuration value for state's 'TranslationLibreTranslateAPIKey' field
+func (st *ConfigState) GetTranslationLibreTranslateAPIKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.TranslationLibreTranslateAPIKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationLibreTranslateAPIKey safely sets the Configuration value for state's 'TranslationLibreTranslateAPIKey' field
+func (st *ConfigState) SetTranslationLibreTranslateAPIKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationLibreTranslateAPIKey = v
+	st.reloadToViper()
+}
+
+// TranslationLibreTranslateAPIKeyFlag returns the flag name for the 'TranslationLibreTranslateAPIKey' field
+func TranslationLibreTranslateAPIKeyFlag() string { return "translation-libretranslate-api-key" }
+
+// GetTranslationLibreTranslateAPIKey safely fetches the value for global configuration 'TranslationLibreTranslateAPIKey' field
+func GetTranslationLibreTranslateAPIKey() string { return global.GetTranslationLibreTranslateAPIKey() }
+
+// SetTranslationLibreTranslateAPIKey safely sets the value for global configuration 'TranslationLibreTranslateAPIKey' field
+func SetTranslationLibreTranslateAPIKey(v string) { global.SetTranslationLibreTranslateAPIKey(v) }
+
+// GetTranslationCommand safely fetches the Configuration value for state's 'TranslationCommand' field
+func (st *ConfigState) GetTranslationCommand() (v []string) {
+	st.mutex.RLock()
+	v = st.config.TranslationCommand
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationCommand safely sets the Configuration value for state's 'TranslationCommand' field
+func (st *ConfigState) SetTranslationCommand(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationCommand = v
+	st.reloadToViper()
+}
+
+// TranslationCommandFlag returns the flag name for the 'TranslationCommand' field
+func TranslationCommandFlag() string { return "translation-command" }
+
+// GetTranslationCommand safely fetches the value for global configuration 'TranslationCommand' field
+func GetTranslationCommand() []string { return global.GetTranslationCommand() }
+
+// SetTranslationCommand safely sets the value for global configuration 'TranslationCommand' field
+func SetTranslationCommand(v []string) { global.SetTranslationCommand(v) }
+
+// GetTranslationTimeout safely fetches the Configuration value for state's 'TranslationTimeout' field
+func (st *ConfigState) GetTranslationTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.TranslationTimeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetTranslationTimeout safely sets the Configuration value for state's 'TranslationTimeout' field
+func (st *ConfigState) SetTranslationTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TranslationTimeout = v
+	st.reloadToViper()
+}
+
+// TranslationTimeoutFlag returns the flag name for the 'TranslationTimeout' field
+func TranslationTimeoutFlag() string { return "translation-timeout" }
+
+// GetTranslationTimeout safely fetches the value for global configuration 'TranslationTimeout' field
+func GetTranslationTimeout() time.Duration { return global.GetTranslationTimeout() }
+
+// SetTranslationTimeout safely sets the value for global configuration 'TranslationTimeout' field
+func SetTranslationTimeout(v time.Duration) { global.SetTranslationTimeout(v) }
+
 // GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
 func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
 	st.mutex.RLock()
@@ -3025,6 +3325,31 @@ func GetCacheBoostOfIDsMemRatio() float64 { return global.GetCacheBoostOfIDsMemR
 // SetCacheBoostOfIDsMemRatio safely sets the value for global configuration 'Cache.BoostOfIDsMemRatio' field
 func SetCacheBoostOfIDsMemRatio(v float64) { global.SetCacheBoostOfIDsMemRatio(v) }
 
+// GetCacheCardMemRatio safely fetches the Configuration value for state's 'Cache.CardMemRatio' field
+func (st *ConfigState) GetCacheCardMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.CardMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheCardMemRatio safely sets the Configuration value for state's 'Cache.CardMemRatio' field
+func (st *ConfigState) SetCacheCardMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.CardMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheCardMemRatioFlag returns the flag name for the 'Cache.CardMemRatio' field
+func CacheCardMemRatioFlag() string { return "cache-card-mem-ratio" }
+
+// GetCacheCardMemRatio safely fetches the value for global configuration 'Cache.CardMemRatio' field
+func GetCacheCardMemRatio() float64 { return global.GetCacheCardMemRatio() }
+
+// SetCacheCardMemRatio safely sets the value for global configuration 'Cache.CardMemRatio' field
+func SetCacheCardMemRatio(v float64) { global.SetCacheCardMemRatio(v) }
+
 // GetCacheClientMemRatio safely fetches the Configuration value for state's 'Cache.ClientMemRatio' field
 func (st *ConfigState) GetCacheClientMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3156,6 +3481,31 @@ func GetCacheEmojiCategoryMemRatio() float64 { return global.GetCacheEmojiCatego
 // SetCacheEmojiCategoryMemRatio safely sets the value for global configuration 'Cache.EmojiCategoryMemRatio' field
 func SetCacheEmojiCategoryMemRatio(v float64) { global.SetCacheEmojiCategoryMemRatio(v) }
 
+// GetCacheFeaturedTagMemRatio safely fetches the Configuration value for state's 'Cache.FeaturedTagMemRatio' field
+func (st *ConfigState) GetCacheFeaturedTagMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.FeaturedTagMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheFeaturedTagMemRatio safely sets the Configuration value for state's 'Cache.FeaturedTagMemRatio' field
+func (st *ConfigState) SetCacheFeaturedTagMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.FeaturedTagMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheFeaturedTagMemRatioFlag returns the flag name for the 'Cache.FeaturedTagMemRatio' field
+func CacheFeaturedTagMemRatioFlag() string { return "cache-featured-tag-mem-ratio" }
+
+// GetCacheFeaturedTagMemRatio safely fetches the value for global configuration 'Cache.FeaturedTagMemRatio' field
+func GetCacheFeaturedTagMemRatio() float64 { return global.GetCacheFeaturedTagMemRatio() }
+
+// SetCacheFeaturedTagMemRatio safely sets the value for global configuration 'Cache.FeaturedTagMemRatio' field
+func SetCacheFeaturedTagMemRatio(v float64) { global.SetCacheFeaturedTagMemRatio(v) }
+
 // GetCacheFilterMemRatio safely fetches the Configuration value for state's 'Cache.FilterMemRatio' field
 func (st *ConfigState) GetCacheFilterMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3731,6 +4081,31 @@ func GetCacheReportMemRatio() float64 { return global.GetCacheReportMemRatio() }
 // SetCacheReportMemRatio safely sets the value for global configuration 'Cache.ReportMemRatio' field
 func SetCacheReportMemRatio(v float64) { global.SetCacheReportMemRatio(v) }
 
+// GetCacheScheduledStatusMemRatio safely fetches the Configuration value for state's 'Cache.ScheduledStatusMemRatio' field
+func (st *ConfigState) GetCacheScheduledStatusMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.ScheduledStatusMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheScheduledStatusMemRatio safely sets the Configuration value for state's 'Cache.ScheduledStatusMemRatio' field
+func (st *ConfigState) SetCacheScheduledStatusMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.ScheduledStatusMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheScheduledStatusMemRatioFlag returns the flag name for the 'Cache.ScheduledStatusMemRatio' field
+func CacheScheduledStatusMemRatioFlag() string { return "cache-scheduled-status-mem-ratio" }
+
+// GetCacheScheduledStatusMemRatio safely fetches the value for global configuration 'Cache.ScheduledStatusMemRatio' field
+func GetCacheScheduledStatusMemRatio() float64 { return global.GetCacheScheduledStatusMemRatio() }
+
+// SetCacheScheduledStatusMemRatio safely sets the value for global configuration 'Cache.ScheduledStatusMemRatio' field
+func SetCacheScheduledStatusMemRatio(v float64) { global.SetCacheScheduledStatusMemRatio(v) }
+
 // GetCacheSinBinStatusMemRatio safely fetches the Configuration value for state's 'Cache.SinBinStatusMemRatio' field
 func (st *ConfigState) GetCacheSinBinStatusMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3831,6 +4206,31 @@ func GetCacheStatusBookmarkIDsMemRatio() float64 { return global.GetCacheStatusB
 // SetCacheStatusBookmarkIDsMemRatio safely sets the value for global configuration 'Cache.StatusBookmarkIDsMemRatio' field
 func SetCacheStatusBookmarkIDsMemRatio(v float64) { global.SetCacheStatusBookmarkIDsMemRatio(v) }
 
+// GetCacheStatusEditMemRatio safely fetches the Configuration value for state's 'Cache.StatusEditMemRatio' field
+func (st *ConfigState) GetCacheStatusEditMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusEditMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusEditMemRatio safely sets the Configuration value for state's 'Cache.StatusEditMemRatio' field
+func (st *ConfigState) SetCacheStatusEditMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusEditMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheStatusEditMemRatioFlag returns the flag name for the 'Cache.StatusEditMemRatio' field
+func CacheStatusEditMemRatioFlag() string { return "cache-status-edit-mem-ratio" }
+
+// GetCacheStatusEditMemRatio safely fetches the value for global configuration 'Cache.StatusEditMemRatio' field
+func GetCacheStatusEditMemRatio() float64 { return global.GetCacheStatusEditMemRatio() }
+
+// SetCacheStatusEditMemRatio safely sets the value for global configuration 'Cache.StatusEditMemRatio' field
+func SetCacheStatusEditMemRatio(v float64) { global.SetCacheStatusEditMemRatio(v) }
+
 // GetCacheStatusFaveMemRatio safely fetches the Configuration value for state's 'Cache.StatusFaveMemRatio' field
 func (st *ConfigState) GetCacheStatusFaveMemRatio() (v float64) {
 	st.mutex.RLock()
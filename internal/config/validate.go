@@ -142,6 +142,46 @@ func Validate() error {
 		}
 	}
 
+	// `translation-backend` and its
+	// backend-specific settings.
+	switch backend := GetTranslationBackend(); backend {
+	case TranslationBackendDisabled:
+		// No problem.
+
+	case TranslationBackendLibreTranslate:
+		ltURL := GetTranslationLibreTranslateURL()
+		if ltURL == "" {
+			errf(
+				"%s must be set when %s is %s",
+				TranslationLibreTranslateURLFlag(), TranslationBackendFlag(), backend,
+			)
+		} else if url, err := url.Parse(ltURL); err != nil {
+			errf(
+				"%s invalid: %w",
+				TranslationLibreTranslateURLFlag(), err,
+			)
+		} else if url.Scheme != "https" && url.Scheme != "http" {
+			errf(
+				"%s scheme must be https or http",
+				TranslationLibreTranslateURLFlag(),
+			)
+		}
+
+	case TranslationBackendCommand:
+		if len(GetTranslationCommand()) == 0 {
+			errf(
+				"%s must be set when %s is %s",
+				TranslationCommandFlag(), TranslationBackendFlag(), backend,
+			)
+		}
+
+	default:
+		errf(
+			"%s must be set to either libretranslate, command, or left empty, provided value was %s",
+			TranslationBackendFlag(), backend,
+		)
+	}
+
 	// Custom / LE TLS settings.
 	//
 	// Only one of custom certs or LE can be set,
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"net/netip"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
@@ -60,6 +61,9 @@ type Account interface {
 	// GetAccountByMovedToURI returns any accounts with given moved_to_uri set.
 	GetAccountsByMovedToURI(ctx context.Context, uri string) ([]*gtsmodel.Account, error)
 
+	// GetLocalGroupAccounts returns all local, unsuspended group accounts, ordered by username.
+	GetLocalGroupAccounts(ctx context.Context) ([]*gtsmodel.Account, error)
+
 	// GetAccounts returns accounts
 	// with the given parameters.
 	GetAccounts(
@@ -168,4 +172,13 @@ type Account interface {
 
 	// DeleteAccountStats deletes the accountStats entry for the given accountID.
 	DeleteAccountStats(ctx context.Context, accountID string) error
+
+	// GetStaleRemoteAccounts returns up to limit remote accounts created and last fetched before olderThan,
+	// with IDs lower than maxID, that have no statuses stored, no moderation actions taken against them, and
+	// are not referenced by any relationships, mentions, notifications, faves, votes, or interaction requests.
+	GetStaleRemoteAccounts(ctx context.Context, olderThan time.Time, maxID string, limit int) ([]*gtsmodel.Account, error)
+
+	// GetRecentlyActiveLocalAccountIDs returns the IDs of up to limit discoverable, unsuspended
+	// local accounts which have created a status since the given time, most recently active first.
+	GetRecentlyActiveLocalAccountIDs(ctx context.Context, since time.Time, limit int) ([]string, error)
 }
@@ -0,0 +1,80 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Announcement interface {
+	// GetAnnouncementByID fetches the announcement with the given ID.
+	GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error)
+
+	// GetAnnouncements fetches all announcements from the
+	// database, published or not, most recently created first.
+	GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// GetActiveAnnouncements fetches all announcements which have been
+	// published and have not yet ended, most recently published first.
+	GetActiveAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// GetScheduledAnnouncements fetches all announcements which
+	// have been scheduled for publication but not yet published.
+	GetScheduledAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error)
+
+	// PopulateAnnouncement ensures that the
+	// announcement's emojis and tags are populated.
+	PopulateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// PutAnnouncement stores the given announcement in the database.
+	PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error
+
+	// UpdateAnnouncement updates the given announcement in the database.
+	// If any columns are specified, only those will be updated.
+	UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error
+
+	// DeleteAnnouncementByID deletes the announcement with
+	// the given ID, along with any reactions and reads of it.
+	DeleteAnnouncementByID(ctx context.Context, id string) error
+
+	// GetAnnouncementReactions fetches all reactions to
+	// the given announcement ID, oldest reaction first.
+	GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error)
+
+	// PutAnnouncementReaction stores the given announcement reaction in the
+	// database. Repeated reactions with the same name by one account are ignored.
+	PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error
+
+	// DeleteAnnouncementReaction deletes the reaction with the given
+	// name by the given account ID to the given announcement ID.
+	DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) error
+
+	// IsAnnouncementRead returns whether the given account
+	// ID has marked the given announcement ID as read.
+	IsAnnouncementRead(ctx context.Context, announcementID string, accountID string) (bool, error)
+
+	// PutAnnouncementRead stores the given announcement read in the database.
+	// Repeated reads of the same announcement by one account are ignored.
+	PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error
+
+	// DeleteAccountAnnouncementInteractions deletes all
+	// announcement reactions and reads by the given account ID.
+	DeleteAccountAnnouncementInteractions(ctx context.Context, accountID string) error
+}
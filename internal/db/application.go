@@ -21,6 +21,7 @@
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 type Application interface {
@@ -48,6 +49,12 @@ type Application interface {
 	// GetAllTokens ...
 	GetAllTokens(ctx context.Context) ([]*gtsmodel.Token, error)
 
+	// GetTokenByID fetches the token from the database with corresponding ID value.
+	GetTokenByID(ctx context.Context, id string) (*gtsmodel.Token, error)
+
+	// GetAccessTokens fetches page of access tokens owned by the given user ID, newest first.
+	GetAccessTokens(ctx context.Context, userID string, page *paging.Page) ([]*gtsmodel.Token, error)
+
 	// GetTokenByCode ...
 	GetTokenByCode(ctx context.Context, code string) (*gtsmodel.Token, error)
 
@@ -60,6 +67,9 @@ type Application interface {
 	// PutToken ...
 	PutToken(ctx context.Context, token *gtsmodel.Token) error
 
+	// UpdateToken updates the given token in the database, only updating given columns if provided.
+	UpdateToken(ctx context.Context, token *gtsmodel.Token, columns ...string) error
+
 	// DeleteTokenByID ...
 	DeleteTokenByID(ctx context.Context, id string) error
 
@@ -26,6 +26,7 @@
 	"strings"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -273,6 +274,30 @@ func (a *accountDB) GetAccountsByMovedToURI(ctx context.Context, uri string) ([]
 	return a.GetAccountsByIDs(ctx, accountIDs)
 }
 
+func (a *accountDB) GetLocalGroupAccounts(ctx context.Context) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	// Find all IDs of local,
+	// unsuspended group actors.
+	if err := a.db.NewSelect().
+		Table("accounts").
+		Column("id").
+		Where("? IS NULL", bun.Ident("domain")).
+		Where("? = ?", bun.Ident("actor_type"), ap.ActorGroup).
+		Where("? IS NULL", bun.Ident("suspended_at")).
+		OrderExpr("? ASC", bun.Ident("username")).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+
+	// Return account models for all found IDs.
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
 // GetAccounts selects accounts using the given parameters.
 // Unlike with other functions, the paging for GetAccounts
 // is done not by ID, but by a concatenation of `[domain]/@[username]`,
@@ -1400,3 +1425,99 @@ func (a *accountDB) DeleteAccountStats(ctx context.Context, accountID string) er
 
 	return nil
 }
+
+func (a *accountDB) GetStaleRemoteAccounts(ctx context.Context, olderThan time.Time, maxID string, limit int) ([]*gtsmodel.Account, error) {
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		// Keep accounts that moderation
+		// action has been taken against.
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Where("? IS NULL", bun.Ident("account.silenced_at")).
+		Where("? IS NULL", bun.Ident("account.sensitized_at")).
+		Where("? < ?", bun.Ident("account.created_at"), olderThan).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("account.fetched_at")).
+				WhereOr("? < ?", bun.Ident("account.fetched_at"), olderThan)
+		}).
+		Order("account.id DESC")
+
+	// Ensure the account isn't referenced by
+	// any of the given table columns, ie., it
+	// has no statuses, relationships to local
+	// accounts, interactions etc left over.
+	for _, ref := range []struct {
+		table  string
+		column string
+	}{
+		{"statuses", "account_id"},
+		{"follows", "account_id"},
+		{"follows", "target_account_id"},
+		{"follow_requests", "account_id"},
+		{"follow_requests", "target_account_id"},
+		{"blocks", "account_id"},
+		{"blocks", "target_account_id"},
+		{"user_mutes", "target_account_id"},
+		{"account_notes", "target_account_id"},
+		{"reports", "account_id"},
+		{"reports", "target_account_id"},
+		{"mentions", "target_account_id"},
+		{"notifications", "origin_account_id"},
+		{"status_faves", "account_id"},
+		{"poll_votes", "account_id"},
+		{"interaction_requests", "interacting_account_id"},
+	} {
+		q = q.Where("NOT EXISTS (?)", a.db.
+			NewSelect().
+			Table(ref.table).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident(ref.table+"."+ref.column), bun.Ident("account.id")),
+		)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account.id"), maxID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	var accountIDs []string
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
+func (a *accountDB) GetRecentlyActiveLocalAccountIDs(ctx context.Context, since time.Time, limit int) ([]string, error) {
+	var accountIDs []string
+
+	// Select authors of local statuses created
+	// since the given time, ranked by how recently
+	// they last created a status.
+	if err := a.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr("? AS ?", bun.Ident("status.account_id"), bun.Ident("account_id")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("status.account_id"),
+		).
+		Where("? = ?", bun.Ident("status.local"), true).
+		Where("? >= ?", bun.Ident("status.created_at"), since).
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		GroupExpr("?", bun.Ident("status.account_id")).
+		OrderExpr("MAX(?) DESC", bun.Ident("status.created_at")).
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
@@ -0,0 +1,256 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error) {
+	announcement := new(gtsmodel.Announcement)
+	if err := a.db.NewSelect().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return announcement, nil
+	}
+
+	if err := a.PopulateAnnouncement(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	return announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	return a.getAnnouncements(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Order("announcement.id DESC")
+	})
+}
+
+func (a *announcementDB) GetActiveAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	return a.getAnnouncements(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? IS NOT NULL", bun.Ident("announcement.published_at")).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					WhereOr("? IS NULL", bun.Ident("announcement.ends_at")).
+					WhereOr("? > ?", bun.Ident("announcement.ends_at"), time.Now())
+			}).
+			Order("announcement.published_at DESC")
+	})
+}
+
+func (a *announcementDB) GetScheduledAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	return a.getAnnouncements(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? IS NULL", bun.Ident("announcement.published_at")).
+			Where("? IS NOT NULL", bun.Ident("announcement.scheduled_at")).
+			Order("announcement.scheduled_at ASC")
+	})
+}
+
+func (a *announcementDB) getAnnouncements(
+	ctx context.Context,
+	query func(*bun.SelectQuery) *bun.SelectQuery,
+) ([]*gtsmodel.Announcement, error) {
+	var announcements []*gtsmodel.Announcement
+	if err := query(a.db.NewSelect().
+		Model(&announcements),
+	).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return announcements, nil
+	}
+
+	for _, announcement := range announcements {
+		if err := a.PopulateAnnouncement(ctx, announcement); err != nil {
+			return nil, err
+		}
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) PopulateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if len(announcement.EmojiIDs) != len(announcement.Emojis) {
+		// Announcement emojis are out-of-date with IDs, repopulate.
+		announcement.Emojis, err = a.state.DB.GetEmojisByIDs(
+			ctx, // these are already barebones
+			announcement.EmojiIDs,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating announcement emojis: %w", err)
+		}
+	}
+
+	if len(announcement.TagIDs) != len(announcement.Tags) {
+		// Announcement tags are out-of-date with IDs, repopulate.
+		announcement.Tags, err = a.state.DB.GetTags(
+			ctx,
+			announcement.TagIDs,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("error populating announcement tags: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	_, err := a.db.NewInsert().
+		Model(announcement).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) error {
+	announcement.UpdatedAt = time.Now()
+	if len(columns) > 0 && !slices.Contains(columns, "updated_at") {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.db.NewUpdate().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), announcement.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Table("announcement_reactions").
+			Where("? = ?", bun.Ident("announcement_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewDelete().
+			Table("announcement_reads").
+			Where("? = ?", bun.Ident("announcement_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Table("announcements").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (a *announcementDB) GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, error) {
+	var reactions []*gtsmodel.AnnouncementReaction
+	if err := a.db.NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Order("id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
+
+func (a *announcementDB) PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) error {
+	_, err := a.db.NewInsert().
+		Model(reaction).
+		On("CONFLICT (?, ?, ?) DO NOTHING",
+			bun.Ident("announcement_id"),
+			bun.Ident("account_id"),
+			bun.Ident("name"),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) error {
+	_, err := a.db.NewDelete().
+		Table("announcement_reactions").
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? = ?", bun.Ident("name"), name).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) IsAnnouncementRead(ctx context.Context, announcementID string, accountID string) (bool, error) {
+	return exists(ctx, a.db.NewSelect().
+		Table("announcement_reads").
+		Column("id").
+		Where("? = ?", bun.Ident("announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("account_id"), accountID),
+	)
+}
+
+func (a *announcementDB) PutAnnouncementRead(ctx context.Context, read *gtsmodel.AnnouncementRead) error {
+	_, err := a.db.NewInsert().
+		Model(read).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("announcement_id"), bun.Ident("account_id")).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAccountAnnouncementInteractions(ctx context.Context, accountID string) error {
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Table("announcement_reactions").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Table("announcement_reads").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx)
+		return err
+	})
+}
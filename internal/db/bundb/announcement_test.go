@@ -0,0 +1,200 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// putAnnouncement stores a new announcement
+// with the given publication and end times.
+func (suite *AnnouncementTestSuite) putAnnouncement(publishedAt, scheduledAt, endsAt time.Time) *gtsmodel.Announcement {
+	announcement := &gtsmodel.Announcement{
+		ID:                 id.NewULID(),
+		Text:               "hello world",
+		Content:            "<p>hello world</p>",
+		AllDay:             util.Ptr(false),
+		PublishedAt:        publishedAt,
+		ScheduledAt:        scheduledAt,
+		EndsAt:             endsAt,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.db.PutAnnouncement(context.Background(), announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return announcement
+}
+
+func (suite *AnnouncementTestSuite) TestGetAnnouncements() {
+	var (
+		ctx  = context.Background()
+		now  = time.Now()
+		zero = time.Time{}
+
+		active    = suite.putAnnouncement(now.Add(-time.Hour), zero, zero)
+		ending    = suite.putAnnouncement(now.Add(-time.Hour), zero, now.Add(time.Hour))
+		scheduled = suite.putAnnouncement(zero, now.Add(time.Hour), zero)
+	)
+
+	// Ended and draft announcements
+	// only show up in the full list.
+	suite.putAnnouncement(now.Add(-time.Hour), zero, now.Add(-time.Minute))
+	suite.putAnnouncement(zero, zero, zero)
+
+	announcements, err := suite.db.GetAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(announcements, 5)
+
+	announcements, err = suite.db.GetActiveAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.ElementsMatch([]string{active.ID, ending.ID}, announcementIDs(announcements))
+
+	announcements, err = suite.db.GetScheduledAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{scheduled.ID}, announcementIDs(announcements))
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementReactionsAndReads() {
+	var (
+		ctx          = context.Background()
+		announcement = suite.putAnnouncement(time.Now(), time.Time{}, time.Time{})
+		account      = suite.testAccounts["local_account_1"]
+	)
+
+	for i := 0; i < 2; i++ {
+		// Repeat reactions are ignored.
+		if err := suite.db.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+			ID:             id.NewULID(),
+			AnnouncementID: announcement.ID,
+			AccountID:      account.ID,
+			Name:           "👍",
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	reactions, err := suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reactions, 1)
+
+	read, err := suite.db.IsAnnouncementRead(ctx, announcement.ID, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(read)
+
+	for i := 0; i < 2; i++ {
+		// Repeat reads are ignored.
+		if err := suite.db.PutAnnouncementRead(ctx, &gtsmodel.AnnouncementRead{
+			ID:             id.NewULID(),
+			AnnouncementID: announcement.ID,
+			AccountID:      account.ID,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	read, err = suite.db.IsAnnouncementRead(ctx, announcement.ID, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(read)
+
+	// Deleting the account's interactions
+	// removes both reactions and reads.
+	if err := suite.db.DeleteAccountAnnouncementInteractions(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reactions, err = suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(reactions)
+
+	read, err = suite.db.IsAnnouncementRead(ctx, announcement.ID, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(read)
+}
+
+func (suite *AnnouncementTestSuite) TestDeleteAnnouncement() {
+	var (
+		ctx          = context.Background()
+		announcement = suite.putAnnouncement(time.Now(), time.Time{}, time.Time{})
+	)
+
+	if err := suite.db.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: announcement.ID,
+		AccountID:      suite.testAccounts["local_account_1"].ID,
+		Name:           "rainbow",
+		EmojiID:        suite.testEmojis["rainbow"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetAnnouncementByID(ctx, announcement.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	reactions, err := suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(reactions)
+}
+
+func announcementIDs(announcements []*gtsmodel.Announcement) []string {
+	ids := make([]string, 0, len(announcements))
+	for _, announcement := range announcements {
+		ids = append(ids, announcement.ID)
+	}
+	return ids
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
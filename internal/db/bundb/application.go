@@ -19,8 +19,11 @@
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -143,35 +146,74 @@ func (a *applicationDB) GetAllTokens(ctx context.Context) ([]*gtsmodel.Token, er
 		return nil, err
 	}
 
-	// Load all input token IDs via cache loader callback.
-	tokens, err := a.state.Caches.DB.Token.LoadIDs("ID",
-		tokenIDs,
-		func(uncached []string) ([]*gtsmodel.Token, error) {
-			// Preallocate expected length of uncached tokens.
-			tokens := make([]*gtsmodel.Token, 0, len(uncached))
-
-			// Perform database query scanning
-			// the remaining (uncached) token IDs.
-			if err := a.db.NewSelect().
-				Model(&tokens).
-				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
-				Scan(ctx); err != nil {
-				return nil, err
-			}
+	return a.getTokensByIDs(ctx, tokenIDs)
+}
 
-			return tokens, nil
+func (a *applicationDB) GetTokenByID(ctx context.Context, id string) (*gtsmodel.Token, error) {
+	return a.getTokenBy(
+		"ID",
+		func(t *gtsmodel.Token) error {
+			return a.db.NewSelect().Model(t).Where("? = ?", bun.Ident("id"), id).Scan(ctx)
 		},
+		id,
 	)
-	if err != nil {
+}
+
+func (a *applicationDB) GetAccessTokens(ctx context.Context, userID string, page *paging.Page) ([]*gtsmodel.Token, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		tokenIDs = make([]string, 0, limit)
+	)
+
+	q := a.db.NewSelect().
+		Table("tokens").
+		Column("id").
+		Where("? = ?", bun.Ident("user_id"), userID).
+		Where("? != ''", bun.Ident("access"))
+
+	if maxID != "" {
+		// Return only tokens LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only tokens HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("id"))
+	}
+
+	if err := q.Scan(ctx, &tokenIDs); err != nil {
 		return nil, err
 	}
 
-	// Reoroder the tokens by their
-	// IDs to ensure in correct order.
-	getID := func(t *gtsmodel.Token) string { return t.ID }
-	util.OrderBy(tokens, tokenIDs, getID)
+	if len(tokenIDs) == 0 {
+		return nil, nil
+	}
 
-	return tokens, nil
+	// If we're paging up, we still want tokens
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(tokenIDs)
+	}
+
+	return a.getTokensByIDs(ctx, tokenIDs)
 }
 
 func (a *applicationDB) GetTokenByCode(ctx context.Context, code string) (*gtsmodel.Token, error) {
@@ -204,6 +246,38 @@ func(t *gtsmodel.Token) error {
 	)
 }
 
+func (a *applicationDB) getTokensByIDs(ctx context.Context, tokenIDs []string) ([]*gtsmodel.Token, error) {
+	// Load all input token IDs via cache loader callback.
+	tokens, err := a.state.Caches.DB.Token.LoadIDs("ID",
+		tokenIDs,
+		func(uncached []string) ([]*gtsmodel.Token, error) {
+			// Preallocate expected length of uncached tokens.
+			tokens := make([]*gtsmodel.Token, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) token IDs.
+			if err := a.db.NewSelect().
+				Model(&tokens).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return tokens, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reoroder the tokens by their
+	// IDs to ensure in correct order.
+	getID := func(t *gtsmodel.Token) string { return t.ID }
+	util.OrderBy(tokens, tokenIDs, getID)
+
+	return tokens, nil
+}
+
 func (a *applicationDB) getTokenBy(lookup string, dbQuery func(*gtsmodel.Token) error, keyParts ...any) (*gtsmodel.Token, error) {
 	return a.state.Caches.DB.Token.LoadOne(lookup, func() (*gtsmodel.Token, error) {
 		var token gtsmodel.Token
@@ -223,7 +297,29 @@ func (a *applicationDB) PutToken(ctx context.Context, token *gtsmodel.Token) err
 	})
 }
 
+func (a *applicationDB) UpdateToken(ctx context.Context, token *gtsmodel.Token, columns ...string) error {
+	token.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return a.state.Caches.DB.Token.Store(token, func() error {
+		_, err := a.db.NewUpdate().
+			Model(token).
+			Where("? = ?", bun.Ident("id"), token.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
 func (a *applicationDB) DeleteTokenByID(ctx context.Context, id string) error {
+	if err := a.deleteTokenWebPushSubscriptions(ctx, "id", id); err != nil {
+		return err
+	}
+
 	_, err := a.db.NewDelete().
 		Table("tokens").
 		Where("? = ?", bun.Ident("id"), id).
@@ -237,6 +333,10 @@ func (a *applicationDB) DeleteTokenByID(ctx context.Context, id string) error {
 }
 
 func (a *applicationDB) DeleteTokenByCode(ctx context.Context, code string) error {
+	if err := a.deleteTokenWebPushSubscriptions(ctx, "code", code); err != nil {
+		return err
+	}
+
 	_, err := a.db.NewDelete().
 		Table("tokens").
 		Where("? = ?", bun.Ident("code"), code).
@@ -250,6 +350,10 @@ func (a *applicationDB) DeleteTokenByCode(ctx context.Context, code string) erro
 }
 
 func (a *applicationDB) DeleteTokenByAccess(ctx context.Context, access string) error {
+	if err := a.deleteTokenWebPushSubscriptions(ctx, "access", access); err != nil {
+		return err
+	}
+
 	_, err := a.db.NewDelete().
 		Table("tokens").
 		Where("? = ?", bun.Ident("access"), access).
@@ -263,6 +367,10 @@ func (a *applicationDB) DeleteTokenByAccess(ctx context.Context, access string)
 }
 
 func (a *applicationDB) DeleteTokenByRefresh(ctx context.Context, refresh string) error {
+	if err := a.deleteTokenWebPushSubscriptions(ctx, "refresh", refresh); err != nil {
+		return err
+	}
+
 	_, err := a.db.NewDelete().
 		Table("tokens").
 		Where("? = ?", bun.Ident("refresh"), refresh).
@@ -274,3 +382,19 @@ func (a *applicationDB) DeleteTokenByRefresh(ctx context.Context, refresh string
 	a.state.Caches.DB.Token.Invalidate("Refresh", refresh)
 	return nil
 }
+
+// deleteTokenWebPushSubscriptions deletes any Web Push subscriptions
+// belonging to tokens with the given column value, as a subscription
+// must not outlive the token it was created with.
+func (a *applicationDB) deleteTokenWebPushSubscriptions(ctx context.Context, column string, value string) error {
+	_, err := a.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? IN (?)", bun.Ident("token_id"),
+			a.db.NewSelect().
+				Table("tokens").
+				Column("id").
+				Where("? = ?", bun.Ident(column), value),
+		).
+		Exec(ctx)
+	return err
+}
@@ -131,6 +131,44 @@ func (suite *ApplicationTestSuite) TestGetAllTokens() {
 	suite.NotEmpty(tokens)
 }
 
+func (suite *ApplicationTestSuite) TestGetAccessTokens() {
+	testUser := suite.testUsers["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	// Only the user's access token should be returned,
+	// not their authorization code token or app token.
+	tokens, err := suite.db.GetAccessTokens(context.Background(), testUser.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(tokens, 1) {
+		suite.Equal(testToken.ID, tokens[0].ID)
+	}
+}
+
+func (suite *ApplicationTestSuite) TestUpdateToken() {
+	ctx := context.Background()
+	testToken := suite.testTokens["local_account_1"]
+
+	token, err := suite.db.GetTokenByID(ctx, testToken.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(token.LastUsed.IsZero())
+
+	now := time.Now()
+	token.LastUsed = now
+	if err := suite.db.UpdateToken(ctx, token, "last_used"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	token, err = suite.db.GetTokenByAccess(ctx, testToken.Access)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(now, token.LastUsed, time.Second)
+}
+
 func TestApplicationTestSuite(t *testing.T) {
 	suite.Run(t, new(ApplicationTestSuite))
 }
@@ -56,14 +56,21 @@ type DBService struct {
 	db.Account
 	db.Admin
 	db.AdvancedMigration
+	db.Announcement
 	db.Application
 	db.Basic
+	db.Card
 	db.Conversation
+	db.DeliveryFailure
 	db.Domain
 	db.Emoji
+	db.FeaturedTag
+	db.GroupModerator
 	db.HeaderFilter
+	db.ImportJob
 	db.Instance
 	db.Interaction
+	db.Invite
 	db.Filter
 	db.List
 	db.Marker
@@ -73,19 +80,25 @@ type DBService struct {
 	db.Notification
 	db.Poll
 	db.Relationship
+	db.Relay
 	db.Report
 	db.Rule
+	db.ScheduledStatus
 	db.Search
 	db.Session
 	db.SinBinStatus
 	db.Status
 	db.StatusBookmark
+	db.StatusEdit
 	db.StatusFave
+	db.Suggestion
 	db.Tag
 	db.Thread
 	db.Timeline
 	db.User
 	db.Tombstone
+	db.Trend
+	db.WebPush
 	db.WorkerTask
 	db *bun.DB
 }
@@ -191,6 +204,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			db:    db,
+			state: state,
+		},
 		Application: &applicationDB{
 			db:    db,
 			state: state,
@@ -198,10 +215,17 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		Basic: &basicDB{
 			db: db,
 		},
+		Card: &cardDB{
+			db:    db,
+			state: state,
+		},
 		Conversation: &conversationDB{
 			db:    db,
 			state: state,
 		},
+		DeliveryFailure: &deliveryFailureDB{
+			db: db,
+		},
 		Domain: &domainDB{
 			db:    db,
 			state: state,
@@ -210,10 +234,21 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		FeaturedTag: &featuredTagDB{
+			db:    db,
+			state: state,
+		},
+		GroupModerator: &groupModeratorDB{
+			db:    db,
+			state: state,
+		},
 		HeaderFilter: &headerFilterDB{
 			db:    db,
 			state: state,
 		},
+		ImportJob: &importJobDB{
+			db: db,
+		},
 		Instance: &instanceDB{
 			db:    db,
 			state: state,
@@ -222,6 +257,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Invite: &inviteDB{
+			db: db,
+		},
 		Filter: &filterDB{
 			db:    db,
 			state: state,
@@ -258,6 +296,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Relay: &relayDB{
+			db: db,
+		},
 		Report: &reportDB{
 			db:    db,
 			state: state,
@@ -266,8 +307,13 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		ScheduledStatus: &scheduledStatusDB{
+			db:    db,
+			state: state,
+		},
 		Search: &searchDB{
 			db:    db,
+			index: newStatusIndex(db),
 			state: state,
 		},
 		Session: &sessionDB{
@@ -285,10 +331,18 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusEdit: &statusEditDB{
+			db:    db,
+			state: state,
+		},
 		StatusFave: &statusFaveDB{
 			db:    db,
 			state: state,
 		},
+		Suggestion: &suggestionDB{
+			db:    db,
+			state: state,
+		},
 		Tag: &tagDB{
 			db:    db,
 			state: state,
@@ -309,6 +363,12 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		Trend: &trendDB{
+			db: db,
+		},
+		WebPush: &webPushDB{
+			db: db,
+		},
 		WorkerTask: &workerTaskDB{
 			db: db,
 		},
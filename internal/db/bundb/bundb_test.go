@@ -42,6 +42,7 @@ type BunDBStandardTestSuite struct {
 	testAttachments         map[string]*gtsmodel.MediaAttachment
 	testStatuses            map[string]*gtsmodel.Status
 	testTags                map[string]*gtsmodel.Tag
+	testFeaturedTags        map[string]*gtsmodel.FeaturedTag
 	testMentions            map[string]*gtsmodel.Mention
 	testFollows             map[string]*gtsmodel.Follow
 	testEmojis              map[string]*gtsmodel.Emoji
@@ -68,6 +69,7 @@ func (suite *BunDBStandardTestSuite) SetupSuite() {
 	suite.testAttachments = testrig.NewTestAttachments()
 	suite.testStatuses = testrig.NewTestStatuses()
 	suite.testTags = testrig.NewTestTags()
+	suite.testFeaturedTags = testrig.NewTestFeaturedTags()
 	suite.testMentions = testrig.NewTestMentions()
 	suite.testFollows = testrig.NewTestFollows()
 	suite.testEmojis = testrig.NewTestEmojis()
@@ -0,0 +1,236 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type cardDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (c *cardDB) GetCardByID(ctx context.Context, id string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"ID",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (c *cardDB) GetCardByURL(ctx context.Context, url string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"URL",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.url"), url).
+				Scan(ctx)
+		},
+		url,
+	)
+}
+
+func (c *cardDB) GetCardByImageID(ctx context.Context, imageID string) (*gtsmodel.Card, error) {
+	return c.getCard(
+		ctx,
+		"ImageID",
+		func(card *gtsmodel.Card) error {
+			return c.db.NewSelect().
+				Model(card).
+				Where("? = ?", bun.Ident("card.image_id"), imageID).
+				Scan(ctx)
+		},
+		imageID,
+	)
+}
+
+func (c *cardDB) getCard(
+	ctx context.Context,
+	lookup string,
+	dbQuery func(*gtsmodel.Card) error,
+	keyParts ...any,
+) (*gtsmodel.Card, error) {
+	// Fetch card from database cache with loader callback.
+	card, err := c.state.Caches.DB.Card.LoadOne(lookup,
+		func() (*gtsmodel.Card, error) {
+			var card gtsmodel.Card
+
+			// Not cached! Perform database query.
+			if err := dbQuery(&card); err != nil {
+				return nil, err
+			}
+
+			return &card, nil
+		}, keyParts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return card, nil
+	}
+
+	// Further populate the card fields where applicable.
+	if err := c.PopulateCard(ctx, card); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (c *cardDB) GetStaleCards(ctx context.Context, fetchedBefore time.Time, createdAfter time.Time, limit int) ([]*gtsmodel.Card, error) {
+	var cardIDs []string
+
+	// Select IDs of cards last fetched before
+	// given time, but created after given time,
+	// least recently fetched first.
+	q := c.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("cards"), bun.Ident("card")).
+		Column("card.id").
+		Where("? < ?", bun.Ident("card.fetched_at"), fetchedBefore).
+		Where("? > ?", bun.Ident("card.created_at"), createdAfter).
+		OrderExpr("? ASC", bun.Ident("card.fetched_at"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &cardIDs); err != nil {
+		return nil, err
+	}
+
+	if len(cardIDs) == 0 {
+		return nil, nil
+	}
+
+	// Load all cards by their IDs.
+	return c.getCardsByIDs(ctx, cardIDs)
+}
+
+func (c *cardDB) getCardsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Card, error) {
+	// Load cards for IDs via cache loader callbacks.
+	cards, err := c.state.Caches.DB.Card.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.Card, error) {
+			// Preallocate expected length of uncached cards.
+			cards := make([]*gtsmodel.Card, 0, len(uncached))
+
+			// Perform database query scanning
+			// the remaining (uncached) card IDs.
+			if err := c.db.NewSelect().
+				Model(&cards).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return cards, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the cards by their
+	// IDs to ensure in correct order.
+	getID := func(c *gtsmodel.Card) string { return c.ID }
+	util.OrderBy(cards, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return cards, nil
+	}
+
+	// Populate all loaded cards, removing those we fail to
+	// populate (removes needing so many nil checks everywhere).
+	cards = slices.DeleteFunc(cards, func(card *gtsmodel.Card) bool {
+		if err := c.PopulateCard(ctx, card); err != nil {
+			log.Errorf(ctx, "error populating card %s: %v", card.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return cards, nil
+}
+
+func (c *cardDB) PopulateCard(ctx context.Context, card *gtsmodel.Card) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	if card.ImageID != "" && card.Image == nil {
+		// Fetch the card's preview thumbnail.
+		card.Image, err = c.state.DB.GetAttachmentByID(
+			gtscontext.SetBarebones(ctx),
+			card.ImageID,
+		)
+		if err != nil {
+			errs.Appendf("error populating card image: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (c *cardDB) PutCard(ctx context.Context, card *gtsmodel.Card) error {
+	return c.state.Caches.DB.Card.Store(card, func() error {
+		_, err := c.db.NewInsert().Model(card).Exec(ctx)
+		return err
+	})
+}
+
+func (c *cardDB) UpdateCard(ctx context.Context, card *gtsmodel.Card, columns ...string) error {
+	card.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return c.state.Caches.DB.Card.Store(card, func() error {
+		_, err := c.db.NewUpdate().
+			Model(card).
+			Where("? = ?", bun.Ident("card.id"), card.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
@@ -0,0 +1,134 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type CardTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *CardTestSuite) TestPutGetUpdateCard() {
+	ctx := context.Background()
+
+	card := &gtsmodel.Card{
+		ID:          id.NewULID(),
+		FetchedAt:   time.Now(),
+		URL:         "https://example.org/some/page",
+		Title:       "Some page",
+		Description: "It's a page.",
+		Type:        gtsmodel.CardTypeLink,
+		ImageID:     suite.testAttachments["local_account_1_unattached_1"].ID,
+	}
+
+	if err := suite.db.PutCard(ctx, card); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only one card may exist per URL.
+	err := suite.db.PutCard(ctx, &gtsmodel.Card{
+		ID:  id.NewULID(),
+		URL: card.URL,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	dbCard, err := suite.db.GetCardByURL(ctx, card.URL)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(card.ID, dbCard.ID)
+	suite.Equal("Some page", dbCard.Title)
+	suite.NotNil(dbCard.Image)
+
+	dbCard, err = suite.db.GetCardByImageID(ctx, card.ImageID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(card.ID, dbCard.ID)
+
+	card.Title = "Some updated page"
+	if err := suite.db.UpdateCard(ctx, card, "title"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbCard, err = suite.db.GetCardByID(ctx, card.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Some updated page", dbCard.Title)
+
+	_, err = suite.db.GetCardByURL(ctx, "https://example.org/another/page")
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *CardTestSuite) TestGetStaleCards() {
+	ctx := context.Background()
+	now := time.Now()
+
+	for i, fetchedAt := range []time.Time{
+		now.Add(-48 * time.Hour),
+		now.Add(-36 * time.Hour),
+		now.Add(-time.Hour),
+	} {
+		if err := suite.db.PutCard(ctx, &gtsmodel.Card{
+			ID:        id.NewULID(),
+			FetchedAt: fetchedAt,
+			URL:       "https://example.org/page/" + string(rune('a'+i)),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	cards, err := suite.db.GetStaleCards(ctx,
+		now.Add(-24*time.Hour),
+		now.Add(-time.Hour),
+		10,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Least recently fetched first.
+	if suite.Len(cards, 2) {
+		suite.Equal("https://example.org/page/a", cards[0].URL)
+		suite.Equal("https://example.org/page/b", cards[1].URL)
+	}
+
+	// No cards were created after the given time.
+	cards, err = suite.db.GetStaleCards(ctx,
+		now.Add(-24*time.Hour),
+		now.Add(time.Hour),
+		10,
+	)
+	suite.NoError(err)
+	suite.Empty(cards)
+}
+
+func TestCardTestSuite(t *testing.T) {
+	suite.Run(t, new(CardTestSuite))
+}
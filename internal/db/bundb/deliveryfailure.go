@@ -0,0 +1,168 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/uptrace/bun"
+)
+
+type deliveryFailureDB struct{ db *bun.DB }
+
+func (d *deliveryFailureDB) GetDeliveryFailureByID(ctx context.Context, id string) (*gtsmodel.DeliveryFailure, error) {
+	failure := new(gtsmodel.DeliveryFailure)
+	if err := d.db.NewSelect().
+		Model(failure).
+		Where("? = ?", bun.Ident("id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return failure, nil
+}
+
+func (d *deliveryFailureDB) GetDeliveryFailures(ctx context.Context, targetHost string, page *paging.Page) ([]*gtsmodel.DeliveryFailure, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		failures = make([]*gtsmodel.DeliveryFailure, 0, limit)
+	)
+
+	q := d.db.NewSelect().Model(&failures)
+
+	if targetHost != "" {
+		q = q.Where("? = ?", bun.Ident("target_host"), targetHost)
+	}
+
+	if maxID != "" {
+		// Return only failures LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only failures HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(failures) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want failures
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(failures)
+	}
+
+	return failures, nil
+}
+
+func (d *deliveryFailureDB) CountDeliveryFailuresByHost(ctx context.Context) (map[string]int, error) {
+	var counts []struct {
+		TargetHost string `bun:"target_host"`
+		Count      int    `bun:"count"`
+	}
+
+	if err := d.db.NewSelect().
+		Table("delivery_failures").
+		Column("target_host").
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Group("target_host").
+		Scan(ctx, &counts); err != nil {
+		return nil, err
+	}
+
+	byHost := make(map[string]int, len(counts))
+	for _, c := range counts {
+		byHost[c.TargetHost] = c.Count
+	}
+
+	return byHost, nil
+}
+
+func (d *deliveryFailureDB) PutDeliveryFailure(ctx context.Context, failure *gtsmodel.DeliveryFailure) error {
+	_, err := d.db.NewInsert().Model(failure).Exec(ctx)
+	return err
+}
+
+func (d *deliveryFailureDB) DeleteDeliveryFailureByID(ctx context.Context, id string) error {
+	_, err := d.db.NewDelete().
+		Table("delivery_failures").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (d *deliveryFailureDB) DeleteDeliveryFailures(ctx context.Context, targetHost string) (int, error) {
+	q := d.db.NewDelete().Table("delivery_failures")
+
+	if targetHost != "" {
+		q = q.Where("? = ?", bun.Ident("target_host"), targetHost)
+	} else {
+		// Bun refuses to run
+		// a DELETE without WHERE.
+		q = q.Where("1 = 1")
+	}
+
+	res, err := q.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (d *deliveryFailureDB) DeleteDeliveryFailuresOlderThan(ctx context.Context, olderThan time.Time) (int, error) {
+	res, err := d.db.NewDelete().
+		Table("delivery_failures").
+		Where("? < ?", bun.Ident("created_at"), olderThan).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
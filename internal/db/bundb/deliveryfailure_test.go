@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DeliveryFailureTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *DeliveryFailureTestSuite) putFailure(host string, createdAt time.Time) *gtsmodel.DeliveryFailure {
+	failureID, err := id.NewULIDFromTime(createdAt)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	failure := &gtsmodel.DeliveryFailure{
+		ID:         failureID,
+		CreatedAt:  createdAt,
+		TargetHost: host,
+		RequestURL: "https://" + host + "/inbox",
+		StatusCode: 410,
+		Error:      "http response: 410 Gone",
+		Attempts:   1,
+		Data:       []byte("{}"),
+	}
+
+	if err := suite.db.PutDeliveryFailure(context.Background(), failure); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return failure
+}
+
+func (suite *DeliveryFailureTestSuite) TestPutGetDeliveryFailures() {
+	ctx := context.Background()
+
+	now := time.Now()
+	f1 := suite.putFailure("example.org", now.Add(-2*time.Minute))
+	f2 := suite.putFailure("example.org", now.Add(-time.Minute))
+	f3 := suite.putFailure("fossbros-anonymous.io", now)
+
+	dbFailure, err := suite.db.GetDeliveryFailureByID(ctx, f1.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("example.org", dbFailure.TargetHost)
+	suite.Equal(410, dbFailure.StatusCode)
+	suite.Equal([]byte("{}"), dbFailure.Data)
+
+	// All failures, newest first.
+	failures, err := suite.db.GetDeliveryFailures(ctx, "", &paging.Page{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(failures, 3)
+	suite.Equal(f3.ID, failures[0].ID)
+
+	// Only failures for one host.
+	failures, err = suite.db.GetDeliveryFailures(ctx, "example.org", &paging.Page{
+		Max: paging.MaxID(f2.ID),
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(failures, 1)
+	suite.Equal(f1.ID, failures[0].ID)
+
+	counts, err := suite.db.CountDeliveryFailuresByHost(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(map[string]int{
+		"example.org":           2,
+		"fossbros-anonymous.io": 1,
+	}, counts)
+}
+
+func (suite *DeliveryFailureTestSuite) TestDeleteDeliveryFailures() {
+	ctx := context.Background()
+
+	f1 := suite.putFailure("example.org", time.Now().Add(-48*time.Hour))
+	suite.putFailure("example.org", time.Now())
+	suite.putFailure("fossbros-anonymous.io", time.Now())
+	suite.putFailure("fossbros-anonymous.io", time.Now())
+
+	n, err := suite.db.DeleteDeliveryFailuresOlderThan(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, n)
+
+	_, err = suite.db.GetDeliveryFailureByID(ctx, f1.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	n, err = suite.db.DeleteDeliveryFailures(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, n)
+
+	n, err = suite.db.DeleteDeliveryFailures(ctx, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, n)
+
+	_, err = suite.db.GetDeliveryFailures(ctx, "", &paging.Page{})
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestDeliveryFailureTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryFailureTestSuite))
+}
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -110,6 +111,50 @@ func (d *domainDB) GetDomainAllowByID(ctx context.Context, id string) (*gtsmodel
 	return &allow, nil
 }
 
+func (d *domainDB) GetDomainAllowsBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainAllow, error) {
+	allows := []*gtsmodel.DomainAllow{}
+
+	if err := d.db.
+		NewSelect().
+		Model(&allows).
+		Where("? = ?", bun.Ident("domain_allow.subscription_id"), subscriptionID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return allows, nil
+}
+
+func (d *domainDB) UpdateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow, columns ...string) error {
+	// Normalize the domain as punycode
+	var err error
+	allow.Domain, err = util.Punify(allow.Domain)
+	if err != nil {
+		return err
+	}
+
+	allow.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Attempt to update domain allow
+	if _, err := d.db.NewUpdate().
+		Model(allow).
+		Column(columns...).
+		Where("? = ?", bun.Ident("domain_allow.id"), allow.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain allow cache (for later reload)
+	d.state.Caches.DB.DomainAllow.Clear()
+
+	return nil
+}
+
 func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) error {
 	// Normalize the domain as punycode
 	domain, err := util.Punify(domain)
@@ -206,6 +251,50 @@ func (d *domainDB) GetDomainBlockByID(ctx context.Context, id string) (*gtsmodel
 	return &block, nil
 }
 
+func (d *domainDB) GetDomainBlocksBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainBlock, error) {
+	blocks := []*gtsmodel.DomainBlock{}
+
+	if err := d.db.
+		NewSelect().
+		Model(&blocks).
+		Where("? = ?", bun.Ident("domain_block.subscription_id"), subscriptionID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) error {
+	// Normalize the domain as punycode
+	var err error
+	block.Domain, err = util.Punify(block.Domain)
+	if err != nil {
+		return err
+	}
+
+	block.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Attempt to update domain block
+	if _, err := d.db.NewUpdate().
+		Model(block).
+		Column(columns...).
+		Where("? = ?", bun.Ident("domain_block.id"), block.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain block cache (for later reload)
+	d.state.Caches.DB.DomainBlock.Clear()
+
+	return nil
+}
+
 func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 	// Normalize the domain as punycode
 	domain, err := util.Punify(domain)
@@ -227,6 +316,201 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 	return nil
 }
 
+func (d *domainDB) CreateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit) error {
+	// Normalize the domain as punycode
+	var err error
+	limit.Domain, err = util.Punify(limit.Domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to store domain limit in DB
+	if _, err := d.db.NewInsert().
+		Model(limit).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain limit cache (for later reload)
+	d.state.Caches.DB.DomainLimit.Clear()
+
+	return nil
+}
+
+func (d *domainDB) GetDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return nil, db.ErrNoEntries
+	}
+
+	var limit gtsmodel.DomainLimit
+
+	// Look for limit matching domain in DB
+	q := d.db.
+		NewSelect().
+		Model(&limit).
+		Where("? = ?", bun.Ident("domain_limit.domain"), domain)
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &limit, nil
+}
+
+func (d *domainDB) GetDomainLimits(ctx context.Context) ([]*gtsmodel.DomainLimit, error) {
+	limits := []*gtsmodel.DomainLimit{}
+
+	if err := d.db.
+		NewSelect().
+		Model(&limits).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+func (d *domainDB) GetDomainLimitByID(ctx context.Context, id string) (*gtsmodel.DomainLimit, error) {
+	var limit gtsmodel.DomainLimit
+
+	q := d.db.
+		NewSelect().
+		Model(&limit).
+		Where("? = ?", bun.Ident("domain_limit.id"), id)
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &limit, nil
+}
+
+func (d *domainDB) UpdateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit, columns ...string) error {
+	// Normalize the domain as punycode
+	var err error
+	limit.Domain, err = util.Punify(limit.Domain)
+	if err != nil {
+		return err
+	}
+
+	limit.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Attempt to update domain limit
+	if _, err := d.db.NewUpdate().
+		Model(limit).
+		Column(columns...).
+		Where("? = ?", bun.Ident("domain_limit.id"), limit.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain limit cache (for later reload)
+	d.state.Caches.DB.DomainLimit.Clear()
+
+	return nil
+}
+
+func (d *domainDB) DeleteDomainLimit(ctx context.Context, domain string) error {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to delete domain limit
+	if _, err := d.db.NewDelete().
+		Model((*gtsmodel.DomainLimit)(nil)).
+		Where("? = ?", bun.Ident("domain_limit.domain"), domain).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain limit cache (for later reload)
+	d.state.Caches.DB.DomainLimit.Clear()
+
+	return nil
+}
+
+func (d *domainDB) MatchDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Domain referencing *us* cannot be limited.
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return nil, nil
+	}
+
+	// Check the cache for a domain limit (hydrating the cache with callback if necessary).
+	// This is the cheap check, since the vast majority of domains will not be limited.
+	limited, err := d.state.Caches.DB.DomainLimit.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all limited domains from DB
+		q := d.db.NewSelect().
+			Table("domain_limits").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !limited {
+		return nil, nil
+	}
+
+	// Gather the domain itself and each of
+	// its parent domains, eg., for "a.b.com"
+	// we want "a.b.com", "b.com" and "com".
+	candidates := []string{domain}
+	for i, c := range domain {
+		if c == '.' {
+			candidates = append(candidates, domain[i+1:])
+		}
+	}
+
+	var limits []*gtsmodel.DomainLimit
+
+	// Select any limits matching candidates.
+	if err := d.db.
+		NewSelect().
+		Model(&limits).
+		Where("? IN (?)", bun.Ident("domain_limit.domain"), bun.In(candidates)).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// Return the most specific
+	// (ie., longest) match.
+	var match *gtsmodel.DomainLimit
+	for _, limit := range limits {
+		if match == nil || len(limit.Domain) > len(match.Domain) {
+			match = limit
+		}
+	}
+
+	return match, nil
+}
+
 func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, error) {
 	// Normalize the domain as punycode
 	domain, err := util.Punify(domain)
@@ -274,6 +274,240 @@ func(dbBlock *gtsmodel.DomainBlock) bool {
 	}
 }
 
+func (suite *DomainTestSuite) TestDomainPermissionSubscriptions() {
+	ctx := context.Background()
+
+	for _, sub := range []*gtsmodel.DomainPermissionSubscription{
+		{
+			ID:                 "01JBAKC7T0M8X1Y0QTY0FXKNJ4",
+			Priority:           10,
+			PermissionType:     gtsmodel.DomainPermissionBlock,
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			URI:                "https://lists.example.org/low.csv",
+			ContentType:        gtsmodel.DomainPermSubContentTypeCSV,
+		},
+		{
+			ID:                 "01JBAKCQ6XWR3Z6T5Q2C7DJ9VA",
+			Priority:           200,
+			PermissionType:     gtsmodel.DomainPermissionBlock,
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			URI:                "https://lists.example.org/high.csv",
+			ContentType:        gtsmodel.DomainPermSubContentTypeCSV,
+		},
+		{
+			ID:                 "01JBAKD2F9H7M4ZC2B7KX8N1SE",
+			Priority:           255,
+			PermissionType:     gtsmodel.DomainPermissionAllow,
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			URI:                "https://lists.example.org/allows.txt",
+			ContentType:        gtsmodel.DomainPermSubContentTypePlain,
+		},
+	} {
+		if err := suite.db.PutDomainPermissionSubscription(ctx, sub); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Block subscriptions should be
+	// sorted highest priority first.
+	subs, err := suite.db.GetDomainPermissionSubscriptions(ctx, gtsmodel.DomainPermissionBlock)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(subs, 2)
+	suite.Equal("01JBAKCQ6XWR3Z6T5Q2C7DJ9VA", subs[0].ID)
+	suite.Equal("01JBAKC7T0M8X1Y0QTY0FXKNJ4", subs[1].ID)
+
+	// Defaults should be set.
+	suite.True(*subs[0].AsDraft)
+	suite.False(*subs[0].AdoptOrphans)
+
+	// Unknown type gets all subscriptions.
+	subs, err = suite.db.GetDomainPermissionSubscriptions(ctx, gtsmodel.DomainPermissionUnknown)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(subs, 3)
+
+	// Update a subscription.
+	sub := subs[0]
+	sub.Error = "oh no"
+	if err := suite.db.UpdateDomainPermissionSubscription(ctx, sub, "error"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	sub, err = suite.db.GetDomainPermissionSubscriptionByID(ctx, sub.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("oh no", sub.Error)
+}
+
+func (suite *DomainTestSuite) TestDomainPermissionDrafts() {
+	ctx := context.Background()
+
+	for _, draft := range []*gtsmodel.DomainPermissionDraft{
+		{
+			ID:                 "01JBB1TM5D7N3YQJ5VCCQ6C7VA",
+			PermissionType:     gtsmodel.DomainPermissionBlock,
+			Domain:             "baddies.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+			SubscriptionID:     "01JBAKC7T0M8X1Y0QTY0FXKNJ4",
+		},
+		{
+			ID:                 "01JBB1V2X4K6S8G5B3PE1PZ2NH",
+			PermissionType:     gtsmodel.DomainPermissionBlock,
+			Domain:             "meanies.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		},
+		{
+			ID:                 "01JBB1VB9Q3H0J5D3W2T6R7Y8M",
+			PermissionType:     gtsmodel.DomainPermissionAllow,
+			Domain:             "goodies.example.org",
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		},
+	} {
+		if err := suite.db.PutDomainPermissionDraft(ctx, draft); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// All drafts, newest first.
+	drafts, err := suite.db.GetDomainPermissionDrafts(ctx, gtsmodel.DomainPermissionUnknown, "", "", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(drafts, 3)
+	suite.Equal("01JBB1VB9Q3H0J5D3W2T6R7Y8M", drafts[0].ID)
+
+	// Filter by type.
+	drafts, err = suite.db.GetDomainPermissionDrafts(ctx, gtsmodel.DomainPermissionBlock, "", "", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(drafts, 2)
+
+	// Filter by subscription.
+	drafts, err = suite.db.GetDomainPermissionDrafts(ctx, gtsmodel.DomainPermissionUnknown, "01JBAKC7T0M8X1Y0QTY0FXKNJ4", "", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(drafts, 1)
+	suite.Equal("baddies.example.org", drafts[0].Domain)
+
+	// Filter by domain.
+	drafts, err = suite.db.GetDomainPermissionDrafts(ctx, gtsmodel.DomainPermissionUnknown, "", "goodies.example.org", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(drafts, 1)
+	suite.Equal(gtsmodel.DomainPermissionAllow, drafts[0].PermissionType)
+}
+
+func (suite *DomainTestSuite) TestIsDomainPermissionExcluded() {
+	ctx := context.Background()
+
+	excluded, err := suite.db.IsDomainPermissionExcluded(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(excluded)
+
+	exclude := &gtsmodel.DomainPermissionExclude{
+		ID:                 "01JBB20E4K8ZQ6X2V9D7R3N5TC",
+		Domain:             "example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.db.PutDomainPermissionExclude(ctx, exclude); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Subdomains should be excluded too.
+	excluded, err = suite.db.IsDomainPermissionExcluded(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(excluded)
+
+	excludes, err := suite.db.GetDomainPermissionExcludes(ctx, "example.org", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(excludes, 1)
+
+	if err := suite.db.DeleteDomainPermissionExclude(ctx, exclude.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	excluded, err = suite.db.IsDomainPermissionExcluded(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(excluded)
+}
+
+func (suite *DomainTestSuite) TestMatchDomainLimit() {
+	ctx := context.Background()
+
+	limit, err := suite.db.MatchDomainLimit(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Nil(limit)
+
+	parent := &gtsmodel.DomainLimit{
+		ID:                 "01JBF0P3Z8X5F6W4Q1S9C7K2MA",
+		Domain:             "example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		ContentWarning:     "from example.org",
+	}
+	if err := suite.db.CreateDomainLimit(ctx, parent); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Subdomains should be limited by the parent.
+	limit, err = suite.db.MatchDomainLimit(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(parent.ID, limit.ID)
+	suite.False(*limit.MediaSensitive)
+	suite.False(*limit.HideFromPublic)
+
+	sub := &gtsmodel.DomainLimit{
+		ID:                 "01JBF0PBNS1T0V4R8M2G6X3D9E",
+		Domain:             "sub.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.db.CreateDomainLimit(ctx, sub); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The most specific limit should be returned.
+	limit, err = suite.db.MatchDomainLimit(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(sub.ID, limit.ID)
+
+	// Unrelated domains aren't limited.
+	limit, err = suite.db.MatchDomainLimit(ctx, "example.com")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Nil(limit)
+
+	if err := suite.db.DeleteDomainLimit(ctx, sub.Domain); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	limit, err = suite.db.MatchDomainLimit(ctx, "sub.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(parent.ID, limit.ID)
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
@@ -0,0 +1,424 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+func (d *domainDB) GetDomainPermissionDraftByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainPermissionDraft, error) {
+	draft := new(gtsmodel.DomainPermissionDraft)
+
+	if err := d.db.
+		NewSelect().
+		Model(draft).
+		Where("? = ?", bun.Ident("domain_permission_draft.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+func (d *domainDB) GetDomainPermissionDrafts(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+	subscriptionID string,
+	domain string,
+	page *paging.Page,
+) ([]*gtsmodel.DomainPermissionDraft, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		drafts = make([]*gtsmodel.DomainPermissionDraft, 0, limit)
+	)
+
+	q := d.db.
+		NewSelect().
+		Model(&drafts)
+
+	if permType != gtsmodel.DomainPermissionUnknown {
+		q = q.Where("? = ?", bun.Ident("domain_permission_draft.permission_type"), permType)
+	}
+
+	if subscriptionID != "" {
+		q = q.Where("? = ?", bun.Ident("domain_permission_draft.subscription_id"), subscriptionID)
+	}
+
+	if domain != "" {
+		// Normalize the domain as punycode
+		domain, err := util.Punify(domain)
+		if err != nil {
+			return nil, err
+		}
+
+		q = q.Where("? = ?", bun.Ident("domain_permission_draft.domain"), domain)
+	}
+
+	if maxID != "" {
+		// Return only drafts LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("domain_permission_draft.id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only drafts HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("domain_permission_draft.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("domain_permission_draft.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("domain_permission_draft.id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(drafts) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want drafts
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(drafts)
+	}
+
+	return drafts, nil
+}
+
+func (d *domainDB) GetDomainPermissionDraft(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+	domain string,
+) (*gtsmodel.DomainPermissionDraft, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := new(gtsmodel.DomainPermissionDraft)
+
+	if err := d.db.
+		NewSelect().
+		Model(draft).
+		Where("? = ?", bun.Ident("domain_permission_draft.permission_type"), permType).
+		Where("? = ?", bun.Ident("domain_permission_draft.domain"), domain).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+func (d *domainDB) PutDomainPermissionDraft(
+	ctx context.Context,
+	draft *gtsmodel.DomainPermissionDraft,
+) error {
+	// Normalize the domain as punycode
+	var err error
+	draft.Domain, err = util.Punify(draft.Domain)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.
+		NewInsert().
+		Model(draft).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainDB) DeleteDomainPermissionDraft(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := d.db.
+		NewDelete().
+		Table("domain_permission_drafts").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainDB) GetDomainPermissionExcludeByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainPermissionExclude, error) {
+	exclude := new(gtsmodel.DomainPermissionExclude)
+
+	if err := d.db.
+		NewSelect().
+		Model(exclude).
+		Where("? = ?", bun.Ident("domain_permission_exclude.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return exclude, nil
+}
+
+func (d *domainDB) GetDomainPermissionExcludes(
+	ctx context.Context,
+	domain string,
+	page *paging.Page,
+) ([]*gtsmodel.DomainPermissionExclude, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		excludes = make([]*gtsmodel.DomainPermissionExclude, 0, limit)
+	)
+
+	q := d.db.
+		NewSelect().
+		Model(&excludes)
+
+	if domain != "" {
+		// Normalize the domain as punycode
+		domain, err := util.Punify(domain)
+		if err != nil {
+			return nil, err
+		}
+
+		q = q.Where("? = ?", bun.Ident("domain_permission_exclude.domain"), domain)
+	}
+
+	if maxID != "" {
+		// Return only excludes LOWER (ie., older) than maxID.
+		q = q.Where("? < ?", bun.Ident("domain_permission_exclude.id"), maxID)
+	}
+
+	if minID != "" {
+		// Return only excludes HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("domain_permission_exclude.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("domain_permission_exclude.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("domain_permission_exclude.id"))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(excludes) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want excludes
+	// to be sorted by ID desc, so reverse slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(excludes)
+	}
+
+	return excludes, nil
+}
+
+func (d *domainDB) PutDomainPermissionExclude(
+	ctx context.Context,
+	exclude *gtsmodel.DomainPermissionExclude,
+) error {
+	// Normalize the domain as punycode
+	var err error
+	exclude.Domain, err = util.Punify(exclude.Domain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.db.
+		NewInsert().
+		Model(exclude).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain permission exclude cache (for later reload)
+	d.state.Caches.DB.DomainPermissionExclude.Clear()
+
+	return nil
+}
+
+func (d *domainDB) DeleteDomainPermissionExclude(
+	ctx context.Context,
+	id string,
+) error {
+	if _, err := d.db.
+		NewDelete().
+		Table("domain_permission_excludes").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the domain permission exclude cache (for later reload)
+	d.state.Caches.DB.DomainPermissionExclude.Clear()
+
+	return nil
+}
+
+func (d *domainDB) IsDomainPermissionExcluded(
+	ctx context.Context,
+	domain string,
+) (bool, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// Check the cache for a domain permission exclude,
+	// hydrating the cache with callback if necessary.
+	return d.state.Caches.DB.DomainPermissionExclude.Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all excluded domains from DB
+		q := d.db.NewSelect().
+			Table("domain_permission_excludes").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+}
+
+func (d *domainDB) GetDomainPermissionSubscriptionByID(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainPermissionSubscription, error) {
+	sub := new(gtsmodel.DomainPermissionSubscription)
+
+	if err := d.db.
+		NewSelect().
+		Model(sub).
+		Where("? = ?", bun.Ident("domain_permission_subscription.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+func (d *domainDB) GetDomainPermissionSubscriptions(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+) ([]*gtsmodel.DomainPermissionSubscription, error) {
+	subs := []*gtsmodel.DomainPermissionSubscription{}
+
+	q := d.db.
+		NewSelect().
+		Model(&subs)
+
+	if permType != gtsmodel.DomainPermissionUnknown {
+		// Filter on the given permission type.
+		q = q.Where("? = ?", bun.Ident("domain_permission_subscription.permission_type"), permType)
+	}
+
+	// Highest priority first, then
+	// oldest first for equal priority.
+	if err := q.
+		Order("domain_permission_subscription.priority DESC").
+		Order("domain_permission_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+func (d *domainDB) PutDomainPermissionSubscription(
+	ctx context.Context,
+	sub *gtsmodel.DomainPermissionSubscription,
+) error {
+	_, err := d.db.
+		NewInsert().
+		Model(sub).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainDB) UpdateDomainPermissionSubscription(
+	ctx context.Context,
+	sub *gtsmodel.DomainPermissionSubscription,
+	columns ...string,
+) error {
+	sub.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.db.
+		NewUpdate().
+		Model(sub).
+		Column(columns...).
+		Where("? = ?", bun.Ident("domain_permission_subscription.id"), sub.ID).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainDB) DeleteDomainPermissionSubscription(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := d.db.
+		NewDelete().
+		Table("domain_permission_subscriptions").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,340 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type featuredTagDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (f *featuredTagDB) GetFeaturedTagByID(ctx context.Context, id string) (*gtsmodel.FeaturedTag, error) {
+	return f.getFeaturedTag(
+		ctx,
+		"ID",
+		func(featuredTag *gtsmodel.FeaturedTag) error {
+			return f.db.NewSelect().
+				Model(featuredTag).
+				Where("? = ?", bun.Ident("featured_tag.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (f *featuredTagDB) GetFeaturedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FeaturedTag, error) {
+	return f.getFeaturedTag(
+		ctx,
+		"AccountID,TagID",
+		func(featuredTag *gtsmodel.FeaturedTag) error {
+			return f.db.NewSelect().
+				Model(featuredTag).
+				Where("? = ?", bun.Ident("featured_tag.account_id"), accountID).
+				Where("? = ?", bun.Ident("featured_tag.tag_id"), tagID).
+				Scan(ctx)
+		},
+		accountID,
+		tagID,
+	)
+}
+
+func (f *featuredTagDB) getFeaturedTag(
+	ctx context.Context,
+	lookup string,
+	dbQuery func(*gtsmodel.FeaturedTag) error,
+	keyParts ...any,
+) (*gtsmodel.FeaturedTag, error) {
+	// Fetch featured tag from database cache with loader callback.
+	featuredTag, err := f.state.Caches.DB.FeaturedTag.LoadOne(lookup,
+		func() (*gtsmodel.FeaturedTag, error) {
+			var featuredTag gtsmodel.FeaturedTag
+
+			// Not cached! Perform database query.
+			if err := dbQuery(&featuredTag); err != nil {
+				return nil, err
+			}
+
+			return &featuredTag, nil
+		}, keyParts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return featuredTag, nil
+	}
+
+	// Further populate the featured tag fields where applicable.
+	if err := f.PopulateFeaturedTag(ctx, featuredTag); err != nil {
+		return nil, err
+	}
+
+	return featuredTag, nil
+}
+
+func (f *featuredTagDB) GetFeaturedTagsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, error) {
+	var featuredTagIDs []string
+
+	// Select IDs of all featured tags for
+	// this account, oldest featured first.
+	if err := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("featured_tags"), bun.Ident("featured_tag")).
+		Column("featured_tag.id").
+		Where("? = ?", bun.Ident("featured_tag.account_id"), accountID).
+		OrderExpr("? ASC", bun.Ident("featured_tag.id")).
+		Scan(ctx, &featuredTagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(featuredTagIDs) == 0 {
+		return nil, nil
+	}
+
+	// Load all featured tags by their IDs.
+	return f.getFeaturedTagsByIDs(ctx, featuredTagIDs)
+}
+
+func (f *featuredTagDB) getFeaturedTagsByIDs(ctx context.Context, ids []string) ([]*gtsmodel.FeaturedTag, error) {
+	// Load featured tags for IDs via cache loader callbacks.
+	featuredTags, err := f.state.Caches.DB.FeaturedTag.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.FeaturedTag, error) {
+			// Preallocate expected length of uncached featured tags.
+			featuredTags := make([]*gtsmodel.FeaturedTag, 0, len(uncached))
+
+			// Perform database query scanning the
+			// remaining (uncached) featured tag IDs.
+			if err := f.db.NewSelect().
+				Model(&featuredTags).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return featuredTags, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the featured tags by
+	// their IDs to ensure in correct order.
+	getID := func(f *gtsmodel.FeaturedTag) string { return f.ID }
+	util.OrderBy(featuredTags, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return featuredTags, nil
+	}
+
+	// Populate all loaded featured tags, removing those we fail
+	// to populate (removes needing so many nil checks everywhere).
+	featuredTags = slices.DeleteFunc(featuredTags, func(featuredTag *gtsmodel.FeaturedTag) bool {
+		if err := f.PopulateFeaturedTag(ctx, featuredTag); err != nil {
+			log.Errorf(ctx, "error populating featured tag %s: %v", featuredTag.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return featuredTags, nil
+}
+
+func (f *featuredTagDB) GetFeaturedTagStats(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (*gtsmodel.FeaturedTagStats, error) {
+	var (
+		stats     gtsmodel.FeaturedTagStats
+		createdAt []time.Time
+	)
+
+	// Select creation times of all the account's
+	// statuses using this tag, and count them.
+	q := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		// Join with statuses for filtering.
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		Column("status.created_at").
+		// This tag only.
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), featuredTag.TagID).
+		// This account only.
+		Where("? = ?", bun.Ident("status.account_id"), featuredTag.AccountID).
+		// Public or unlisted only.
+		Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+			gtsmodel.VisibilityPublic,
+			gtsmodel.VisibilityUnlocked,
+		})).
+		// Only include statuses that aren't pending approval.
+		Where("NOT ? = ?", bun.Ident("status.pending_approval"), true).
+		OrderExpr("? DESC", bun.Ident("status.created_at")).
+		Limit(1)
+
+	count, err := q.ScanAndCount(ctx, &createdAt)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	stats.StatusesCount = count
+	if len(createdAt) != 0 {
+		stats.LastStatusAt = createdAt[0]
+	}
+
+	return &stats, nil
+}
+
+func (f *featuredTagDB) GetFeaturedTagSuggestions(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Tag, error) {
+	var tagIDs []string
+
+	// Subquery selecting IDs of
+	// tags already featured by account.
+	featuredQ := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("featured_tags"), bun.Ident("featured_tag")).
+		Column("featured_tag.tag_id").
+		Where("? = ?", bun.Ident("featured_tag.account_id"), accountID)
+
+	// Select IDs of tags used by
+	// this account, most used first.
+	q := f.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status_to_tag.tag_id").
+		// Join with statuses for filtering.
+		Join(
+			"INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+		).
+		// This account only.
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		// Exclude tags already featured.
+		Where("? NOT IN (?)", bun.Ident("status_to_tag.tag_id"), featuredQ).
+		Group("status_to_tag.tag_id").
+		OrderExpr("COUNT(*) DESC, ? DESC", bun.Ident("status_to_tag.tag_id"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &tagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	// Load all tags by their IDs.
+	return f.state.DB.GetTags(ctx, tagIDs)
+}
+
+func (f *featuredTagDB) PopulateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	// For sub-models we only want
+	// barebones versions of them.
+	ctx = gtscontext.SetBarebones(ctx)
+
+	if featuredTag.Account == nil {
+		// Fetch the account that features this tag.
+		featuredTag.Account, err = f.state.DB.GetAccountByID(
+			ctx,
+			featuredTag.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating featured tag account: %w", err)
+		}
+	}
+
+	if featuredTag.Tag == nil {
+		// Fetch the tag being featured.
+		featuredTag.Tag, err = f.state.DB.GetTag(
+			ctx,
+			featuredTag.TagID,
+		)
+		if err != nil {
+			errs.Appendf("error populating featured tag tag: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (f *featuredTagDB) PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error {
+	return f.state.Caches.DB.FeaturedTag.Store(featuredTag, func() error {
+		_, err := f.db.NewInsert().Model(featuredTag).Exec(ctx)
+		return err
+	})
+}
+
+func (f *featuredTagDB) DeleteFeaturedTagByID(ctx context.Context, id string) error {
+	// Delete the featured tag from DB.
+	if _, err := f.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("featured_tags"), bun.Ident("featured_tag")).
+		Where("? = ?", bun.Ident("featured_tag.id"), id).
+		Exec(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate any cached featured tag model by ID.
+	f.state.Caches.DB.FeaturedTag.Invalidate("ID", id)
+
+	return nil
+}
+
+func (f *featuredTagDB) DeleteFeaturedTagsByAccountID(ctx context.Context, accountID string) error {
+	// Delete all featured tags of account from DB.
+	if _, err := f.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("featured_tags"), bun.Ident("featured_tag")).
+		Where("? = ?", bun.Ident("featured_tag.account_id"), accountID).
+		Exec(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate any cached featured tag models by account ID.
+	f.state.Caches.DB.FeaturedTag.Invalidate("AccountID", accountID)
+
+	return nil
+}
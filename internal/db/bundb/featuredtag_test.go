@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type FeaturedTagTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FeaturedTagTestSuite) TestGetFeaturedTag() {
+	ctx := context.Background()
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	featuredTag, err := suite.db.GetFeaturedTagByID(ctx, testFeaturedTag.ID)
+	suite.NoError(err)
+	suite.Equal(testFeaturedTag.AccountID, featuredTag.AccountID)
+	suite.NotNil(featuredTag.Account)
+	suite.NotNil(featuredTag.Tag)
+	suite.Equal("welcome", featuredTag.Tag.Name)
+
+	featuredTag, err = suite.db.GetFeaturedTag(ctx, testFeaturedTag.AccountID, testFeaturedTag.TagID)
+	suite.NoError(err)
+	suite.Equal(testFeaturedTag.ID, featuredTag.ID)
+
+	featuredTags, err := suite.db.GetFeaturedTagsByAccountID(ctx, testFeaturedTag.AccountID)
+	suite.NoError(err)
+	suite.Len(featuredTags, 1)
+
+	featuredTags, err = suite.db.GetFeaturedTagsByAccountID(ctx, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Empty(featuredTags)
+}
+
+func (suite *FeaturedTagTestSuite) TestGetFeaturedTagStats() {
+	ctx := context.Background()
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	stats, err := suite.db.GetFeaturedTagStats(ctx, testFeaturedTag)
+	suite.NoError(err)
+	suite.Equal(1, stats.StatusesCount)
+	suite.Equal("2021-10-20T11:36:45Z", stats.LastStatusAt.UTC().Format("2006-01-02T15:04:05Z07:00"))
+
+	// An unused tag should have empty stats.
+	stats, err = suite.db.GetFeaturedTagStats(ctx, &gtsmodel.FeaturedTag{
+		AccountID: testFeaturedTag.AccountID,
+		TagID:     suite.testTags["Hashtag"].ID,
+	})
+	suite.NoError(err)
+	suite.Zero(stats.StatusesCount)
+	suite.True(stats.LastStatusAt.IsZero())
+}
+
+func (suite *FeaturedTagTestSuite) TestGetFeaturedTagSuggestions() {
+	ctx := context.Background()
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	// The only tag used by the admin
+	// account is already featured.
+	tags, err := suite.db.GetFeaturedTagSuggestions(ctx, testFeaturedTag.AccountID, 10)
+	suite.NoError(err)
+	suite.Empty(tags)
+
+	// Unfeature it, it should now be suggested.
+	if err := suite.db.DeleteFeaturedTagByID(ctx, testFeaturedTag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tags, err = suite.db.GetFeaturedTagSuggestions(ctx, testFeaturedTag.AccountID, 10)
+	suite.NoError(err)
+	if suite.Len(tags, 1) {
+		suite.Equal(testFeaturedTag.TagID, tags[0].ID)
+	}
+}
+
+func (suite *FeaturedTagTestSuite) TestPutDeleteFeaturedTag() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	featuredTag := &gtsmodel.FeaturedTag{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		TagID:     suite.testTags["Hashtag"].ID,
+	}
+
+	if err := suite.db.PutFeaturedTag(ctx, featuredTag); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Featuring the same tag twice should fail.
+	err := suite.db.PutFeaturedTag(ctx, &gtsmodel.FeaturedTag{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		TagID:     suite.testTags["Hashtag"].ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	featuredTags, err := suite.db.GetFeaturedTagsByAccountID(ctx, account.ID)
+	suite.NoError(err)
+	suite.Len(featuredTags, 1)
+
+	if err := suite.db.DeleteFeaturedTagsByAccountID(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetFeaturedTagByID(ctx, featuredTag.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestFeaturedTagTestSuite(t *testing.T) {
+	suite.Run(t, new(FeaturedTagTestSuite))
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type groupModeratorDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (g *groupModeratorDB) GetGroupModerators(ctx context.Context, groupID string) ([]*gtsmodel.GroupModerator, error) {
+	var moderators []*gtsmodel.GroupModerator
+	if err := g.db.NewSelect().
+		Model(&moderators).
+		Where("? = ?", bun.Ident("group_id"), groupID).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, moderator := range moderators {
+		var err error
+
+		// Populate the moderating account.
+		moderator.Account, err = g.state.DB.GetAccountByID(
+			ctx,
+			moderator.AccountID,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error populating moderator account: %w", err)
+		}
+	}
+
+	return moderators, nil
+}
+
+func (g *groupModeratorDB) GetModeratedGroupIDs(ctx context.Context, accountID string) ([]string, error) {
+	var groupIDs []string
+	if err := g.db.NewSelect().
+		Table("group_moderators").
+		Column("group_id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx, &groupIDs); err != nil {
+		return nil, err
+	}
+	return groupIDs, nil
+}
+
+func (g *groupModeratorDB) IsGroupModerator(ctx context.Context, groupID string, accountID string) (bool, error) {
+	return g.db.NewSelect().
+		Table("group_moderators").
+		Where("? = ?", bun.Ident("group_id"), groupID).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exists(ctx)
+}
+
+func (g *groupModeratorDB) PutGroupModerator(ctx context.Context, moderator *gtsmodel.GroupModerator) error {
+	_, err := g.db.NewInsert().
+		Model(moderator).
+		Exec(ctx)
+	return err
+}
+
+func (g *groupModeratorDB) DeleteGroupModerator(ctx context.Context, groupID string, accountID string) error {
+	_, err := g.db.NewDelete().
+		Table("group_moderators").
+		Where("? = ?", bun.Ident("group_id"), groupID).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
+func (g *groupModeratorDB) DeleteGroupModeratorsByAccountID(ctx context.Context, accountID string) error {
+	_, err := g.db.NewDelete().
+		Table("group_moderators").
+		WhereOr("? = ?", bun.Ident("group_id"), accountID).
+		WhereOr("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
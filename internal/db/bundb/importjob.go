@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type importJobDB struct{ db *bun.DB }
+
+func (i *importJobDB) GetImportJobByID(ctx context.Context, id string) (*gtsmodel.ImportJob, error) {
+	job := new(gtsmodel.ImportJob)
+	if err := i.db.NewSelect().
+		Model(job).
+		Where("? = ?", bun.Ident("id"), id).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (i *importJobDB) GetUnfinishedImportJobs(ctx context.Context) ([]*gtsmodel.ImportJob, error) {
+	var jobs []*gtsmodel.ImportJob
+	if err := i.db.NewSelect().
+		Model(&jobs).
+		Where("? NOT IN (?)", bun.Ident("state"), bun.In([]gtsmodel.ImportJobState{
+			gtsmodel.ImportJobStateFinished,
+			gtsmodel.ImportJobStateFailed,
+		})).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (i *importJobDB) PutImportJob(ctx context.Context, job *gtsmodel.ImportJob) error {
+	_, err := i.db.NewInsert().
+		Model(job).
+		Exec(ctx)
+	return err
+}
+
+func (i *importJobDB) UpdateImportJob(ctx context.Context, job *gtsmodel.ImportJob, columns ...string) error {
+	job.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.db.NewUpdate().
+		Model(job).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), job.ID).
+		Exec(ctx)
+	return err
+}
+
+func (i *importJobDB) DeleteImportJobsByAccountID(ctx context.Context, accountID string) error {
+	return i.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Delete failures of all this account's jobs first.
+		if _, err := tx.NewDelete().
+			Table("import_job_failures").
+			Where("? IN (?)", bun.Ident("import_job_id"), tx.NewSelect().
+				Table("import_jobs").
+				Column("id").
+				Where("? = ?", bun.Ident("account_id"), accountID),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Then delete the jobs themselves.
+		_, err := tx.NewDelete().
+			Table("import_jobs").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (i *importJobDB) GetImportJobFailures(ctx context.Context, jobID string) ([]*gtsmodel.ImportJobFailure, error) {
+	var failures []*gtsmodel.ImportJobFailure
+	if err := i.db.NewSelect().
+		Model(&failures).
+		Where("? = ?", bun.Ident("import_job_id"), jobID).
+		OrderExpr("? ASC", bun.Ident("row_number")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return failures, nil
+}
+
+func (i *importJobDB) PutImportJobFailure(ctx context.Context, failure *gtsmodel.ImportJobFailure) error {
+	_, err := i.db.NewInsert().
+		Model(failure).
+		Exec(ctx)
+	return err
+}
+
+func (i *importJobDB) DeleteImportJobFailures(ctx context.Context, jobID string) error {
+	_, err := i.db.NewDelete().
+		Table("import_job_failures").
+		Where("? = ?", bun.Ident("import_job_id"), jobID).
+		Exec(ctx)
+	return err
+}
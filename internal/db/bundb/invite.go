@@ -0,0 +1,140 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type inviteDB struct{ db *bun.DB }
+
+func (i *inviteDB) GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error) {
+	return i.getInvite(ctx, "id", id)
+}
+
+func (i *inviteDB) GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error) {
+	return i.getInvite(ctx, "code", code)
+}
+
+func (i *inviteDB) getInvite(ctx context.Context, column string, value string) (*gtsmodel.Invite, error) {
+	invite := new(gtsmodel.Invite)
+	if err := i.db.NewSelect().
+		Model(invite).
+		Where("? = ?", bun.Ident(column), value).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (i *inviteDB) GetInvitesByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Invite, error) {
+	var invites []*gtsmodel.Invite
+	if err := i.db.NewSelect().
+		Model(&invites).
+		Where("? = ?", bun.Ident("created_by_account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (i *inviteDB) PutInvite(ctx context.Context, invite *gtsmodel.Invite) error {
+	_, err := i.db.NewInsert().
+		Model(invite).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error {
+	invite.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.db.NewUpdate().
+		Model(invite).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), invite.ID).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) IncrementInviteUses(ctx context.Context, id string) error {
+	// Do the check + increment in one
+	// query, so that concurrent sign-ups
+	// can't push an invite over max uses.
+	res, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ? + 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("id"), id).
+		Where("? IS NULL", bun.Ident("revoked_at")).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				WhereOr("? IS NULL", bun.Ident("max_uses")).
+				WhereOr("? = 0", bun.Ident("max_uses")).
+				WhereOr("? < ?", bun.Ident("uses"), bun.Ident("max_uses"))
+		}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// Invite not found or not usable.
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
+
+func (i *inviteDB) DecrementInviteUses(ctx context.Context, id string) error {
+	_, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ? - 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("id"), id).
+		Where("? > 0", bun.Ident("uses")).
+		Exec(ctx)
+	return err
+}
+
+func (i *inviteDB) RevokeInvitesByAccountID(ctx context.Context, accountID string) error {
+	now := time.Now()
+	_, err := i.db.NewUpdate().
+		Table("invites").
+		Set("? = ?", bun.Ident("revoked_at"), now).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("created_by_account_id"), accountID).
+		Where("? IS NULL", bun.Ident("revoked_at")).
+		Exec(ctx)
+	return err
+}
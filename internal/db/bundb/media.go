@@ -110,13 +110,27 @@ func (m *mediaDB) UpdateAttachment(ctx context.Context, media *gtsmodel.MediaAtt
 		columns = append(columns, "updated_at")
 	}
 
+	// If the description of attached media may have
+	// changed, the owning status needs reindexing too.
+	reindex := media.StatusID != "" &&
+		(len(columns) == 0 || slices.Contains(columns, "description"))
+
 	return m.state.Caches.DB.Media.Store(media, func() error {
-		_, err := m.db.NewUpdate().
-			Model(media).
-			Where("? = ?", bun.Ident("media_attachment.id"), media.ID).
-			Column(columns...).
-			Exec(ctx)
-		return err
+		return m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.NewUpdate().
+				Model(media).
+				Where("? = ?", bun.Ident("media_attachment.id"), media.ID).
+				Column(columns...).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			if reindex {
+				return reindexStatusText(ctx, tx, media.StatusID)
+			}
+
+			return nil
+		})
 	})
 }
 
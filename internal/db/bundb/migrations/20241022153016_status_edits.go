@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// Create the new
+			// status edits table.
+			if _, err := tx.
+				NewCreateTable().
+				Model(&gtsmodel.StatusEdit{}).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index status edits by status ID,
+			// for when we delete edits by status.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("status_edits").
+				Index("status_edits_status_id_idx").
+				Column("status_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// SQLite doesn't have an array
+			// type, bun stores them as text.
+			arrayType := "VARCHAR[]"
+			if tx.Dialect().Name() == dialect.SQLite {
+				arrayType = "VARCHAR"
+			}
+
+			// Add the new columns to the
+			// statuses table if not exist.
+			for column, colType := range map[string]string{
+				"edits":     arrayType,
+				"edited_at": "TIMESTAMPTZ",
+			} {
+				exists, err := doesColumnExist(ctx, tx, "statuses", column)
+				if err != nil {
+					return err
+				} else if exists {
+					continue
+				}
+
+				if _, err := tx.ExecContext(
+					ctx,
+					"ALTER TABLE ? ADD COLUMN ? "+colType,
+					bun.Ident("statuses"),
+					bun.Ident(column),
+				); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+
+			// Create the new
+			// cards table.
+			if _, err := tx.
+				NewCreateTable().
+				Model(&gtsmodel.Card{}).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index cards by fetched at,
+			// for when we select stale
+			// cards to be refreshed.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("cards").
+				Index("cards_fetched_at_idx").
+				Column("fetched_at").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index cards by image ID, for
+			// when we check media in use.
+			if _, err := tx.
+				NewCreateIndex().
+				Table("cards").
+				Index("cards_image_id_idx").
+				Column("image_id").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Add the new card ID column
+			// to statuses table if not exist.
+			exists, err := doesColumnExist(ctx, tx, "statuses", "card_id")
+			if err != nil {
+				return err
+			} else if exists {
+				return nil
+			}
+
+			_, err = tx.ExecContext(
+				ctx,
+				"ALTER TABLE ? ADD COLUMN ? CHAR(26)",
+				bun.Ident("statuses"),
+				bun.Ident("card_id"),
+			)
+			return err
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
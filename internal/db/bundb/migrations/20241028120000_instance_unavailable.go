@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Add delivery failure tracking columns to instances.
+			for _, column := range []struct {
+				name string
+				typ  string
+			}{
+				{name: "delivery_failures", typ: "INTEGER NOT NULL DEFAULT 0"},
+				{name: "delivery_failing_since", typ: "TIMESTAMPTZ"},
+				{name: "unavailable_at", typ: "TIMESTAMPTZ"},
+			} {
+				// If column already exists we don't need to do anything.
+				if exists, err := doesColumnExist(ctx, tx, "instances", column.name); err != nil {
+					return err
+				} else if exists {
+					continue
+				}
+
+				if _, err := tx.ExecContext(
+					ctx,
+					"ALTER TABLE ? ADD COLUMN ? "+column.typ,
+					bun.Ident("instances"),
+					bun.Ident(column.name),
+				); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Recovery codes are stored as an
+			// array, which differs per dialect.
+			var arrayType string
+			switch tx.Dialect().Name() {
+			case dialect.SQLite:
+				arrayType = "VARCHAR"
+			case dialect.PG:
+				arrayType = "VARCHAR ARRAY"
+			default:
+				panic("db conn was neither pg not sqlite")
+			}
+
+			for _, column := range []struct {
+				name    string
+				colType string
+			}{
+				{"two_factor_secret", "VARCHAR"},
+				{"two_factor_recovery_codes", arrayType},
+				{"two_factor_enabled_at", "TIMESTAMPTZ"},
+			} {
+				// If column already exists we don't need to do anything.
+				if exists, err := doesColumnExist(ctx, tx, "users", column.name); err != nil {
+					return err
+				} else if exists {
+					continue
+				}
+
+				if _, err := tx.
+					NewAddColumn().
+					Table("users").
+					ColumnExpr("? "+column.colType, bun.Ident(column.name)).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
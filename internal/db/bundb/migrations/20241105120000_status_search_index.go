@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		if err := db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			var stmts []string
+
+			switch tx.Dialect().Name() {
+			case dialect.SQLite:
+				stmts = []string{
+					// Plain table of status text, with an
+					// integer primary key to use as FTS5 rowid.
+					`CREATE TABLE IF NOT EXISTS "status_search" (
+						"id" INTEGER PRIMARY KEY,
+						"status_id" CHAR(26) NOT NULL UNIQUE,
+						"text" TEXT NOT NULL
+					)`,
+
+					// FTS5 index using status_search as external
+					// content, so the text isn't stored twice.
+					`CREATE VIRTUAL TABLE IF NOT EXISTS "status_search_fts" USING fts5(
+						"text",
+						content='status_search',
+						content_rowid='id',
+						tokenize='unicode61 remove_diacritics 2'
+					)`,
+
+					// Triggers to keep the FTS5
+					// index in sync with status_search.
+					`CREATE TRIGGER IF NOT EXISTS "status_search_ai" AFTER INSERT ON "status_search" BEGIN
+						INSERT INTO "status_search_fts" ("rowid", "text") VALUES (new."id", new."text");
+					END`,
+					`CREATE TRIGGER IF NOT EXISTS "status_search_ad" AFTER DELETE ON "status_search" BEGIN
+						INSERT INTO "status_search_fts" ("status_search_fts", "rowid", "text") VALUES ('delete', old."id", old."text");
+					END`,
+					`CREATE TRIGGER IF NOT EXISTS "status_search_au" AFTER UPDATE ON "status_search" BEGIN
+						INSERT INTO "status_search_fts" ("status_search_fts", "rowid", "text") VALUES ('delete', old."id", old."text");
+						INSERT INTO "status_search_fts" ("rowid", "text") VALUES (new."id", new."text");
+					END`,
+				}
+
+			case dialect.PG:
+				stmts = []string{
+					// Table of status text, with a generated
+					// tsvector column to match queries against.
+					`CREATE TABLE IF NOT EXISTS "status_search" (
+						"status_id" CHAR(26) PRIMARY KEY,
+						"text" TEXT NOT NULL,
+						"vector" TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', "text")) STORED
+					)`,
+
+					// GIN index on the tsvector column.
+					`CREATE INDEX IF NOT EXISTS "status_search_vector_idx" ON "status_search" USING GIN ("vector")`,
+				}
+
+			default:
+				panic("db conn was neither pg not sqlite")
+			}
+
+			for _, stmt := range stmts {
+				if _, err := tx.ExecContext(ctx, stmt); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		// Backfill the index with the text of existing
+		// statuses, in batches so we don't hold one huge
+		// transaction open for the whole thing.
+		log.Info(ctx, "indexing existing statuses for search, please wait and don't interrupt it (this may take a while)")
+
+		type status struct {
+			bun.BaseModel `bun:"table:statuses"`
+
+			ID             string `bun:"id"`
+			Content        string `bun:"content,nullzero"`
+			ContentWarning string `bun:"content_warning,nullzero"`
+		}
+
+		var (
+			minID   = "00000000000000000000000000"
+			indexed int
+		)
+
+		for {
+			var statuses []*status
+			if err := db.NewSelect().
+				Model(&statuses).
+				Column("id", "content", "content_warning").
+				Where("? IS NULL", bun.Ident("boost_of_id")).
+				Where("? > ?", bun.Ident("id"), minID).
+				Order("id ASC").
+				Limit(500).
+				Scan(ctx); err != nil {
+				return err
+			}
+
+			if len(statuses) == 0 {
+				break
+			}
+
+			if err := db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+				for _, s := range statuses {
+					var descriptions []string
+					if err := tx.NewSelect().
+						Table("media_attachments").
+						Column("description").
+						Where("? = ?", bun.Ident("status_id"), s.ID).
+						Where("? IS NOT NULL", bun.Ident("description")).
+						Scan(ctx, &descriptions); err != nil {
+						return err
+					}
+
+					// Same as statusSearchText in bundb,
+					// but frozen here at time of migration.
+					content := strings.ReplaceAll(s.Content, "<", " <")
+					parts := []string{text.SanitizeToPlaintext(content)}
+					if s.ContentWarning != "" {
+						parts = append(parts, s.ContentWarning)
+					}
+					parts = append(parts, descriptions...)
+
+					if _, err := tx.NewRaw(
+						"INSERT INTO ? (?, ?) VALUES (?, ?) ON CONFLICT (?) DO NOTHING",
+						bun.Ident("status_search"),
+						bun.Ident("status_id"), bun.Ident("text"),
+						s.ID, strings.Join(parts, "\n"),
+						bun.Ident("status_id"),
+					).Exec(ctx); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			indexed += len(statuses)
+			minID = statuses[len(statuses)-1].ID
+			log.Infof(ctx, "indexed %d statuses so far", indexed)
+		}
+
+		return nil
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -243,6 +243,39 @@ func (r *relationshipDB) GetAccountLocalFollowerIDs(ctx context.Context, account
 	})
 }
 
+func (r *relationshipDB) GetFriendsOfFriendsIDs(ctx context.Context, accountID string, limit int) ([]string, error) {
+	var accountIDs []string
+
+	// Select targets of follows owned by accounts that
+	// accountID follows, ranked by number of such follows.
+	if err := r.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		ColumnExpr("? AS ?", bun.Ident("fof.target_account_id"), bun.Ident("target_account_id")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"), bun.Ident("fof"),
+			bun.Ident("fof.account_id"), bun.Ident("follow.target_account_id"),
+		).
+		Where("? = ?", bun.Ident("follow.account_id"), accountID).
+		Where("? != ?", bun.Ident("fof.target_account_id"), accountID).
+		Where("NOT EXISTS (?)", r.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("existing")).
+			Column("existing.id").
+			Where("? = ?", bun.Ident("existing.account_id"), accountID).
+			Where("? = ?", bun.Ident("existing.target_account_id"), bun.Ident("fof.target_account_id")),
+		).
+		GroupExpr("?", bun.Ident("fof.target_account_id")).
+		OrderExpr("COUNT(*) DESC").
+		OrderExpr("? DESC", bun.Ident("fof.target_account_id")).
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
 func (r *relationshipDB) GetAccountFollowRequestIDs(ctx context.Context, accountID string, page *paging.Page) ([]string, error) {
 	return loadPagedIDs(&r.state.Caches.DB.FollowRequestIDs, ">"+accountID, page, func() ([]string, error) {
 		var followReqIDs []string
@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type RelayTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *RelayTestSuite) TestPutGetUpdateDeleteRelay() {
+	ctx := context.Background()
+
+	relay := &gtsmodel.Relay{
+		ID:        "01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+		InboxURI:  "https://relay.example.org/inbox",
+		FollowURI: "http://localhost:8080/users/localhost:8080/follow/01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+		State:     gtsmodel.RelayStatePending,
+	}
+	if err := suite.db.PutRelay(ctx, relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Inbox URI must be unique.
+	err := suite.db.PutRelay(ctx, &gtsmodel.Relay{
+		ID:        "01JBMZBQ7ZK1C0XQ1B6YJ3GZ9N",
+		InboxURI:  "https://relay.example.org/inbox",
+		FollowURI: "http://localhost:8080/users/localhost:8080/follow/01JBMZBQ7ZK1C0XQ1B6YJ3GZ9N",
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	dbRelay, err := suite.db.GetRelayByFollowURI(ctx, relay.FollowURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(relay.ID, dbRelay.ID)
+	suite.False(dbRelay.Accepted())
+
+	// No actor URI yet.
+	_, err = suite.db.GetRelayByActorURI(ctx, "https://relay.example.org/actor")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	dbRelay.ActorURI = "https://relay.example.org/actor"
+	dbRelay.State = gtsmodel.RelayStateAccepted
+	if err := suite.db.UpdateRelay(ctx, dbRelay, "actor_uri", "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbRelay, err = suite.db.GetRelayByActorURI(ctx, "https://relay.example.org/actor")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbRelay.Accepted())
+
+	relays, err := suite.db.GetRelays(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(relays, 1)
+
+	if err := suite.db.DeleteRelayByID(ctx, relay.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetRelayByInboxURI(ctx, relay.InboxURI)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
@@ -0,0 +1,317 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type scheduledStatusDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, error) {
+	// Fetch scheduled status from database cache with loader callback.
+	status, err := s.state.Caches.DB.ScheduledStatus.LoadOne("ID",
+		func() (*gtsmodel.ScheduledStatus, error) {
+			var status gtsmodel.ScheduledStatus
+
+			// Not cached, load scheduled
+			// status from database by its ID.
+			if err := s.db.NewSelect().
+				Model(&status).
+				Where("? = ?", bun.Ident("id"), id).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return &status, nil
+		}, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return status, nil
+	}
+
+	// Further populate the scheduled status fields where applicable.
+	if err := s.PopulateScheduledStatus(ctx, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.ScheduledStatus, error) {
+	// Load scheduled statuses for IDs via cache loader callbacks.
+	statuses, err := s.state.Caches.DB.ScheduledStatus.LoadIDs("ID",
+		ids,
+		func(uncached []string) ([]*gtsmodel.ScheduledStatus, error) {
+			// Preallocate expected length of uncached scheduled statuses.
+			statuses := make([]*gtsmodel.ScheduledStatus, 0, len(uncached))
+
+			// Perform database query scanning the
+			// remaining (uncached) scheduled status IDs.
+			if err := s.db.NewSelect().
+				Model(&statuses).
+				Where("? IN (?)", bun.Ident("id"), bun.In(uncached)).
+				Scan(ctx); err != nil {
+				return nil, err
+			}
+
+			return statuses, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the scheduled statuses by
+	// their IDs to ensure in correct order.
+	getID := func(s *gtsmodel.ScheduledStatus) string { return s.ID }
+	util.OrderBy(statuses, ids, getID)
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return statuses, nil
+	}
+
+	// Populate all loaded scheduled statuses, removing those we fail
+	// to populate (removes needing so many nil checks everywhere).
+	statuses = slices.DeleteFunc(statuses, func(status *gtsmodel.ScheduledStatus) bool {
+		if err := s.PopulateScheduledStatus(ctx, status); err != nil {
+			log.Errorf(ctx, "error populating scheduled status %s: %v", status.ID, err)
+			return true
+		}
+		return false
+	})
+
+	return statuses, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusesForAcct(
+	ctx context.Context,
+	acctID string,
+	page *paging.Page,
+) ([]*gtsmodel.ScheduledStatus, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		statusIDs = make([]string, 0, limit)
+	)
+
+	// Create the basic select query.
+	q := s.db.
+		NewSelect().
+		Column("id").
+		TableExpr(
+			"? AS ?",
+			bun.Ident("scheduled_statuses"),
+			bun.Ident("scheduled_status"),
+		).
+		Where("? = ?", bun.Ident("account_id"), acctID)
+
+	// Add paging param max ID.
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("id"), maxID)
+	}
+
+	// Add paging param min ID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("id"), minID)
+	}
+
+	// Add paging param order.
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("id"))
+	}
+
+	// Add paging param limit.
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	// Execute the query and scan into IDs.
+	err := q.Scan(ctx, &statusIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Catch case of no items early
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// If we're paging up, we still want scheduled
+	// statuses to be sorted by ID desc, so reverse.
+	if order == paging.OrderAscending {
+		slices.Reverse(statusIDs)
+	}
+
+	// Load all scheduled statuses by their IDs.
+	return s.GetScheduledStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *scheduledStatusDB) GetAllScheduledStatuses(ctx context.Context) ([]*gtsmodel.ScheduledStatus, error) {
+	var statusIDs []string
+
+	// Select IDs of all scheduled statuses.
+	if err := s.db.
+		NewSelect().
+		Table("scheduled_statuses").
+		Column("id").
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, nil
+	}
+
+	// Load all scheduled statuses by their IDs.
+	return s.GetScheduledStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *scheduledStatusDB) PopulateScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus) error {
+	var (
+		err  error
+		errs gtserror.MultiError
+	)
+
+	// For sub-models we only want
+	// barebones versions of them.
+	ctx = gtscontext.SetBarebones(ctx)
+
+	if status.Account == nil {
+		// Fetch the account that scheduled this status.
+		status.Account, err = s.state.DB.GetAccountByID(
+			ctx,
+			status.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating scheduled status account: %w", err)
+		}
+	}
+
+	if !status.AttachmentsPopulated() {
+		// Fetch all attachments for scheduled status media IDs.
+		status.MediaAttachments, err = s.state.DB.GetAttachmentsByIDs(
+			ctx,
+			status.MediaIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating scheduled status attachments: %w", err)
+		}
+	}
+
+	if status.ApplicationID != "" && status.Application == nil {
+		// Fetch the application used to schedule this status.
+		status.Application, err = s.state.DB.GetApplicationByID(
+			ctx,
+			status.ApplicationID,
+		)
+		if err != nil {
+			errs.Appendf("error populating scheduled status application: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (s *scheduledStatusDB) PutScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus) error {
+	return s.state.Caches.DB.ScheduledStatus.Store(status, func() error {
+		_, err := s.db.NewInsert().Model(status).Exec(ctx)
+		return err
+	})
+}
+
+func (s *scheduledStatusDB) UpdateScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus, columns ...string) error {
+	// Update the scheduled status' last-updated
+	status.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	return s.state.Caches.DB.ScheduledStatus.Store(status, func() error {
+		_, err := s.db.
+			NewUpdate().
+			Model(status).
+			Where("? = ?", bun.Ident("scheduled_status.id"), status.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusByID(ctx context.Context, id string) error {
+	// Delete the scheduled status from DB.
+	if _, err := s.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+		Where("? = ?", bun.Ident("scheduled_status.id"), id).
+		Exec(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate any cached scheduled status model by ID.
+	s.state.Caches.DB.ScheduledStatus.Invalidate("ID", id)
+
+	return nil
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusesByAccountID(ctx context.Context, accountID string) error {
+	// Delete all scheduled statuses owned by account from DB.
+	if _, err := s.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+		Where("? = ?", bun.Ident("scheduled_status.account_id"), accountID).
+		Exec(ctx); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate any cached scheduled status models by account ID.
+	s.state.Caches.DB.ScheduledStatus.Invalidate("AccountID", accountID)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ScheduledStatusTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ScheduledStatusTestSuite) putScheduledStatus(scheduledID string, accountID string) *gtsmodel.ScheduledStatus {
+	scheduled := &gtsmodel.ScheduledStatus{
+		ID:            scheduledID,
+		AccountID:     accountID,
+		ScheduledAt:   time.Now().Add(time.Hour),
+		Text:          "hello from the future",
+		Sensitive:     util.Ptr(false),
+		Visibility:    gtsmodel.VisibilityPublic,
+		LocalOnly:     util.Ptr(false),
+		MediaIDs:      []string{suite.testAttachments["local_account_1_unattached_1"].ID},
+		PollOptions:   []string{"yes", "no"},
+		PollExpiresIn: 600,
+		ApplicationID: suite.testApplications["application_1"].ID,
+	}
+
+	if err := suite.db.PutScheduledStatus(context.Background(), scheduled); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return scheduled
+}
+
+func (suite *ScheduledStatusTestSuite) TestPutGetScheduledStatus() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	scheduled := suite.putScheduledStatus(id.NewULID(), account.ID)
+
+	dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, scheduled.ID)
+	suite.NoError(err)
+	suite.Equal(scheduled.Text, dbScheduled.Text)
+	suite.Equal(scheduled.MediaIDs, dbScheduled.MediaIDs)
+	suite.Equal(scheduled.PollOptions, dbScheduled.PollOptions)
+	suite.True(dbScheduled.HasPoll())
+
+	// Sub-models should be populated.
+	suite.NotNil(dbScheduled.Account)
+	suite.NotNil(dbScheduled.Application)
+	suite.True(dbScheduled.AttachmentsPopulated())
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatusesForAcct() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	// Make sure first is older than second.
+	firstID, err := id.NewULIDFromTime(time.Now().Add(-time.Minute))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	first := suite.putScheduledStatus(firstID, account.ID)
+	second := suite.putScheduledStatus(id.NewULID(), account.ID)
+	suite.putScheduledStatus(id.NewULID(), suite.testAccounts["local_account_2"].ID)
+
+	// Get all for account.
+	all, err := suite.db.GetScheduledStatusesForAcct(ctx, account.ID, nil)
+	suite.NoError(err)
+	suite.Len(all, 2)
+
+	// Get a page of one.
+	page, err := suite.db.GetScheduledStatusesForAcct(ctx, account.ID, &paging.Page{Limit: 1})
+	suite.NoError(err)
+	suite.Len(page, 1)
+	suite.Equal(second.ID, page[0].ID)
+
+	// Get the next page.
+	page, err = suite.db.GetScheduledStatusesForAcct(ctx, account.ID, &paging.Page{
+		Max:   paging.MaxID(second.ID),
+		Limit: 1,
+	})
+	suite.NoError(err)
+	suite.Len(page, 1)
+	suite.Equal(first.ID, page[0].ID)
+}
+
+func (suite *ScheduledStatusTestSuite) TestUpdateDeleteScheduledStatus() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	scheduled := suite.putScheduledStatus(id.NewULID(), account.ID)
+
+	newScheduledAt := time.Now().Add(2 * time.Hour).Truncate(time.Millisecond)
+	scheduled.ScheduledAt = newScheduledAt
+	if err := suite.db.UpdateScheduledStatus(ctx, scheduled, "scheduled_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, scheduled.ID)
+	suite.NoError(err)
+	suite.True(newScheduledAt.Equal(dbScheduled.ScheduledAt))
+
+	if err := suite.db.DeleteScheduledStatusesByAccountID(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetScheduledStatusByID(ctx, scheduled.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestScheduledStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledStatusTestSuite))
+}
@@ -21,6 +21,7 @@
 	"context"
 	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -58,6 +59,7 @@
 // a caller paging down through results.
 type searchDB struct {
 	db    *bun.DB
+	index statusIndex
 	state *state.State
 }
 
@@ -260,15 +262,14 @@ func (s *searchDB) accountText(following bool) *bun.SelectQuery {
 //	SELECT "status"."id"
 //	FROM "statuses" AS "status"
 //	WHERE ("status"."boost_of_id" IS NULL)
-//	AND (("status"."account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF') OR ("status"."in_reply_to_account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF'))
+//	AND (("status"."account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF') OR ("status"."visibility" IN ('public', 'unlocked')) OR ...)
+//	AND ("status"."id" IN (SELECT "status_search"."status_id" FROM "status_search" AS "status_search" JOIN "status_search_fts" ON "status_search_fts"."rowid" = "status_search"."id" WHERE ("status_search_fts" MATCH '"hello"')))
 //	AND ("status"."id" < 'ZZZZZZZZZZZZZZZZZZZZZZZZZZ')
-//	AND ((SELECT "status"."content" || COALESCE("status"."content_warning", '') AS "status_text") LIKE '%hello%' ESCAPE '\')
 //	ORDER BY "status"."id" DESC LIMIT 10
 func (s *searchDB) SearchForStatuses(
 	ctx context.Context,
 	requestingAccountID string,
-	query string,
-	fromAccountID string,
+	params *db.StatusSearchParams,
 	maxID string,
 	minID string,
 	limit int,
@@ -292,15 +293,73 @@ func (s *searchDB) SearchForStatuses(
 		Column("status.id").
 		// Ignore boosts.
 		Where("? IS NULL", bun.Ident("status.boost_of_id")).
-		// Select only statuses created by
-		// accountID or replying to accountID.
+		// Select only statuses that the requesting
+		// account could plausibly see. This is just
+		// a cheap pre-filter; callers should still
+		// check visibility properly on the results.
 		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
 			return q.
 				Where("? = ?", bun.Ident("status.account_id"), requestingAccountID).
-				WhereOr("? = ?", bun.Ident("status.in_reply_to_account_id"), requestingAccountID)
+				WhereOr("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+					gtsmodel.VisibilityPublic,
+					gtsmodel.VisibilityUnlocked,
+				})).
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.
+						Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+							gtsmodel.VisibilityFollowersOnly,
+							gtsmodel.VisibilityMutualsOnly,
+						})).
+						Where("? IN (?)", bun.Ident("status.account_id"), s.followedAccounts(requestingAccountID))
+				}).
+				WhereOr("? IN (?)", bun.Ident("status.id"), s.mentioningStatuses(requestingAccountID))
+		})
+
+	if params.Query != "" {
+		// Match query text using the full-text index.
+		q = s.index.match(q, params.Query)
+	}
+
+	if params.FromAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status.account_id"), params.FromAccountID)
+	}
+
+	if params.HasMedia {
+		q = q.Where("EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("media_attachment.status_id"), bun.Ident("status.id")),
+		)
+	}
+
+	if params.InLibrary {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("? = ?", bun.Ident("status.account_id"), requestingAccountID)
+			for _, subq := range s.libraryStatuses(requestingAccountID) {
+				q = q.WhereOr("? IN (?)", bun.Ident("status.id"), subq)
+			}
+			return q
 		})
-	if fromAccountID != "" {
-		q = q.Where("? = ?", bun.Ident("status.account_id"), fromAccountID)
+	}
+
+	// Status IDs are ULIDs, so we can
+	// filter on creation time by
+	// comparing to a ULID of that time.
+	if !params.Before.IsZero() {
+		beforeID, err := id.NewULIDFromTime(params.Before)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Where("? < ?", bun.Ident("status.id"), beforeID)
+	}
+
+	if !params.After.IsZero() {
+		afterID, err := id.NewULIDFromTime(params.After)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Where("? > ?", bun.Ident("status.id"), afterID)
 	}
 
 	// Return only items with a LOWER id than maxID.
@@ -317,13 +376,6 @@ func (s *searchDB) SearchForStatuses(
 		frontToBack = false
 	}
 
-	// Select status text as subquery.
-	statusTextSubq := s.statusText()
-
-	// Search using LIKE for matches of query
-	// string within statusText subquery.
-	q = whereLike(q, statusTextSubq, query)
-
 	if limit > 0 {
 		// Limit amount of statuses returned.
 		q = q.Limit(limit)
@@ -370,32 +422,94 @@ func (s *searchDB) SearchForStatuses(
 	return statuses, nil
 }
 
-// statusText returns a subquery that selects a concatenation
-// of status content and content warning as "status_text".
-func (s *searchDB) statusText() *bun.SelectQuery {
-	statusText := s.db.NewSelect()
+// mentioningStatuses returns a subquery that selects only
+// IDs of statuses that mention the given accountID.
+func (s *searchDB) mentioningStatuses(accountID string) *bun.SelectQuery {
+	return s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+		Column("mention.status_id").
+		Where("? = ?", bun.Ident("mention.target_account_id"), accountID)
+}
+
+// libraryStatuses returns subqueries that select only IDs of
+// statuses that the given accountID has faved, bookmarked, or
+// boosted respectively. Statuses created by accountID are not
+// included. These are kept separate rather than UNIONed, as
+// SQLite doesn't support parenthesized compound subqueries.
+func (s *searchDB) libraryStatuses(accountID string) []*bun.SelectQuery {
+	return []*bun.SelectQuery{
+		s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+			Column("status_fave.status_id").
+			Where("? = ?", bun.Ident("status_fave.account_id"), accountID),
+		s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
+			Column("status_bookmark.status_id").
+			Where("? = ?", bun.Ident("status_bookmark.account_id"), accountID),
+		s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("boost")).
+			Column("boost.boost_of_id").
+			Where("? = ?", bun.Ident("boost.account_id"), accountID).
+			Where("? IS NOT NULL", bun.Ident("boost.boost_of_id")),
+	}
+}
+
+func (s *searchDB) ReindexStatuses(ctx context.Context) error {
+	// Clear out the existing index first,
+	// so that text of any statuses that
+	// have since been deleted is dropped.
+	if _, err := s.db.NewDelete().
+		Table("status_search").
+		Where("1 = 1").
+		Exec(ctx); err != nil {
+		return err
+	}
 
-	// SQLite and Postgres use different
-	// syntaxes for concatenation.
-	switch d := s.db.Dialect().Name(); d {
+	// Page through all statuses
+	// in batches, oldest first.
+	const batchSize = 500
+	var (
+		minID   = id.Lowest
+		indexed int
+	)
 
-	case dialect.SQLite:
-		statusText = statusText.ColumnExpr(
-			"? || COALESCE(?, ?) AS ?",
-			bun.Ident("status.content"), bun.Ident("status.content_warning"), "",
-			bun.Ident("status_text"))
+	for {
+		var statuses []*gtsmodel.Status
+		if err := s.db.NewSelect().
+			Model(&statuses).
+			Column("id", "content", "content_warning", "attachments", "boost_of_id").
+			Where("? > ?", bun.Ident("id"), minID).
+			Order("id ASC").
+			Limit(batchSize).
+			Scan(ctx); err != nil {
+			return err
+		}
 
-	case dialect.PG:
-		statusText = statusText.ColumnExpr(
-			"CONCAT(?, COALESCE(?, ?)) AS ?",
-			bun.Ident("status.content"), bun.Ident("status.content_warning"), "",
-			bun.Ident("status_text"))
+		if len(statuses) == 0 {
+			break
+		}
 
-	default:
-		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+		if err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, status := range statuses {
+				if err := putStatusText(ctx, tx, status); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		indexed += len(statuses)
+		minID = statuses[len(statuses)-1].ID
+		log.Debugf(ctx, "reindexed %d statuses", indexed)
 	}
 
-	return statusText
+	return nil
 }
 
 // Query example (SQLite):
@@ -20,9 +20,11 @@
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type SearchTestSuite struct {
@@ -107,20 +109,150 @@ func (suite *SearchTestSuite) TestSearchAccountsFossAny() {
 func (suite *SearchTestSuite) TestSearchStatuses() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hello", "", "", "", 10, 0)
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, &db.StatusSearchParams{
+		Query: "hello",
+	}, "", "", 10, 0)
 	suite.NoError(err)
-	suite.Len(statuses, 1)
+	suite.Len(statuses, 2)
 }
 
 func (suite *SearchTestSuite) TestSearchStatusesFromAccount() {
 	testAccount := suite.testAccounts["local_account_1"]
 	fromAccount := suite.testAccounts["local_account_2"]
 
-	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hi", fromAccount.ID, "", "", 10, 0)
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, &db.StatusSearchParams{
+		Query:         "hi",
+		FromAccountID: fromAccount.ID,
+	}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 4) {
+		for _, status := range statuses {
+			suite.Equal(fromAccount.ID, status.AccountID)
+		}
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesMediaDescription() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// "haunted" only appears in a media description.
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, &db.StatusSearchParams{
+		Query: "haunted",
+	}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 3) {
+		for _, status := range statuses {
+			suite.Contains(status.AttachmentIDs, "01FVW7RXPQ8YJHTEXYPE7Q8ZY0")
+		}
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesHasMedia() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, &db.StatusSearchParams{
+		HasMedia: true,
+	}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 5) {
+		for _, status := range statuses {
+			suite.NotEmpty(status.AttachmentIDs)
+		}
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesInLibrary() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, &db.StatusSearchParams{
+		Query:     "turtles",
+		InLibrary: true,
+	}, "", "", 10, 0)
 	suite.NoError(err)
 	if suite.Len(statuses, 1) {
-		suite.Equal(fromAccount.ID, statuses[0].AccountID)
+		// Not our own status, but one we've faved.
+		suite.Equal("01F8MHBQCBTDKN6X5VHGMMN4MA", statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesBeforeAfter() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, &db.StatusSearchParams{
+		Query:  "hi",
+		After:  time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
+		Before: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, "", "", 10, 0)
+	suite.NoError(err)
+	if suite.Len(statuses, 2) {
+		suite.Equal("01FN3VJGFH10KR7S2PB0GFJZYG", statuses[0].ID)
+		suite.Equal("01FF25D5Q0DH7CHD57CTRS6WK0", statuses[1].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesUpdatedAndDeleted() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+
+	search := func(query string) []*gtsmodel.Status {
+		statuses, err := suite.db.SearchForStatuses(ctx, testAccount.ID, &db.StatusSearchParams{
+			Query: query,
+		}, "", "", 10, 0)
+		suite.NoError(err)
+		return statuses
+	}
+
+	// Update the content of the status,
+	// it should be found by its new content.
+	testStatus.Content = "<p>this status has been edited</p>"
+	if err := suite.db.UpdateStatus(ctx, testStatus, "content"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(search("edited"), 1)
+
+	// Delete the status, it
+	// should no longer be found.
+	if err := suite.db.DeleteStatusByID(ctx, testStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(search("edited"))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesAttachmentDescriptionUpdated() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testAttachment := new(gtsmodel.MediaAttachment)
+	*testAttachment = *suite.testAttachments["admin_account_status_1_attachment_1"]
+
+	search := func(query string) []string {
+		statuses, err := suite.db.SearchForStatuses(ctx, testAccount.ID, &db.StatusSearchParams{
+			Query: query,
+		}, "", "", 10, 0)
+		suite.NoError(err)
+
+		ids := make([]string, len(statuses))
+		for i, status := range statuses {
+			ids[i] = status.ID
+		}
+		return ids
+	}
+
+	// Status should be found by its
+	// attachment's current description.
+	suite.Contains(search("50's style"), testStatus.ID)
+
+	// Update the description of the attachment,
+	// the status should be found by the new
+	// description, and not by the old one.
+	testAttachment.Description = "a very shiny zeppelin"
+	if err := suite.db.UpdateAttachment(ctx, testAttachment, "description"); err != nil {
+		suite.FailNow(err.Error())
 	}
+	suite.Equal([]string{testStatus.ID}, search("zeppelin"))
+	suite.NotContains(search("50's style"), testStatus.ID)
 }
 
 func (suite *SearchTestSuite) TestSearchTags() {
@@ -181,7 +181,7 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status) error {
 	var (
 		err  error
-		errs = gtserror.NewMultiError(9)
+		errs = gtserror.NewMultiError(10)
 	)
 
 	if status.Account == nil {
@@ -254,6 +254,17 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 		}
 	}
 
+	if status.CardID != "" && status.Card == nil {
+		// Status card is not set, fetch from database.
+		status.Card, err = s.state.DB.GetCardByID(
+			ctx, // card image is needed for API model.
+			status.CardID,
+		)
+		if err != nil {
+			errs.Appendf("error populating status card: %w", err)
+		}
+	}
+
 	if !status.AttachmentsPopulated() {
 		// Status attachments are out-of-date with IDs, repopulate.
 		status.Attachments, err = s.state.DB.GetAttachmentsByIDs(
@@ -298,6 +309,17 @@ func (s *statusDB) PopulateStatus(ctx context.Context, status *gtsmodel.Status)
 		}
 	}
 
+	if !status.EditsPopulated() {
+		// Status edits are out-of-date with IDs, repopulate.
+		status.Edits, err = s.state.DB.GetStatusEditsByIDs(
+			ctx, // leave fully populated for now
+			status.EditIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating status edits: %w", err)
+		}
+	}
+
 	if status.CreatedWithApplicationID != "" && status.CreatedWithApplication == nil {
 		// Populate the status' expected CreatedWithApplication (not always set).
 		status.CreatedWithApplication, err = s.state.DB.GetApplicationByID(
@@ -357,7 +379,7 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error
 				if _, err := tx.
 					NewUpdate().
 					Model(a).
-					Column("status_id", "updated_at").
+					Column("status_id", "scheduled_status_id", "updated_at").
 					Where("? = ?", bun.Ident("media_attachment.id"), a.ID).
 					Exec(ctx); err != nil {
 					if !errors.Is(err, db.ErrAlreadyExists) {
@@ -383,6 +405,11 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error
 				}
 			}
 
+			// Index the status text for search.
+			if err := putStatusText(ctx, tx, status); err != nil {
+				return err
+			}
+
 			// Finally, insert the status
 			_, err := tx.NewInsert().Model(status).Exec(ctx)
 			return err
@@ -402,6 +429,28 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 		// as the cache does not attempt a mutex lock until AFTER hook.
 		//
 		return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// drop links between this status and any emojis it no longer uses
+			q := tx.NewDelete().
+				Table("status_to_emojis").
+				Where("? = ?", bun.Ident("status_id"), status.ID)
+			if len(status.EmojiIDs) > 0 {
+				q = q.Where("? NOT IN (?)", bun.Ident("emoji_id"), bun.In(status.EmojiIDs))
+			}
+			if _, err := q.Exec(ctx); err != nil {
+				return err
+			}
+
+			// drop links between this status and any tags it no longer uses
+			q = tx.NewDelete().
+				Table("status_to_tags").
+				Where("? = ?", bun.Ident("status_id"), status.ID)
+			if len(status.TagIDs) > 0 {
+				q = q.Where("? NOT IN (?)", bun.Ident("tag_id"), bun.In(status.TagIDs))
+			}
+			if _, err := q.Exec(ctx); err != nil {
+				return err
+			}
+
 			// create links between this status and any emojis it uses
 			for _, i := range status.EmojiIDs {
 				if _, err := tx.
@@ -466,6 +515,17 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 				}
 			}
 
+			// Reindex the status text for search,
+			// if any of the indexed fields changed.
+			if len(columns) == 0 ||
+				slices.Contains(columns, "content") ||
+				slices.Contains(columns, "content_warning") ||
+				slices.Contains(columns, "attachments") {
+				if err := putStatusText(ctx, tx, status); err != nil {
+					return err
+				}
+			}
+
 			// Finally, update the status
 			_, err := tx.
 				NewUpdate().
@@ -514,6 +574,11 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 			return err
 		}
 
+		// Delete the indexed text of this status.
+		if err := deleteStatusText(ctx, tx, id); err != nil {
+			return err
+		}
+
 		// delete the status itself
 		if _, err := tx.
 			NewDelete().
@@ -719,3 +784,116 @@ func (s *statusDB) GetDirectStatusIDsBatch(ctx context.Context, minID string, ma
 	}
 	return statusIDs, nil
 }
+
+func (s *statusDB) GetStaleRemoteStatuses(ctx context.Context, olderThan time.Time, maxID string, limit int) ([]*gtsmodel.Status, error) {
+	// Subquery selecting IDs of all local accounts,
+	// used to check for interactions by local accounts.
+	localAccountIDs := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.domain"))
+
+	q := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.local"), false).
+		// Ignore boosts, these are removed
+		// along with the status they boost.
+		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		Where("? IS NULL", bun.Ident("status.pinned_at")).
+		// Ignore statuses in threads that
+		// a local account has taken part in.
+		Where("? IS NULL", bun.Ident("status.thread_id")).
+		Where("? < ?", bun.Ident("status.created_at"), olderThan).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("status.fetched_at")).
+				WhereOr("? < ?", bun.Ident("status.fetched_at"), olderThan)
+		}).
+		// Not replying to a local account.
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("account.id"), bun.Ident("status.in_reply_to_account_id")).
+			Where("? IS NULL", bun.Ident("account.domain")),
+		).
+		// Not mentioning a local account.
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("mention.status_id"), bun.Ident("status.id")).
+			Where("? IN (?)", bun.Ident("mention.target_account_id"), localAccountIDs),
+		).
+		// Not faved by a local account.
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("status_fave.status_id"), bun.Ident("status.id")).
+			Where("? IN (?)", bun.Ident("status_fave.account_id"), localAccountIDs),
+		).
+		// Not bookmarked (only
+		// local accounts bookmark).
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("status_bookmark.status_id"), bun.Ident("status.id")),
+		).
+		// Not boosted or replied to by a local account.
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("local_status")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("local_status.local"), true).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("? = ?", bun.Ident("local_status.boost_of_id"), bun.Ident("status.id")).
+					WhereOr("? = ?", bun.Ident("local_status.in_reply_to_id"), bun.Ident("status.id"))
+			}),
+		).
+		// Not voted in by a local account.
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("poll_vote.poll_id"), bun.Ident("status.poll_id")).
+			Where("? IN (?)", bun.Ident("poll_vote.account_id"), localAccountIDs),
+		).
+		// Not filtered by a local account
+		// (only local accounts have filters).
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("filter_statuses"), bun.Ident("filter_status")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("filter_status.status_id"), bun.Ident("status.id")),
+		).
+		// Not by a reported account, as the
+		// statuses may be needed as evidence.
+		Where("NOT EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("reports"), bun.Ident("report")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("report.target_account_id"), bun.Ident("status.account_id")),
+		).
+		Order("status.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	var statusIDs []string
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
@@ -0,0 +1,188 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// statusIndex is a full-text index of status text, used
+// to implement status search. The text of each status is
+// stored by status ID in the "status_search" table, which
+// is created by migration with a dialect-specific schema.
+//
+// Storing and removing text works the same way for each
+// dialect; it's only the full-text matching that differs.
+type statusIndex interface {
+	// match wraps q, which must select from "statuses"
+	// aliased as "status", to only return statuses
+	// whose indexed text matches the given query.
+	match(q *bun.SelectQuery, query string) *bun.SelectQuery
+}
+
+// newStatusIndex returns the
+// statusIndex for the given db.
+func newStatusIndex(db *bun.DB) statusIndex {
+	switch d := db.Dialect().Name(); d {
+	case dialect.SQLite:
+		return &sqliteStatusIndex{}
+	case dialect.PG:
+		return &pgStatusIndex{}
+	default:
+		log.Panicf(nil, "db conn %s was neither pg nor sqlite", d)
+		return nil
+	}
+}
+
+// sqliteStatusIndex uses an FTS5 virtual table,
+// "status_search_fts", using "status_search" as
+// external content, kept in sync using triggers.
+type sqliteStatusIndex struct{}
+
+func (*sqliteStatusIndex) match(q *bun.SelectQuery, query string) *bun.SelectQuery {
+	return q.Where("? IN (?)",
+		bun.Ident("status.id"),
+		q.NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_search"), bun.Ident("status_search")).
+			Column("status_search.status_id").
+			Join("JOIN ? ON ? = ?",
+				bun.Ident("status_search_fts"),
+				bun.Ident("status_search_fts.rowid"),
+				bun.Ident("status_search.id"),
+			).
+			Where("? MATCH ?", bun.Ident("status_search_fts"), fts5Query(query)),
+	)
+}
+
+// fts5Query converts the given user-provided query text
+// into an FTS5 query matching all terms in the text, by
+// quoting each term so that FTS5 syntax is not interpreted.
+func fts5Query(query string) string {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	}
+	return strings.Join(terms, " ")
+}
+
+// pgStatusIndex uses a tsvector column "vector" on
+// the "status_search" table, generated from the text
+// column, with a GIN index for fast matching.
+type pgStatusIndex struct{}
+
+func (*pgStatusIndex) match(q *bun.SelectQuery, query string) *bun.SelectQuery {
+	return q.Where("? IN (?)",
+		bun.Ident("status.id"),
+		q.NewSelect().
+			Table("status_search").
+			Column("status_id").
+			Where("? @@ plainto_tsquery('simple', ?)", bun.Ident("vector"), query),
+	)
+}
+
+// putStatusText stores the indexed text of the
+// given status, replacing any existing text. Boosts
+// are not indexed, as they have no text of their own.
+func putStatusText(ctx context.Context, db bun.IDB, status *gtsmodel.Status) error {
+	if status.BoostOfID != "" {
+		return nil
+	}
+
+	// Fetch media descriptions for this status
+	// directly, since status attachments may
+	// not be populated, or may be out of date.
+	var descriptions []string
+	if len(status.AttachmentIDs) > 0 {
+		if err := db.NewSelect().
+			Table("media_attachments").
+			Column("description").
+			Where("? IN (?)", bun.Ident("id"), bun.In(status.AttachmentIDs)).
+			Where("? IS NOT NULL", bun.Ident("description")).
+			Scan(ctx, &descriptions); err != nil {
+			return err
+		}
+	}
+
+	text := statusSearchText(status.Content, status.ContentWarning, descriptions)
+	_, err := db.NewRaw(
+		"INSERT INTO ? (?, ?) VALUES (?, ?) ON CONFLICT (?) DO UPDATE SET ? = ?",
+		bun.Ident("status_search"),
+		bun.Ident("status_id"), bun.Ident("text"),
+		status.ID, text,
+		bun.Ident("status_id"),
+		bun.Ident("text"), text,
+	).Exec(ctx)
+	return err
+}
+
+// reindexStatusText refreshes the indexed text of the
+// status with the given ID from the database, eg., after
+// the description of one of its attachments has changed.
+func reindexStatusText(ctx context.Context, db bun.IDB, statusID string) error {
+	var statuses []*gtsmodel.Status
+	if err := db.NewSelect().
+		Model(&statuses).
+		Column("id", "content", "content_warning", "attachments", "boost_of_id").
+		Where("? = ?", bun.Ident("id"), statusID).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		if err := putStatusText(ctx, db, status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteStatusText removes the indexed
+// text of the status with the given ID.
+func deleteStatusText(ctx context.Context, db bun.IDB, statusID string) error {
+	_, err := db.NewDelete().
+		Table("status_search").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Exec(ctx)
+	return err
+}
+
+// statusSearchText returns the text to index for a
+// status with the given (HTML) content, content warning,
+// and media descriptions, separated by newlines.
+func statusSearchText(content string, contentWarning string, descriptions []string) string {
+	// Space out tags before removing
+	// them, so that words either side
+	// of eg. "</p><p>" aren't joined.
+	content = strings.ReplaceAll(content, "<", " <")
+
+	parts := make([]string, 0, 2+len(descriptions))
+	parts = append(parts, text.SanitizeToPlaintext(content))
+	if contentWarning != "" {
+		parts = append(parts, contentWarning)
+	}
+	parts = append(parts, descriptions...)
+	return strings.Join(parts, "\n")
+}
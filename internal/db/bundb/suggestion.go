@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type suggestionDB struct {
+	db    *bun.DB
+	state *state.State
+}
+
+func (s *suggestionDB) GetFeaturedAccounts(ctx context.Context) ([]*gtsmodel.FeaturedAccount, error) {
+	var featured []*gtsmodel.FeaturedAccount
+	if err := s.db.NewSelect().
+		Model(&featured).
+		Order("id DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, f := range featured {
+		if err := s.PopulateFeaturedAccount(ctx, f); err != nil {
+			return nil, err
+		}
+	}
+
+	return featured, nil
+}
+
+func (s *suggestionDB) GetFeaturedAccount(ctx context.Context, accountID string) (*gtsmodel.FeaturedAccount, error) {
+	featured := new(gtsmodel.FeaturedAccount)
+	if err := s.db.NewSelect().
+		Model(featured).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.PopulateFeaturedAccount(ctx, featured); err != nil {
+		return nil, err
+	}
+
+	return featured, nil
+}
+
+func (s *suggestionDB) PopulateFeaturedAccount(ctx context.Context, featured *gtsmodel.FeaturedAccount) error {
+	if featured.Account != nil {
+		// Already populated.
+		return nil
+	}
+
+	account, err := s.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		featured.AccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating featured account: %w", err)
+	}
+	featured.Account = account
+
+	return nil
+}
+
+func (s *suggestionDB) PutFeaturedAccount(ctx context.Context, featured *gtsmodel.FeaturedAccount) error {
+	_, err := s.db.NewInsert().
+		Model(featured).
+		Exec(ctx)
+	return err
+}
+
+func (s *suggestionDB) DeleteFeaturedAccount(ctx context.Context, accountID string) error {
+	_, err := s.db.NewDelete().
+		Table("featured_accounts").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
+func (s *suggestionDB) GetSuggestionDismissedIDs(ctx context.Context, accountID string) ([]string, error) {
+	var targetIDs []string
+	if err := s.db.NewSelect().
+		Table("suggestion_dismissals").
+		Column("target_account_id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &targetIDs); err != nil {
+		return nil, err
+	}
+	return targetIDs, nil
+}
+
+func (s *suggestionDB) PutSuggestionDismissal(ctx context.Context, dismissal *gtsmodel.SuggestionDismissal) error {
+	_, err := s.db.NewInsert().
+		Model(dismissal).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("account_id"), bun.Ident("target_account_id")).
+		Exec(ctx)
+	return err
+}
+
+func (s *suggestionDB) DeleteAccountSuggestions(ctx context.Context, accountID string) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Table("featured_accounts").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Table("suggestion_dismissals").
+			WhereOr("? = ?", bun.Ident("account_id"), accountID).
+			WhereOr("? = ?", bun.Ident("target_account_id"), accountID).
+			Exec(ctx)
+		return err
+	})
+}
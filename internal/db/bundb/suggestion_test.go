@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type SuggestionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *SuggestionTestSuite) TestGetFriendsOfFriendsIDs() {
+	ctx := context.Background()
+
+	// Admin follows local_account_1, who
+	// follows local_account_2 (and admin).
+	accountIDs, err := suite.db.GetFriendsOfFriendsIDs(ctx, suite.testAccounts["admin_account"].ID, 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{suite.testAccounts["local_account_2"].ID}, accountIDs)
+
+	// local_account_1 already follows
+	// everyone their follows follow.
+	accountIDs, err = suite.db.GetFriendsOfFriendsIDs(ctx, suite.testAccounts["local_account_1"].ID, 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(accountIDs)
+}
+
+func (suite *SuggestionTestSuite) TestGetRecentlyActiveLocalAccountIDs() {
+	ctx := context.Background()
+
+	// local_account_2 isn't discoverable, so
+	// only admin and local_account_1 remain.
+	accountIDs, err := suite.db.GetRecentlyActiveLocalAccountIDs(ctx, time.Time{}, 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.ElementsMatch([]string{
+		suite.testAccounts["admin_account"].ID,
+		suite.testAccounts["local_account_1"].ID,
+	}, accountIDs)
+
+	// No test statuses are recent.
+	accountIDs, err = suite.db.GetRecentlyActiveLocalAccountIDs(ctx, time.Now().Add(-time.Hour), 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(accountIDs)
+}
+
+func (suite *SuggestionTestSuite) TestFeaturedAccounts() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_1"]
+
+	if err := suite.db.PutFeaturedAccount(ctx, &gtsmodel.FeaturedAccount{
+		ID:                 id.NewULID(),
+		AccountID:          account.ID,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	featured, err := suite.db.GetFeaturedAccounts(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(featured, 1)
+	suite.Equal(account.ID, featured[0].Account.ID)
+
+	if err := suite.db.DeleteFeaturedAccount(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetFeaturedAccount(ctx, account.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *SuggestionTestSuite) TestSuggestionDismissals() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+	targetID := suite.testAccounts["remote_account_1"].ID
+
+	// Repeated dismissals are ignored.
+	for i := 0; i < 2; i++ {
+		if err := suite.db.PutSuggestionDismissal(ctx, &gtsmodel.SuggestionDismissal{
+			ID:              id.NewULID(),
+			AccountID:       accountID,
+			TargetAccountID: targetID,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	dismissedIDs, err := suite.db.GetSuggestionDismissedIDs(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{targetID}, dismissedIDs)
+
+	// Deleting the dismissed account's
+	// suggestions removes the dismissal.
+	if err := suite.db.DeleteAccountSuggestions(ctx, targetID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dismissedIDs, err = suite.db.GetSuggestionDismissedIDs(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dismissedIDs)
+}
+
+func TestSuggestionTestSuite(t *testing.T) {
+	suite.Run(t, new(SuggestionTestSuite))
+}
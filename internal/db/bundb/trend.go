@@ -0,0 +1,192 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type trendDB struct{ db *bun.DB }
+
+func (t *trendDB) GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error) {
+	trend := new(gtsmodel.Trend)
+	if err := t.db.NewSelect().
+		Model(trend).
+		Where("? = ?", bun.Ident("id"), id).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return trend, nil
+}
+
+func (t *trendDB) GetTrend(ctx context.Context, trendType gtsmodel.TrendType, targetID string) (*gtsmodel.Trend, error) {
+	trend := new(gtsmodel.Trend)
+	if err := t.db.NewSelect().
+		Model(trend).
+		Where("? = ?", bun.Ident("type"), trendType).
+		Where("? = ?", bun.Ident("target_id"), targetID).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return trend, nil
+}
+
+func (t *trendDB) GetTrends(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	state gtsmodel.TrendState,
+	since time.Time,
+	limit int,
+	offset int,
+) ([]*gtsmodel.Trend, error) {
+	var trendIDs []string
+
+	// Select IDs of trends with uses since
+	// the given time, ranked by how many
+	// accounts used them in that window.
+	q := t.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("trend_uses"), bun.Ident("trend_use")).
+		ColumnExpr("? AS ?", bun.Ident("trend_use.trend_id"), bun.Ident("trend_id")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("trends"), bun.Ident("trend"),
+			bun.Ident("trend.id"), bun.Ident("trend_use.trend_id"),
+		).
+		Where("? = ?", bun.Ident("trend.type"), trendType).
+		Where("? >= ?", bun.Ident("trend_use.created_at"), since).
+		GroupExpr("?", bun.Ident("trend_use.trend_id")).
+		OrderExpr("COUNT(DISTINCT ?) DESC", bun.Ident("trend_use.account_id")).
+		OrderExpr("MAX(?) DESC", bun.Ident("trend_use.created_at")).
+		Limit(limit).
+		Offset(offset)
+
+	if state != gtsmodel.TrendStateUnknown {
+		q = q.Where("? = ?", bun.Ident("trend.state"), state)
+	}
+
+	if err := q.Scan(ctx, &trendIDs); err != nil {
+		return nil, err
+	}
+
+	trends := make([]*gtsmodel.Trend, 0, len(trendIDs))
+	for _, id := range trendIDs {
+		trend, err := t.GetTrendByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		trends = append(trends, trend)
+	}
+
+	return trends, nil
+}
+
+func (t *trendDB) PutTrend(ctx context.Context, trend *gtsmodel.Trend) error {
+	_, err := t.db.NewInsert().
+		Model(trend).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error {
+	trend.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := t.db.NewUpdate().
+		Model(trend).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), trend.ID).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) DeleteTrendByID(ctx context.Context, id string) error {
+	return t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Table("trend_uses").
+			Where("? = ?", bun.Ident("trend_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewDelete().
+			Table("trends").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (t *trendDB) DeleteUnusedTrends(ctx context.Context, state gtsmodel.TrendState) (int, error) {
+	res, err := t.db.NewDelete().
+		Table("trends").
+		Where("? = ?", bun.Ident("state"), state).
+		Where("NOT EXISTS (?)", t.db.NewSelect().
+			Table("trend_uses").
+			Column("id").
+			Where("? = ?", bun.Ident("trend_uses.trend_id"), bun.Ident("trends.id"))).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (t *trendDB) GetTrendUses(ctx context.Context, trendID string, since time.Time) ([]*gtsmodel.TrendUse, error) {
+	var uses []*gtsmodel.TrendUse
+	if err := t.db.NewSelect().
+		Model(&uses).
+		Where("? = ?", bun.Ident("trend_id"), trendID).
+		Where("? >= ?", bun.Ident("created_at"), since).
+		OrderExpr("? ASC", bun.Ident("created_at")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return uses, nil
+}
+
+func (t *trendDB) PutTrendUse(ctx context.Context, use *gtsmodel.TrendUse) error {
+	_, err := t.db.NewInsert().
+		Model(use).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("trend_id"), bun.Ident("source_id")).
+		Exec(ctx)
+	return err
+}
+
+func (t *trendDB) DeleteTrendUsesOlderThan(ctx context.Context, olderThan time.Time) (int, error) {
+	res, err := t.db.NewDelete().
+		Table("trend_uses").
+		Where("? < ?", bun.Ident("created_at"), olderThan).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type TrendTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TrendTestSuite) putTrend(trendType gtsmodel.TrendType, targetID string, state gtsmodel.TrendState) *gtsmodel.Trend {
+	trend := &gtsmodel.Trend{
+		ID:       id.NewULID(),
+		Type:     trendType,
+		TargetID: targetID,
+		State:    state,
+	}
+
+	if err := suite.db.PutTrend(context.Background(), trend); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return trend
+}
+
+func (suite *TrendTestSuite) putUse(trend *gtsmodel.Trend, accountID string, sourceID string, createdAt time.Time) {
+	use := &gtsmodel.TrendUse{
+		ID:        id.NewULID(),
+		CreatedAt: createdAt,
+		TrendID:   trend.ID,
+		AccountID: accountID,
+		SourceID:  sourceID,
+	}
+
+	if err := suite.db.PutTrendUse(context.Background(), use); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *TrendTestSuite) TestGetTrends() {
+	var (
+		ctx      = context.Background()
+		now      = time.Now()
+		admin    = suite.testAccounts["admin_account"]
+		account1 = suite.testAccounts["local_account_1"]
+		account2 = suite.testAccounts["local_account_2"]
+	)
+
+	welcome := suite.putTrend(gtsmodel.TrendTypeTag, suite.testTags["welcome"].ID, gtsmodel.TrendStateApproved)
+	hashtag := suite.putTrend(gtsmodel.TrendTypeTag, suite.testTags["Hashtag"].ID, gtsmodel.TrendStatePending)
+
+	// A trend can only exist once per target.
+	err := suite.db.PutTrend(ctx, &gtsmodel.Trend{
+		ID:       id.NewULID(),
+		Type:     gtsmodel.TrendTypeTag,
+		TargetID: welcome.TargetID,
+		State:    gtsmodel.TrendStatePending,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	// Welcome is used by one account, twice.
+	suite.putUse(welcome, admin.ID, id.NewULID(), now.Add(-2*time.Hour))
+	suite.putUse(welcome, admin.ID, id.NewULID(), now.Add(-time.Hour))
+
+	// Hashtag is used by two accounts, once
+	// recently and once a long time ago.
+	suite.putUse(hashtag, account1.ID, id.NewULID(), now.Add(-time.Hour))
+	suite.putUse(hashtag, account2.ID, id.NewULID(), now.Add(-10*24*time.Hour))
+
+	// Repeated uses from the same source are ignored.
+	source := id.NewULID()
+	suite.putUse(hashtag, account2.ID, source, now.Add(-30*time.Minute))
+	suite.putUse(hashtag, account2.ID, source, now.Add(-20*time.Minute))
+
+	since := now.Add(-gtsmodel.TrendWindow)
+
+	// Hashtag ranks first in any state, as it
+	// was used by the most distinct accounts.
+	trends, err := suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, gtsmodel.TrendStateUnknown, since, 10, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(trends, 2) {
+		suite.Equal(hashtag.ID, trends[0].ID)
+		suite.Equal(welcome.ID, trends[1].ID)
+	}
+
+	// Offset skips the first trend.
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, gtsmodel.TrendStateUnknown, since, 10, 1)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(trends, 1) {
+		suite.Equal(welcome.ID, trends[0].ID)
+	}
+
+	// Only welcome is approved.
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeTag, gtsmodel.TrendStateApproved, since, 10, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(trends, 1) {
+		suite.Equal(welcome.ID, trends[0].ID)
+	}
+
+	// No other types of trend.
+	trends, err = suite.db.GetTrends(ctx, gtsmodel.TrendTypeStatus, gtsmodel.TrendStateUnknown, since, 10, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(trends)
+
+	uses, err := suite.db.GetTrendUses(ctx, hashtag.ID, now.Add(-24*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(uses, 2)
+}
+
+func (suite *TrendTestSuite) TestPruneTrends() {
+	var (
+		ctx     = context.Background()
+		now     = time.Now()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	welcome := suite.putTrend(gtsmodel.TrendTypeTag, suite.testTags["welcome"].ID, gtsmodel.TrendStatePending)
+	hashtag := suite.putTrend(gtsmodel.TrendTypeTag, suite.testTags["Hashtag"].ID, gtsmodel.TrendStatePending)
+	link := suite.putTrend(gtsmodel.TrendTypeLink, id.NewULID(), gtsmodel.TrendStateRejected)
+
+	suite.putUse(welcome, account.ID, id.NewULID(), now.Add(-time.Hour))
+	suite.putUse(hashtag, account.ID, id.NewULID(), now.Add(-10*24*time.Hour))
+	suite.putUse(link, account.ID, id.NewULID(), now.Add(-10*24*time.Hour))
+
+	n, err := suite.db.DeleteTrendUsesOlderThan(ctx, now.Add(-7*24*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, n)
+
+	// Only the unused, pending hashtag
+	// trend should be deleted.
+	n, err = suite.db.DeleteUnusedTrends(ctx, gtsmodel.TrendStatePending)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, n)
+
+	_, err = suite.db.GetTrendByID(ctx, hashtag.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	for _, trend := range []*gtsmodel.Trend{welcome, link} {
+		if _, err := suite.db.GetTrendByID(ctx, trend.ID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Deleting a trend deletes its uses.
+	if err := suite.db.DeleteTrendByID(ctx, welcome.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	uses, err := suite.db.GetTrendUses(ctx, welcome.ID, time.Time{})
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(uses)
+}
+
+func TestTrendTestSuite(t *testing.T) {
+	suite.Run(t, new(TrendTestSuite))
+}
@@ -19,13 +19,17 @@
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
 type userDB struct {
@@ -206,6 +210,91 @@ func (u *userDB) UpdateUser(ctx context.Context, user *gtsmodel.User, columns ..
 	})
 }
 
+func (u *userDB) UseUserTwoFactorStep(ctx context.Context, userID string, step int64) error {
+	// Do the check + update in one query,
+	// so that concurrent sign-ins can't
+	// both succeed with the same code.
+	res, err := u.db.NewUpdate().
+		Table("users").
+		Set("? = ?", bun.Ident("two_factor_last_used_step"), step).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("id"), userID).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				WhereOr("? IS NULL", bun.Ident("two_factor_last_used_step")).
+				WhereOr("? < ?", bun.Ident("two_factor_last_used_step"), step)
+		}).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// User not found or step already used.
+		return db.ErrNoEntries
+	}
+
+	// Invalidate cached user so
+	// the new step is picked up.
+	u.state.Caches.DB.User.Invalidate("ID", userID)
+	return nil
+}
+
+func (u *userDB) UseUserTwoFactorRecoveryCode(ctx context.Context, userID string, codes []string, used string) error {
+	remaining := slices.DeleteFunc(
+		slices.Clone(codes),
+		func(code string) bool { return code == used },
+	)
+	if len(remaining) == len(codes) {
+		// Nothing to use.
+		return db.ErrNoEntries
+	}
+
+	// Array columns are stored as
+	// JSON on SQLite, but as native
+	// arrays on Postgres.
+	var current, updated any = codes, remaining
+	if u.db.Dialect().Name() == dialect.PG {
+		current = pgdialect.Array(codes)
+		updated = pgdialect.Array(remaining)
+	}
+
+	// Do the check + update in one query,
+	// comparing against the codes we read,
+	// so that concurrent sign-ins can't
+	// both succeed with the same code.
+	res, err := u.db.NewUpdate().
+		Table("users").
+		Set("? = ?", bun.Ident("two_factor_recovery_codes"), updated).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("id"), userID).
+		Where("? = ?", bun.Ident("two_factor_recovery_codes"), current).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// User not found or codes changed.
+		return db.ErrNoEntries
+	}
+
+	// Invalidate cached user so
+	// the new codes are picked up.
+	u.state.Caches.DB.User.Invalidate("ID", userID)
+	return nil
+}
+
 func (u *userDB) DeleteUserByID(ctx context.Context, userID string) error {
 	// Gather necessary fields from
 	// deleted for cache invaliation.
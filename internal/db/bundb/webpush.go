@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/uptrace/bun"
+)
+
+type webPushDB struct{ db *bun.DB }
+
+func (w *webPushDB) GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, error) {
+	kps := make([]*gtsmodel.VAPIDKeyPair, 0, 1)
+
+	// get the first key pair in the db or...
+	if err := w.db.
+		NewSelect().
+		Model(&kps).
+		Limit(1).
+		Order("vapid_key_pair.id DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	// ... create a new one
+	if len(kps) == 0 {
+		return w.createVAPIDKeyPair(ctx)
+	}
+
+	return kps[0], nil
+}
+
+func (w *webPushDB) createVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	kp := &gtsmodel.VAPIDKeyPair{
+		ID:         id.NewULID(),
+		PublicKey:  base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		PrivateKey: base64.RawURLEncoding.EncodeToString(key.Bytes()),
+	}
+
+	if _, err := w.db.
+		NewInsert().
+		Model(kp).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return kp, nil
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, error) {
+	subscription := new(gtsmodel.WebPushSubscription)
+	if err := w.db.NewSelect().
+		Model(subscription).
+		Where("? = ?", bun.Ident("token_id"), tokenID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (w *webPushDB) GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, error) {
+	var subscriptions []*gtsmodel.WebPushSubscription
+	if err := w.db.NewSelect().
+		Model(&subscriptions).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return subscriptions, nil
+}
+
+func (w *webPushDB) PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) error {
+	return w.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// A token may only have one
+		// subscription, so drop any
+		// existing one before insert.
+		if _, err := tx.NewDelete().
+			Table("web_push_subscriptions").
+			Where("? = ?", bun.Ident("token_id"), subscription.TokenID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewInsert().
+			Model(subscription).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (w *webPushDB) UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := w.db.NewUpdate().
+		Model(subscription).
+		Column(columns...).
+		Where("? = ?", bun.Ident("id"), subscription.ID).
+		Exec(ctx)
+	return err
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) error {
+	_, err := w.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? = ?", bun.Ident("token_id"), tokenID).
+		Exec(ctx)
+	return err
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionsByEndpoint(ctx context.Context, endpoint string) error {
+	_, err := w.db.NewDelete().
+		Table("web_push_subscriptions").
+		Where("? = ?", bun.Ident("endpoint"), endpoint).
+		Exec(ctx)
+	return err
+}
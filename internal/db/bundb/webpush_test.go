@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type WebPushTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *WebPushTestSuite) putSubscription(token *gtsmodel.Token, endpoint string) *gtsmodel.WebPushSubscription {
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:        id.NewULID(),
+		AccountID: token.UserID,
+		TokenID:   token.ID,
+		Endpoint:  endpoint,
+		Auth:      "BTBZMqHH6r4Tts7J_aSIgg",
+		P256dh:    "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		Policy:    gtsmodel.WebPushSubscriptionPolicyAll,
+	}
+	subscription.NotificationFlags.Set(gtsmodel.NotificationMention, true)
+
+	if err := suite.db.PutWebPushSubscription(context.Background(), subscription); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return subscription
+}
+
+func (suite *WebPushTestSuite) TestGetVAPIDKeyPair() {
+	ctx := context.Background()
+
+	kp1, err := suite.db.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(kp1.PublicKey)
+	suite.NotEmpty(kp1.PrivateKey)
+
+	// Key pair should only be created once.
+	kp2, err := suite.db.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(kp1.ID, kp2.ID)
+	suite.Equal(kp1.PublicKey, kp2.PublicKey)
+}
+
+func (suite *WebPushTestSuite) TestPutReplaceWebPushSubscription() {
+	ctx := context.Background()
+	token := suite.testTokens["local_account_1"]
+
+	suite.putSubscription(token, "https://push.example.org/1")
+	sub2 := suite.putSubscription(token, "https://push.example.org/2")
+
+	// Only the most recent subscription should remain.
+	dbSub, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(sub2.ID, dbSub.ID)
+	suite.Equal("https://push.example.org/2", dbSub.Endpoint)
+	suite.True(dbSub.NotificationFlags.Get(gtsmodel.NotificationMention))
+	suite.False(dbSub.NotificationFlags.Get(gtsmodel.NotificationFollow))
+
+	subs, err := suite.db.GetWebPushSubscriptionsByAccountID(ctx, token.UserID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(subs, 1)
+
+	dbSub.Policy = gtsmodel.WebPushSubscriptionPolicyNone
+	if err := suite.db.UpdateWebPushSubscription(ctx, dbSub, "policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbSub, err = suite.db.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.WebPushSubscriptionPolicyNone, dbSub.Policy)
+}
+
+func (suite *WebPushTestSuite) TestDeleteWebPushSubscriptions() {
+	ctx := context.Background()
+	token1 := suite.testTokens["local_account_1"]
+	token2 := suite.testTokens["local_account_2"]
+
+	suite.putSubscription(token1, "https://push.example.org/1")
+	suite.putSubscription(token2, "https://push.example.org/2")
+
+	// Expire the first subscription by endpoint.
+	if err := suite.db.DeleteWebPushSubscriptionsByEndpoint(ctx, "https://push.example.org/1"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, token1.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Deleting the token should delete its subscription.
+	if err := suite.db.DeleteTokenByID(ctx, token2.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	_, err = suite.db.GetWebPushSubscriptionByTokenID(ctx, token2.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestWebPushTestSuite(t *testing.T) {
+	suite.Run(t, new(WebPushTestSuite))
+}
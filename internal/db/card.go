@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Card interface {
+	// GetCardByID fetches the Card with given ID from the database.
+	GetCardByID(ctx context.Context, id string) (*gtsmodel.Card, error)
+
+	// GetCardByURL fetches the Card for given page URL from the database.
+	GetCardByURL(ctx context.Context, url string) (*gtsmodel.Card, error)
+
+	// GetCardByImageID fetches the Card using the given media attachment ID as its image.
+	GetCardByImageID(ctx context.Context, imageID string) (*gtsmodel.Card, error)
+
+	// GetStaleCards fetches up to limit Cards that were last fetched before
+	// fetchedBefore, and created after createdAfter, least recently fetched first.
+	GetStaleCards(ctx context.Context, fetchedBefore time.Time, createdAfter time.Time, limit int) ([]*gtsmodel.Card, error)
+
+	// PopulateCard ensures the given Card's sub-models are populated.
+	PopulateCard(ctx context.Context, card *gtsmodel.Card) error
+
+	// PutCard inserts the given new Card into the database.
+	PutCard(ctx context.Context, card *gtsmodel.Card) error
+
+	// UpdateCard updates the given Card in the database, only updating given columns if provided.
+	UpdateCard(ctx context.Context, card *gtsmodel.Card, columns ...string) error
+}
@@ -27,14 +27,21 @@ type DB interface {
 	Account
 	Admin
 	AdvancedMigration
+	Announcement
 	Application
 	Basic
+	Card
 	Conversation
+	DeliveryFailure
 	Domain
 	Emoji
+	FeaturedTag
+	GroupModerator
 	HeaderFilter
+	ImportJob
 	Instance
 	Interaction
+	Invite
 	Filter
 	List
 	Marker
@@ -44,18 +51,24 @@ type DB interface {
 	Notification
 	Poll
 	Relationship
+	Relay
 	Report
 	Rule
+	ScheduledStatus
 	Search
 	Session
 	SinBinStatus
 	Status
 	StatusBookmark
+	StatusEdit
 	StatusFave
+	Suggestion
 	Tag
 	Thread
 	Timeline
 	User
 	Tombstone
+	Trend
+	WebPush
 	WorkerTask
 }
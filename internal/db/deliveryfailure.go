@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DeliveryFailure interface {
+	// GetDeliveryFailureByID fetches the delivery failure with given ID from the database.
+	GetDeliveryFailureByID(ctx context.Context, id string) (*gtsmodel.DeliveryFailure, error)
+
+	// GetDeliveryFailures fetches a page of delivery failures from the
+	// database, newest first, optionally filtered by target host.
+	GetDeliveryFailures(ctx context.Context, targetHost string, page *paging.Page) ([]*gtsmodel.DeliveryFailure, error)
+
+	// CountDeliveryFailuresByHost returns the number of stored delivery failures for each target host.
+	CountDeliveryFailuresByHost(ctx context.Context) (map[string]int, error)
+
+	// PutDeliveryFailure stores the given delivery failure in the database.
+	PutDeliveryFailure(ctx context.Context, failure *gtsmodel.DeliveryFailure) error
+
+	// DeleteDeliveryFailureByID deletes the delivery failure with given ID from the database.
+	DeleteDeliveryFailureByID(ctx context.Context, id string) error
+
+	// DeleteDeliveryFailures deletes all delivery failures from the database,
+	// optionally only those for the given target host, returning the number deleted.
+	DeleteDeliveryFailures(ctx context.Context, targetHost string) (int, error)
+
+	// DeleteDeliveryFailuresOlderThan deletes all delivery
+	// failures created before the given time from the database.
+	DeleteDeliveryFailuresOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+}
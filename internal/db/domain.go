@@ -22,6 +22,7 @@
 	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Domain contains DB functions related to domains and domain blocks.
@@ -42,6 +43,12 @@ type Domain interface {
 	// GetDomainAllows returns all instance-level domain allows currently enforced by this instance.
 	GetDomainAllows(ctx context.Context) ([]*gtsmodel.DomainAllow, error)
 
+	// GetDomainAllowsBySubscriptionID returns all domain allows created by the given subscription.
+	GetDomainAllowsBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainAllow, error)
+
+	// UpdateDomainAllow updates the given domain allow, setting the provided columns (empty for all).
+	UpdateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow, columns ...string) error
+
 	// DeleteDomainAllow deletes an instance-level domain allow with the given domain, if it exists.
 	DeleteDomainAllow(ctx context.Context, domain string) error
 
@@ -57,9 +64,110 @@ type Domain interface {
 	// GetDomainBlocks returns all instance-level domain blocks currently enforced by this instance.
 	GetDomainBlocks(ctx context.Context) ([]*gtsmodel.DomainBlock, error)
 
+	// GetDomainBlocksBySubscriptionID returns all domain blocks created by the given subscription.
+	GetDomainBlocksBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainBlock, error)
+
+	// UpdateDomainBlock updates the given domain block, setting the provided columns (empty for all).
+	UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) error
+
 	// DeleteDomainBlock deletes an instance-level domain block with the given domain, if it exists.
 	DeleteDomainBlock(ctx context.Context, domain string) error
 
+	/*
+		Domain limit stuff.
+	*/
+
+	// CreateDomainLimit puts the given instance-level domain limit into the database.
+	CreateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit) error
+
+	// GetDomainLimit returns one instance-level domain limit with the given domain, if it exists.
+	GetDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error)
+
+	// GetDomainLimitByID returns one instance-level domain limit with the given id, if it exists.
+	GetDomainLimitByID(ctx context.Context, id string) (*gtsmodel.DomainLimit, error)
+
+	// GetDomainLimits returns all instance-level domain limits currently enforced by this instance.
+	GetDomainLimits(ctx context.Context) ([]*gtsmodel.DomainLimit, error)
+
+	// UpdateDomainLimit updates the given domain limit, setting the provided columns (empty for all).
+	UpdateDomainLimit(ctx context.Context, limit *gtsmodel.DomainLimit, columns ...string) error
+
+	// DeleteDomainLimit deletes an instance-level domain limit with the given domain, if it exists.
+	DeleteDomainLimit(ctx context.Context, domain string) error
+
+	// MatchDomainLimit returns the most specific domain limit applying
+	// to the given domain or one of its parent domains, or nil if the
+	// domain is not limited.
+	MatchDomainLimit(ctx context.Context, domain string) (*gtsmodel.DomainLimit, error)
+
+	/*
+		Domain permission draft stuff.
+	*/
+
+	// GetDomainPermissionDraftByID gets one DomainPermissionDraft with the given ID.
+	GetDomainPermissionDraftByID(ctx context.Context, id string) (*gtsmodel.DomainPermissionDraft, error)
+
+	// GetDomainPermissionDrafts returns a page of DomainPermissionDrafts, optionally
+	// filtered by permission type (if not unknown), subscription ID, and domain.
+	GetDomainPermissionDrafts(
+		ctx context.Context,
+		permType gtsmodel.DomainPermissionType,
+		subscriptionID string,
+		domain string,
+		page *paging.Page,
+	) ([]*gtsmodel.DomainPermissionDraft, error)
+
+	// GetDomainPermissionDraft gets the DomainPermissionDraft of the given type targeting the given domain, if it exists.
+	GetDomainPermissionDraft(ctx context.Context, permType gtsmodel.DomainPermissionType, domain string) (*gtsmodel.DomainPermissionDraft, error)
+
+	// PutDomainPermissionDraft stores one DomainPermissionDraft.
+	PutDomainPermissionDraft(ctx context.Context, draft *gtsmodel.DomainPermissionDraft) error
+
+	// DeleteDomainPermissionDraft deletes one DomainPermissionDraft with the given id.
+	DeleteDomainPermissionDraft(ctx context.Context, id string) error
+
+	/*
+		Domain permission exclude stuff.
+	*/
+
+	// GetDomainPermissionExcludeByID gets one DomainPermissionExclude with the given ID.
+	GetDomainPermissionExcludeByID(ctx context.Context, id string) (*gtsmodel.DomainPermissionExclude, error)
+
+	// GetDomainPermissionExcludes returns a page of
+	// DomainPermissionExcludes, optionally filtered by domain.
+	GetDomainPermissionExcludes(ctx context.Context, domain string, page *paging.Page) ([]*gtsmodel.DomainPermissionExclude, error)
+
+	// PutDomainPermissionExclude stores one DomainPermissionExclude.
+	PutDomainPermissionExclude(ctx context.Context, exclude *gtsmodel.DomainPermissionExclude) error
+
+	// DeleteDomainPermissionExclude deletes one DomainPermissionExclude with the given id.
+	DeleteDomainPermissionExclude(ctx context.Context, id string) error
+
+	// IsDomainPermissionExcluded checks if the given domain (or one
+	// of its parent domains) matches a DomainPermissionExclude.
+	IsDomainPermissionExcluded(ctx context.Context, domain string) (bool, error)
+
+	/*
+		Domain permission subscription stuff.
+	*/
+
+	// GetDomainPermissionSubscriptionByID gets one DomainPermissionSubscription with the given ID.
+	GetDomainPermissionSubscriptionByID(ctx context.Context, id string) (*gtsmodel.DomainPermissionSubscription, error)
+
+	// GetDomainPermissionSubscriptions returns all DomainPermissionSubscriptions of the given
+	// permission type (or of any type, if type is unknown), sorted by priority, highest first.
+	GetDomainPermissionSubscriptions(ctx context.Context, permType gtsmodel.DomainPermissionType) ([]*gtsmodel.DomainPermissionSubscription, error)
+
+	// PutDomainPermissionSubscription stores one DomainPermissionSubscription.
+	PutDomainPermissionSubscription(ctx context.Context, sub *gtsmodel.DomainPermissionSubscription) error
+
+	// UpdateDomainPermissionSubscription updates the provided
+	// columns of one DomainPermissionSubscription (empty for all).
+	UpdateDomainPermissionSubscription(ctx context.Context, sub *gtsmodel.DomainPermissionSubscription, columns ...string) error
+
+	// DeleteDomainPermissionSubscription deletes one DomainPermissionSubscription with the given id.
+	DeleteDomainPermissionSubscription(ctx context.Context, id string) error
+
 	/*
 		Block/allow checking functions.
 	*/
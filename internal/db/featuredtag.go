@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FeaturedTag interface {
+	// GetFeaturedTagByID fetches the FeaturedTag with given ID from the database.
+	GetFeaturedTagByID(ctx context.Context, id string) (*gtsmodel.FeaturedTag, error)
+
+	// GetFeaturedTag fetches the FeaturedTag of given tag ID by given account ID from the database.
+	GetFeaturedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FeaturedTag, error)
+
+	// GetFeaturedTagsByAccountID fetches all FeaturedTags of the given account ID, in order of creation.
+	GetFeaturedTagsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, error)
+
+	// GetFeaturedTagStats calculates usage statistics for the given featured tag,
+	// counting only the public and unlisted statuses of the featuring account.
+	GetFeaturedTagStats(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (*gtsmodel.FeaturedTagStats, error)
+
+	// GetFeaturedTagSuggestions fetches up to limit of the given account's
+	// most-used tags, ordered by usage, excluding those already featured.
+	GetFeaturedTagSuggestions(ctx context.Context, accountID string, limit int) ([]*gtsmodel.Tag, error)
+
+	// PopulateFeaturedTag ensures the given FeaturedTag's sub-models are populated.
+	PopulateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error
+
+	// PutFeaturedTag inserts the given new FeaturedTag into the database.
+	PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error
+
+	// DeleteFeaturedTagByID deletes the FeaturedTag with given ID from the database.
+	DeleteFeaturedTagByID(ctx context.Context, id string) error
+
+	// DeleteFeaturedTagsByAccountID deletes all FeaturedTags of the given account ID.
+	DeleteFeaturedTagsByAccountID(ctx context.Context, accountID string) error
+}
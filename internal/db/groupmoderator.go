@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type GroupModerator interface {
+	// GetGroupModerators fetches all moderators of the group
+	// with the given ID, oldest first, with accounts populated.
+	GetGroupModerators(ctx context.Context, groupID string) ([]*gtsmodel.GroupModerator, error)
+
+	// GetModeratedGroupIDs fetches the IDs of all
+	// groups moderated by the given account ID.
+	GetModeratedGroupIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// IsGroupModerator returns whether the given
+	// account ID moderates the given group ID.
+	IsGroupModerator(ctx context.Context, groupID string, accountID string) (bool, error)
+
+	// PutGroupModerator inserts the given new GroupModerator into the database.
+	PutGroupModerator(ctx context.Context, moderator *gtsmodel.GroupModerator) error
+
+	// DeleteGroupModerator deletes the GroupModerator
+	// with the given group ID and account ID.
+	DeleteGroupModerator(ctx context.Context, groupID string, accountID string) error
+
+	// DeleteGroupModeratorsByAccountID deletes all GroupModerators
+	// with the given account ID as either group or moderator.
+	DeleteGroupModeratorsByAccountID(ctx context.Context, accountID string) error
+}
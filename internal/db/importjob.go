@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ImportJob interface {
+	// GetImportJobByID fetches the import job with given ID from the database.
+	GetImportJobByID(ctx context.Context, id string) (*gtsmodel.ImportJob, error)
+
+	// GetUnfinishedImportJobs fetches all import jobs
+	// that are queued or still processing, oldest first.
+	GetUnfinishedImportJobs(ctx context.Context) ([]*gtsmodel.ImportJob, error)
+
+	// PutImportJob stores the given import job in the database.
+	PutImportJob(ctx context.Context, job *gtsmodel.ImportJob) error
+
+	// UpdateImportJob updates the given import job in the database,
+	// only updating the given columns if provided.
+	UpdateImportJob(ctx context.Context, job *gtsmodel.ImportJob, columns ...string) error
+
+	// DeleteImportJobsByAccountID deletes all import jobs
+	// owned by the given account, and their failures.
+	DeleteImportJobsByAccountID(ctx context.Context, accountID string) error
+
+	// GetImportJobFailures fetches all failures of
+	// the given import job, ordered by row number.
+	GetImportJobFailures(ctx context.Context, jobID string) ([]*gtsmodel.ImportJobFailure, error)
+
+	// PutImportJobFailure stores the given import job failure in the database.
+	PutImportJobFailure(ctx context.Context, failure *gtsmodel.ImportJobFailure) error
+
+	// DeleteImportJobFailures deletes all failures of the given import job.
+	DeleteImportJobFailures(ctx context.Context, jobID string) error
+}
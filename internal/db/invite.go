@@ -0,0 +1,56 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Invite interface {
+	// GetInviteByID fetches the invite with given ID from the database.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, error)
+
+	// GetInviteByCode fetches the invite with given code from the database.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, error)
+
+	// GetInvitesByAccountID fetches all invites created
+	// by the given account from the database, newest first.
+	GetInvitesByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Invite, error)
+
+	// PutInvite stores the given invite in the database.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) error
+
+	// UpdateInvite updates the given invite in the database,
+	// only updating the given columns if provided.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) error
+
+	// IncrementInviteUses atomically increments the uses count of the
+	// given invite by one, as long as the invite is still usable. If
+	// the invite is not usable, db.ErrNoEntries will be returned.
+	IncrementInviteUses(ctx context.Context, id string) error
+
+	// DecrementInviteUses decrements the uses count of the given
+	// invite by one, giving back a use taken by IncrementInviteUses.
+	DecrementInviteUses(ctx context.Context, id string) error
+
+	// RevokeInvitesByAccountID marks all not-yet-revoked
+	// invites created by the given account as revoked.
+	RevokeInvitesByAccountID(ctx context.Context, accountID string) error
+}
@@ -164,6 +164,11 @@ type Relationship interface {
 	// GetAccountLocalFollowerIDs is like GetAccountLocalFollowers, but returns just IDs.
 	GetAccountLocalFollowerIDs(ctx context.Context, accountID string) ([]string, error)
 
+	// GetFriendsOfFriendsIDs returns the IDs of up to limit accounts followed by accounts that the given
+	// accountID follows, ordered by how many of those follow them. Accounts already followed by accountID
+	// (and accountID itself) are excluded.
+	GetFriendsOfFriendsIDs(ctx context.Context, accountID string, limit int) ([]string, error)
+
 	// GetAccountFollowRequests returns all follow requests targeting the given account.
 	GetAccountFollowRequests(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.FollowRequest, error)
 
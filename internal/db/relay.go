@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Relay interface {
+	// GetRelayByID fetches the relay with given ID from the database.
+	GetRelayByID(ctx context.Context, id string) (*gtsmodel.Relay, error)
+
+	// GetRelayByInboxURI fetches the relay with given inbox URI from the database.
+	GetRelayByInboxURI(ctx context.Context, inboxURI string) (*gtsmodel.Relay, error)
+
+	// GetRelayByFollowURI fetches the relay with given
+	// instance actor Follow URI from the database.
+	GetRelayByFollowURI(ctx context.Context, followURI string) (*gtsmodel.Relay, error)
+
+	// GetRelayByActorURI fetches the relay with given relay actor URI from the database.
+	GetRelayByActorURI(ctx context.Context, actorURI string) (*gtsmodel.Relay, error)
+
+	// GetRelays fetches all relays from the database, oldest first.
+	GetRelays(ctx context.Context) ([]*gtsmodel.Relay, error)
+
+	// PutRelay stores the given relay in the database.
+	PutRelay(ctx context.Context, relay *gtsmodel.Relay) error
+
+	// UpdateRelay updates the given relay in the database,
+	// only updating the given columns if provided.
+	UpdateRelay(ctx context.Context, relay *gtsmodel.Relay, columns ...string) error
+
+	// DeleteRelayByID deletes the relay with given ID from the database.
+	DeleteRelayByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type ScheduledStatus interface {
+	// GetScheduledStatusByID fetches the ScheduledStatus with given ID from the database.
+	GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, error)
+
+	// GetScheduledStatusesByIDs fetches all ScheduledStatuses with given IDs from database,
+	// this is optimized and faster than multiple calls to GetScheduledStatusByID.
+	GetScheduledStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.ScheduledStatus, error)
+
+	// GetScheduledStatusesForAcct fetches a page of ScheduledStatuses
+	// owned by the given account ID, ordered by ID descending.
+	// A nil page will return all scheduled statuses for the account.
+	GetScheduledStatusesForAcct(ctx context.Context, acctID string, page *paging.Page) ([]*gtsmodel.ScheduledStatus, error)
+
+	// GetAllScheduledStatuses fetches all ScheduledStatuses in the database,
+	// used when (re)loading scheduled status jobs on startup.
+	GetAllScheduledStatuses(ctx context.Context) ([]*gtsmodel.ScheduledStatus, error)
+
+	// PopulateScheduledStatus ensures the given ScheduledStatus's sub-models are populated.
+	PopulateScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus) error
+
+	// PutScheduledStatus inserts the given new ScheduledStatus into the database.
+	PutScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus) error
+
+	// UpdateScheduledStatus updates the given ScheduledStatus in the database.
+	UpdateScheduledStatus(ctx context.Context, status *gtsmodel.ScheduledStatus, columns ...string) error
+
+	// DeleteScheduledStatusByID deletes the ScheduledStatus with given ID from the database.
+	DeleteScheduledStatusByID(ctx context.Context, id string) error
+
+	// DeleteScheduledStatusesByAccountID deletes all ScheduledStatuses owned by the given account ID.
+	DeleteScheduledStatusesByAccountID(ctx context.Context, accountID string) error
+}
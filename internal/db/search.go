@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -27,10 +28,32 @@ type Search interface {
 	// SearchForAccounts uses the given query text to search for accounts that accountID follows.
 	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, offset int) ([]*gtsmodel.Account, error)
 
-	// SearchForStatuses uses the given query text to search for statuses created by requestingAccountID, or in reply to requestingAccountID.
-	// If fromAccountID is used, the results are restricted to statuses created by fromAccountID.
-	SearchForStatuses(ctx context.Context, requestingAccountID string, query string, fromAccountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
+	// SearchForStatuses uses the full-text status index to search for statuses matching the given params.
+	// Results are pre-filtered to statuses that requestingAccountID may plausibly be able to see, but callers
+	// should still check visibility of each returned status.
+	SearchForStatuses(ctx context.Context, requestingAccountID string, params *StatusSearchParams, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
+
+	// ReindexStatuses rebuilds the full-text status index from scratch,
+	// using the content, content warning, and media descriptions of all
+	// statuses currently stored in the database.
+	ReindexStatuses(ctx context.Context) error
 
 	// SearchForTags searches for tags that start with the given query text (case insensitive).
 	SearchForTags(ctx context.Context, query string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Tag, error)
 }
+
+// StatusSearchParams models parameters
+// for a full-text search of statuses.
+//
+// Aside from Query, it is fine to
+// use zero values on fields of this
+// struct, and even Query can be empty
+// if at least one other field is set.
+type StatusSearchParams struct {
+	Query         string    // Text to match against indexed status content, content warning, and media descriptions.
+	FromAccountID string    // Only match statuses created by this account.
+	HasMedia      bool      // Only match statuses with media attachments.
+	Before        time.Time // Only match statuses created before this time.
+	After         time.Time // Only match statuses created after this time.
+	InLibrary     bool      // Only match statuses created, faved, boosted, or bookmarked by the requesting account.
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -90,4 +91,11 @@ type Status interface {
 	// MaxDirectStatusID, and expects to eventually return the status with that ID.
 	// It is used only by the conversation advanced migration.
 	GetDirectStatusIDsBatch(ctx context.Context, minID string, maxIDInclusive string, count int) ([]string, error)
+
+	// GetStaleRemoteStatuses returns up to limit remote statuses (excluding boosts) created and last fetched
+	// before olderThan, with IDs lower than maxID, that no local account has ever interacted with. That is,
+	// statuses that are not pinned, part of a thread involving local accounts, replying to or mentioning
+	// local accounts, nor faved, boosted, bookmarked, replied to, voted in or filtered by local accounts.
+	// Statuses by accounts that have been reported are never returned, as they may be needed as evidence.
+	GetStaleRemoteStatuses(ctx context.Context, olderThan time.Time, maxID string, limit int) ([]*gtsmodel.Status, error)
 }
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Suggestion interface {
+	// GetFeaturedAccounts fetches all featured accounts
+	// from the database, most recently featured first.
+	GetFeaturedAccounts(ctx context.Context) ([]*gtsmodel.FeaturedAccount, error)
+
+	// GetFeaturedAccount fetches the featured
+	// account entry for the given account ID.
+	GetFeaturedAccount(ctx context.Context, accountID string) (*gtsmodel.FeaturedAccount, error)
+
+	// PopulateFeaturedAccount ensures that the
+	// featured account's Account is populated.
+	PopulateFeaturedAccount(ctx context.Context, featured *gtsmodel.FeaturedAccount) error
+
+	// PutFeaturedAccount stores the given featured account in the database.
+	PutFeaturedAccount(ctx context.Context, featured *gtsmodel.FeaturedAccount) error
+
+	// DeleteFeaturedAccount deletes the featured
+	// account entry for the given account ID.
+	DeleteFeaturedAccount(ctx context.Context, accountID string) error
+
+	// GetSuggestionDismissedIDs fetches the IDs of all accounts
+	// that the given account has dismissed from its suggestions.
+	GetSuggestionDismissedIDs(ctx context.Context, accountID string) ([]string, error)
+
+	// PutSuggestionDismissal stores the given suggestion dismissal in
+	// the database. Repeated dismissals of the same account are ignored.
+	PutSuggestionDismissal(ctx context.Context, dismissal *gtsmodel.SuggestionDismissal) error
+
+	// DeleteAccountSuggestions deletes any featured account entry and
+	// suggestion dismissals by or targeting the given account ID.
+	DeleteAccountSuggestions(ctx context.Context, accountID string) error
+}
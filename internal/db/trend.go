@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Trend interface {
+	// GetTrendByID fetches the trend with given ID from the database.
+	GetTrendByID(ctx context.Context, id string) (*gtsmodel.Trend, error)
+
+	// GetTrend fetches the trend of given type targeting
+	// the given tag, status or card ID from the database.
+	GetTrend(ctx context.Context, trendType gtsmodel.TrendType, targetID string) (*gtsmodel.Trend, error)
+
+	// GetTrends fetches up to limit trends of the given type, skipping the first
+	// offset, which have been used since the given time, ordered by the number of
+	// distinct accounts that used them since then. If state is not unknown, only
+	// trends in that review state will be returned.
+	GetTrends(ctx context.Context, trendType gtsmodel.TrendType, state gtsmodel.TrendState, since time.Time, limit int, offset int) ([]*gtsmodel.Trend, error)
+
+	// PutTrend stores the given trend in the database.
+	PutTrend(ctx context.Context, trend *gtsmodel.Trend) error
+
+	// UpdateTrend updates the given trend in the database,
+	// only updating the given columns if provided.
+	UpdateTrend(ctx context.Context, trend *gtsmodel.Trend, columns ...string) error
+
+	// DeleteTrendByID deletes the trend with given ID, and its uses.
+	DeleteTrendByID(ctx context.Context, id string) error
+
+	// DeleteUnusedTrends deletes trends in the given review state
+	// which have no remaining uses, returning the number deleted.
+	DeleteUnusedTrends(ctx context.Context, state gtsmodel.TrendState) (int, error)
+
+	// GetTrendUses fetches all uses of the given
+	// trend made since the given time, oldest first.
+	GetTrendUses(ctx context.Context, trendID string, since time.Time) ([]*gtsmodel.TrendUse, error)
+
+	// PutTrendUse stores the given trend use in the database.
+	// Repeated uses of a trend from the same source are ignored.
+	PutTrendUse(ctx context.Context, use *gtsmodel.TrendUse) error
+
+	// DeleteTrendUsesOlderThan deletes all trend uses made
+	// before the given time, returning the number deleted.
+	DeleteTrendUsesOlderThan(ctx context.Context, olderThan time.Time) (int, error)
+}
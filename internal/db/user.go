@@ -52,6 +52,16 @@ type User interface {
 	// UpdateUser updates one user by its primary key, updating either only the specified columns, or all of them.
 	UpdateUser(ctx context.Context, user *gtsmodel.User, columns ...string) error
 
+	// UseUserTwoFactorStep marks the given TOTP time step as used for the user with the given ID,
+	// as long as no code of the same or a later time step has already been used. If the step has
+	// already been used (or the user doesn't exist), db.ErrNoEntries will be returned.
+	UseUserTwoFactorStep(ctx context.Context, userID string, step int64) error
+
+	// UseUserTwoFactorRecoveryCode removes the given used recovery code hash from the recovery codes of
+	// the user with the given ID, as long as the user's recovery codes are still exactly the given codes.
+	// If the codes have since changed (or the user doesn't exist), db.ErrNoEntries will be returned.
+	UseUserTwoFactorRecoveryCode(ctx context.Context, userID string, codes []string, used string) error
+
 	// DeleteUserByID deletes one user by its ID.
 	DeleteUserByID(ctx context.Context, userID string) error
 
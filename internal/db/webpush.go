@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// WebPush handles storage of Web Push
+// subscriptions and the instance VAPID key pair.
+type WebPush interface {
+	// GetVAPIDKeyPair gets the instance VAPID key pair,
+	// generating and storing a new one if none exists yet.
+	GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, error)
+
+	// GetWebPushSubscriptionByTokenID fetches the Web Push subscription created with the given access token ID.
+	GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, error)
+
+	// GetWebPushSubscriptionsByAccountID fetches all Web Push subscriptions owned by the given account ID.
+	GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, error)
+
+	// PutWebPushSubscription stores the given Web Push subscription,
+	// replacing any existing subscription with the same token ID.
+	PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) error
+
+	// UpdateWebPushSubscription updates the given Web Push subscription, only updating given columns if provided.
+	UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) error
+
+	// DeleteWebPushSubscriptionByTokenID deletes the Web Push subscription created with the given access token ID.
+	DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) error
+
+	// DeleteWebPushSubscriptionsByEndpoint deletes all Web Push subscriptions with
+	// the given endpoint, eg., because the push service reported it as expired.
+	DeleteWebPushSubscriptionsByEndpoint(ctx context.Context, endpoint string) error
+}
@@ -234,6 +234,13 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		return nil, gtserror.NewErrorForbidden(errors.New(text))
 	}
 
+	if !isLocal {
+		// The remote instance evidently
+		// is reachable, so clear any marks
+		// of it being unavailable (if set).
+		f.MarkInstanceAvailable(ctx, pubKeyID.Host)
+	}
+
 	return pubKeyAuth, nil
 }
 
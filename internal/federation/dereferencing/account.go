@@ -513,10 +513,20 @@ func (d *Dereferencer) enrichAccount(
 		// A username was provided so we can attempt to webfinger,
 		// this ensures up-to-date account domain, and handles some
 		// edge cases where servers don't provide a preferred_username.
+		//
+		// Prefer the account URI we already know (if any), as
+		// some software (eg., Lemmy) returns links for both a
+		// user and a group (community) of the same name.
+		preferURI := account.URI
+		if preferURI == "" && uri != nil {
+			preferURI = uri.String()
+		}
+
 		accUsername, accDomain, accURI, err := d.fingerRemoteAccount(ctx,
 			tsport,
 			account.Username,
 			account.Domain,
+			preferURI,
 		)
 
 		switch {
@@ -704,6 +714,7 @@ func (d *Dereferencer) enrichAccount(
 			tsport,
 			latestAcc.Username,
 			accHost,
+			latestAcc.URI,
 		)
 		if err != nil {
 			// Webfingering account still failed, so we're not certain
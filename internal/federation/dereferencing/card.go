@@ -0,0 +1,783 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"golang.org/x/net/html"
+)
+
+const (
+	// maxCardPageSize is the maximum size of a
+	// page (or oEmbed document) that will be read
+	// when dereferencing a preview card for a link.
+	maxCardPageSize = 1 << 20 // 1MiB
+
+	// maximum lengths (in runes) of
+	// card title and description.
+	maxCardTitleLength       = 300
+	maxCardDescriptionLength = 1000
+)
+
+// DereferenceStatusCard sets the link preview card for
+// the given status, based on the first suitable link in its
+// content (see StatusCardURL()), dereferencing the card if
+// necessary. The status' card_id column is updated in the
+// database if its card has changed.
+func (d *Dereferencer) DereferenceStatusCard(ctx context.Context, status *gtsmodel.Status) error {
+	var cardID string
+
+	if pageURL := StatusCardURL(status); pageURL != "" {
+		card, err := d.GetCard(ctx, pageURL)
+		if err != nil {
+			return err
+		}
+
+		if card.HasContent() {
+			// Only set cards
+			// with something
+			// to display.
+			cardID = card.ID
+			status.Card = card
+		}
+	}
+
+	if cardID == status.CardID {
+		// Nothing
+		// changed.
+		return nil
+	}
+
+	// Update the status card.
+	status.CardID = cardID
+	if cardID == "" {
+		status.Card = nil
+	}
+
+	if err := d.state.DB.UpdateStatus(ctx, status, "card_id"); err != nil {
+		return gtserror.Newf("error updating status card: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshStaleCards refreshes link preview cards that
+// were created in the last week, but are no longer fresh
+// according to DefaultCardFreshness. Older cards are left
+// alone, as statuses linking to them are unlikely to be
+// seen by anyone anymore.
+func (d *Dereferencer) RefreshStaleCards(ctx context.Context) {
+	const batchSize = 50
+
+	var (
+		now           = time.Now()
+		fetchedBefore = now.Add(-time.Duration(*DefaultCardFreshness))
+		createdAfter  = now.Add(-7 * 24 * time.Hour)
+		total         int
+	)
+
+	for {
+		cards, err := d.state.DB.GetStaleCards(ctx,
+			fetchedBefore,
+			createdAfter,
+			batchSize,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error getting stale cards: %v", err)
+			return
+		}
+
+		for _, card := range cards {
+			// Refresh card, this will always update
+			// its FetchedAt, even on failure, so the
+			// next selected batch won't include it.
+			if _, err := d.RefreshCard(ctx, card, DefaultCardFreshness); err != nil {
+				log.Errorf(ctx, "error refreshing card %s: %v", card.URL, err)
+				return
+			}
+		}
+
+		total += len(cards)
+
+		if len(cards) < batchSize {
+			// Reached the end.
+			break
+		}
+	}
+
+	log.Infof(ctx, "refreshed %d stale cards", total)
+}
+
+// GetCard fetches the preview card for the web page at given
+// URL. This handles the case of existing cards by passing them
+// to RefreshCard(), which will refetch the card if it is stale.
+// If the card does not yet exist it will be newly dereferenced
+// and inserted into the database. Pages that could not be
+// dereferenced still result in a (contentless) card being
+// stored, to prevent repeatedly dereferencing them.
+func (d *Dereferencer) GetCard(
+	ctx context.Context,
+	pageURL string,
+) (
+	*gtsmodel.Card,
+	error,
+) {
+	// Acquire per-URL lock to prevent
+	// dereferencing a card concurrently.
+	unlock := d.state.FedLocks.Lock("card:" + pageURL)
+	defer unlock()
+
+	// Look for an existing card with this URL.
+	card, err := d.state.DB.GetCardByURL(ctx, pageURL)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error fetching card from db: %w", err)
+	}
+
+	if card != nil {
+		// This was an existing card, pass to refresh func.
+		return d.refreshCard(ctx, card, DefaultCardFreshness)
+	}
+
+	// Create a new card for this URL.
+	card = &gtsmodel.Card{
+		ID:  id.NewULID(),
+		URL: pageURL,
+	}
+
+	// Dereference the card's page, logging any error:
+	// we still store the card to mark that it's been
+	// (attempted to be) fetched, the card simply won't
+	// have any content for the client to display.
+	if err := d.enrichCard(ctx, card); err != nil {
+		log.Warnf(ctx, "error dereferencing card %s: %v", pageURL, err)
+	}
+
+	// Insert the new card into the database.
+	if err := d.state.DB.PutCard(ctx, card); err != nil {
+		return nil, gtserror.Newf("error inserting card in db: %w", err)
+	}
+
+	return card, nil
+}
+
+// RefreshCard ensures that the given card is up-to-date,
+// refetching the page at its URL if it was last fetched
+// longer ago than the given window. A nil window forces a refetch.
+func (d *Dereferencer) RefreshCard(
+	ctx context.Context,
+	card *gtsmodel.Card,
+	window *FreshnessWindow,
+) (
+	*gtsmodel.Card,
+	error,
+) {
+	// Acquire per-URL lock to prevent
+	// dereferencing a card concurrently.
+	unlock := d.state.FedLocks.Lock("card:" + card.URL)
+	defer unlock()
+
+	return d.refreshCard(ctx, card, window)
+}
+
+// refreshCard is the lock-free implementation of RefreshCard().
+func (d *Dereferencer) refreshCard(
+	ctx context.Context,
+	card *gtsmodel.Card,
+	window *FreshnessWindow,
+) (
+	*gtsmodel.Card,
+	error,
+) {
+	if window != nil &&
+		time.Since(card.FetchedAt) < time.Duration(*window) {
+		// Card is fresh enough, ensure
+		// any preview image is still cached.
+		return d.recacheCardImage(ctx, card)
+	}
+
+	// Take a copy of the card
+	// to update with latest info.
+	latest := new(gtsmodel.Card)
+	*latest = *card
+
+	// Dereference the card's page. If this
+	// fails, keep the existing card info
+	// but still mark it as being fetched.
+	if err := d.enrichCard(ctx, latest); err != nil {
+		log.Warnf(ctx, "error dereferencing card %s: %v", card.URL, err)
+		latest = card
+		latest.FetchedAt = time.Now()
+	}
+
+	if card.ImageID != "" && card.ImageID != latest.ImageID {
+		// The card's image has been replaced,
+		// so delete the previous image media.
+		if err := d.state.DB.DeleteAttachment(ctx, card.ImageID); err != nil &&
+			!errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "error deleting old card image: %v", err)
+		}
+	}
+
+	// Update the card in the database.
+	if err := d.state.DB.UpdateCard(ctx, latest); err != nil {
+		return nil, gtserror.Newf("error updating card in db: %w", err)
+	}
+
+	return latest, nil
+}
+
+// recacheCardImage ensures that the given card's
+// preview image is cached in local storage, in case
+// it was uncached by the scheduled media cleaner.
+func (d *Dereferencer) recacheCardImage(ctx context.Context, card *gtsmodel.Card) (*gtsmodel.Card, error) {
+	if card.ImageID == "" {
+		// Nothing
+		// to do.
+		return card, nil
+	}
+
+	if card.Image == nil {
+		var err error
+
+		// Ensure the card image is populated.
+		card.Image, err = d.state.DB.GetAttachmentByID(
+			gtscontext.SetBarebones(ctx),
+			card.ImageID,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error fetching card image: %w", err)
+		}
+	}
+
+	// Recache the image if necessary.
+	image, err := d.RefreshMedia(ctx,
+		"", // instance account
+		card.Image,
+		media.AdditionalMediaInfo{},
+		false,
+	)
+	if err != nil {
+		log.Warnf(ctx, "error recaching card image: %v", err)
+	}
+
+	if image != nil {
+		card.Image = image
+	}
+
+	return card, nil
+}
+
+// enrichCard dereferences the page at the given card's URL,
+// updating the card with the page's OpenGraph and oEmbed
+// metadata, and caching the card's preview image locally.
+func (d *Dereferencer) enrichCard(ctx context.Context, card *gtsmodel.Card) error {
+	// Mark card as fetched now, even
+	// if the dereference below fails.
+	card.FetchedAt = time.Now()
+
+	pageURI, err := url.Parse(card.URL)
+	if err != nil {
+		return gtserror.Newf("invalid card url %s: %w", card.URL, err)
+	}
+
+	// Acquire new instance account transport for card dereferencing.
+	tsport, err := d.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance transport: %w", err)
+	}
+
+	// Card pages may be slow or broken,
+	// don't bother retrying on failure.
+	ctx = gtscontext.SetFastFail(ctx)
+
+	// Fetch the page's metadata.
+	meta, err := dereferenceCardPage(ctx, tsport, pageURI)
+	if err != nil {
+		return err
+	}
+
+	if meta.oEmbedURL != nil {
+		// Page advertised oEmbed information, which
+		// is generally more useful than OpenGraph.
+		oEmbed, err := dereferenceOEmbed(ctx, tsport, meta.oEmbedURL)
+		if err != nil {
+			log.Debugf(ctx, "error dereferencing oembed for %s: %v", card.URL, err)
+		} else {
+			meta.applyOEmbed(oEmbed)
+		}
+	}
+
+	// Set card content
+	// from the metadata.
+	meta.applyTo(card)
+
+	if meta.image == "" {
+		// No image
+		// to cache.
+		card.ImageRemoteURL = ""
+		card.ImageID = ""
+		card.Image = nil
+		return nil
+	}
+
+	if meta.image == card.ImageRemoteURL && card.Image != nil {
+		// Image unchanged, just
+		// ensure it's still cached.
+		_, err := d.recacheCardImage(ctx, card)
+		return err
+	}
+
+	// Get the instance account, which
+	// owns the card's preview image.
+	instanceAcc, err := d.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	// Dereference the card's
+	// new preview image.
+	image, err := d.GetMedia(ctx,
+		"", // instance account
+		instanceAcc.ID,
+		meta.image,
+		media.AdditionalMediaInfo{
+			RemoteURL: &meta.image,
+		},
+	)
+	if err != nil {
+		log.Warnf(ctx, "error dereferencing card image: %v", err)
+	}
+
+	card.ImageRemoteURL = meta.image
+	card.ImageID = ""
+	card.Image = nil
+
+	if image != nil {
+		// Set card image, even if only
+		// a placeholder due to an error,
+		// this can be recached later.
+		card.ImageID = image.ID
+		card.Image = image
+	}
+
+	return nil
+}
+
+// cardMetadata contains preview card information
+// gathered from the html of a page, and optionally
+// from the oEmbed document advertised by the page.
+type cardMetadata struct {
+	cardType     gtsmodel.CardType
+	title        string
+	description  string
+	authorName   string
+	authorURL    string
+	providerName string
+	providerURL  string
+	html         string
+	width        int
+	height       int
+	embedURL     string
+	image        string
+
+	// oEmbedURL is the URL of the
+	// oEmbed JSON advertised by the
+	// page, if any. Not used on cards.
+	oEmbedURL *url.URL
+}
+
+// applyTo sets the gathered metadata on the given card.
+func (m *cardMetadata) applyTo(card *gtsmodel.Card) {
+	card.Type = m.cardType
+	card.Title = truncate(text.SanitizeToPlaintext(m.title), maxCardTitleLength)
+	card.Description = truncate(text.SanitizeToPlaintext(m.description), maxCardDescriptionLength)
+	card.AuthorName = text.SanitizeToPlaintext(m.authorName)
+	card.AuthorURL = m.authorURL
+	card.ProviderName = text.SanitizeToPlaintext(m.providerName)
+	card.ProviderURL = m.providerURL
+	card.HTML = m.html
+	card.Width = m.width
+	card.Height = m.height
+	card.EmbedURL = m.embedURL
+}
+
+// oEmbed models the fields we're interested in from an oEmbed
+// JSON document. See: https://oembed.com/#section2.3
+type oEmbed struct {
+	Type         string      `json:"type"`
+	Title        string      `json:"title"`
+	AuthorName   string      `json:"author_name"`
+	AuthorURL    string      `json:"author_url"`
+	ProviderName string      `json:"provider_name"`
+	ProviderURL  string      `json:"provider_url"`
+	HTML         string      `json:"html"`
+	URL          string      `json:"url"`
+	Width        json.Number `json:"width"`
+	Height       json.Number `json:"height"`
+	ThumbnailURL string      `json:"thumbnail_url"`
+}
+
+// applyOEmbed updates the metadata with
+// information from the given oEmbed document,
+// preferring it to info parsed from html.
+func (m *cardMetadata) applyOEmbed(o *oEmbed) {
+	width, _ := o.Width.Int64()
+	height, _ := o.Height.Int64()
+
+	switch o.Type {
+	case "photo":
+		if !isHTTPSURL(o.URL) {
+			// Can't embed
+			// this photo.
+			break
+		}
+		m.cardType = gtsmodel.CardTypePhoto
+		m.embedURL = o.URL
+		m.width = int(width)
+		m.height = int(height)
+		if m.image == "" {
+			m.image = o.URL
+		}
+
+	case "video", "rich":
+		html := text.SanitizeToEmbedHTML(o.HTML)
+		if html == "" {
+			// Nothing embeddable
+			// left after sanitizing.
+			break
+		}
+		m.cardType = gtsmodel.CardTypeRich
+		if o.Type == "video" {
+			m.cardType = gtsmodel.CardTypeVideo
+		}
+		m.html = html
+		m.width = int(width)
+		m.height = int(height)
+	}
+
+	if o.Title != "" {
+		m.title = o.Title
+	}
+
+	if o.AuthorName != "" {
+		m.authorName = o.AuthorName
+	}
+
+	if isHTTPURL(o.AuthorURL) {
+		m.authorURL = o.AuthorURL
+	}
+
+	if o.ProviderName != "" {
+		m.providerName = o.ProviderName
+	}
+
+	if isHTTPURL(o.ProviderURL) {
+		m.providerURL = o.ProviderURL
+	}
+
+	if m.image == "" && isHTTPURL(o.ThumbnailURL) {
+		m.image = o.ThumbnailURL
+	}
+}
+
+// dereferenceCardPage fetches the html page at given URL using
+// transport, parsing preview card metadata from its <head>.
+func dereferenceCardPage(
+	ctx context.Context,
+	tsport transport.Transport,
+	pageURI *url.URL,
+) (*cardMetadata, error) {
+	rsp, err := tsport.DereferenceLink(ctx,
+		pageURI,
+		"text/html,application/xhtml+xml",
+		maxCardPageSize,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error dereferencing page: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	// Ensure we were actually served an html page.
+	ct, _, _ := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+	if ct != "text/html" && ct != "application/xhtml+xml" {
+		return nil, gtserror.Newf("non html response type: %s", ct)
+	}
+
+	return parseCardMetadata(rsp.Body, pageURI), nil
+}
+
+// dereferenceOEmbed fetches the oEmbed JSON document at given URL.
+func dereferenceOEmbed(
+	ctx context.Context,
+	tsport transport.Transport,
+	oEmbedURI *url.URL,
+) (*oEmbed, error) {
+	rsp, err := tsport.DereferenceLink(ctx,
+		oEmbedURI,
+		"application/json",
+		maxCardPageSize,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error dereferencing oembed: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	var o oEmbed
+	if err := json.NewDecoder(rsp.Body).Decode(&o); err != nil {
+		return nil, gtserror.Newf("error decoding oembed: %w", err)
+	}
+
+	return &o, nil
+}
+
+// parseCardMetadata parses preview card metadata
+// from the <head> of the html page read from r,
+// resolving any relative URLs against pageURI.
+func parseCardMetadata(r io.Reader, pageURI *url.URL) *cardMetadata {
+	var (
+		meta    = cardMetadata{cardType: gtsmodel.CardTypeLink}
+		ogTitle string
+		ogDesc  string
+		inTitle bool
+		title   strings.Builder
+	)
+
+	// resolve returns the absolute http(s)
+	// form of the given possibly-relative URL.
+	resolve := func(ref string) string {
+		u, err := pageURI.Parse(strings.TrimSpace(ref))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return ""
+		}
+		return u.String()
+	}
+
+	z := html.NewTokenizer(r)
+
+loop:
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// Either EOF, or
+			// a broken page.
+			break loop
+
+		case html.TextToken:
+			if inTitle {
+				title.Write(z.Text())
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				// Everything
+				// we need is
+				// in <head>.
+				break loop
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch string(name) {
+			case "body":
+				// Past <head>.
+				break loop
+
+			case "title":
+				inTitle = (tt == html.StartTagToken)
+
+			case "meta":
+				attrs := tagAttrs(z, hasAttr)
+				key := attrs["property"]
+				if key == "" {
+					key = attrs["name"]
+				}
+				content := attrs["content"]
+
+				switch strings.ToLower(key) {
+				case "og:title":
+					ogTitle = content
+				case "og:description":
+					ogDesc = content
+				case "description":
+					if meta.description == "" {
+						meta.description = content
+					}
+				case "og:site_name":
+					meta.providerName = content
+				case "og:image", "og:image:url", "og:image:secure_url":
+					if meta.image == "" {
+						meta.image = resolve(content)
+					}
+				case "author":
+					meta.authorName = content
+				}
+
+			case "link":
+				attrs := tagAttrs(z, hasAttr)
+				if strings.EqualFold(attrs["rel"], "alternate") &&
+					strings.EqualFold(attrs["type"], "application/json+oembed") {
+					if u, err := url.Parse(resolve(attrs["href"])); err == nil && u.Host != "" {
+						meta.oEmbedURL = u
+					}
+				}
+			}
+		}
+	}
+
+	// Prefer OpenGraph over
+	// html title + description.
+	meta.title = ogTitle
+	if meta.title == "" {
+		meta.title = title.String()
+	}
+	if ogDesc != "" {
+		meta.description = ogDesc
+	}
+
+	return &meta
+}
+
+// tagAttrs returns the attributes of the
+// current tag in tokenizer as a map.
+func tagAttrs(z *html.Tokenizer, hasAttr bool) map[string]string {
+	attrs := make(map[string]string)
+	for hasAttr {
+		var key, val []byte
+		key, val, hasAttr = z.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(val)
+	}
+	return attrs
+}
+
+// StatusCardURL returns the URL of the first link in the
+// given status that is suitable for having a preview card
+// generated, skipping mentions, hashtags and links to this
+// instance. Returns an empty string if no suitable link.
+func StatusCardURL(status *gtsmodel.Status) string {
+	if status.BoostOfID != "" ||
+		len(status.AttachmentIDs) != 0 {
+		// Boosts use the boosted
+		// status' card, and statuses
+		// with media don't have cards.
+		return ""
+	}
+
+	z := html.NewTokenizer(strings.NewReader(status.Content))
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// Reached the end
+			// without a link.
+			return ""
+
+		case html.StartTagToken:
+			name, hasAttr := z.TagName()
+			if string(name) != "a" {
+				continue
+			}
+
+			attrs := tagAttrs(z, hasAttr)
+			if isMentionOrHashtag(attrs) {
+				continue
+			}
+
+			u, err := url.Parse(attrs["href"])
+			if err != nil ||
+				(u.Scheme != "http" && u.Scheme != "https") ||
+				u.Host == "" {
+				continue
+			}
+
+			host := strings.ToLower(u.Host)
+			if host == config.GetHost() ||
+				host == config.GetAccountDomain() {
+				// Don't generate
+				// cards for our
+				// own pages.
+				continue
+			}
+
+			return u.String()
+		}
+	}
+}
+
+// isMentionOrHashtag returns whether the given
+// attributes of an <a> tag mark it as a mention
+// or a hashtag, rather than a link to a page.
+func isMentionOrHashtag(attrs map[string]string) bool {
+	for _, class := range strings.Fields(attrs["class"]) {
+		if class == "mention" || class == "hashtag" {
+			return true
+		}
+	}
+	for _, rel := range strings.Fields(attrs["rel"]) {
+		if rel == "tag" {
+			return true
+		}
+	}
+	return false
+}
+
+// isHTTPURL returns whether given string is an absolute http(s) URL.
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Host != "" &&
+		(u.Scheme == "http" || u.Scheme == "https")
+}
+
+// isHTTPSURL returns whether given string is an absolute https URL.
+func isHTTPSURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Host != "" && u.Scheme == "https"
+}
+
+// truncate trims given string
+// to specified length (in runes).
+func truncate(s string, l int) string {
+	r := []rune(s)
+	if len(r) <= l {
+		return s
+	}
+	return string(r[:l-1]) + "…"
+}
@@ -0,0 +1,199 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/interaction"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const testCardPage = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Fallback title</title>
+	<meta name="description" content="Fallback description">
+	<meta property="og:title" content="Big <b>Turnip</b> News">
+	<meta property="og:description" content="A very large turnip was grown.">
+	<meta property="og:site_name" content="Turnip Times">
+	<meta property="og:image" content="/images/turnip.jpg">
+</head>
+<body>
+	<p>Not part of the card.</p>
+</body>
+</html>`
+
+type CardTestSuite struct {
+	DereferencerStandardTestSuite
+}
+
+// cardDereferencer returns a new dereferencer which
+// serves the test card page, and an image for it.
+func (suite *CardTestSuite) cardDereferencer() *dereferencing.Dereferencer {
+	image, err := os.ReadFile("../../../testrig/media/giant-turnip-world-record.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	client := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		var (
+			body        []byte
+			contentType string
+		)
+
+		switch req.URL.String() {
+		case "https://turnip.example.org/news/big-turnip":
+			body, contentType = []byte(testCardPage), "text/html; charset=utf-8"
+		case "https://turnip.example.org/images/turnip.jpg":
+			body, contentType = image, "image/jpeg"
+		default:
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     http.StatusText(http.StatusNotFound),
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+				Request:    req,
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Status:        http.StatusText(http.StatusOK),
+			Header:        http.Header{"Content-Type": {contentType}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}, "")
+
+	d := dereferencing.NewDereferencer(
+		&suite.state,
+		typeutils.NewConverter(&suite.state),
+		testrig.NewTestTransportController(&suite.state, client),
+		visibility.NewFilter(&suite.state),
+		interaction.NewFilter(&suite.state),
+		testrig.NewTestMediaManager(&suite.state),
+	)
+	return &d
+}
+
+func (suite *CardTestSuite) TestGetCard() {
+	ctx := context.Background()
+	d := suite.cardDereferencer()
+
+	card, err := d.GetCard(ctx, "https://turnip.example.org/news/big-turnip")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(card.HasContent())
+	suite.Equal(gtsmodel.CardTypeLink, card.Type)
+	suite.Equal("Big Turnip News", card.Title)
+	suite.Equal("A very large turnip was grown.", card.Description)
+	suite.Equal("Turnip Times", card.ProviderName)
+	suite.Equal("https://turnip.example.org/images/turnip.jpg", card.ImageRemoteURL)
+	suite.NotEmpty(card.ImageID)
+	suite.NotZero(card.FetchedAt)
+
+	// Card should now be stored in the database.
+	dbCard, err := suite.db.GetCardByURL(ctx, card.URL)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(card.ID, dbCard.ID)
+
+	// Getting the card again should return
+	// the same card, without refetching it.
+	again, err := d.GetCard(ctx, card.URL)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(card.ID, again.ID)
+	suite.Equal(card.FetchedAt, again.FetchedAt)
+}
+
+func (suite *CardTestSuite) TestGetCardNotFound() {
+	ctx := context.Background()
+	d := suite.cardDereferencer()
+
+	// A failed dereference should still store
+	// a card, it just won't have any content.
+	card, err := d.GetCard(ctx, "https://turnip.example.org/news/missing")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(card.HasContent())
+	suite.NotZero(card.FetchedAt)
+}
+
+func (suite *CardTestSuite) TestDereferenceStatusCard() {
+	ctx := context.Background()
+	d := suite.cardDereferencer()
+
+	status, err := suite.db.GetStatusByID(ctx, testrig.NewTestStatuses()["local_account_1_status_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status.Content = `<p>hello <span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> ` +
+		`<a href="http://localhost:8080/tags/turnip" class="mention hashtag" rel="tag">#<span>turnip</span></a> ` +
+		`read this: <a href="https://turnip.example.org/news/big-turnip" rel="nofollow noreferrer noopener">https://turnip.example.org/news/big-turnip</a></p>`
+
+	suite.Equal("https://turnip.example.org/news/big-turnip", dereferencing.StatusCardURL(status))
+
+	if err := d.DereferenceStatusCard(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(status.CardID)
+
+	// The status card should have been stored.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(status.CardID, dbStatus.CardID)
+	if suite.NotNil(dbStatus.Card) {
+		suite.Equal("Big Turnip News", dbStatus.Card.Title)
+	}
+
+	// Statuses with only mentions, hashtags
+	// and local links shouldn't get a card.
+	status.Content = `<p><a href="http://localhost:8080/tags/turnip" class="mention hashtag" rel="tag">#<span>turnip</span></a> ` +
+		`<a href="http://localhost:8080/@the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY">local link</a></p>`
+	suite.Empty(dereferencing.StatusCardURL(status))
+
+	if err := d.DereferenceStatusCard(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(status.CardID)
+	suite.Nil(status.Card)
+}
+
+func TestCardTestSuite(t *testing.T) {
+	suite.Run(t, new(CardTestSuite))
+}
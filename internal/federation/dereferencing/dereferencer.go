@@ -56,6 +56,12 @@
 	// fresh dereference of a Status.
 	DefaultStatusFreshness = util.Ptr(FreshnessWindow(2 * time.Hour))
 
+	// 24 hours.
+	//
+	// Default window for doing a fresh
+	// dereference of a link preview Card.
+	DefaultCardFreshness = util.Ptr(FreshnessWindow(24 * time.Hour))
+
 	// 5 minutes.
 	//
 	// Fresh is useful when you're wanting
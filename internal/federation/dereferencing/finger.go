@@ -36,6 +36,11 @@
 // The webfinger response will be parsed, and the subject
 // domain and AP URI will be extracted and returned.
 //
+// If preferURI is set, and is among the AP URIs in the
+// response, it will be returned in favour of the others.
+// This matters for software such as Lemmy, which returns
+// links to both a Person and a Group sharing a name.
+//
 // In case the response cannot be parsed, or the response
 // does not contain a valid subject string or AP URI, an
 // error will be returned instead.
@@ -44,6 +49,7 @@ func (d *Dereferencer) fingerRemoteAccount(
 	transport transport.Transport,
 	username string,
 	host string,
+	preferURI string,
 ) (
 	string, // discovered username
 	string, // discovered account domain
@@ -88,6 +94,9 @@ func (d *Dereferencer) fingerRemoteAccount(
 	//   - Must be self link.
 	//   - Must be AP type.
 	//   - Valid https/http URI.
+	//
+	// (or the preferred URI, if found).
+	var accURI *url.URL
 	for _, link := range resp.Links {
 		if link.Rel != "self" {
 			// Not self link, ignore.
@@ -121,9 +130,24 @@ func (d *Dereferencer) fingerRemoteAccount(
 			continue
 		}
 
-		// All looks good, return happily!
-		return accUsername, accDomain, uri, nil
+		if preferURI != "" && uri.String() == preferURI {
+			// This is the one we
+			// want, look no further.
+			accURI = uri
+			break
+		}
+
+		if accURI == nil {
+			// First suitable
+			// link we've seen.
+			accURI = uri
+		}
+	}
+
+	if accURI == nil {
+		return "", "", nil, gtserror.Newf("no suitable self, AP-type link found in webfinger response for %s", target)
 	}
 
-	return "", "", nil, gtserror.Newf("no suitable self, AP-type link found in webfinger response for %s", target)
+	// All looks good, return happily!
+	return accUsername, accDomain, accURI, nil
 }
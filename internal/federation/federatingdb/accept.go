@@ -93,6 +93,19 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 
 			// ACCEPT FOLLOW
 			case ap.ActivityFollow:
+				// Check first whether this is a relay
+				// responding to our instance actor.
+				if handled, err := f.relayFollowResponse(ctx,
+					ap.GetJSONLDId(asType),
+					receivingAcct,
+					requestingAcct,
+					gtsmodel.RelayStateAccepted,
+				); err != nil {
+					return err
+				} else if handled {
+					continue
+				}
+
 				if err := f.acceptFollowType(
 					ctx,
 					asType,
@@ -108,9 +121,24 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 			}
 
 		} else if object.IsIRI() {
+			objIRI := object.GetIRI()
+
+			// Check first whether this is a relay
+			// responding to our instance actor.
+			if handled, err := f.relayFollowResponse(ctx,
+				objIRI,
+				receivingAcct,
+				requestingAcct,
+				gtsmodel.RelayStateAccepted,
+			); err != nil {
+				return err
+			} else if handled {
+				continue
+			}
+
 			// Check and handle any
 			// IRI type objects.
-			switch objIRI := object.GetIRI(); {
+			switch {
 
 			// ACCEPT FOLLOW
 			case uris.IsFollowPath(objIRI):
@@ -22,6 +22,7 @@
 	"net/url"
 	"slices"
 
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -60,6 +61,28 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		)
 	}
 
+	// Check whether this Announce is from a relay
+	// we're subscribed to; if so, the announced
+	// statuses are ingested as regular statuses.
+	if handled, err := f.relayAnnounce(ctx,
+		announce,
+		receivingAcct,
+		requestingAcct,
+	); err != nil {
+		return err
+	} else if handled {
+		return nil
+	}
+
+	// Groups may Announce whole activities
+	// by their members, rather than objects.
+	if requestingAcct.IsGroup() &&
+		!unwrapGroupAnnounce(ctx, announce) {
+		// Nothing left to
+		// boost, we're done.
+		return nil
+	}
+
 	boost, isNew, err := f.converter.ASAnnounceToStatus(ctx, announce)
 	if err != nil {
 		return gtserror.Newf("error converting announce to boost: %w", err)
@@ -82,3 +105,65 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 
 	return nil
 }
+
+// unwrapGroupAnnounce unwraps any activities embedded in
+// an Announce from a group actor. Some group implementations
+// (eg., Lemmy, see FEP-1b12) Announce the activities of their
+// members, rather than just the objects they concern. So, any
+// Announced Create is replaced with its object(s), which are
+// then handled as boosted by the group, and other activities
+// (Likes, Updates, Deletes etc.) are dropped from the Announce.
+//
+// The returned bool indicates whether any objects remain
+// in the Announce, ie., whether there's still work to do.
+func unwrapGroupAnnounce(ctx context.Context, announce vocab.ActivityStreamsAnnounce) bool {
+	objects := ap.ExtractObjects(announce)
+	if len(objects) == 0 {
+		// Leave malformed Announce
+		// to be rejected later on.
+		return true
+	}
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	for _, object := range objects {
+		asType := object.GetType()
+		if asType == nil {
+			// Just an IRI,
+			// keep as-is.
+			if object.IsIRI() {
+				objectProp.AppendIRI(object.GetIRI())
+			}
+			continue
+		}
+
+		typeName := asType.GetTypeName()
+		if !ap.IsActivityable(typeName) {
+			// Not an activity,
+			// keep as-is.
+			if err := objectProp.AppendType(asType); err != nil {
+				log.Warnf(ctx, "error appending %s: %v", typeName, err)
+			}
+			continue
+		}
+
+		if typeName != ap.ActivityCreate {
+			// Only interested in
+			// wrapped Creates.
+			continue
+		}
+
+		create, ok := asType.(ap.WithObject)
+		if !ok {
+			continue
+		}
+
+		// Boost the created
+		// object(s) instead.
+		for _, iri := range ap.GetObjectIRIs(create) {
+			objectProp.AppendIRI(iri)
+		}
+	}
+
+	announce.SetActivityStreamsObject(objectProp)
+	return objectProp.Len() != 0
+}
@@ -22,10 +22,13 @@
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type AnnounceTestSuite struct {
@@ -99,6 +102,85 @@ func (suite *AnnounceTestSuite) TestAnnounceTwice() {
 	suite.False(ok)
 }
 
+func (suite *AnnounceTestSuite) TestGroupAnnounceCreate() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	// Treat the announcing account as a group.
+	groupAccount := new(gtsmodel.Account)
+	*groupAccount = *suite.testAccounts["remote_account_1"]
+	groupAccount.ActorType = ap.ActorGroup
+
+	// Groups like Lemmy communities Announce
+	// the Create of a member's post, not the post.
+	noteIRI := testrig.URLMustParse("http://example.org/users/Some_User/statuses/01JD5QG2M6AWW1XBKTQTMP4KHT")
+	create := streams.NewActivityStreamsCreate()
+	ap.AppendObjectIRIs(create, noteIRI)
+
+	announce := suite.newGroupAnnounce(groupAccount, create)
+	ctx := createTestContext(receivingAccount, groupAccount)
+
+	err := suite.federatingDB.Announce(ctx, announce)
+	suite.NoError(err)
+
+	// The Create should have been unwrapped, so
+	// the group should be boosting the post itself.
+	msg, _ := suite.getFederatorMsg(5 * time.Second)
+	suite.Equal(ap.ActivityAnnounce, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+
+	boost, ok := msg.GTSModel.(*gtsmodel.Status)
+	suite.True(ok)
+	suite.Equal(groupAccount.ID, boost.AccountID)
+	suite.Equal(noteIRI.String(), boost.BoostOfURI)
+}
+
+func (suite *AnnounceTestSuite) TestGroupAnnounceLike() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	// Treat the announcing account as a group.
+	groupAccount := new(gtsmodel.Account)
+	*groupAccount = *suite.testAccounts["remote_account_1"]
+	groupAccount.ActorType = ap.ActorGroup
+
+	// Groups also Announce other activities by
+	// members, like Likes, which aren't boosts.
+	like := streams.NewActivityStreamsLike()
+	ap.AppendObjectIRIs(like, testrig.URLMustParse("http://example.org/users/Some_User/statuses/01JD5QG2M6AWW1XBKTQTMP4KHT"))
+
+	announce := suite.newGroupAnnounce(groupAccount, like)
+	ctx := createTestContext(receivingAccount, groupAccount)
+
+	err := suite.federatingDB.Announce(ctx, announce)
+	suite.NoError(err)
+
+	// Nothing should have been boosted.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+}
+
+func (suite *AnnounceTestSuite) newGroupAnnounce(
+	group *gtsmodel.Account,
+	activity vocab.Type,
+) vocab.ActivityStreamsAnnounce {
+	announce := streams.NewActivityStreamsAnnounce()
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(testrig.URLMustParse(group.URI + "/activities/" + id.NewULID()))
+	announce.SetJSONLDId(idProp)
+
+	ap.AppendActorIRIs(announce, testrig.URLMustParse(group.URI))
+	ap.AppendTo(announce, testrig.URLMustParse(pub.PublicActivityPubIRI))
+	ap.SetPublished(announce, time.Now())
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	if err := objectProp.AppendType(activity); err != nil {
+		suite.FailNow(err.Error())
+	}
+	announce.SetActivityStreamsObject(objectProp)
+
+	return announce
+}
+
 func TestAnnounceTestSuite(t *testing.T) {
 	suite.Run(t, &AnnounceTestSuite{})
 }
@@ -58,6 +58,19 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 
 			// REJECT FOLLOW
 			case ap.ActivityFollow:
+				// Check first whether this is a relay
+				// responding to our instance actor.
+				if handled, err := f.relayFollowResponse(ctx,
+					ap.GetJSONLDId(asType),
+					receivingAcct,
+					requestingAcct,
+					gtsmodel.RelayStateRejected,
+				); err != nil {
+					return err
+				} else if handled {
+					continue
+				}
+
 				if err := f.rejectFollowType(
 					ctx,
 					asType,
@@ -73,9 +86,24 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 			}
 
 		} else if object.IsIRI() {
+			objIRI := object.GetIRI()
+
+			// Check first whether this is a relay
+			// responding to our instance actor.
+			if handled, err := f.relayFollowResponse(ctx,
+				objIRI,
+				receivingAcct,
+				requestingAcct,
+				gtsmodel.RelayStateRejected,
+			); err != nil {
+				return err
+			} else if handled {
+				continue
+			}
+
 			// Check and handle any
 			// IRI type objects.
-			switch objIRI := object.GetIRI(); {
+			switch {
 
 			// REJECT FOLLOW
 			case uris.IsFollowPath(objIRI):
@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// relayFollowResponse checks whether the given Follow IRI
+// is the Follow sent by our instance actor to a relay and,
+// if so, updates the relay to the given state (ie., the
+// relay Accepted or Rejected the Follow). The returned
+// bool indicates whether the Follow was a relay Follow.
+func (f *federatingDB) relayFollowResponse(
+	ctx context.Context,
+	followIRI *url.URL,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+	state gtsmodel.RelayState,
+) (bool, error) {
+	if followIRI == nil || !receivingAcct.IsInstance() {
+		// Relays are only ever
+		// followed by instance actor.
+		return false, nil
+	}
+
+	relay, err := f.state.DB.GetRelayByFollowURI(ctx, followIRI.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relay: %w", err)
+		return false, gtserror.NewErrorInternalError(err)
+	}
+
+	if relay == nil {
+		// Not a relay Follow.
+		return false, nil
+	}
+
+	if relay.ActorURI != "" &&
+		relay.ActorURI != requestingAcct.URI {
+		const text = "relay actor and requesting account were not the same"
+		return true, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	relay.ActorURI = requestingAcct.URI
+	relay.State = state
+	if err := f.state.DB.UpdateRelay(ctx, relay,
+		"actor_uri",
+		"state",
+	); err != nil {
+		err := gtserror.Newf("db error updating relay: %w", err)
+		return true, gtserror.NewErrorInternalError(err)
+	}
+
+	log.Infof(ctx, "relay %s is now %s", relay.InboxURI, state)
+	return true, nil
+}
+
+// relayAnnounce checks whether the given Announce was sent
+// by an accepted relay and, if so, queues the announced
+// statuses to be dereferenced and stored as regular new
+// statuses, rather than as boosts by the relay actor. The
+// returned bool indicates whether the Announce was from a relay.
+func (f *federatingDB) relayAnnounce(
+	ctx context.Context,
+	announce vocab.ActivityStreamsAnnounce,
+	receivingAcct *gtsmodel.Account,
+	requestingAcct *gtsmodel.Account,
+) (bool, error) {
+	relay, err := f.state.DB.GetRelayByActorURI(ctx, requestingAcct.URI)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting relay: %w", err)
+	}
+
+	if relay == nil {
+		// Not a relay Announce.
+		return false, nil
+	}
+
+	if !relay.Accepted() {
+		// We're not (or no longer)
+		// subscribed to this relay,
+		// just drop the Announce.
+		return true, nil
+	}
+
+	for _, object := range ap.ExtractObjects(announce) {
+		var objIRI *url.URL
+
+		if asType := object.GetType(); asType != nil {
+			objIRI = ap.GetJSONLDId(asType)
+		} else if object.IsIRI() {
+			objIRI = object.GetIRI()
+		}
+
+		if objIRI == nil ||
+			objIRI.Host == config.GetHost() ||
+			objIRI.Host == config.GetAccountDomain() {
+			// Nothing to do for
+			// unknown or local objects.
+			continue
+		}
+
+		// Pass to the processor to dereference
+		// the status as if it had been forwarded.
+		f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			APIRI:          objIRI,
+			Receiving:      receivingAcct,
+			Requesting:     requestingAcct,
+		})
+	}
+
+	return true, nil
+}
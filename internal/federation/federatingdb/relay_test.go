@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RelayTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *RelayTestSuite) putRelay(state gtsmodel.RelayState, actorURI string) *gtsmodel.Relay {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relay := &gtsmodel.Relay{
+		ID:        "01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+		InboxURI:  "http://fossbros-anonymous.io/inbox",
+		FollowURI: uris.GenerateURIForFollow(instanceAccount.Username, "01JBMZ9ZVJ5KX3QYHRN0T7F6CW"),
+		ActorURI:  actorURI,
+		State:     state,
+	}
+
+	if err := suite.db.PutRelay(context.Background(), relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return relay
+}
+
+func (suite *RelayTestSuite) TestAcceptRelayFollow() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	relay := suite.putRelay(gtsmodel.RelayStatePending, "")
+
+	asFollow, err := suite.tc.RelayToASFollow(ctx, relay, instanceAccount)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Relay accepts the Follow.
+	accept := streams.NewActivityStreamsAccept()
+	ap.SetJSONLDId(accept, testrig.URLMustParse("http://fossbros-anonymous.io/accept/1"))
+	ap.AppendActorIRIs(accept, testrig.URLMustParse(relayAccount.URI))
+	acceptObject := streams.NewActivityStreamsObjectProperty()
+	acceptObject.AppendActivityStreamsFollow(asFollow)
+	accept.SetActivityStreamsObject(acceptObject)
+
+	if err := suite.federatingDB.Accept(ctx, accept); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Nothing to pass to the processor.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+
+	// Relay should now be accepted,
+	// with the actor URI filled in.
+	dbRelay, err := suite.db.GetRelayByID(ctx, relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.RelayStateAccepted, dbRelay.State)
+	suite.Equal(relayAccount.URI, dbRelay.ActorURI)
+}
+
+func (suite *RelayTestSuite) TestRejectRelayFollowIRI() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	relay := suite.putRelay(gtsmodel.RelayStatePending, "")
+
+	// Relay rejects the Follow, by IRI only.
+	reject := streams.NewActivityStreamsReject()
+	ap.SetJSONLDId(reject, testrig.URLMustParse("http://fossbros-anonymous.io/reject/1"))
+	ap.AppendActorIRIs(reject, testrig.URLMustParse(relayAccount.URI))
+	rejectObject := streams.NewActivityStreamsObjectProperty()
+	rejectObject.AppendIRI(testrig.URLMustParse(relay.FollowURI))
+	reject.SetActivityStreamsObject(rejectObject)
+
+	if err := suite.federatingDB.Reject(ctx, reject); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbRelay, err := suite.db.GetRelayByID(ctx, relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.RelayStateRejected, dbRelay.State)
+	suite.Equal(relayAccount.URI, dbRelay.ActorURI)
+}
+
+func (suite *RelayTestSuite) TestRelayAnnounce() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	suite.putRelay(gtsmodel.RelayStateAccepted, relayAccount.URI)
+
+	announce := suite.testActivities["announce_forwarded_1_zork"]
+	if err := suite.federatingDB.Announce(ctx, announce.Activity.(vocab.ActivityStreamsAnnounce)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The announced status should be passed to
+	// the processor as a forwarded Create, not
+	// as a boost by the relay actor.
+	msg, ok := suite.getFederatorMsg(5 * time.Second)
+	if !ok {
+		suite.FailNow("expected message for processor")
+	}
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+	suite.Nil(msg.GTSModel)
+	suite.Equal("http://example.org/users/Some_User/statuses/afaba698-5740-4e32-a702-af61aa543bc1", msg.APIRI.String())
+	suite.Equal(instanceAccount.ID, msg.Receiving.ID)
+}
+
+func (suite *RelayTestSuite) TestRelayAnnounceRejected() {
+	instanceAccount := suite.testAccounts["instance_account"]
+	relayAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(instanceAccount, relayAccount)
+
+	suite.putRelay(gtsmodel.RelayStateRejected, relayAccount.URI)
+
+	announce := suite.testActivities["announce_forwarded_1_zork"]
+	if err := suite.federatingDB.Announce(ctx, announce.Activity.(vocab.ActivityStreamsAnnounce)); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Announce from a relay we're not
+	// subscribed to should be dropped.
+	_, ok := suite.getFederatorMsg(time.Second)
+	suite.False(ok)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+// DeliveryFailed records a failed delivery against the target instance
+// of the given delivery, marking the instance as unavailable when it
+// has been failing for longer than the configured thresholds. This is
+// intended to be set as (part of) the delivery WorkerPool{}.Failed func.
+func (f *Federator) DeliveryFailed(
+	ctx context.Context,
+	dlv *delivery.Delivery,
+	statusCode int,
+	_ error,
+) {
+	host := dlv.Request.URL.Host
+
+	if statusCode != 0 && statusCode < http.StatusInternalServerError {
+		// The remote responded with a client
+		// error, so it's reachable, it just
+		// didn't like what we sent. Treat
+		// this as the instance being alive.
+		f.MarkInstanceAvailable(ctx, host)
+		return
+	}
+
+	threshold := config.GetInstanceUnavailableFailures()
+	if threshold <= 0 {
+		// Tracking disabled.
+		return
+	}
+
+	instance, err := f.getDeliveryInstance(ctx, host)
+	if err != nil || instance == nil {
+		return
+	}
+
+	now := time.Now()
+	columns := []string{"delivery_failures"}
+
+	instance.DeliveryFailures++
+	if instance.DeliveryFailingSince.IsZero() {
+		// Start of a new failure streak.
+		instance.DeliveryFailingSince = now
+		columns = append(columns, "delivery_failing_since")
+	}
+
+	if !instance.IsUnavailable() &&
+		instance.DeliveryFailures >= threshold &&
+		now.Sub(instance.DeliveryFailingSince) >= config.GetInstanceUnavailableAfter() {
+		// Failed for long enough,
+		// mark instance unavailable.
+		instance.UnavailableAt = now
+		columns = append(columns, "unavailable_at")
+
+		log.Infof(ctx,
+			"marking instance %s unavailable after %d failed deliveries since %s",
+			host, instance.DeliveryFailures, instance.DeliveryFailingSince,
+		)
+	}
+
+	if err := f.db.UpdateInstance(ctx, instance, columns...); err != nil {
+		log.Errorf(ctx, "error updating instance %s: %v", host, err)
+	}
+}
+
+// Delivered clears any recorded delivery failures against the target
+// instance of the given delivery. This is intended to be set as the
+// delivery WorkerPool{}.Delivered func.
+func (f *Federator) Delivered(ctx context.Context, dlv *delivery.Delivery) {
+	f.MarkInstanceAvailable(ctx, dlv.Request.URL.Host)
+}
+
+// MarkInstanceAvailable clears any recorded delivery failures and
+// unavailable mark from the instance with the given domain, if set.
+func (f *Federator) MarkInstanceAvailable(ctx context.Context, domain string) {
+	instance, err := f.getDeliveryInstance(ctx, domain)
+	if err != nil || instance == nil {
+		return
+	}
+
+	if instance.DeliveryFailures == 0 &&
+		!instance.IsUnavailable() {
+		// Nothing to do.
+		return
+	}
+
+	if instance.IsUnavailable() {
+		log.Infof(ctx, "marking instance %s available again", domain)
+	}
+
+	instance.DeliveryFailures = 0
+	instance.DeliveryFailingSince = time.Time{}
+	instance.UnavailableAt = time.Time{}
+
+	if err := f.db.UpdateInstance(ctx, instance,
+		"delivery_failures",
+		"delivery_failing_since",
+		"unavailable_at",
+	); err != nil {
+		log.Errorf(ctx, "error updating instance %s: %v", domain, err)
+	}
+}
+
+// getDeliveryInstance fetches the instance with given domain
+// for delivery failure tracking, returning nil if not found.
+func (f *Federator) getDeliveryInstance(ctx context.Context, domain string) (*gtsmodel.Instance, error) {
+	instance, err := f.db.GetInstance(
+		gtscontext.SetBarebones(ctx),
+		domain,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting instance %s: %v", domain, err)
+		return nil, err
+	}
+	return instance, nil
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+type UnavailableTestSuite struct {
+	FederatorStandardTestSuite
+}
+
+func (suite *UnavailableTestSuite) newDelivery(host string) *delivery.Delivery {
+	req, err := http.NewRequest(http.MethodPost, "https://"+host+"/inbox", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return &delivery.Delivery{Request: httpclient.WrapRequest(req)}
+}
+
+func (suite *UnavailableTestSuite) TestDeliveryFailedMarksUnavailable() {
+	ctx := context.Background()
+	const host = "fossbros-anonymous.io"
+
+	config.SetInstanceUnavailableFailures(3)
+	config.SetInstanceUnavailableAfter(0)
+
+	dlv := suite.newDelivery(host)
+	for i := 0; i < 3; i++ {
+		suite.federator.DeliveryFailed(ctx, dlv, http.StatusBadGateway, errors.New("bad gateway"))
+	}
+
+	instance, err := suite.state.DB.GetInstance(ctx, host)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(3, instance.DeliveryFailures)
+	suite.False(instance.DeliveryFailingSince.IsZero())
+	suite.True(instance.IsUnavailable())
+
+	// A successful delivery should clear the mark.
+	suite.federator.Delivered(ctx, dlv)
+
+	instance, err = suite.state.DB.GetInstance(ctx, host)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(instance.DeliveryFailures)
+	suite.True(instance.DeliveryFailingSince.IsZero())
+	suite.False(instance.IsUnavailable())
+}
+
+func (suite *UnavailableTestSuite) TestDeliveryFailedNotLongEnough() {
+	ctx := context.Background()
+	const host = "fossbros-anonymous.io"
+
+	config.SetInstanceUnavailableFailures(1)
+	config.SetInstanceUnavailableAfter(time.Hour)
+
+	dlv := suite.newDelivery(host)
+	suite.federator.DeliveryFailed(ctx, dlv, 0, errors.New("connection refused"))
+	suite.federator.DeliveryFailed(ctx, dlv, 0, errors.New("connection refused"))
+
+	// Failing, but not for long enough to be unavailable.
+	instance, err := suite.state.DB.GetInstance(ctx, host)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, instance.DeliveryFailures)
+	suite.False(instance.IsUnavailable())
+
+	// A client error response means the
+	// instance is reachable, resetting count.
+	suite.federator.DeliveryFailed(ctx, dlv, http.StatusGone, errors.New("gone"))
+
+	instance, err = suite.state.DB.GetInstance(ctx, host)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(instance.DeliveryFailures)
+}
+
+func TestUnavailableTestSuite(t *testing.T) {
+	suite.Run(t, new(UnavailableTestSuite))
+}
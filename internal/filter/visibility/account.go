@@ -118,17 +118,21 @@ func (f *Filter) isAccountVisible(ctx context.Context, account *gtsmodel.Account
 			return true, nil
 		}
 
-		// Fetch the local user model for this account.
-		user, err := f.state.DB.GetUserByAccountID(ctx, account.ID)
-		if err != nil {
-			err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
-			return false, err
-		}
-
-		// Make sure that user is active (i.e. not disabled, not approved etc).
-		if *user.Disabled || !*user.Approved || user.ConfirmedAt.IsZero() {
-			log.Trace(ctx, "local account not active")
-			return false, nil
+		// Local groups have no user, so
+		// only check suspension for them.
+		if !account.IsGroup() {
+			// Fetch the local user model for this account.
+			user, err := f.state.DB.GetUserByAccountID(ctx, account.ID)
+			if err != nil {
+				err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
+				return false, err
+			}
+
+			// Make sure that user is active (i.e. not disabled, not approved etc).
+			if *user.Disabled || !*user.Approved || user.ConfirmedAt.IsZero() {
+				log.Trace(ctx, "local account not active")
+				return false, nil
+			}
 		}
 	} else {
 		// This is a remote account.
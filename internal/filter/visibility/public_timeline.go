@@ -91,6 +91,19 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 		return false, nil
 	}
 
+	// Check whether status author is silenced or limited.
+	hidden, err := f.isStatusHiddenFromPublic(ctx, status)
+	if err != nil {
+		return false, err
+	}
+
+	if hidden {
+		// Statuses from silenced accounts
+		// and limited domains are never
+		// shown on this timeline.
+		return false, nil
+	}
+
 	for parent := status; parent.InReplyToURI != ""; {
 		// Fetch next parent to lookup.
 		parentID := parent.InReplyToID
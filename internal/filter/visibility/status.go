@@ -22,6 +22,7 @@
 	"slices"
 
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -104,6 +105,15 @@ func (f *Filter) isStatusVisible(
 		return false, nil
 	}
 
+	// Check whether status accounts are silenced, and if
+	// so, whether the requester is allowed to see them.
+	silencedVisible, err := f.areSilencedStatusAccountsVisible(ctx, requester, status)
+	if err != nil {
+		return false, gtserror.Newf("error checking status %s silenced account visibility: %w", status.ID, err)
+	} else if !silencedVisible {
+		return false, nil
+	}
+
 	if util.PtrOrZero(status.PendingApproval) {
 		// Use a different visibility heuristic
 		// for pending approval statuses.
@@ -334,3 +344,91 @@ func (f *Filter) areStatusAccountsVisible(ctx context.Context, requester *gtsmod
 
 	return true, nil
 }
+
+// areSilencedStatusAccountsVisible checks whether status author, and the status boost-of
+// author (if set), are silenced. Statuses from silenced accounts are only visible to the
+// silenced account itself, and to accounts that follow the silenced account.
+func (f *Filter) areSilencedStatusAccountsVisible(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	for _, account := range []*gtsmodel.Account{
+		status.Account,
+		status.BoostOfAccount,
+	} {
+		if account == nil || !account.IsSilenced() {
+			// Nothing to check.
+			continue
+		}
+
+		if requester == nil {
+			// Silenced accounts are
+			// never visible unauthed.
+			return false, nil
+		}
+
+		if requester.ID == account.ID {
+			// Silenced account can
+			// always see itself.
+			continue
+		}
+
+		// Check requester follows silenced account.
+		follows, err := f.state.DB.IsFollowing(ctx,
+			requester.ID,
+			account.ID,
+		)
+		if err != nil {
+			return false, gtserror.Newf("error checking follow %s->%s: %w", requester.ID, account.ID, err)
+		}
+
+		if !follows {
+			log.Trace(ctx, "silenced account not visible to non-follower requester")
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isStatusHiddenFromPublic returns whether the given status should be kept off
+// the public and tag timelines, as its author is silenced, or the author's domain
+// is limited by a domain limit which hides its statuses from these timelines.
+func (f *Filter) isStatusHiddenFromPublic(ctx context.Context, status *gtsmodel.Status) (bool, error) {
+	account := status.Account
+	if account == nil {
+		// Status author isn't populated, fetch from database.
+		var err error
+		account, err = f.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), status.AccountID)
+		if err != nil {
+			return false, gtserror.Newf("error getting status author %s: %w", status.AccountID, err)
+		}
+	}
+
+	return f.isAccountHiddenFromPublic(ctx, account)
+}
+
+// isAccountHiddenFromPublic returns whether the given account's statuses (and
+// interactions) should be kept out of public view, as it's silenced, or its
+// domain is limited by a domain limit which hides it from public timelines.
+func (f *Filter) isAccountHiddenFromPublic(ctx context.Context, account *gtsmodel.Account) (bool, error) {
+	if account.IsSilenced() {
+		log.Trace(ctx, "account is silenced")
+		return true, nil
+	}
+
+	if account.IsLocal() {
+		// Local accounts can't
+		// be domain limited.
+		return false, nil
+	}
+
+	limit, err := f.state.DB.MatchDomainLimit(ctx, account.Domain)
+	if err != nil {
+		return false, gtserror.Newf("error checking domain limit for %s: %w", account.Domain, err)
+	}
+
+	if limit != nil && *limit.HideFromPublic {
+		log.Trace(ctx, "account domain is limited")
+		return true, nil
+	}
+
+	return false, nil
+}
@@ -20,6 +20,7 @@
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -277,6 +278,55 @@ func (suite *StatusVisibleTestSuite) TestVisibleLocalOnly() {
 	}
 }
 
+func (suite *StatusVisibleTestSuite) TestVisibleSilenced() {
+	ctx := context.Background()
+
+	// Silence local_account_1.
+	silenced := new(gtsmodel.Account)
+	*silenced = *suite.testAccounts["local_account_1"]
+	silenced.SilencedAt = time.Now()
+	if err := suite.db.UpdateAccount(ctx, silenced, "silenced_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Public status.
+	testStatus, err := suite.db.GetStatusByID(ctx, suite.testStatuses["local_account_1_status_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, testCase := range []struct {
+		acct    *gtsmodel.Account
+		visible bool
+	}{
+		{
+			acct:    suite.testAccounts["local_account_1"],
+			visible: true, // Own status, always visible.
+		},
+		{
+			acct:    nil,
+			visible: false, // No auth, should not be visible.
+		},
+		{
+			acct:    suite.testAccounts["local_account_2"],
+			visible: true, // Follower, should be visible.
+		},
+		{
+			acct:    suite.testAccounts["local_account_3"],
+			visible: false, // Not a follower, should not be visible.
+		},
+	} {
+		visible, err := suite.filter.StatusVisible(ctx, testCase.acct, testStatus)
+		suite.NoError(err)
+		suite.Equal(testCase.visible, visible)
+
+		// Should never be on public timeline.
+		timelinable, err := suite.filter.StatusPublicTimelineable(ctx, testCase.acct, testStatus)
+		suite.NoError(err)
+		suite.False(timelinable)
+	}
+}
+
 func TestStatusVisibleTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusVisibleTestSuite))
 }
@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// AccountSuggestable checks if given account may be suggested for the requester to follow.
+// Accounts not visible to the requester, the instance account, and moved accounts are never
+// suggested. Unless featured by an admin, only discoverable accounts are suggested, and never
+// those which are silenced, or limited by a domain limit which hides them from public timelines.
+func (f *Filter) AccountSuggestable(ctx context.Context, requester *gtsmodel.Account, account *gtsmodel.Account, featured bool) (bool, error) {
+	if account.IsInstance() {
+		log.Trace(ctx, "account is instance account")
+		return false, nil
+	}
+
+	if account.IsMoving() {
+		log.Trace(ctx, "account has moved")
+		return false, nil
+	}
+
+	// Check whether account is visible to requesting account.
+	visible, err := f.AccountVisible(ctx, requester, account)
+	if err != nil {
+		return false, err
+	}
+
+	if !visible {
+		log.Trace(ctx, "account not visible to requester")
+		return false, nil
+	}
+
+	if featured {
+		// Admin chose to feature
+		// this account, allow it.
+		return true, nil
+	}
+
+	if !util.PtrOrZero(account.Discoverable) {
+		log.Trace(ctx, "account not discoverable")
+		return false, nil
+	}
+
+	hidden, err := f.isAccountHiddenFromPublic(ctx, account)
+	if err != nil {
+		return false, err
+	}
+
+	return !hidden, nil
+}
@@ -55,6 +55,19 @@ func (f *Filter) StatusTagTimelineable(
 		return false, nil
 	}
 
+	// Check whether status author is silenced or limited.
+	hidden, err := f.isStatusHiddenFromPublic(ctx, status)
+	if err != nil {
+		return false, err
+	}
+
+	if hidden {
+		// Statuses from silenced accounts
+		// and limited domains are never
+		// shown on this timeline.
+		return false, nil
+	}
+
 	// Looks good!
 	return true, nil
 }
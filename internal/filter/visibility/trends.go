@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// StatusTrendable checks if given status may contribute to trends, or be shown as
+// a trending status to the requester (which may be nil, for no requester). Only
+// public, non-sensitive, top-level statuses by discoverable accounts are trendable,
+// and never those by accounts which are silenced, or limited by a domain limit.
+func (f *Filter) StatusTrendable(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	if status.BoostOfID != "" {
+		// Boosts themselves don't
+		// trend, only their targets.
+		return false, nil
+	}
+
+	if status.Visibility != gtsmodel.VisibilityPublic {
+		log.Trace(ctx, "status not public")
+		return false, nil
+	}
+
+	if util.PtrOrZero(status.Sensitive) {
+		log.Trace(ctx, "status is sensitive")
+		return false, nil
+	}
+
+	if status.InReplyToURI != "" {
+		log.Trace(ctx, "status is a reply")
+		return false, nil
+	}
+
+	// Check whether status is visible to requesting account.
+	visible, err := f.StatusVisible(ctx, requester, status)
+	if err != nil {
+		return false, err
+	}
+
+	if !visible {
+		log.Trace(ctx, "status not visible to requester")
+		return false, nil
+	}
+
+	account := status.Account
+	if account == nil {
+		// Status author isn't populated, fetch from database.
+		account, err = f.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), status.AccountID)
+		if err != nil {
+			return false, gtserror.Newf("error getting status author %s: %w", status.AccountID, err)
+		}
+	}
+
+	if !util.PtrOrZero(account.Discoverable) {
+		log.Trace(ctx, "status author not discoverable")
+		return false, nil
+	}
+
+	return f.AccountTrendable(ctx, account)
+}
+
+// AccountTrendable checks if interactions (ie., boosts and faves) by the given
+// account may contribute to trends, which they can't if the account is suspended,
+// silenced, or limited by a domain limit which hides it from public timelines.
+func (f *Filter) AccountTrendable(ctx context.Context, account *gtsmodel.Account) (bool, error) {
+	if account.IsSuspended() {
+		log.Trace(ctx, "account is suspended")
+		return false, nil
+	}
+
+	hidden, err := f.isAccountHiddenFromPublic(ctx, account)
+	if err != nil {
+		return false, err
+	}
+
+	return !hidden, nil
+}
@@ -107,11 +107,24 @@ func (a *Account) IsInstance() bool {
 	}
 
 	// Check if remote instance account.
-	return a.Username == a.Domain ||
-		a.FollowersURI == "" ||
-		a.FollowingURI == "" ||
-		(a.Username == "internal.fetch" && strings.Contains(a.Note, "internal service actor")) ||
-		a.Username == "instance.actor" // <- misskey
+	if a.Username == a.Domain ||
+		a.Username == "instance.actor" || // <- misskey
+		(a.Username == "internal.fetch" && strings.Contains(a.Note, "internal service actor")) {
+		return true
+	}
+
+	// Instance actors often don't have follow
+	// collections, but neither do some groups
+	// (eg., Lemmy communities have no following).
+	return !a.IsGroup() &&
+		(a.FollowersURI == "" || a.FollowingURI == "")
+}
+
+// IsGroup returns whether account is a group actor,
+// ie., an account that members join by following it,
+// and which boosts posts that mention it to members.
+func (a *Account) IsGroup() bool {
+	return a.ActorType == "Group" // ap.ActorGroup
 }
 
 // EmojisPopulated returns whether emojis are
@@ -181,6 +194,19 @@ func (a *Account) IsSuspended() bool {
 	return !a.SuspendedAt.IsZero()
 }
 
+// IsSilenced returns true if account
+// has been silenced on this instance.
+func (a *Account) IsSilenced() bool {
+	return !a.SilencedAt.IsZero()
+}
+
+// IsSensitized returns true if account has
+// been set to have all its media marked as
+// sensitive on this instance.
+func (a *Account) IsSensitized() bool {
+	return !a.SensitizedAt.IsZero()
+}
+
 // IsMoving returns true if
 // account is Moving or has Moved.
 func (a *Account) IsMoving() bool {
@@ -73,6 +73,8 @@ func NewAdminActionCategory(in string) AdminActionCategory {
 	AdminActionSuspend
 	AdminActionUnsuspend
 	AdminActionExpireKeys
+	AdminActionSensitive
+	AdminActionUnsensitive
 )
 
 func (t AdminActionType) String() string {
@@ -91,6 +93,10 @@ func (t AdminActionType) String() string {
 		return "unsuspend"
 	case AdminActionExpireKeys:
 		return "expire-keys"
+	case AdminActionSensitive:
+		return "sensitive"
+	case AdminActionUnsensitive:
+		return "unsensitive"
 	default:
 		return "unknown"
 	}
@@ -112,6 +118,10 @@ func NewAdminActionType(in string) AdminActionType {
 		return AdminActionUnsuspend
 	case "expire-keys":
 		return AdminActionExpireKeys
+	case "sensitive":
+		return AdminActionSensitive
+	case "unsensitive":
+		return AdminActionUnsensitive
 	default:
 		return AdminActionUnknown
 	}
@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Announcement represents an instance-wide
+// announcement created by an admin, shown
+// to local users once it's been published.
+type Announcement struct {
+	ID                  string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Text                string    `bun:""`                                                            // markdown source text of the announcement, as entered by the admin
+	Content             string    `bun:""`                                                            // html-formatted content of the announcement, parsed from Text
+	EmojiIDs            []string  `bun:"emojis,array"`                                                // database IDs of any emojis used in the announcement
+	Emojis              []*Emoji  `bun:"-"`                                                           // emojis corresponding to EmojiIDs
+	TagIDs              []string  `bun:"tags,array"`                                                  // database IDs of any tags used in the announcement
+	Tags                []*Tag    `bun:"-"`                                                           // tags corresponding to TagIDs
+	MentionedAccountIDs []string  `bun:"mentioned_account_ids,array"`                                 // database IDs of any accounts mentioned in the announcement
+	StartsAt            time.Time `bun:"type:timestamptz,nullzero"`                                   // when the event the announcement refers to starts, if any
+	EndsAt              time.Time `bun:"type:timestamptz,nullzero"`                                   // when the announcement stops being shown, if ever
+	AllDay              *bool     `bun:",nullzero,notnull,default:false"`                             // StartsAt and EndsAt refer to whole days rather than times
+	ScheduledAt         time.Time `bun:"type:timestamptz,nullzero"`                                   // when the announcement is to be published, if it was scheduled
+	PublishedAt         time.Time `bun:"type:timestamptz,nullzero"`                                   // when the announcement was published, zero if not (yet) published
+	CreatedByAccountID  string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that created the announcement
+}
+
+// IsPublished returns true if the announcement has been published.
+func (a *Announcement) IsPublished() bool {
+	return !a.PublishedAt.IsZero()
+}
+
+// IsEnded returns true if the announcement
+// has an end time which has already passed.
+func (a *Announcement) IsEnded() bool {
+	return !a.EndsAt.IsZero() && !a.EndsAt.After(time.Now())
+}
+
+// IsActive returns true if the announcement has
+// been published and has not yet ended, ie., it
+// should currently be shown to users.
+func (a *Announcement) IsActive() bool {
+	return a.IsPublished() && !a.IsEnded()
+}
+
+// AnnouncementReaction represents an emoji
+// reaction by an account to an announcement.
+type AnnouncementReaction struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                          // id of this item in the database
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                       // when was item created
+	AnnouncementID string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reactions_announcement_id_account_id_name_uniq"` // id of the announcement reacted to
+	AccountID      string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reactions_announcement_id_account_id_name_uniq"` // id of the account that reacted
+	Name           string    `bun:",nullzero,notnull,unique:announcement_reactions_announcement_id_account_id_name_uniq"`              // unicode emoji or custom emoji shortcode of the reaction
+	EmojiID        string    `bun:"type:CHAR(26),nullzero"`                                                                            // id of the local custom emoji corresponding to Name, if any
+	Emoji          *Emoji    `bun:"-"`                                                                                                 // custom emoji corresponding to EmojiID
+}
+
+// AnnouncementRead represents an account
+// having read (dismissed) an announcement.
+type AnnouncementRead struct {
+	ID             string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                 // id of this item in the database
+	CreatedAt      time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                              // when was item created
+	AnnouncementID string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reads_announcement_id_account_id_uniq"` // id of the announcement read
+	AccountID      string    `bun:"type:CHAR(26),nullzero,notnull,unique:announcement_reads_announcement_id_account_id_uniq"` // id of the account that read the announcement
+}
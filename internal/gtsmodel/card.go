@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Card represents a preview card for a web
+// link included in a status, generated using
+// the OpenGraph and oEmbed metadata of the
+// page at URL. Cards are shared between all
+// statuses that link to the same URL.
+type Card struct {
+	ID             string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt      time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt      time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	FetchedAt      time.Time        `bun:"type:timestamptz,nullzero"`                                   // when was the page at URL last (attempted to be) fetched
+	URL            string           `bun:",nullzero,notnull,unique"`                                    // url of the linked page
+	Title          string           `bun:""`                                                            // title of the linked page
+	Description    string           `bun:""`                                                            // description of the linked page
+	Type           CardType         `bun:",nullzero,notnull,default:1"`                                 // type of preview card
+	AuthorName     string           `bun:""`                                                            // name of the author of the linked page
+	AuthorURL      string           `bun:""`                                                            // url of the author of the linked page
+	ProviderName   string           `bun:""`                                                            // name of the provider of the linked page, eg., site name
+	ProviderURL    string           `bun:""`                                                            // url of the provider of the linked page
+	HTML           string           `bun:""`                                                            // oEmbed html for rich and video cards
+	Width          int              `bun:",nullzero"`                                                   // width of the oEmbed html or photo, in pixels
+	Height         int              `bun:",nullzero"`                                                   // height of the oEmbed html or photo, in pixels
+	EmbedURL       string           `bun:""`                                                            // url of the embedded photo, for photo cards
+	ImageRemoteURL string           `bun:""`                                                            // remote url of the preview thumbnail, if any
+	ImageID        string           `bun:"type:CHAR(26),nullzero"`                                      // id of the locally cached preview thumbnail, if any
+	Image          *MediaAttachment `bun:"-"`                                                           // locally cached preview thumbnail corresponding to ImageID
+}
+
+// HasContent returns whether the card has enough
+// information set to be worth showing to clients.
+func (c *Card) HasContent() bool {
+	return c.Title != ""
+}
+
+// CardType refers to the
+// type of a preview card.
+type CardType int
+
+const (
+	// Preview card types.
+	CardTypeUnknown CardType = 0 // CardTypeUnknown is for cards of unknown type
+	CardTypeLink    CardType = 1 // CardTypeLink is for plain links to a page
+	CardTypePhoto   CardType = 2 // CardTypePhoto is for embedded photos
+	CardTypeVideo   CardType = 3 // CardTypeVideo is for embedded video players
+	CardTypeRich    CardType = 4 // CardTypeRich is for other embedded (iframe) html
+)
+
+// String returns a stringified, frontend API compatible form of CardType.
+func (t CardType) String() string {
+	switch t {
+	case CardTypeLink:
+		return "link"
+	case CardTypePhoto:
+		return "photo"
+	case CardTypeVideo:
+		return "video"
+	case CardTypeRich:
+		return "rich"
+	default:
+		panic("invalid card type")
+	}
+}
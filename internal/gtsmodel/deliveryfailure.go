@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DeliveryFailure represents an outgoing ActivityPub
+// delivery that could not be delivered to its target,
+// either because it reached the maximum number of
+// retry attempts, or because the remote responded
+// with a non-retryable error. The serialized delivery
+// is stored so that it can be retried by an admin.
+type DeliveryFailure struct {
+	ID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created (ie., when delivery was given up on)
+	TargetHost string    `bun:",nullzero,notnull"`                                           // host of the inbox this delivery was addressed to
+	RequestURL string    `bun:",nullzero,notnull"`                                           // URL of the inbox this delivery was addressed to
+	ActorID    string    `bun:",nullzero"`                                                   // ActivityPub ID IRI of the actor of the delivered activity (if any)
+	ObjectID   string    `bun:",nullzero"`                                                   // ActivityPub ID IRI of the object of the delivered activity (if any)
+	TargetID   string    `bun:",nullzero"`                                                   // ActivityPub ID IRI of the target of the delivered activity (if any)
+	StatusCode int       `bun:",nullzero"`                                                   // HTTP status code of the last delivery attempt (if any)
+	Error      string    `bun:",nullzero"`                                                   // error message of the last delivery attempt
+	Attempts   int       `bun:",nullzero,notnull,default:0"`                                 // number of delivery attempts made before giving up
+	Data       []byte    `bun:",nullzero,notnull"`                                           // serialized delivery, for retrying
+}
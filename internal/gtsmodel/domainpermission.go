@@ -20,7 +20,7 @@
 import "time"
 
 // DomainPermission models a domain
-// permission entry (block/allow).
+// permission entry (block/allow/limit).
 type DomainPermission interface {
 	GetID() string
 	GetCreatedAt() time.Time
@@ -42,6 +42,7 @@ type DomainPermission interface {
 	DomainPermissionUnknown DomainPermissionType = iota
 	DomainPermissionBlock                        // Explicitly block a domain.
 	DomainPermissionAllow                        // Explicitly allow a domain.
+	DomainPermissionLimit                        // Limit content from a domain.
 )
 
 func (p DomainPermissionType) String() string {
@@ -50,6 +51,8 @@ func (p DomainPermissionType) String() string {
 		return "block"
 	case DomainPermissionAllow:
 		return "allow"
+	case DomainPermissionLimit:
+		return "limit"
 	default:
 		return "unknown"
 	}
@@ -61,6 +64,8 @@ func NewDomainPermissionType(in string) DomainPermissionType {
 		return DomainPermissionBlock
 	case "allow":
 		return DomainPermissionAllow
+	case "limit":
+		return DomainPermissionLimit
 	default:
 		return DomainPermissionUnknown
 	}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainPermissionSubscription represents a remote list of domain
+// permissions (blocks or allows) that this instance subscribes to.
+// The list is fetched periodically, and domain permissions are
+// created, adopted, or orphaned according to its contents.
+type DomainPermissionSubscription struct {
+	ID                    string                   `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt             time.Time                `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt             time.Time                `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Priority              uint8                    `bun:",notnull,default:0"`                                          // priority of this subscription compared to others of the same permission type, higher wins
+	Title                 string                   `bun:",nullzero"`                                                   // moderator-set title for this list
+	PermissionType        DomainPermissionType     `bun:",notnull"`                                                    // permission type of the subscription
+	AsDraft               *bool                    `bun:",nullzero,notnull,default:true"`                              // create domain permission entries resulting from this subscription as drafts
+	AdoptOrphans          *bool                    `bun:",nullzero,notnull,default:false"`                             // take ownership of existing manually-created domain permissions that appear in this list
+	CreatedByAccountID    string                   `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this subscription
+	CreatedByAccount      *Account                 `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	URI                   string                   `bun:",nullzero,notnull,unique"`                                    // URI of the domain permission list
+	ContentType           DomainPermSubContentType `bun:",notnull"`                                                    // content type to expect from the URI
+	FetchUsername         string                   `bun:",nullzero"`                                                   // username to send when doing a GET of URI using basic auth
+	FetchPassword         string                   `bun:",nullzero"`                                                   // password to send when doing a GET of URI using basic auth
+	FetchedAt             time.Time                `bun:"type:timestamptz,nullzero"`                                   // when was the URI last fetched (successfully or not)
+	SuccessfullyFetchedAt time.Time                `bun:"type:timestamptz,nullzero"`                                   // when was the URI last fetched successfully
+	LastModified          time.Time                `bun:"type:timestamptz,nullzero"`                                   // "Last-Modified" time received from the server (if any) on last successful fetch
+	ETag                  string                   `bun:"etag,nullzero"`                                               // "ETag" header last received from the server (if any) on last successful fetch
+	Error                 string                   `bun:",nullzero"`                                                   // error message of the last unsuccessful fetch (if any)
+}
+
+// DomainPermSubContentType represents the content
+// type of the list at a domain permission subscription's URI.
+type DomainPermSubContentType uint8
+
+const (
+	DomainPermSubContentTypeUnknown DomainPermSubContentType = 0 // ???
+	DomainPermSubContentTypeCSV     DomainPermSubContentType = 1 // text/csv
+	DomainPermSubContentTypeJSON    DomainPermSubContentType = 2 // application/json
+	DomainPermSubContentTypePlain   DomainPermSubContentType = 3 // text/plain
+)
+
+func (p DomainPermSubContentType) String() string {
+	switch p {
+	case DomainPermSubContentTypeCSV:
+		return "text/csv"
+	case DomainPermSubContentTypeJSON:
+		return "application/json"
+	case DomainPermSubContentTypePlain:
+		return "text/plain"
+	default:
+		return "unknown"
+	}
+}
+
+func NewDomainPermSubContentType(in string) DomainPermSubContentType {
+	switch in {
+	case "text/csv":
+		return DomainPermSubContentTypeCSV
+	case "application/json":
+		return DomainPermSubContentTypeJSON
+	case "text/plain":
+		return DomainPermSubContentTypePlain
+	default:
+		return DomainPermSubContentTypeUnknown
+	}
+}
@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// FeaturedTag represents a hashtag that an
+// account has chosen to feature on their profile.
+type FeaturedTag struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID string    `bun:"type:CHAR(26),unique:featuredtagaccounttag,nullzero,notnull"` // id of the account featuring the tag
+	Account   *Account  `bun:"-"`                                                           // account corresponding to AccountID
+	TagID     string    `bun:"type:CHAR(26),unique:featuredtagaccounttag,nullzero,notnull"` // id of the featured tag
+	Tag       *Tag      `bun:"-"`                                                           // tag corresponding to TagID
+}
+
+// FeaturedTagStats contains statistics about an account's
+// usage of a featured tag. These are not stored in the
+// database, but instead calculated on the fly.
+type FeaturedTagStats struct {
+	StatusesCount int       // number of public statuses by the account using the tag
+	LastStatusAt  time.Time // time of most recent public status by the account using the tag, if any
+}
@@ -0,0 +1,32 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// GroupModerator represents a local account which
+// may moderate the members of a local group, ie.,
+// approve or reject requests to join, and remove
+// or ban members, via the client API.
+type GroupModerator struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	GroupID   string    `bun:"type:CHAR(26),nullzero,notnull,unique:groupaccount"`          // id of the local group account being moderated
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull,unique:groupaccount"`          // id of the local account moderating the group
+	Account   *Account  `bun:"-"`                                                           // moderating account corresponding to AccountID
+}
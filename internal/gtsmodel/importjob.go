@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// ImportJob represents a CSV data import uploaded
+// by a local account, which is processed row by row
+// in the background by the processing worker pool.
+//
+// Import jobs that have not finished by the time the
+// instance shuts down are persisted as worker tasks,
+// and restarted from the beginning on startup. Jobs
+// that failed outright are not restarted.
+type ImportJob struct {
+	ID            string         `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID     string         `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the local account that uploaded this import
+	Account       *Account       `bun:"-"`                                                           // account corresponding to AccountID
+	Type          string         `bun:",nullzero,notnull"`                                           // type of entries in the data, eg., "following", "blocks"
+	Overwrite     *bool          `bun:",nullzero,notnull,default:false"`                             // replace existing entries with entries in the data, rather than merging
+	Data          []byte         `bun:",nullzero,notnull"`                                           // raw uploaded CSV data
+	State         ImportJobState `bun:",nullzero,notnull,default:1"`                                 // processing state of this import
+	TotalRows     int            `bun:",notnull,default:0"`                                          // number of rows in the data, not including any header row
+	ProcessedRows int            `bun:",notnull,default:0"`                                          // number of rows processed so far
+	Successes     int            `bun:",notnull,default:0"`                                          // number of rows processed successfully so far
+	Failures      int            `bun:",notnull,default:0"`                                          // number of rows that failed to process so far
+	FinishedAt    time.Time      `bun:"type:timestamptz,nullzero"`                                   // time at which processing finished or failed, zero if neither
+	Error         string         `bun:",nullzero"`                                                   // reason processing of the whole job failed, if failed
+}
+
+// Finished returns true if this import
+// job has been completely processed.
+func (j *ImportJob) Finished() bool {
+	return j.State == ImportJobStateFinished
+}
+
+// Failed returns true if this import job
+// could not be processed, and so will
+// not be retried.
+func (j *ImportJob) Failed() bool {
+	return j.State == ImportJobStateFailed
+}
+
+// ImportJobState describes the
+// processing state of an import job.
+type ImportJobState uint8
+
+const (
+	ImportJobStateUnknown    ImportJobState = 0 // ???
+	ImportJobStateQueued     ImportJobState = 1 // Waiting to be processed.
+	ImportJobStateProcessing ImportJobState = 2 // Rows currently being processed.
+	ImportJobStateFinished   ImportJobState = 3 // All rows processed.
+	ImportJobStateFailed     ImportJobState = 4 // Job could not be processed.
+)
+
+// String returns a stringified, frontend API compatible form of ImportJobState.
+func (s ImportJobState) String() string {
+	switch s {
+	case ImportJobStateQueued:
+		return "queued"
+	case ImportJobStateProcessing:
+		return "processing"
+	case ImportJobStateFinished:
+		return "finished"
+	case ImportJobStateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// ImportJobFailure represents a single row of
+// an import job that could not be processed.
+type ImportJobFailure struct {
+	ID          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	ImportJobID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the import job this failure belongs to
+	RowNumber   int       `bun:",notnull"`                                                    // 1-indexed number of the failed row in the uploaded data
+	Record      []string  `bun:",array"`                                                      // fields of the failed CSV row
+	Reason      string    `bun:",nullzero"`                                                   // human-readable reason for the failure
+}
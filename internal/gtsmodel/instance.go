@@ -43,4 +43,13 @@ type Instance struct {
 	Reputation             int64        `bun:",notnull,default:0"`                                          // Reputation score of this instance
 	Version                string       `bun:",nullzero"`                                                   // Version of the software used on this instance
 	Rules                  []Rule       `bun:"-"`                                                           // List of instance rules
+	DeliveryFailures       int          `bun:",notnull,default:0"`                                          // Number of consecutive failed deliveries to this instance.
+	DeliveryFailingSince   time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did the current streak of failed deliveries to this instance begin, if at all?
+	UnavailableAt          time.Time    `bun:"type:timestamptz,nullzero"`                                   // When was this instance marked as unavailable for deliveries, if at all?
+}
+
+// IsUnavailable returns whether this instance has been
+// marked as unavailable due to repeated delivery failures.
+func (i *Instance) IsUnavailable() bool {
+	return !i.UnavailableAt.IsZero()
 }
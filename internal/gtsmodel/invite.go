@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Invite represents an invite link created by a local
+// account, which can be used to sign up to this instance
+// even when registration is closed.
+//
+// Users who sign up via an invite link are
+// approved automatically, and the ID of the
+// invite is stored on their User as InviteID.
+type Invite struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Code               string    `bun:",nullzero,notnull,unique"`                                    // URL-safe random code used in the invite link
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the local account that created this invite
+	CreatedByAccount   *Account  `bun:"-"`                                                           // account corresponding to CreatedByAccountID
+	MaxUses            int       `bun:",nullzero,notnull,default:0"`                                 // maximum number of sign-ups permitted with this invite, 0 means no maximum
+	Uses               int       `bun:",nullzero,notnull,default:0"`                                 // number of sign-ups that have used this invite so far
+	ExpiresAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // time after which this invite can no longer be used, zero means never
+	RevokedAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // time at which this invite was revoked by its creator or an admin, zero if not revoked
+}
+
+// Expired returns true if this
+// invite has an expiry in the past.
+func (i *Invite) Expired() bool {
+	return !i.ExpiresAt.IsZero() &&
+		time.Now().After(i.ExpiresAt)
+}
+
+// Revoked returns true if this invite has been revoked.
+func (i *Invite) Revoked() bool {
+	return !i.RevokedAt.IsZero()
+}
+
+// UsedUp returns true if this invite has
+// a maximum number of uses, and it's been
+// used that many times already.
+func (i *Invite) UsedUp() bool {
+	return i.MaxUses != 0 && i.Uses >= i.MaxUses
+}
+
+// Usable returns true if this invite is not
+// expired, revoked, or used up, ie., if it
+// can still be used to sign up.
+func (i *Invite) Usable() bool {
+	return !i.Expired() && !i.Revoked() && !i.UsedUp()
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Relay represents an ActivityPub relay that this
+// instance subscribes to via its instance actor.
+//
+// Public local statuses are additionally delivered to
+// the inbox of accepted relays, and Announces received
+// from relay actors are ingested as plain statuses.
+type Relay struct {
+	ID        string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	InboxURI  string     `bun:",nullzero,notnull,unique"`                                    // inbox of the relay, as given by the admin
+	FollowURI string     `bun:",nullzero,notnull,unique"`                                    // ActivityPub ID of the Follow sent by the instance actor to the relay
+	ActorURI  string     `bun:",nullzero"`                                                   // ActivityPub ID of the relay actor, set once the relay accepts the Follow
+	State     RelayState `bun:",nullzero,notnull,default:1"`                                 // state of the Follow handshake with the relay
+}
+
+// Accepted returns true if the relay
+// has accepted the instance's Follow.
+func (r *Relay) Accepted() bool {
+	return r.State == RelayStateAccepted
+}
+
+// RelayState describes the state of the
+// Follow handshake with a relay.
+type RelayState uint8
+
+const (
+	RelayStateUnknown  RelayState = 0 // ???
+	RelayStatePending  RelayState = 1 // Follow sent, no response yet.
+	RelayStateAccepted RelayState = 2 // Follow accepted by relay.
+	RelayStateRejected RelayState = 3 // Follow rejected by relay.
+)
+
+// String returns a stringified, frontend API compatible form of RelayState.
+func (s RelayState) String() string {
+	switch s {
+	case RelayStatePending:
+		return "pending"
+	case RelayStateAccepted:
+		return "accepted"
+	case RelayStateRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
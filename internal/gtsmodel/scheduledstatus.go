@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// ScheduledStatus represents a status created by a local
+// account that is to be published at some later scheduled
+// time. It stores the status creation parameters, which
+// will be processed into a Status once the time arrives.
+type ScheduledStatus struct {
+	ID                string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt         time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt         time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID         string             `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that scheduled this status
+	Account           *Account           `bun:"-"`                                                           // account corresponding to AccountID
+	ScheduledAt       time.Time          `bun:"type:timestamptz,nullzero,notnull"`                           // time at which the status should be published
+	Text              string             `bun:""`                                                            // text content of the status, unformatted
+	ContentWarning    string             `bun:",nullzero"`                                                   // content warning / spoiler text of the status
+	ContentType       string             `bun:",nullzero"`                                                   // content type to parse text with, else account default
+	Sensitive         *bool              `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive?
+	Visibility        Visibility         `bun:",nullzero"`                                                   // visibility of the status, else account default
+	LocalOnly         *bool              `bun:",nullzero,notnull,default:false"`                             // publish the status as local-only?
+	Language          string             `bun:",nullzero"`                                                   // language of the status, else account default
+	InReplyToID       string             `bun:"type:CHAR(26),nullzero"`                                      // id of the status being replied to, if any
+	MediaIDs          []string           `bun:"attachments,array"`                                           // database IDs of media attachments to attach to the status
+	MediaAttachments  []*MediaAttachment `bun:"-"`                                                           // media attachments relating to MediaIDs (not always populated)
+	PollOptions       []string           `bun:",array"`                                                      // options of the status poll, if any
+	PollExpiresIn     int                `bun:",nullzero"`                                                   // duration the poll should be open for, in seconds
+	PollMultiple      *bool              `bun:",nullzero"`                                                   // is the poll multiple choice?
+	PollHideTotals    *bool              `bun:",nullzero"`                                                   // should the poll hide vote counts until it ends?
+	InteractionPolicy *InteractionPolicy `bun:""`                                                            // interaction policy of the status, else account default
+	ApplicationID     string             `bun:"type:CHAR(26),nullzero"`                                      // id of the application used to schedule the status
+	Application       *Application       `bun:"-"`                                                           // application corresponding to ApplicationID
+	Error             string             `bun:",nullzero"`                                                   // reason publishing the status failed, if failed
+}
+
+// Failed returns true if publishing
+// this scheduled status failed.
+func (s *ScheduledStatus) Failed() bool {
+	return s.Error != ""
+}
+
+// HasPoll returns whether the scheduled status will be published with a poll.
+func (s *ScheduledStatus) HasPoll() bool {
+	return len(s.PollOptions) > 0
+}
+
+// AttachmentsPopulated returns whether media attachments
+// are populated according to current MediaIDs.
+func (s *ScheduledStatus) AttachmentsPopulated() bool {
+	if len(s.MediaIDs) != len(s.MediaAttachments) {
+		// this is the quickest indicator.
+		return false
+	}
+	for i, id := range s.MediaIDs {
+		if s.MediaAttachments[i].ID != id {
+			return false
+		}
+	}
+	return true
+}
@@ -27,6 +27,7 @@ type Status struct {
 	ID                       string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
 	CreatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
 	UpdatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	EditedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // when this status was last edited (if set)
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time.
 	URI                      string             `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this status
@@ -57,6 +58,10 @@ type Status struct {
 	ThreadID                 string             `bun:"type:CHAR(26),nullzero"`                                      // id of the thread to which this status belongs; only set for remote statuses if a local account is involved at some point in the thread, otherwise null
 	PollID                   string             `bun:"type:CHAR(26),nullzero"`                                      //
 	Poll                     *Poll              `bun:"-"`                                                           //
+	EditIDs                  []string           `bun:"edits,array"`                                                 // IDs of historical edits of this status, in order of creation
+	Edits                    []*StatusEdit      `bun:"-"`                                                           // Historical edits of this status, corresponding to EditIDs
+	CardID                   string             `bun:"type:CHAR(26),nullzero"`                                      // id of the preview card for the first link in this status, if any
+	Card                     *Card              `bun:"-"`                                                           // preview card corresponding to CardID
 	ContentWarning           string             `bun:",nullzero"`                                                   // cw string for this status
 	Visibility               Visibility         `bun:",nullzero,notnull"`                                           // visibility entry for this status
 	Sensitive                *bool              `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive?
@@ -148,6 +153,20 @@ func (s *Status) EmojisPopulated() bool {
 	return true
 }
 
+// EditsPopulated returns whether edits are populated according to current EditIDs.
+func (s *Status) EditsPopulated() bool {
+	if len(s.EditIDs) != len(s.Edits) {
+		// this is the quickest indicator.
+		return false
+	}
+	for i, id := range s.EditIDs {
+		if s.Edits[i].ID != id {
+			return false
+		}
+	}
+	return true
+}
+
 // EmojissUpToDate returns whether status emoji attachments of receiving status are up-to-date
 // according to emoji attachments of the passed status, by comparing their emoji URIs. We don't
 // use IDs as this is used to determine whether there are new emojis to fetch.
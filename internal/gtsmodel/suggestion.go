@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// FeaturedAccount represents an account which
+// an admin has chosen to feature in the follow
+// suggestions shown to users of this instance.
+type FeaturedAccount struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	AccountID          string    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // id of the featured account
+	Account            *Account  `bun:"-"`                                                           // featured account corresponding to AccountID
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the admin account that featured the account
+}
+
+// SuggestionDismissal represents an account
+// which a user has dismissed from their follow
+// suggestions, so that it's not suggested again.
+type SuggestionDismissal struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                      // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                   // when was item created
+	AccountID       string    `bun:"type:CHAR(26),nullzero,notnull,unique:suggestion_dismissals_account_id_target_account_id_uniq"` // id of the account that dismissed the suggestion
+	TargetAccountID string    `bun:"type:CHAR(26),nullzero,notnull,unique:suggestion_dismissals_account_id_target_account_id_uniq"` // id of the dismissed account
+}
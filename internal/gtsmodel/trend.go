@@ -0,0 +1,131 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+const (
+	// TrendWindow is the period over which uses
+	// are counted when ranking trends against
+	// one another, and which statuses must
+	// have been created within to trend.
+	TrendWindow = 48 * time.Hour
+
+	// TrendHistoryDays is the number of days of
+	// daily usage history kept for each trend.
+	TrendHistoryDays = 7
+)
+
+// Trend represents a tag, status or link preview
+// card which has been used, boosted / faved or
+// shared recently, and the admin review state
+// which governs whether it may be shown publicly.
+type Trend struct {
+	ID                  string     `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                         // id of this item in the database
+	CreatedAt           time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`      // when was item created
+	UpdatedAt           time.Time  `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`      // when was item last updated
+	Type                TrendType  `bun:",nullzero,notnull,unique:trends_type_target_id_uniq"`              // type of the trending item
+	TargetID            string     `bun:"type:CHAR(26),nullzero,notnull,unique:trends_type_target_id_uniq"` // id of the trending tag, status or card
+	State               TrendState `bun:",nullzero,notnull,default:1"`                                      // admin review state of this trend
+	ReviewedByAccountID string     `bun:"type:CHAR(26),nullzero"`                                           // id of the admin account that last reviewed this trend, if any
+	ReviewedAt          time.Time  `bun:"type:timestamptz,nullzero"`                                        // when was this trend last reviewed
+}
+
+// TrendUse represents a single use of a trend by an
+// account, from which windowed usage is counted: a
+// status using a tag or link, or a boost or fave of
+// a status.
+type TrendUse struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                 // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
+	TrendID   string    `bun:"type:CHAR(26),nullzero,notnull,unique:trend_uses_trend_id_source_id_uniq"` // id of the trend that was used
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                                           // id of the account that used the trend
+	SourceID  string    `bun:"type:CHAR(26),nullzero,notnull,unique:trend_uses_trend_id_source_id_uniq"` // id of the status, boost or fave constituting this use
+}
+
+// TrendType refers to the type of a trending item.
+type TrendType uint8
+
+const (
+	TrendTypeUnknown TrendType = iota
+	TrendTypeTag               // Trending hashtag.
+	TrendTypeStatus            // Trending status.
+	TrendTypeLink              // Trending link preview card.
+)
+
+func (t TrendType) String() string {
+	switch t {
+	case TrendTypeTag:
+		return "tag"
+	case TrendTypeStatus:
+		return "status"
+	case TrendTypeLink:
+		return "link"
+	default:
+		return "unknown"
+	}
+}
+
+func NewTrendType(in string) TrendType {
+	switch in {
+	case "tag":
+		return TrendTypeTag
+	case "status":
+		return TrendTypeStatus
+	case "link":
+		return TrendTypeLink
+	default:
+		return TrendTypeUnknown
+	}
+}
+
+// TrendState refers to the admin review state of a trend.
+type TrendState uint8
+
+const (
+	TrendStateUnknown  TrendState = iota
+	TrendStatePending             // Not yet reviewed, not shown publicly.
+	TrendStateApproved            // Approved, shown publicly while trending.
+	TrendStateRejected            // Rejected, never shown publicly.
+)
+
+func (s TrendState) String() string {
+	switch s {
+	case TrendStatePending:
+		return "pending"
+	case TrendStateApproved:
+		return "approved"
+	case TrendStateRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+func NewTrendState(in string) TrendState {
+	switch in {
+	case "pending":
+		return TrendStatePending
+	case "approved":
+		return TrendStateApproved
+	case "rejected":
+		return TrendStateRejected
+	default:
+		return TrendStateUnknown
+	}
+}
@@ -39,7 +39,7 @@ type User struct {
 	Account                *Account     `bun:"rel:belongs-to"`                                              // Pointer to the account of this user that corresponds to AccountID.
 	EncryptedPassword      string       `bun:",nullzero,notnull"`                                           // The encrypted password of this user, generated using https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword. A salt is included so we're safe against 🌈 tables.
 	SignUpIP               net.IP       `bun:",nullzero"`                                                   // IP this user used to sign up. Only stored for pending sign-ups.
-	InviteID               string       `bun:"type:CHAR(26),nullzero"`                                      // id of the user who invited this user (who let this joker in?)
+	InviteID               string       `bun:"type:CHAR(26),nullzero"`                                      // id of the invite this user signed up with, if any (who let this joker in?)
 	Reason                 string       `bun:",nullzero"`                                                   // What reason was given for signing up when this user was created?
 	Locale                 string       `bun:",nullzero"`                                                   // In what timezone/locale is this user located?
 	CreatedByApplicationID string       `bun:"type:CHAR(26),nullzero"`                                      // Which application id created this user? See gtsmodel.Application
@@ -56,6 +56,16 @@ type User struct {
 	ResetPasswordToken     string       `bun:",nullzero"`                                                   // The generated token that the user can use to reset their password
 	ResetPasswordSentAt    time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did we email the user their reset-password email?
 	ExternalID             string       `bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	TwoFactorSecret        string       `bun:",nullzero"`                                                   // Encrypted TOTP secret for two-factor authentication. Set (but not yet in force) during enrollment.
+	TwoFactorRecoveryCodes []string     `bun:",array"`                                                      // Bcrypt hashes of unused single-use two-factor recovery codes.
+	TwoFactorEnabledAt     time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did the user confirm enrollment in two-factor authentication. Zero if 2FA is not enabled.
+	TwoFactorLastUsedStep  int64        `bun:",nullzero"`                                                   // Time step of the last TOTP code accepted for this user, to prevent codes being replayed.
+}
+
+// TwoFactorEnabled returns true if this
+// user has enabled two-factor authentication.
+func (u *User) TwoFactorEnabled() bool {
+	return !u.TwoFactorEnabledAt.IsZero()
 }
 
 // DeniedUser represents one user sign-up that
@@ -97,4 +107,5 @@ type NewSignup struct {
 	EmailVerified bool   // Mark submitted email address as already verified (optional).
 	ExternalID    string // ID of this user in external OIDC system (optional).
 	Admin         bool   // Mark new user as an admin user (optional).
+	InviteID      string // ID of the invite used to sign up (optional).
 }
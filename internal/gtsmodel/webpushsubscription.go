@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// WebPushSubscription represents a Web Push subscription
+// created by a client application on behalf of an account.
+// Each subscription is tied to exactly one access token.
+type WebPushSubscription struct {
+	ID                string                    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt         time.Time                 `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt         time.Time                 `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID         string                    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account that owns this subscription
+	TokenID           string                    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // ID of the access token this subscription was created with
+	Endpoint          string                    `bun:",nullzero,notnull"`                                           // URL of the push service endpoint to deliver to
+	Auth              string                    `bun:",nullzero,notnull"`                                           // base64url-encoded authentication secret of the user agent
+	P256dh            string                    `bun:",nullzero,notnull"`                                           // base64url-encoded P-256 ECDH public key of the user agent
+	NotificationFlags WebPushNotificationFlags  `bun:",notnull,default:0"`                                          // which notification types should be pushed
+	Policy            WebPushSubscriptionPolicy `bun:",nullzero,notnull,default:'all'"`                             // whose notifications should be pushed
+}
+
+// WebPushSubscriptionPolicy describes which accounts'
+// notifications should be pushed to a subscription.
+type WebPushSubscriptionPolicy string
+
+const (
+	WebPushSubscriptionPolicyAll      WebPushSubscriptionPolicy = "all"      // Notifications from anyone.
+	WebPushSubscriptionPolicyFollowed WebPushSubscriptionPolicy = "followed" // Notifications from accounts the subscriber follows.
+	WebPushSubscriptionPolicyFollower WebPushSubscriptionPolicy = "follower" // Notifications from accounts that follow the subscriber.
+	WebPushSubscriptionPolicyNone     WebPushSubscriptionPolicy = "none"     // No notifications.
+)
+
+// WebPushNotificationFlags is a bitfield
+// of notification types to be pushed.
+type WebPushNotificationFlags int64
+
+// webPushNotificationFlagBits maps each
+// notification type to its bit in the field.
+var webPushNotificationFlagBits = map[NotificationType]WebPushNotificationFlags{
+	NotificationFollow:        1 << 0,
+	NotificationFollowRequest: 1 << 1,
+	NotificationMention:       1 << 2,
+	NotificationReblog:        1 << 3,
+	NotificationFave:          1 << 4,
+	NotificationPoll:          1 << 5,
+	NotificationStatus:        1 << 6,
+	NotificationSignup:        1 << 7,
+	NotificationPendingFave:   1 << 8,
+	NotificationPendingReply:  1 << 9,
+	NotificationPendingReblog: 1 << 10,
+}
+
+// Get returns whether notifications
+// of the given type should be pushed.
+func (f WebPushNotificationFlags) Get(t NotificationType) bool {
+	bit, ok := webPushNotificationFlagBits[t]
+	return ok && f&bit != 0
+}
+
+// Set sets whether notifications of the given
+// type should be pushed. Unknown types are ignored.
+func (f *WebPushNotificationFlags) Set(t NotificationType, value bool) {
+	bit, ok := webPushNotificationFlagBits[t]
+	if !ok {
+		return
+	}
+
+	if value {
+		*f |= bit
+	} else {
+		*f &^= bit
+	}
+}
@@ -25,6 +25,7 @@
 	DeliveryWorker  WorkerType = 1
 	FederatorWorker WorkerType = 2
 	ClientWorker    WorkerType = 3
+	ImportWorker    WorkerType = 4
 )
 
 // WorkerTask represents a queued worker task
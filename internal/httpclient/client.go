@@ -331,6 +331,7 @@ func (c *Client) do(r *Request) (rsp *http.Response, retry bool, err error) {
 		// are generally temporary errors. For these
 		// we replace the response with a loggable error.
 		err = fmt.Errorf(`http response: %s`, rsp.Status)
+		err = gtserror.WithStatusCode(err, rsp.StatusCode)
 
 		// Search for a provided "Retry-After" header value.
 		if after := rsp.Header.Get("Retry-After"); after != "" {
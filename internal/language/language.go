@@ -100,6 +100,14 @@ func (l *Language) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// BaseStr returns the base language
+// subtag of the Language as an ISO 639
+// code, eg., "en" for "en-GB".
+func (l *Language) BaseStr() string {
+	base, _ := l.Tag.Base()
+	return base.String()
+}
+
 type Languages []*Language
 
 func (l Languages) Tags() []language.Tag {
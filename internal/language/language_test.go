@@ -140,3 +140,25 @@ func TestInstanceLangs(t *testing.T) {
 		}
 	}
 }
+
+func TestBaseStr(t *testing.T) {
+	for i, test := range []struct {
+		lang         string
+		expectedBase string
+	}{
+		{lang: "en", expectedBase: "en"},
+		{lang: "en-GB", expectedBase: "en"},
+		{lang: "pt-BR", expectedBase: "pt"},
+		{lang: "zh-Hant", expectedBase: "zh"},
+	} {
+		parsedLang, err := language.Parse(test.lang)
+		if err != nil {
+			t.Errorf("test %d unexpected error %v", i, err)
+			continue
+		}
+
+		if base := parsedLang.BaseStr(); base != test.expectedBase {
+			t.Errorf("test %d expected base %s, got %s", i, test.expectedBase, base)
+		}
+	}
+}
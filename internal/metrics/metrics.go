@@ -30,6 +30,7 @@
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/extra/bunotel"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
@@ -124,6 +125,26 @@ func Initialize(db db.DB) error {
 		return err
 	}
 
+	_, err = meter.Int64ObservableGauge(
+		"gotosocial.delivery.failures",
+		metric.WithDescription("Number of stored failed outgoing deliveries, per target host"),
+		metric.WithInt64Callback(func(c context.Context, o metric.Int64Observer) error {
+			failureCounts, err := db.CountDeliveryFailuresByHost(c)
+			if err != nil {
+				return err
+			}
+			for host, count := range failureCounts {
+				o.Observe(int64(count), metric.WithAttributes(
+					attribute.String("target_host", host),
+				))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
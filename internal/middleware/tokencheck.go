@@ -18,7 +18,9 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -42,9 +44,12 @@
 // Next, it will look up the *gtsmodel.Account for the User. If the Account has been suspended, then the
 // middleware will return early. Otherwise, it will set the Account on the gin context too.
 //
-// Finally, it will check the client ID of the token to see if a *gtsmodel.Application can be retrieved
+// It will then check the client ID of the token to see if a *gtsmodel.Application can be retrieved
 // for that client ID. This will also be set on the gin context.
 //
+// Finally, it will update the last used time of the token, if it's
+// not already been updated within the last tokenLastUsedFreq.
+//
 // If an invalid token is presented, or a user/account/application can't be found, then this middleware
 // won't abort the request, since the server might want to still allow public requests that don't have a
 // Bearer token set (eg., for public instance information and so on).
@@ -136,5 +141,37 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 
 			c.Set(oauth.SessionAuthorizedApplication, app)
 		}
+
+		updateTokenLastUsed(ctx, dbConn, ti.GetAccess())
+	}
+}
+
+// tokenLastUsedFreq is the granularity at which
+// token last used times are stored in the database,
+// to avoid writing to the database on every request.
+const tokenLastUsedFreq = time.Hour
+
+// updateTokenLastUsed updates the last used time of the
+// database token with the given access code, if necessary.
+func updateTokenLastUsed(ctx context.Context, dbConn db.DB, access string) {
+	if access == "" {
+		return
+	}
+
+	token, err := dbConn.GetTokenByAccess(ctx, access)
+	if err != nil {
+		log.Errorf(ctx, "database error getting token: %v", err)
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(token.LastUsed) < tokenLastUsedFreq {
+		// Recently updated.
+		return
+	}
+
+	token.LastUsed = now
+	if err := dbConn.UpdateToken(ctx, token, "last_used"); err != nil {
+		log.Errorf(ctx, "database error updating token last used: %v", err)
 	}
 }
@@ -21,3 +21,6 @@
 
 // ErrInvalidRequest is an oauth spec compliant 'invalid_request' error.
 var ErrInvalidRequest = errors.New("invalid_request")
+
+// ErrUnauthorizedClient is an oauth spec compliant 'unauthorized_client' error.
+var ErrUnauthorizedClient = errors.New("unauthorized_client")
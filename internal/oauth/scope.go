@@ -0,0 +1,226 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/oauth2/v4"
+)
+
+// Scope represents an oauth scope that may be
+// granted to a token, and required by a route.
+//
+// Scopes are hierarchical, with levels separated by
+// colons: a token granted the "read" scope is permitted
+// to access a route requiring "read:statuses", and a
+// token granted "admin" may access "admin:read:accounts".
+type Scope string
+
+const (
+	ScopeRead                   Scope = "read"
+	ScopeReadAccounts           Scope = ScopeRead + ":accounts"
+	ScopeReadBlocks             Scope = ScopeRead + ":blocks"
+	ScopeReadBookmarks          Scope = ScopeRead + ":bookmarks"
+	ScopeReadCustomEmojis       Scope = ScopeRead + ":custom_emojis"
+	ScopeReadFavourites         Scope = ScopeRead + ":favourites"
+	ScopeReadFilters            Scope = ScopeRead + ":filters"
+	ScopeReadFollows            Scope = ScopeRead + ":follows"
+	ScopeReadLists              Scope = ScopeRead + ":lists"
+	ScopeReadMedia              Scope = ScopeRead + ":media"
+	ScopeReadMutes              Scope = ScopeRead + ":mutes"
+	ScopeReadNotifications      Scope = ScopeRead + ":notifications"
+	ScopeReadReports            Scope = ScopeRead + ":reports"
+	ScopeReadSearch             Scope = ScopeRead + ":search"
+	ScopeReadStatuses           Scope = ScopeRead + ":statuses"
+	ScopeReadStreaming          Scope = ScopeRead + ":streaming"
+	ScopeReadUser               Scope = ScopeRead + ":user"
+	ScopeWrite                  Scope = "write"
+	ScopeWriteAccounts          Scope = ScopeWrite + ":accounts"
+	ScopeWriteBlocks            Scope = ScopeWrite + ":blocks"
+	ScopeWriteConversations     Scope = ScopeWrite + ":conversations"
+	ScopeWriteFilters           Scope = ScopeWrite + ":filters"
+	ScopeWriteFollows           Scope = ScopeWrite + ":follows"
+	ScopeWriteLists             Scope = ScopeWrite + ":lists"
+	ScopeWriteMedia             Scope = ScopeWrite + ":media"
+	ScopeWriteMutes             Scope = ScopeWrite + ":mutes"
+	ScopeWriteNotifications     Scope = ScopeWrite + ":notifications"
+	ScopeWriteReports           Scope = ScopeWrite + ":reports"
+	ScopeWriteStatuses          Scope = ScopeWrite + ":statuses"
+	ScopeWriteUser              Scope = ScopeWrite + ":user"
+	ScopeFollow                 Scope = "follow"
+	ScopeUser                   Scope = "user"
+	ScopePush                   Scope = "push"
+	ScopeAdmin                  Scope = "admin"
+	ScopeAdminRead              Scope = ScopeAdmin + ":read"
+	ScopeAdminReadAccounts      Scope = ScopeAdminRead + ":accounts"
+	ScopeAdminReadReports       Scope = ScopeAdminRead + ":reports"
+	ScopeAdminReadDomainAllows  Scope = ScopeAdminRead + ":domain_allows"
+	ScopeAdminReadDomainBlocks  Scope = ScopeAdminRead + ":domain_blocks"
+	ScopeAdminReadDomainLimits  Scope = ScopeAdminRead + ":domain_limits"
+	ScopeAdminWrite             Scope = ScopeAdmin + ":write"
+	ScopeAdminWriteAccounts     Scope = ScopeAdminWrite + ":accounts"
+	ScopeAdminWriteReports      Scope = ScopeAdminWrite + ":reports"
+	ScopeAdminWriteDomainAllows Scope = ScopeAdminWrite + ":domain_allows"
+	ScopeAdminWriteDomainBlocks Scope = ScopeAdminWrite + ":domain_blocks"
+	ScopeAdminWriteDomainLimits Scope = ScopeAdminWrite + ":domain_limits"
+)
+
+const (
+	// scopeDefault is the scope assumed
+	// for tokens with no scope set.
+	scopeDefault = ScopeRead
+
+	// scopeSeparator separates
+	// levels of scope hierarchy.
+	scopeSeparator = ":"
+
+	// scopeWildcard may be used as the last
+	// level of a granted scope, eg., "admin:*",
+	// which is equivalent to granting "admin".
+	scopeWildcard = "*"
+)
+
+// legacyFollowScopes are the scopes that are implied
+// by the deprecated "follow" scope, which predates the
+// granular "read:<x>" and "write:<x>" scopes.
+var legacyFollowScopes = []Scope{
+	ScopeReadBlocks,
+	ScopeWriteBlocks,
+	ScopeReadFollows,
+	ScopeWriteFollows,
+	ScopeReadMutes,
+	ScopeWriteMutes,
+}
+
+// legacyUserScopes are the scopes that are implied
+// by the deprecated "user" scope, which was requested
+// by older versions of the settings panel.
+var legacyUserScopes = []Scope{
+	ScopeReadAccounts,
+	ScopeWriteAccounts,
+	ScopeReadUser,
+	ScopeWriteUser,
+}
+
+// ParseScopes parses the given space-separated
+// scope string, as stored on a token or application,
+// into a slice of scopes. An empty string is parsed
+// as the default scope "read", in line with the scope
+// assigned when authorizing with no scope requested.
+func ParseScopes(s string) []Scope {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return []Scope{scopeDefault}
+	}
+
+	scopes := make([]Scope, 0, len(fields))
+	for _, field := range fields {
+		scopes = append(scopes, Scope(field))
+	}
+
+	return scopes
+}
+
+// Permits returns whether the given granted scope
+// (or a parent of it, in the scope hierarchy) permits
+// access to a route requiring scope s. For example,
+// "read" permits "read:statuses", and "admin:*" or
+// "admin" both permit "admin:write:reports".
+func (s Scope) Permits(granted Scope) bool {
+	if granted == s {
+		return true
+	}
+
+	switch granted {
+	case ScopeFollow:
+		// Expand deprecated follow scope.
+		return slices.Contains(legacyFollowScopes, s)
+	case ScopeUser:
+		// Expand deprecated user scope.
+		return slices.Contains(legacyUserScopes, s)
+	}
+
+	// Trim any trailing wildcard, eg.,
+	// "admin:*" is equivalent to "admin".
+	parent := strings.TrimSuffix(
+		string(granted),
+		scopeSeparator+scopeWildcard,
+	)
+
+	// Check whether granted is
+	// a parent of required scope.
+	return parent == string(s) ||
+		strings.HasPrefix(string(s), parent+scopeSeparator)
+}
+
+// PermittedBy returns whether any of the
+// scopes in the given space-separated
+// scope string permit required scope s.
+func (s Scope) PermittedBy(scopes string) bool {
+	for _, granted := range ParseScopes(scopes) {
+		if s.Permits(granted) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireScope returns a gin handler that checks the oauth token
+// set on the gin context by the TokenCheck middleware against the
+// given required scope, aborting with 403 Forbidden if the token's
+// granted scopes do not permit it. This should be passed before the
+// route handler when attaching a route, eg:
+//
+//	attachHandler(http.MethodGet, BasePath, oauth.RequireScope(oauth.ScopeReadStatuses), m.ThingGETHandler)
+//
+// If no token is set on the context, the request is passed through
+// unchanged, leaving it up to the route handler to decide whether
+// the route requires authorization at all (see Authed()).
+func RequireScope(scope Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		i, ok := c.Get(SessionAuthorizedToken)
+		if !ok {
+			// No token,
+			// nothing
+			// to check.
+			return
+		}
+
+		token, ok := i.(oauth2.TokenInfo)
+		if !ok {
+			// This should never happen,
+			// but refuse access if so.
+			err := errors.New("could not parse token from session context")
+			_ = c.Error(err)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+			return
+		}
+
+		if !scope.PermittedBy(token.GetScope()) {
+			err := errors.New("token does not have required scope " + string(scope))
+			_ = c.Error(err)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden: " + err.Error()})
+			return
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/oauth2/v4/models"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeTestSuite) TestPermittedBy() {
+	for _, test := range []struct {
+		required oauth.Scope
+		granted  string
+		expect   bool
+	}{
+		// Exact matches.
+		{oauth.ScopeReadStatuses, "read:statuses", true},
+		{oauth.ScopeWriteStatuses, "read:statuses", false},
+		{oauth.ScopeAdmin, "admin", true},
+
+		// Parent scopes permit child scopes.
+		{oauth.ScopeReadStatuses, "read", true},
+		{oauth.ScopeReadStatuses, "write", false},
+		{oauth.ScopeWriteMedia, "read write follow push", true},
+		{oauth.ScopeAdminReadAccounts, "admin", true},
+		{oauth.ScopeAdminReadAccounts, "admin:read", true},
+		{oauth.ScopeAdminReadAccounts, "admin:write", false},
+		{oauth.ScopeAdminWriteReports, "admin:*", true},
+		{oauth.ScopeAdminWriteReports, "admin:write:*", true},
+
+		// Child scopes don't permit parent scopes.
+		{oauth.ScopeRead, "read:statuses", false},
+		{oauth.ScopeAdmin, "admin:read", false},
+
+		// Prefix that isn't a parent scope.
+		{oauth.ScopeReadStatuses, "read:status", false},
+		{oauth.ScopeReadAccounts, "rea", false},
+
+		// Deprecated follow scope.
+		{oauth.ScopeWriteFollows, "follow", true},
+		{oauth.ScopeReadBlocks, "follow", true},
+		{oauth.ScopeReadStatuses, "follow", false},
+
+		// Deprecated user scope.
+		{oauth.ScopeReadAccounts, "user", true},
+		{oauth.ScopeWriteAccounts, "user admin", true},
+		{oauth.ScopeWriteUser, "user", true},
+		{oauth.ScopeReadStatuses, "user", false},
+		{oauth.ScopeAdminReadAccounts, "user", false},
+
+		// Empty scope is treated as "read".
+		{oauth.ScopeReadLists, "", true},
+		{oauth.ScopeWriteLists, "", false},
+	} {
+		suite.Equal(
+			test.expect,
+			test.required.PermittedBy(test.granted),
+			"required=%s granted=%q", test.required, test.granted,
+		)
+	}
+}
+
+func (suite *ScopeTestSuite) TestRequireScope() {
+	handler := oauth.RequireScope(oauth.ScopeWriteStatuses)
+
+	for _, test := range []struct {
+		scope      *string
+		expectCode int
+	}{
+		// No token, handler is left to decide.
+		{nil, http.StatusOK},
+		{util.Ptr("read write"), http.StatusOK},
+		{util.Ptr("write:statuses"), http.StatusOK},
+		{util.Ptr("read"), http.StatusForbidden},
+		{util.Ptr("write:media"), http.StatusForbidden},
+	} {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "http://localhost:8080/api/v1/statuses", nil)
+
+		if test.scope != nil {
+			token := models.NewToken()
+			token.SetScope(*test.scope)
+			ctx.Set(oauth.SessionAuthorizedToken, token)
+		}
+
+		handler(ctx)
+		if !ctx.IsAborted() {
+			ctx.Status(http.StatusOK)
+		}
+
+		suite.Equal(test.expectCode, recorder.Code)
+	}
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeTestSuite))
+}
@@ -73,10 +73,11 @@ func (p *Processor) Delete(
 		l.Errorf("continuing after error during account delete: %v", err)
 	}
 
-	if account.IsLocal() {
+	if account.IsLocal() && !account.IsGroup() {
 		// We delete tokens, applications and clients for
 		// account as one of the last stages during deletion,
-		// as other database models rely on these.
+		// as other database models rely on these. Groups
+		// have no user, so there's nothing to do for them.
 		if err := p.deleteUserAndTokensForAccount(ctx, account); err != nil {
 			l.Errorf("continuing after error during account delete: %v", err)
 		}
@@ -480,6 +481,62 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return gtserror.Newf("error deleting followed tags by account: %w", err)
 	}
 
+	// Delete all featured tags owned by given account.
+	if err := p.state.DB.DeleteFeaturedTagsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting featured tags by account: %w", err)
+	}
+
+	// Delete all group moderators of or by given account.
+	if err := p.state.DB.DeleteGroupModeratorsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting group moderators by account: %w", err)
+	}
+
+	// Cancel any statuses scheduled by given account.
+	scheduled, err := p.state.DB.GetScheduledStatusesForAcct(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		nil, // all
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting scheduled statuses by account: %w", err)
+	}
+
+	for _, status := range scheduled {
+		_ = p.state.Workers.Scheduler.Cancel(status.ID)
+	}
+
+	// Delete all scheduled statuses owned by given account.
+	if err := p.state.DB.DeleteScheduledStatusesByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting scheduled statuses by account: %w", err)
+	}
+
+	// Revoke all invites created by given account. These
+	// aren't deleted, so that accounts who signed up using
+	// them can still be traced back to their inviter.
+	if err := p.state.DB.RevokeInvitesByAccountID(ctx, account.ID); err != nil {
+		return gtserror.Newf("error revoking invites by account: %w", err)
+	}
+
+	// Delete all import jobs (and their
+	// uploaded data) owned by given account.
+	if err := p.state.DB.DeleteImportJobsByAccountID(ctx, account.ID); err != nil {
+		return gtserror.Newf("error deleting import jobs by account: %w", err)
+	}
+
+	// Stop featuring given account in follow suggestions,
+	// and delete suggestion dismissals by or targeting it.
+	if err := p.state.DB.DeleteAccountSuggestions(ctx, account.ID); err != nil {
+		return gtserror.Newf("error deleting suggestions for account: %w", err)
+	}
+
+	// Delete announcement reactions and reads by given account.
+	if err := p.state.DB.DeleteAccountAnnouncementInteractions(ctx, account.ID); err != nil {
+		return gtserror.Newf("error deleting announcement interactions for account: %w", err)
+	}
+
 	// Delete account stats model.
 	if err := p.state.DB.DeleteAccountStats(ctx, account.ID); err != nil {
 		return gtserror.Newf("error deleting stats for account: %w", err)
@@ -18,362 +18,898 @@
 package account
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
+	"net/url"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
-func (p *Processor) ImportData(
-	ctx context.Context,
-	requester *gtsmodel.Account,
-	data *multipart.FileHeader,
+// errBadRow is returned by importers
+// when a CSV row can't be parsed.
+var errBadRow = errors.New("badly formatted row")
+
+// importer wraps logic for importing
+// rows of one type of CSV import data.
+type importer interface {
+	// prepare is called once before any
+	// rows are imported. When overwriting,
+	// it should gather existing entries.
+	prepare(ctx context.Context) error
+
+	// importRow imports the given CSV record,
+	// returning a user-facing error if it failed.
+	importRow(ctx context.Context, record []string) error
+
+	// finish is called once after all rows are
+	// imported. When overwriting, it should
+	// remove existing entries not in the import.
+	finish(ctx context.Context)
+}
+
+// newImporter returns an importer for the given
+// import type, or nil if the type is unsupported.
+func (p *Processor) newImporter(
 	importType string,
+	requester *gtsmodel.Account,
 	overwrite bool,
-) gtserror.WithCode {
+) importer {
 	switch importType {
-
 	case "following":
-		return p.importFollowing(
-			ctx,
-			requester,
-			data,
-			overwrite,
-		)
-
+		return &followingImporter{p: p, requester: requester, overwrite: overwrite}
 	case "blocks":
-		return p.importBlocks(
-			ctx,
-			requester,
-			data,
-			overwrite,
-		)
-
+		return &blocksImporter{p: p, requester: requester, overwrite: overwrite}
+	case "mutes":
+		return &mutesImporter{p: p, requester: requester, overwrite: overwrite}
+	case "lists":
+		return &listsImporter{p: p, requester: requester, overwrite: overwrite}
+	case "bookmarks":
+		return &bookmarksImporter{p: p, requester: requester, overwrite: overwrite}
 	default:
-		const text = "import type not yet supported"
-		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		return nil
 	}
 }
 
-func (p *Processor) importFollowing(
+// ImportData stores the given CSV data as a new import
+// job owned by requester, and queues the job for processing.
+//
+// The returned job can be polled to check import progress.
+func (p *Processor) ImportData(
 	ctx context.Context,
 	requester *gtsmodel.Account,
-	followingData *multipart.FileHeader,
+	data *multipart.FileHeader,
+	importType string,
 	overwrite bool,
-) gtserror.WithCode {
-	file, err := followingData.Open()
+) (*apimodel.ImportJob, gtserror.WithCode) {
+	if p.newImporter(importType, requester, overwrite) == nil {
+		const text = "import type not yet supported"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	file, err := data.Open()
 	if err != nil {
-		err := fmt.Errorf("error opening following data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		err := fmt.Errorf("error opening %s data file: %w", importType, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 	defer file.Close()
 
-	// Parse records out of the file.
-	records, err := csv.NewReader(file).ReadAll()
+	b, err := io.ReadAll(file)
 	if err != nil {
-		err := fmt.Errorf("error reading following data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		err := fmt.Errorf("error reading %s data file: %w", importType, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
-	// Convert the records into a slice of barebones follows.
+	// Parse records out of the data now, so
+	// we can reject invalid CSV immediately
+	// rather than failing in the background.
+	records, err := parseImportRecords(b)
+	if err != nil {
+		err := fmt.Errorf("error reading %s data file: %w", importType, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	totalRows := len(records)
+	if totalRows > 0 && isImportHeader(records[0]) {
+		// Don't count header.
+		totalRows--
+	}
+
+	now := time.Now()
+	job := &gtsmodel.ImportJob{
+		ID:        id.NewULID(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		AccountID: requester.ID,
+		Account:   requester,
+		Type:      importType,
+		Overwrite: &overwrite,
+		Data:      b,
+		State:     gtsmodel.ImportJobStateQueued,
+		TotalRows: totalRows,
+	}
+
+	if err := p.state.DB.PutImportJob(ctx, job); err != nil {
+		err := gtserror.Newf("db error putting import job: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert before queueing, as the
+	// job is modified during processing.
+	apiJob := p.converter.ImportJobToAPIImportJob(ctx, job, nil)
+
+	// Do remaining processing of this import asynchronously.
+	p.QueueImportJob(job)
+
+	return apiJob, nil
+}
+
+// parseImportRecords parses CSV records from raw import data.
+func parseImportRecords(data []byte) ([][]string, error) {
+	return csv.NewReader(bytes.NewReader(data)).ReadAll()
+}
+
+// isImportHeader returns true if the given
+// record looks like a Mastodon CSV header row.
+func isImportHeader(record []string) bool {
+	return len(record) > 0 && record[0] == "Account address"
+}
+
+type followingImporter struct {
+	p         *Processor
+	requester *gtsmodel.Account
+	overwrite bool
+
+	// Existing follow(-request)s and
+	// wanted follow targets (if overwriting).
+	prevFollows    []*gtsmodel.Follow
+	prevFollowReqs []*gtsmodel.FollowRequest
+	wanted         map[string]struct{}
+}
+
+func (i *followingImporter) prepare(ctx context.Context) error {
+	if !i.overwrite {
+		return nil
+	}
+
+	// If we're overwriting, we need to get current
+	// follow(-req)s owned by requester *before*
+	// making any changes, so that we can remove
+	// unwanted follows after we've created new ones.
+	var err error
+
+	i.prevFollows, err = i.p.state.DB.GetAccountFollows(ctx, i.requester.ID, nil)
+	if err != nil {
+		return gtserror.Newf("db error getting following: %w", err)
+	}
+
+	i.prevFollowReqs, err = i.p.state.DB.GetAccountFollowRequesting(ctx, i.requester.ID, nil)
+	if err != nil {
+		return gtserror.Newf("db error getting follow requesting: %w", err)
+	}
+
+	i.wanted = make(map[string]struct{})
+	return nil
+}
+
+func (i *followingImporter) importRow(ctx context.Context, record []string) error {
+	// Convert the record into a barebones follow.
 	//
 	// Only TargetAccount.Username, TargetAccount.Domain,
-	// and ShowReblogs will be set on each Follow.
-	follows, err := p.converter.CSVToFollowing(ctx, records)
+	// and ShowReblogs will be set on the Follow.
+	follows, err := i.p.converter.CSVToFollowing(ctx, [][]string{record})
+	if err != nil || len(follows) != 1 {
+		return errBadRow
+	}
+	follow := follows[0]
+
+	var (
+		// Username of the target.
+		username = follow.TargetAccount.Username
+
+		// Domain of the target.
+		// Empty for our domain.
+		domain = follow.TargetAccount.Domain
+	)
+
+	if i.overwrite {
+		// We'll be overwriting, so store
+		// this new follow in our handy map.
+		i.wanted[username+"@"+domain] = struct{}{}
+	}
+
+	// Get the target account, dereferencing it if necessary.
+	targetAcct, _, err := i.p.federator.Dereferencer.GetAccountByUsernameDomain(
+		ctx,
+		i.requester.Username,
+		username,
+		domain,
+	)
 	if err != nil {
-		err := fmt.Errorf("error converting records to follows: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		log.Errorf(ctx, "could not retrieve account: %v", err)
+		return errors.New("could not retrieve account")
 	}
 
-	// Do remaining processing of this import asynchronously.
-	f := importFollowingAsyncF(p, requester, follows, overwrite)
-	p.state.Workers.Processing.Queue.Push(f)
+	// Use the processor's FollowCreate function
+	// to create or update the follow. This takes
+	// account of existing follows, and also sends
+	// the follow to the FromClientAPI processor.
+	if _, errWithCode := i.p.FollowCreate(
+		ctx,
+		i.requester,
+		&apimodel.AccountFollowRequest{
+			ID:      targetAcct.ID,
+			Reblogs: follow.ShowReblogs,
+			Notify:  follow.Notify,
+		},
+	); errWithCode != nil {
+		log.Errorf(ctx, "could not follow account: %v", errWithCode.Unwrap())
+		return fmt.Errorf("could not follow account: %s", errWithCode.Safe())
+	}
 
 	return nil
 }
 
-func importFollowingAsyncF(
-	p *Processor,
-	requester *gtsmodel.Account,
-	follows []*gtsmodel.Follow,
-	overwrite bool,
-) func(context.Context) {
-	return func(ctx context.Context) {
-		// Map used to store wanted
-		// follow targets (if overwriting).
-		var wantedFollows map[string]struct{}
-
-		if overwrite {
-			// If we're overwriting, we need to get current
-			// follow(-req)s owned by requester *before*
-			// making any changes, so that we can remove
-			// unwanted follows after we've created new ones.
-			prevFollows, err := p.state.DB.GetAccountFollows(ctx, requester.ID, nil)
-			if err != nil {
-				log.Errorf(ctx, "db error getting following: %v", err)
-				return
-			}
+func (i *followingImporter) finish(ctx context.Context) {
+	if !i.overwrite {
+		return
+	}
+
+	// AccountIDs to unfollow.
+	toRemove := []string{}
+
+	// Check previous follows.
+	for _, prev := range i.prevFollows {
+		username := prev.TargetAccount.Username
+		domain := prev.TargetAccount.Domain
+
+		_, wanted := i.wanted[username+"@"+domain]
+		if !wanted {
+			toRemove = append(toRemove, prev.TargetAccountID)
+		}
+	}
+
+	// Now any pending follow requests.
+	for _, prev := range i.prevFollowReqs {
+		username := prev.TargetAccount.Username
+		domain := prev.TargetAccount.Domain
+
+		_, wanted := i.wanted[username+"@"+domain]
+		if !wanted {
+			toRemove = append(toRemove, prev.TargetAccountID)
+		}
+	}
+
+	// Remove each discovered
+	// unwanted follow.
+	for _, accountID := range toRemove {
+		if _, errWithCode := i.p.FollowRemove(
+			ctx,
+			i.requester,
+			accountID,
+		); errWithCode != nil {
+			log.Errorf(ctx, "could not unfollow account: %v", errWithCode.Unwrap())
+			continue
+		}
+	}
+}
+
+type blocksImporter struct {
+	p         *Processor
+	requester *gtsmodel.Account
+	overwrite bool
+
+	// Existing blocks and wanted
+	// block targets (if overwriting).
+	prevBlocks []*gtsmodel.Block
+	wanted     map[string]struct{}
+}
+
+func (i *blocksImporter) prepare(ctx context.Context) error {
+	if !i.overwrite {
+		return nil
+	}
+
+	// If we're overwriting, we need to get current
+	// blocks owned by requester *before* making any
+	// changes, so that we can remove unwanted blocks
+	// after we've created new ones.
+	var err error
+
+	i.prevBlocks, err = i.p.state.DB.GetAccountBlocks(ctx, i.requester.ID, nil)
+	if err != nil {
+		return gtserror.Newf("db error getting blocks: %w", err)
+	}
+
+	i.wanted = make(map[string]struct{})
+	return nil
+}
+
+func (i *blocksImporter) importRow(ctx context.Context, record []string) error {
+	// Convert the record into a barebones block.
+	//
+	// Only TargetAccount.Username and TargetAccount.Domain,
+	// will be set on the Block.
+	blocks, err := i.p.converter.CSVToBlocks(ctx, [][]string{record})
+	if err != nil || len(blocks) != 1 {
+		return errBadRow
+	}
+	block := blocks[0]
+
+	var (
+		// Username of the target.
+		username = block.TargetAccount.Username
+
+		// Domain of the target.
+		// Empty for our domain.
+		domain = block.TargetAccount.Domain
+	)
+
+	if i.overwrite {
+		// We'll be overwriting, so store
+		// this new block in our handy map.
+		i.wanted[username+"@"+domain] = struct{}{}
+	}
+
+	// Get the target account, dereferencing it if necessary.
+	targetAcct, _, err := i.p.federator.Dereferencer.GetAccountByUsernameDomain(
+		ctx,
+		// Provide empty request user to use the
+		// instance account to deref the account.
+		//
+		// It's pointless to make lots of calls
+		// to a remote from an account that's about
+		// to block that account.
+		"",
+		username,
+		domain,
+	)
+	if err != nil {
+		log.Errorf(ctx, "could not retrieve account: %v", err)
+		return errors.New("could not retrieve account")
+	}
+
+	// Use the processor's BlockCreate function
+	// to create or update the block. This takes
+	// account of existing blocks, and also sends
+	// the block to the FromClientAPI processor.
+	if _, errWithCode := i.p.BlockCreate(
+		ctx,
+		i.requester,
+		targetAcct.ID,
+	); errWithCode != nil {
+		log.Errorf(ctx, "could not block account: %v", errWithCode.Unwrap())
+		return fmt.Errorf("could not block account: %s", errWithCode.Safe())
+	}
+
+	return nil
+}
+
+func (i *blocksImporter) finish(ctx context.Context) {
+	if !i.overwrite {
+		return
+	}
+
+	for _, prev := range i.prevBlocks {
+		username := prev.TargetAccount.Username
+		domain := prev.TargetAccount.Domain
+
+		_, wanted := i.wanted[username+"@"+domain]
+		if wanted {
+			// Leave this
+			// one alone.
+			continue
+		}
+
+		if _, errWithCode := i.p.BlockRemove(
+			ctx,
+			i.requester,
+			prev.TargetAccountID,
+		); errWithCode != nil {
+			log.Errorf(ctx, "could not unblock account: %v", errWithCode.Unwrap())
+			continue
+		}
+	}
+}
+
+type mutesImporter struct {
+	p         *Processor
+	requester *gtsmodel.Account
+	overwrite bool
+
+	// Existing mutes and wanted
+	// mute targets (if overwriting).
+	prevMutes []*gtsmodel.UserMute
+	wanted    map[string]struct{}
+}
+
+func (i *mutesImporter) prepare(ctx context.Context) error {
+	if !i.overwrite {
+		return nil
+	}
+
+	// If we're overwriting, we need to get current
+	// mutes owned by requester *before* making any
+	// changes, so that we can remove unwanted mutes
+	// after we've created new ones.
+	var err error
+
+	i.prevMutes, err = i.p.state.DB.GetAccountMutes(ctx, i.requester.ID, nil)
+	if err != nil {
+		return gtserror.Newf("db error getting mutes: %w", err)
+	}
+
+	i.wanted = make(map[string]struct{})
+	return nil
+}
 
-			prevFollowReqs, err := p.state.DB.GetAccountFollowRequesting(ctx, requester.ID, nil)
-			if err != nil {
-				log.Errorf(ctx, "db error getting follow requesting: %v", err)
-				return
+func (i *mutesImporter) importRow(ctx context.Context, record []string) error {
+	// Convert the record into a barebones mute.
+	//
+	// Only TargetAccount.Username, TargetAccount.Domain,
+	// and Notifications will be set on the UserMute.
+	mutes, err := i.p.converter.CSVToMutes(ctx, [][]string{record})
+	if err != nil || len(mutes) != 1 {
+		return errBadRow
+	}
+	mute := mutes[0]
+
+	var (
+		// Username of the target.
+		username = mute.TargetAccount.Username
+
+		// Domain of the target.
+		// Empty for our domain.
+		domain = mute.TargetAccount.Domain
+	)
+
+	if i.overwrite {
+		// We'll be overwriting, so store
+		// this new mute in our handy map.
+		i.wanted[username+"@"+domain] = struct{}{}
+	}
+
+	// Get the target account, dereferencing it if necessary.
+	targetAcct, _, err := i.p.federator.Dereferencer.GetAccountByUsernameDomain(
+		ctx,
+		// Provide empty request user to use the
+		// instance account to deref the account.
+		"",
+		username,
+		domain,
+	)
+	if err != nil {
+		log.Errorf(ctx, "could not retrieve account: %v", err)
+		return errors.New("could not retrieve account")
+	}
+
+	// Use the processor's MuteCreate function
+	// to create or update the mute. This takes
+	// account of existing mutes.
+	if _, errWithCode := i.p.MuteCreate(
+		ctx,
+		i.requester,
+		targetAcct.ID,
+		&apimodel.UserMuteCreateUpdateRequest{
+			Notifications: mute.Notifications,
+		},
+	); errWithCode != nil {
+		log.Errorf(ctx, "could not mute account: %v", errWithCode.Unwrap())
+		return fmt.Errorf("could not mute account: %s", errWithCode.Safe())
+	}
+
+	return nil
+}
+
+func (i *mutesImporter) finish(ctx context.Context) {
+	if !i.overwrite {
+		return
+	}
+
+	for _, prev := range i.prevMutes {
+		username := prev.TargetAccount.Username
+		domain := prev.TargetAccount.Domain
+
+		_, wanted := i.wanted[username+"@"+domain]
+		if wanted {
+			// Leave this
+			// one alone.
+			continue
+		}
+
+		if _, errWithCode := i.p.MuteRemove(
+			ctx,
+			i.requester,
+			prev.TargetAccountID,
+		); errWithCode != nil {
+			log.Errorf(ctx, "could not unmute account: %v", errWithCode.Unwrap())
+			continue
+		}
+	}
+}
+
+type listsImporter struct {
+	p         *Processor
+	requester *gtsmodel.Account
+	overwrite bool
+
+	// Existing lists, lists
+	// keyed by title, and wanted
+	// list entries keyed by list
+	// ID (if overwriting).
+	prevLists     []*gtsmodel.List
+	listsByTitle  map[string]*gtsmodel.List
+	wantedEntries map[string]map[string]struct{}
+}
+
+func (i *listsImporter) prepare(ctx context.Context) error {
+	// Get current lists owned by requester, so we
+	// can add entries to lists that already exist.
+	var err error
+
+	i.prevLists, err = i.p.state.DB.GetListsByAccountID(ctx, i.requester.ID)
+	if err != nil {
+		return gtserror.Newf("db error getting lists: %w", err)
+	}
+
+	// Key existing lists by title.
+	i.listsByTitle = make(map[string]*gtsmodel.List, len(i.prevLists))
+	for _, list := range i.prevLists {
+		i.listsByTitle[list.Title] = list
+	}
+
+	if i.overwrite {
+		i.wantedEntries = make(map[string]map[string]struct{})
+	}
+
+	return nil
+}
+
+func (i *listsImporter) importRow(ctx context.Context, record []string) error {
+	// Convert the record into a list title
+	// mapped to a single barebones follow.
+	//
+	// Only TargetAccount.Username and TargetAccount.Domain
+	// will be set on the Follow.
+	lists, err := i.p.converter.CSVToLists(ctx, [][]string{record})
+	if err != nil || len(lists) != 1 {
+		return errBadRow
+	}
+
+	var (
+		title  string
+		follow *gtsmodel.Follow
+	)
+
+	for t, follows := range lists {
+		title, follow = t, follows[0]
+	}
+
+	list, ok := i.listsByTitle[title]
+	if !ok {
+		// No list with this title
+		// yet, so create a new one.
+		list = &gtsmodel.List{
+			ID:            id.NewULID(),
+			Title:         title,
+			AccountID:     i.requester.ID,
+			RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+			Exclusive:     util.Ptr(false),
+		}
+
+		if err := i.p.state.DB.PutList(ctx, list); err != nil {
+			log.Errorf(ctx, "could not create list: %v", err)
+			return errors.New("could not create list")
+		}
+
+		i.listsByTitle[title] = list
+	}
+
+	var (
+		// Username of the target.
+		username = follow.TargetAccount.Username
+
+		// Domain of the target.
+		// Empty for our domain.
+		domain = follow.TargetAccount.Domain
+	)
+
+	if i.overwrite {
+		// We'll be overwriting, so store
+		// this entry in our handy map.
+		if i.wantedEntries[list.ID] == nil {
+			i.wantedEntries[list.ID] = make(map[string]struct{})
+		}
+		i.wantedEntries[list.ID][username+"@"+domain] = struct{}{}
+	}
+
+	return i.p.importListEntry(ctx, i.requester, list, username, domain)
+}
+
+func (i *listsImporter) finish(ctx context.Context) {
+	if !i.overwrite {
+		return
+	}
+
+	// Go through previous lists
+	// and remove unwanted ones.
+	for _, prev := range i.prevLists {
+		wanted, ok := i.wantedEntries[prev.ID]
+		if !ok {
+			// List not in import
+			// at all, remove it.
+			if err := i.p.state.DB.DeleteListByID(ctx, prev.ID); err != nil {
+				log.Errorf(ctx, "could not delete list: %v", err)
 			}
+			continue
+		}
 
-			// Initialize new follows map.
-			wantedFollows = make(map[string]struct{}, len(follows))
-
-			// Once we've created (or tried to create)
-			// the required follows, go through previous
-			// follow(-request)s and remove unwanted ones.
-			defer func() {
-
-				// AccountIDs to unfollow.
-				toRemove := []string{}
-
-				// Check previous follows.
-				for _, prev := range prevFollows {
-					username := prev.TargetAccount.Username
-					domain := prev.TargetAccount.Domain
-
-					_, wanted := wantedFollows[username+"@"+domain]
-					if !wanted {
-						toRemove = append(toRemove, prev.TargetAccountID)
-					}
-				}
-
-				// Now any pending follow requests.
-				for _, prev := range prevFollowReqs {
-					username := prev.TargetAccount.Username
-					domain := prev.TargetAccount.Domain
-
-					_, wanted := wantedFollows[username+"@"+domain]
-					if !wanted {
-						toRemove = append(toRemove, prev.TargetAccountID)
-					}
-				}
-
-				// Remove each discovered
-				// unwanted follow.
-				for _, accountID := range toRemove {
-					if _, errWithCode := p.FollowRemove(
-						ctx,
-						requester,
-						accountID,
-					); errWithCode != nil {
-						log.Errorf(ctx, "could not unfollow account: %v", errWithCode.Unwrap())
-						continue
-					}
-				}
-			}()
+		// List is wanted, remove just
+		// the unwanted entries from it.
+		follows, err := i.p.state.DB.GetFollowsInList(ctx, prev.ID, nil)
+		if err != nil {
+			log.Errorf(ctx, "db error getting list follows: %v", err)
+			continue
 		}
 
-		// Go through the follows parsed from CSV
-		// file, and create / update each one.
 		for _, follow := range follows {
-			var (
-				// Username of the target.
-				username = follow.TargetAccount.Username
-
-				// Domain of the target.
-				// Empty for our domain.
-				domain = follow.TargetAccount.Domain
-
-				// Show reblogs on
-				// the new follow.
-				showReblogs = follow.ShowReblogs
-
-				// Notify when new
-				// follow posts.
-				notify = follow.Notify
-			)
-
-			if overwrite {
-				// We'll be overwriting, so store
-				// this new follow in our handy map.
-				wantedFollows[username+"@"+domain] = struct{}{}
-			}
+			username := follow.TargetAccount.Username
+			domain := follow.TargetAccount.Domain
 
-			// Get the target account, dereferencing it if necessary.
-			targetAcct, _, err := p.federator.Dereferencer.GetAccountByUsernameDomain(
-				ctx,
-				requester.Username,
-				username,
-				domain,
-			)
-			if err != nil {
-				log.Errorf(ctx, "could not retrieve account: %v", err)
+			if _, ok := wanted[username+"@"+domain]; ok {
+				// Leave this
+				// one alone.
 				continue
 			}
 
-			// Use the processor's FollowCreate function
-			// to create or update the follow. This takes
-			// account of existing follows, and also sends
-			// the follow to the FromClientAPI processor.
-			if _, errWithCode := p.FollowCreate(
+			if err := i.p.state.DB.DeleteListEntry(
 				ctx,
-				requester,
-				&apimodel.AccountFollowRequest{
-					ID:      targetAcct.ID,
-					Reblogs: showReblogs,
-					Notify:  notify,
-				},
-			); errWithCode != nil {
-				log.Errorf(ctx, "could not follow account: %v", errWithCode.Unwrap())
+				prev.ID,
+				follow.ID,
+			); err != nil && !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "could not remove list entry: %v", err)
 				continue
 			}
 		}
 	}
 }
 
-func (p *Processor) importBlocks(
+// importListEntry adds the account with given username
+// and domain to the given list, following the account
+// first if necessary, as Mastodon does on list import.
+func (p *Processor) importListEntry(
 	ctx context.Context,
 	requester *gtsmodel.Account,
-	blocksData *multipart.FileHeader,
-	overwrite bool,
-) gtserror.WithCode {
-	file, err := blocksData.Open()
+	list *gtsmodel.List,
+	username string,
+	domain string,
+) error {
+	// Get the target account, dereferencing it if necessary.
+	targetAcct, _, err := p.federator.Dereferencer.GetAccountByUsernameDomain(
+		ctx,
+		requester.Username,
+		username,
+		domain,
+	)
 	if err != nil {
-		err := fmt.Errorf("error opening blocks data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		log.Errorf(ctx, "could not retrieve account: %v", err)
+		return errors.New("could not retrieve account")
+	}
+
+	// Check for an existing follow to target.
+	follow, err := p.state.DB.GetFollow(
+		gtscontext.SetBarebones(ctx),
+		requester.ID,
+		targetAcct.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting follow: %v", err)
+		return errors.New("could not check follow")
 	}
-	defer file.Close()
 
-	// Parse records out of the file.
-	records, err := csv.NewReader(file).ReadAll()
+	if follow == nil {
+		// Not following yet, use the processor's
+		// FollowCreate function to follow the account.
+		if _, errWithCode := p.FollowCreate(
+			ctx,
+			requester,
+			&apimodel.AccountFollowRequest{
+				ID: targetAcct.ID,
+			},
+		); errWithCode != nil {
+			log.Errorf(ctx, "could not follow account: %v", errWithCode.Unwrap())
+			return fmt.Errorf("could not follow account: %s", errWithCode.Safe())
+		}
+
+		// Recheck for a follow, as the account
+		// may require follow request approval.
+		follow, err = p.state.DB.GetFollow(
+			gtscontext.SetBarebones(ctx),
+			requester.ID,
+			targetAcct.ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting follow: %v", err)
+			return errors.New("could not check follow")
+		}
+
+		if follow == nil {
+			return errors.New("follow request pending approval, import this row again once accepted")
+		}
+	}
+
+	// Check whether the account is already in the list.
+	inList, err := p.state.DB.IsAccountInList(ctx, list.ID, targetAcct.ID)
 	if err != nil {
-		err := fmt.Errorf("error reading blocks data file: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		log.Errorf(ctx, "db error checking list entry: %v", err)
+		return errors.New("could not check list entry")
+	}
+
+	if inList {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	if err := p.state.DB.PutListEntries(ctx, []*gtsmodel.ListEntry{{
+		ID:       id.NewULID(),
+		ListID:   list.ID,
+		FollowID: follow.ID,
+	}}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		log.Errorf(ctx, "could not add list entry: %v", err)
+		return errors.New("could not add account to list")
 	}
 
-	// Convert the records into a slice of barebones blocks.
+	return nil
+}
+
+type bookmarksImporter struct {
+	p         *Processor
+	requester *gtsmodel.Account
+	overwrite bool
+
+	// Existing bookmarks and wanted
+	// bookmark URIs (if overwriting).
+	prevBookmarks []*gtsmodel.StatusBookmark
+	wanted        map[string]struct{}
+}
+
+func (i *bookmarksImporter) prepare(ctx context.Context) error {
+	if !i.overwrite {
+		return nil
+	}
+
+	// If we're overwriting, we need to get current
+	// bookmarks owned by requester *before* making
+	// any changes, so that we can remove unwanted
+	// bookmarks after we've created new ones.
+	var err error
+
+	i.prevBookmarks, err = i.p.state.DB.GetStatusBookmarks(ctx, i.requester.ID, -1, "", "")
+	if err != nil {
+		return gtserror.Newf("db error getting bookmarks: %w", err)
+	}
+
+	i.wanted = make(map[string]struct{})
+	return nil
+}
+
+func (i *bookmarksImporter) importRow(ctx context.Context, record []string) error {
+	// Convert the record into a barebones bookmark.
 	//
-	// Only TargetAccount.Username and TargetAccount.Domain,
-	// will be set on each Block.
-	blocks, err := p.converter.CSVToBlocks(ctx, records)
+	// Only Status.URI will be set on the StatusBookmark.
+	bookmarks, err := i.p.converter.CSVToBookmarks(ctx, [][]string{record})
+	if err != nil || len(bookmarks) != 1 {
+		return errBadRow
+	}
+	uriStr := bookmarks[0].Status.URI
+
+	if i.overwrite {
+		// We'll be overwriting, so store
+		// this new bookmark in our handy map.
+		i.wanted[uriStr] = struct{}{}
+	}
+
+	uri, err := url.Parse(uriStr)
 	if err != nil {
-		err := fmt.Errorf("error converting records to blocks: %w", err)
-		return gtserror.NewErrorBadRequest(err, err.Error())
+		return errBadRow
 	}
 
-	// Do remaining processing of this import asynchronously.
-	f := importBlocksAsyncF(p, requester, blocks, overwrite)
-	p.state.Workers.Processing.Queue.Push(f)
+	// Get the target status, dereferencing it if necessary.
+	status, _, err := i.p.federator.Dereferencer.GetStatusByURI(
+		ctx,
+		i.requester.Username,
+		uri,
+	)
+	if err != nil {
+		log.Errorf(ctx, "could not retrieve status: %v", err)
+		return errors.New("could not retrieve status")
+	}
+
+	if i.overwrite && status.URI != uriStr {
+		// Status URI may differ from the URI
+		// in the file (eg., if the file contained
+		// a URL), so store both to be safe.
+		i.wanted[status.URI] = struct{}{}
+	}
+
+	// Ensure requester can actually see the status.
+	visible, err := i.p.visFilter.StatusVisible(ctx, i.requester, status)
+	if err != nil {
+		log.Errorf(ctx, "error checking status visibility: %v", err)
+		return errors.New("could not check status visibility")
+	}
+
+	if !visible {
+		return errors.New("status not visible")
+	}
+
+	// Check for an existing bookmark.
+	existing, err := i.p.state.DB.IsStatusBookmarkedBy(ctx, i.requester.ID, status.ID)
+	if err != nil {
+		log.Errorf(ctx, "db error checking bookmark: %v", err)
+		return errors.New("could not check bookmark")
+	}
+
+	if existing {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	// Create and store a new bookmark.
+	if err := i.p.state.DB.PutStatusBookmark(ctx, &gtsmodel.StatusBookmark{
+		ID:              id.NewULID(),
+		AccountID:       i.requester.ID,
+		Account:         i.requester,
+		TargetAccountID: status.AccountID,
+		TargetAccount:   status.Account,
+		StatusID:        status.ID,
+		Status:          status,
+	}); err != nil {
+		log.Errorf(ctx, "could not create bookmark: %v", err)
+		return errors.New("could not create bookmark")
+	}
+
+	if err := i.p.c.InvalidateTimelinedStatus(ctx, i.requester.ID, status.ID); err != nil {
+		log.Errorf(ctx, "error invalidating status from timelines: %v", err)
+	}
 
 	return nil
 }
 
-func importBlocksAsyncF(
-	p *Processor,
-	requester *gtsmodel.Account,
-	blocks []*gtsmodel.Block,
-	overwrite bool,
-) func(context.Context) {
-	return func(ctx context.Context) {
-		// Map used to store wanted
-		// block targets (if overwriting).
-		var wantedBlocks map[string]struct{}
-
-		if overwrite {
-			// If we're overwriting, we need to get current
-			// blocks owned by requester *before* making any
-			// changes, so that we can remove unwanted blocks
-			// after we've created new ones.
-			var (
-				prevBlocks []*gtsmodel.Block
-				err        error
-			)
-
-			prevBlocks, err = p.state.DB.GetAccountBlocks(ctx, requester.ID, nil)
-			if err != nil {
-				log.Errorf(ctx, "db error getting blocks: %v", err)
-				return
-			}
+func (i *bookmarksImporter) finish(ctx context.Context) {
+	if !i.overwrite {
+		return
+	}
 
-			// Initialize new blocks map.
-			wantedBlocks = make(map[string]struct{}, len(blocks))
-
-			// Once we've created (or tried to create)
-			// the required blocks, go through previous
-			// blocks and remove unwanted ones.
-			defer func() {
-				for _, prev := range prevBlocks {
-					username := prev.TargetAccount.Username
-					domain := prev.TargetAccount.Domain
-
-					_, wanted := wantedBlocks[username+"@"+domain]
-					if wanted {
-						// Leave this
-						// one alone.
-						continue
-					}
-
-					if _, errWithCode := p.BlockRemove(
-						ctx,
-						requester,
-						prev.TargetAccountID,
-					); errWithCode != nil {
-						log.Errorf(ctx, "could not unblock account: %v", errWithCode.Unwrap())
-						continue
-					}
-				}
-			}()
+	for _, prev := range i.prevBookmarks {
+		_, wanted := i.wanted[prev.Status.URI]
+		if wanted {
+			// Leave this
+			// one alone.
+			continue
 		}
 
-		// Go through the blocks parsed from CSV
-		// file, and create / update each one.
-		for _, block := range blocks {
-			var (
-				// Username of the target.
-				username = block.TargetAccount.Username
-
-				// Domain of the target.
-				// Empty for our domain.
-				domain = block.TargetAccount.Domain
-			)
-
-			if overwrite {
-				// We'll be overwriting, so store
-				// this new block in our handy map.
-				wantedBlocks[username+"@"+domain] = struct{}{}
-			}
-
-			// Get the target account, dereferencing it if necessary.
-			targetAcct, _, err := p.federator.Dereferencer.GetAccountByUsernameDomain(
-				ctx,
-				// Provide empty request user to use the
-				// instance account to deref the account.
-				//
-				// It's pointless to make lots of calls
-				// to a remote from an account that's about
-				// to block that account.
-				"",
-				username,
-				domain,
-			)
-			if err != nil {
-				log.Errorf(ctx, "could not retrieve account: %v", err)
-				continue
-			}
+		if err := i.p.state.DB.DeleteStatusBookmarkByID(ctx, prev.ID); err != nil {
+			log.Errorf(ctx, "could not remove bookmark: %v", err)
+			continue
+		}
 
-			// Use the processor's BlockCreate function
-			// to create or update the block. This takes
-			// account of existing blocks, and also sends
-			// the block to the FromClientAPI processor.
-			if _, errWithCode := p.BlockCreate(
-				ctx,
-				requester,
-				targetAcct.ID,
-			); errWithCode != nil {
-				log.Errorf(ctx, "could not block account: %v", errWithCode.Unwrap())
-				continue
-			}
+		if err := i.p.c.InvalidateTimelinedStatus(ctx, i.requester.ID, prev.StatusID); err != nil {
+			log.Errorf(ctx, "error invalidating status from timelines: %v", err)
 		}
 	}
 }
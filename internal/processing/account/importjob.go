@@ -0,0 +1,247 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// QueueImportJob pushes the given import job
+// to the processing worker queue, to be processed
+// asynchronously from the beginning of its data.
+func (p *Processor) QueueImportJob(job *gtsmodel.ImportJob) {
+	p.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		if err := p.processImportJob(ctx, job); err != nil {
+			log.Errorf(ctx, "error processing import job %s: %v", job.ID, err)
+		}
+	})
+}
+
+// processImportJob processes the given job, marking
+// it as failed if it could not be processed, so that
+// it's reported as such and not restarted on startup.
+//
+// If ctx is canceled (eg., on shutdown) before all
+// rows are processed, the job is left unfinished,
+// to be persisted and restarted on next startup.
+func (p *Processor) processImportJob(ctx context.Context, job *gtsmodel.ImportJob) error {
+	err := p.importJobRows(ctx, job)
+	if err == nil || ctx.Err() != nil {
+		// Either done, or we're shutting
+		// down and the job will be restarted.
+		return err
+	}
+
+	job.State = gtsmodel.ImportJobStateFailed
+	job.FinishedAt = time.Now()
+	job.Error = err.Error()
+	if err := p.state.DB.UpdateImportJob(ctx, job,
+		"state",
+		"finished_at",
+		"error",
+	); err != nil {
+		log.Errorf(ctx, "db error updating import job: %v", err)
+	}
+
+	return err
+}
+
+// importJobRows imports each row of the given
+// job's data in turn, recording progress and any
+// failed rows on the job as it goes.
+func (p *Processor) importJobRows(ctx context.Context, job *gtsmodel.ImportJob) error {
+	requester, err := p.state.DB.GetAccountByID(ctx, job.AccountID)
+	if err != nil {
+		return gtserror.Newf("db error getting requester: %w", err)
+	}
+
+	imp := p.newImporter(job.Type, requester, *job.Overwrite)
+	if imp == nil {
+		return gtserror.Newf("unsupported import type %s", job.Type)
+	}
+
+	records, err := parseImportRecords(job.Data)
+	if err != nil {
+		return gtserror.Newf("error parsing import data: %w", err)
+	}
+
+	// Clear out any progress from a
+	// previous, interrupted run of this
+	// job; imports are idempotent so it's
+	// safe to just start from the beginning.
+	if err := p.state.DB.DeleteImportJobFailures(ctx, job.ID); err != nil {
+		return gtserror.Newf("db error deleting import job failures: %w", err)
+	}
+
+	job.State = gtsmodel.ImportJobStateProcessing
+	job.ProcessedRows = 0
+	job.Successes = 0
+	job.Failures = 0
+	if err := p.state.DB.UpdateImportJob(ctx, job,
+		"state",
+		"processed_rows",
+		"successes",
+		"failures",
+	); err != nil {
+		return gtserror.Newf("db error updating import job: %w", err)
+	}
+
+	if err := imp.prepare(ctx); err != nil {
+		return gtserror.Newf("error preparing import: %w", err)
+	}
+
+	for i, record := range records {
+		if ctx.Err() != nil {
+			// We're shutting down, leave
+			// the job to be picked up again.
+			return nil
+		}
+
+		if i == 0 && isImportHeader(record) {
+			// CSV header row,
+			// skip this one.
+			continue
+		}
+
+		job.ProcessedRows++
+
+		if err := imp.importRow(ctx, record); err != nil {
+			job.Failures++
+
+			// Store the failed row so it can
+			// be downloaded and retried later.
+			if err := p.state.DB.PutImportJobFailure(ctx, &gtsmodel.ImportJobFailure{
+				ID:          id.NewULID(),
+				ImportJobID: job.ID,
+				RowNumber:   i + 1,
+				Record:      record,
+				Reason:      err.Error(),
+			}); err != nil {
+				log.Errorf(ctx, "db error putting import job failure: %v", err)
+			}
+		} else {
+			job.Successes++
+		}
+
+		if err := p.state.DB.UpdateImportJob(ctx, job,
+			"processed_rows",
+			"successes",
+			"failures",
+		); err != nil {
+			log.Errorf(ctx, "db error updating import job: %v", err)
+		}
+	}
+
+	if ctx.Err() != nil {
+		// Don't finish up (and maybe remove
+		// entries when overwriting) if we
+		// might not have imported every row.
+		return nil
+	}
+
+	imp.finish(ctx)
+
+	job.State = gtsmodel.ImportJobStateFinished
+	job.FinishedAt = time.Now()
+	if err := p.state.DB.UpdateImportJob(ctx, job,
+		"state",
+		"finished_at",
+	); err != nil {
+		return gtserror.Newf("db error updating import job: %w", err)
+	}
+
+	return nil
+}
+
+// ImportJobGet returns the import job with the given
+// ID owned by requester, including any failed rows.
+func (p *Processor) ImportJobGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	jobID string,
+) (*apimodel.ImportJob, gtserror.WithCode) {
+	job, errWithCode := p.getImportJob(ctx, requester, jobID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	failures, err := p.state.DB.GetImportJobFailures(ctx, job.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting import job failures: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.ImportJobToAPIImportJob(ctx, job, failures), nil
+}
+
+// ImportJobFailuresGet returns the CSV records of rows of the
+// import job with the given ID owned by requester that failed
+// to import, in the same format in which they were uploaded.
+func (p *Processor) ImportJobFailuresGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	jobID string,
+) ([][]string, gtserror.WithCode) {
+	job, errWithCode := p.getImportJob(ctx, requester, jobID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	failures, err := p.state.DB.GetImportJobFailures(ctx, job.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting import job failures: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	records := make([][]string, 0, len(failures))
+	for _, failure := range failures {
+		records = append(records, failure.Record)
+	}
+
+	return records, nil
+}
+
+func (p *Processor) getImportJob(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	jobID string,
+) (*gtsmodel.ImportJob, gtserror.WithCode) {
+	job, err := p.state.DB.GetImportJobByID(ctx, jobID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting import job: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if job == nil || job.AccountID != requester.ID {
+		// Don't reveal existence of
+		// other accounts' import jobs.
+		const text = "import job not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return job, nil
+}
@@ -19,6 +19,7 @@
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -77,6 +78,120 @@ func (suite *AccountTestSuite) TestAccountActionSuspend() {
 	suite.NotZero(targetAcct.SuspendedAt)
 }
 
+func (suite *AccountTestSuite) accountAction(actionType gtsmodel.AdminActionType, targetAcct *gtsmodel.Account) *gtsmodel.AdminAction {
+	ctx := context.Background()
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(
+		ctx,
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     actionType.String(),
+			Text:     "stinky",
+			TargetID: targetAcct.ID,
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure action recorded and
+	// marked as completed in the database.
+	adminAction, err := suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(actionType, adminAction.Type)
+	suite.Equal(targetAcct.ID, adminAction.TargetID)
+	suite.NotZero(adminAction.CompletedAt)
+	suite.Empty(adminAction.Errors)
+
+	return adminAction
+}
+
+func (suite *AccountTestSuite) TestAccountActionDisableReenable() {
+	ctx := context.Background()
+	targetAcct := suite.testAccounts["local_account_1"]
+
+	suite.accountAction(gtsmodel.AdminActionDisable, targetAcct)
+
+	user, err := suite.db.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*user.Disabled)
+
+	suite.accountAction(gtsmodel.AdminActionReenable, targetAcct)
+
+	user, err = suite.db.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*user.Disabled)
+}
+
+func (suite *AccountTestSuite) TestAccountActionDisableRemote() {
+	_, errWithCode := suite.adminProcessor.AccountAction(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionDisable.String(),
+			TargetID: suite.testAccounts["remote_account_1"].ID,
+		},
+	)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *AccountTestSuite) TestAccountActionSilenceUnsilence() {
+	ctx := context.Background()
+	targetAcct := suite.testAccounts["remote_account_1"]
+
+	suite.accountAction(gtsmodel.AdminActionSilence, targetAcct)
+
+	dbAcct, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbAcct.IsSilenced())
+
+	suite.accountAction(gtsmodel.AdminActionUnsilence, targetAcct)
+
+	dbAcct, err = suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbAcct.IsSilenced())
+}
+
+func (suite *AccountTestSuite) TestAccountActionSensitiveUnsensitive() {
+	ctx := context.Background()
+	targetAcct := suite.testAccounts["local_account_1"]
+
+	suite.accountAction(gtsmodel.AdminActionSensitive, targetAcct)
+
+	dbAcct, err := suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbAcct.IsSensitized())
+
+	suite.accountAction(gtsmodel.AdminActionUnsensitive, targetAcct)
+
+	dbAcct, err = suite.db.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbAcct.IsSensitized())
+}
+
 func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 	var (
 		ctx       = context.Background()
@@ -94,7 +209,7 @@ func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 		adminAcct,
 		request,
 	)
-	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\"]")
+	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"disable\" \"reenable\" \"silence\" \"unsilence\" \"sensitive\" \"unsensitive\" \"suspend\"]")
 	suite.Empty(actionID)
 }
 
@@ -19,13 +19,18 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 )
 
@@ -40,14 +45,29 @@ func (p *Processor) AccountAction(
 		return "", gtserror.NewErrorInternalError(err)
 	}
 
-	switch gtsmodel.NewAdminActionType(request.Type) {
+	switch actionType := gtsmodel.NewAdminActionType(request.Type); actionType {
 	case gtsmodel.AdminActionSuspend:
 		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
 
+	case gtsmodel.AdminActionDisable, gtsmodel.AdminActionReenable:
+		return p.accountActionDisable(ctx, adminAcct, targetAcct, actionType, request.Text)
+
+	case gtsmodel.AdminActionSilence, gtsmodel.AdminActionUnsilence:
+		return p.accountActionSilence(ctx, adminAcct, targetAcct, actionType, request.Text)
+
+	case gtsmodel.AdminActionSensitive, gtsmodel.AdminActionUnsensitive:
+		return p.accountActionSensitive(ctx, adminAcct, targetAcct, actionType, request.Text)
+
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
+			gtsmodel.AdminActionDisable.String(),
+			gtsmodel.AdminActionReenable.String(),
+			gtsmodel.AdminActionSilence.String(),
+			gtsmodel.AdminActionUnsilence.String(),
+			gtsmodel.AdminActionSensitive.String(),
+			gtsmodel.AdminActionUnsensitive.String(),
 			gtsmodel.AdminActionSuspend.String(),
 		}
 
@@ -66,19 +86,7 @@ func (p *Processor) accountActionSuspend(
 	targetAcct *gtsmodel.Account,
 	text string,
 ) (string, gtserror.WithCode) {
-	actionID := id.NewULID()
-
-	errWithCode := p.actions.Run(
-		ctx,
-		&gtsmodel.AdminAction{
-			ID:             actionID,
-			TargetCategory: gtsmodel.AdminActionCategoryAccount,
-			TargetID:       targetAcct.ID,
-			Target:         targetAcct,
-			Type:           gtsmodel.AdminActionSuspend,
-			AccountID:      adminAcct.ID,
-			Text:           text,
-		},
+	return p.runAccountAction(ctx, adminAcct, targetAcct, gtsmodel.AdminActionSuspend, text,
 		func(ctx context.Context) gtserror.MultiError {
 			if err := p.state.Workers.Client.Process(
 				ctx,
@@ -97,6 +105,198 @@ func(ctx context.Context) gtserror.MultiError {
 			return nil
 		},
 	)
+}
+
+// accountActionDisable disables (or reenables) the user
+// of the given local account, preventing (or allowing)
+// them from logging in or using any existing tokens.
+func (p *Processor) accountActionDisable(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionType gtsmodel.AdminActionType,
+	text string,
+) (string, gtserror.WithCode) {
+	if targetAcct.IsRemote() {
+		err := fmt.Errorf("account %s is not a local account, only local accounts can be disabled or reenabled", targetAcct.ID)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if targetAcct.IsGroup() {
+		err := fmt.Errorf("account %s is a group, groups have no user to disable or reenable", targetAcct.ID)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", targetAcct.ID, err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	disabled := (actionType == gtsmodel.AdminActionDisable)
+
+	return p.runAccountAction(ctx, adminAcct, targetAcct, actionType, text,
+		func(ctx context.Context) gtserror.MultiError {
+			user.Disabled = &disabled
+			if err := p.state.DB.UpdateUser(ctx, user, "disabled"); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Appendf("db error updating user: %w", err)
+				return errs
+			}
+
+			return nil
+		},
+	)
+}
+
+// accountActionSilence silences (or unsilences) the given
+// account, hiding its statuses from public timelines and from
+// accounts that don't follow it, and preventing notifications
+// from it reaching accounts that don't follow it.
+func (p *Processor) accountActionSilence(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionType gtsmodel.AdminActionType,
+	text string,
+) (string, gtserror.WithCode) {
+	return p.runAccountAction(ctx, adminAcct, targetAcct, actionType, text,
+		func(ctx context.Context) gtserror.MultiError {
+			if actionType == gtsmodel.AdminActionSilence {
+				targetAcct.SilencedAt = time.Now()
+			} else {
+				targetAcct.SilencedAt = time.Time{}
+			}
+
+			if err := p.state.DB.UpdateAccount(ctx, targetAcct, "silenced_at"); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Appendf("db error updating account: %w", err)
+				return errs
+			}
+
+			// Visibility of this account's statuses is cached
+			// per status, so just clear the lot rather than
+			// trying to track down every status of the account.
+			p.state.Caches.Visibility.Clear()
+
+			return nil
+		},
+	)
+}
+
+// accountActionSensitive sets (or unsets) the given account
+// to have all of its media shown as sensitive, regardless of
+// whether the account marked the media as sensitive itself.
+func (p *Processor) accountActionSensitive(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionType gtsmodel.AdminActionType,
+	text string,
+) (string, gtserror.WithCode) {
+	return p.runAccountAction(ctx, adminAcct, targetAcct, actionType, text,
+		func(ctx context.Context) gtserror.MultiError {
+			if actionType == gtsmodel.AdminActionSensitive {
+				targetAcct.SensitizedAt = time.Now()
+			} else {
+				targetAcct.SensitizedAt = time.Time{}
+			}
+
+			if err := p.state.DB.UpdateAccount(ctx, targetAcct, "sensitized_at"); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Appendf("db error updating account: %w", err)
+				return errs
+			}
+
+			// Unprepare this account's statuses with media from
+			// timelines, so they're prepared again next time
+			// with the updated sensitive flag.
+			if err := p.unprepareAccountStatuses(ctx, targetAcct.ID, true); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(err)
+				return errs
+			}
+
+			return nil
+		},
+	)
+}
+
+// unprepareAccountStatuses unprepares statuses of the given account
+// (optionally only those with media) from all home and list timelines.
+func (p *Processor) unprepareAccountStatuses(
+	ctx context.Context,
+	accountID string,
+	mediaOnly bool,
+) error {
+	var maxID string
+	for {
+		statuses, err := p.state.DB.GetAccountStatuses(
+			gtscontext.SetBarebones(ctx),
+			accountID,
+			200,   // limit
+			false, // excludeReplies
+			true,  // excludeReblogs
+			maxID,
+			"", // minID
+			mediaOnly,
+			false, // publicOnly
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting account statuses: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			// Done.
+			return nil
+		}
+
+		for _, status := range statuses {
+			p.unprepareStatus(ctx, status.ID)
+		}
+
+		maxID = statuses[len(statuses)-1].ID
+	}
+}
+
+// unprepareStatus unprepares the given status from all home
+// and list timelines, forcing it to be prepared again next time.
+func (p *Processor) unprepareStatus(ctx context.Context, statusID string) {
+	if err := p.state.Timelines.Home.UnprepareItemFromAllTimelines(ctx, statusID); err != nil {
+		log.Errorf(ctx, "error unpreparing status %s from home timelines: %v", statusID, err)
+	}
+
+	if err := p.state.Timelines.List.UnprepareItemFromAllTimelines(ctx, statusID); err != nil {
+		log.Errorf(ctx, "error unpreparing status %s from list timelines: %v", statusID, err)
+	}
+}
+
+// runAccountAction runs the given function as an admin action
+// of the given type, taken by adminAcct on targetAcct, returning
+// the ID of the admin action so it can be looked up later.
+func (p *Processor) runAccountAction(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	actionType gtsmodel.AdminActionType,
+	text string,
+	f func(context.Context) gtserror.MultiError,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           actionType,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		f,
+	)
 
 	return actionID, errWithCode
 }
@@ -23,7 +23,10 @@
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/announcements"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/groups"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
@@ -33,6 +36,18 @@ type Processor struct {
 	// common processor logic
 	c *common.Processor
 
+	// used to queue import
+	// jobs on startup
+	account *account.Processor
+
+	// used to format, publish
+	// and stream announcements
+	announcements *announcements.Processor
+
+	// used to moderate
+	// local groups
+	groups *groups.Processor
+
 	state     *state.State
 	cleaner   *cleaner.Cleaner
 	converter *typeutils.Converter
@@ -53,6 +68,9 @@ func (p *Processor) Actions() *Actions {
 // New returns a new admin processor.
 func New(
 	common *common.Processor,
+	account *account.Processor,
+	announcements *announcements.Processor,
+	groups *groups.Processor,
 	state *state.State,
 	cleaner *cleaner.Cleaner,
 	federator *federation.Federator,
@@ -62,14 +80,17 @@ func New(
 	emailSender email.Sender,
 ) Processor {
 	return Processor{
-		c:         common,
-		state:     state,
-		cleaner:   cleaner,
-		converter: converter,
-		federator: federator,
-		media:     mediaManager,
-		transport: transportController,
-		email:     emailSender,
+		c:             common,
+		account:       account,
+		announcements: announcements,
+		groups:        groups,
+		state:         state,
+		cleaner:       cleaner,
+		converter:     converter,
+		federator:     federator,
+		media:         mediaManager,
+		transport:     transportController,
+		email:         emailSender,
 		actions: &Actions{
 			r:     make(map[string]*gtsmodel.AdminAction),
 			state: state,
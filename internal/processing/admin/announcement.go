@@ -0,0 +1,375 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// AnnouncementsGet returns all announcements, including
+// unpublished and ended ones, most recently created first.
+func (p *Processor) AnnouncementsGet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, adminAcct)
+		if err != nil {
+			log.Errorf(ctx, "error converting announcement %s: %v", announcement.ID, err)
+			continue
+		}
+
+		items = append(items, apiAnnouncement)
+	}
+
+	return items, nil
+}
+
+// AnnouncementCreate creates a new announcement from the given form.
+// The announcement is published immediately if the form says so, or
+// else at its scheduled time, if any. Otherwise, it's kept as a draft
+// until it's published by a later update.
+func (p *Processor) AnnouncementCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AdminAnnouncementRequest,
+) (*apimodel.Announcement, gtserror.WithCode) {
+	text := strings.TrimSpace(util.PtrOrZero(form.Text))
+	if text == "" {
+		const errText = "text must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(errText), errText)
+	}
+
+	announcement := &gtsmodel.Announcement{
+		ID:                 id.NewULID(),
+		Text:               text,
+		AllDay:             util.Ptr(util.PtrOrZero(form.AllDay)),
+		CreatedByAccountID: adminAcct.ID,
+	}
+
+	var errWithCode gtserror.WithCode
+
+	announcement.StartsAt, errWithCode = parseAnnouncementTime("starts_at", form.StartsAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	announcement.EndsAt, errWithCode = parseAnnouncementTime("ends_at", form.EndsAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	announcement.ScheduledAt, errWithCode = parseAnnouncementTime("scheduled_at", form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := validateAnnouncementTimes(announcement); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.announcements.Format(ctx, announcement)
+
+	if err := p.state.DB.PutAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("db error putting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	switch {
+	case util.PtrOrZero(form.Publish):
+		if err := p.announcements.Publish(ctx, announcement); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+	case !announcement.ScheduledAt.IsZero():
+		if err := p.announcements.Schedule(ctx, announcement); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiAnnouncement(ctx, announcement, adminAcct)
+}
+
+// AnnouncementUpdate updates the announcement with the given ID using
+// the fields set on the given form. Edits to an active announcement
+// are streamed out to users; an announcement that's no longer active
+// after the update is removed from users' streams instead.
+func (p *Processor) AnnouncementUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	announcementID string,
+	form *apimodel.AdminAnnouncementRequest,
+) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var (
+		wasActive = announcement.IsActive()
+		columns   []string
+	)
+
+	if form.Text != nil {
+		text := strings.TrimSpace(*form.Text)
+		if text == "" {
+			const errText = "text cannot be empty"
+			return nil, gtserror.NewErrorBadRequest(errors.New(errText), errText)
+		}
+
+		announcement.Text = text
+		p.announcements.Format(ctx, announcement)
+		columns = append(columns,
+			"text",
+			"content",
+			"emojis",
+			"tags",
+			"mentioned_account_ids",
+		)
+	}
+
+	if form.StartsAt != nil {
+		announcement.StartsAt, errWithCode = parseAnnouncementTime("starts_at", form.StartsAt)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "starts_at")
+	}
+
+	if form.EndsAt != nil {
+		announcement.EndsAt, errWithCode = parseAnnouncementTime("ends_at", form.EndsAt)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "ends_at")
+	}
+
+	if form.AllDay != nil {
+		announcement.AllDay = util.Ptr(*form.AllDay)
+		columns = append(columns, "all_day")
+	}
+
+	if form.ScheduledAt != nil {
+		if announcement.IsPublished() {
+			const text = "cannot schedule an announcement that's already published"
+			return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+
+		announcement.ScheduledAt, errWithCode = parseAnnouncementTime("scheduled_at", form.ScheduledAt)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "scheduled_at")
+	}
+
+	if errWithCode := validateAnnouncementTimes(announcement); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if len(columns) > 0 {
+		if err := p.state.DB.UpdateAnnouncement(ctx, announcement, columns...); err != nil {
+			err := gtserror.Newf("db error updating announcement: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	switch {
+	case util.PtrOrZero(form.Publish) && !announcement.IsPublished():
+		// Publish now, no
+		// need to schedule.
+		p.announcements.Unschedule(announcement)
+		if err := p.announcements.Publish(ctx, announcement); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+	case form.ScheduledAt != nil:
+		// Publication time changed.
+		if announcement.ScheduledAt.IsZero() {
+			p.announcements.Unschedule(announcement)
+		} else if err := p.announcements.Schedule(ctx, announcement); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+	case len(columns) > 0 && announcement.IsActive():
+		// Stream out edits.
+		p.announcements.StreamUpdate(ctx, announcement)
+
+	case wasActive && !announcement.IsActive():
+		// Edited out of view,
+		// eg., ends_at moved.
+		p.announcements.StreamDelete(ctx, announcement.ID)
+	}
+
+	return p.apiAnnouncement(ctx, announcement, adminAcct)
+}
+
+// AnnouncementEnd ends the announcement with the given ID
+// now, removing it from users' view, and cancelling its
+// scheduled publication, if it wasn't yet published.
+func (p *Processor) AnnouncementEnd(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	announcementID string,
+) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if announcement.IsEnded() {
+		// Nothing to do.
+		return p.apiAnnouncement(ctx, announcement, adminAcct)
+	}
+
+	wasActive := announcement.IsActive()
+	p.announcements.Unschedule(announcement)
+
+	announcement.EndsAt = time.Now()
+	columns := []string{"ends_at"}
+	if !announcement.IsPublished() {
+		announcement.ScheduledAt = time.Time{}
+		columns = append(columns, "scheduled_at")
+	}
+
+	if err := p.state.DB.UpdateAnnouncement(ctx, announcement, columns...); err != nil {
+		err := gtserror.Newf("db error updating announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if wasActive {
+		p.announcements.StreamDelete(ctx, announcement.ID)
+	}
+
+	return p.apiAnnouncement(ctx, announcement, adminAcct)
+}
+
+// AnnouncementDelete deletes the announcement with the given ID,
+// along with all reactions to it, removing it from users' view.
+func (p *Processor) AnnouncementDelete(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	announcementID string,
+) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, announcementID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deleting,
+	// while reactions still exist.
+	apiAnnouncement, errWithCode := p.apiAnnouncement(ctx, announcement, adminAcct)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.announcements.Unschedule(announcement)
+
+	if err := p.state.DB.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		err := gtserror.Newf("db error deleting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement.IsActive() {
+		p.announcements.StreamDelete(ctx, announcement.ID)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// getAnnouncement fetches the announcement with
+// the given ID, returning 404 if it doesn't exist.
+func (p *Processor) getAnnouncement(
+	ctx context.Context,
+	announcementID string,
+) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, announcementID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("announcement %s not found", announcementID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err := gtserror.Newf("db error getting announcement %s: %w", announcementID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return announcement, nil
+}
+
+func (p *Processor) apiAnnouncement(
+	ctx context.Context,
+	announcement *gtsmodel.Announcement,
+	adminAcct *gtsmodel.Account,
+) (*apimodel.Announcement, gtserror.WithCode) {
+	apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, adminAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting announcement %s: %w", announcement.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// parseAnnouncementTime parses the given announcement form
+// field value as an ISO 8601 datetime, returning the zero
+// time if the value is not set or set to an empty string.
+func parseAnnouncementTime(field string, value *string) (time.Time, gtserror.WithCode) {
+	if util.PtrOrZero(value) == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		text := fmt.Sprintf("%s %s could not be parsed as an ISO 8601 datetime", field, *value)
+		return time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	return t, nil
+}
+
+// validateAnnouncementTimes checks that the given
+// announcement doesn't end before it starts.
+func validateAnnouncementTimes(announcement *gtsmodel.Announcement) gtserror.WithCode {
+	if !announcement.StartsAt.IsZero() &&
+		!announcement.EndsAt.IsZero() &&
+		announcement.EndsAt.Before(announcement.StartsAt) {
+		const text = "ends_at must not be before starts_at"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+// DeliveryFailed stores the given failed delivery in the database
+// so that it may later be inspected, retried or purged by an admin.
+// This is intended to be set as the delivery WorkerPool{}.Failed func.
+func (p *Processor) DeliveryFailed(
+	ctx context.Context,
+	dlv *delivery.Delivery,
+	statusCode int,
+	err error,
+) {
+	// Serialize delivery so it can be retried later.
+	data, serr := dlv.Serialize()
+	if serr != nil {
+		log.Errorf(ctx, "error serializing failed delivery: %v", serr)
+		return
+	}
+
+	failure := &gtsmodel.DeliveryFailure{
+		ID:         id.NewULID(),
+		TargetHost: dlv.Request.URL.Host,
+		RequestURL: dlv.Request.URL.String(),
+		ActorID:    dlv.ActorID,
+		ObjectID:   dlv.ObjectID,
+		TargetID:   dlv.TargetID,
+		StatusCode: statusCode,
+		Attempts:   dlv.Attempts(),
+		Data:       data,
+	}
+
+	if err != nil {
+		failure.Error = err.Error()
+	}
+
+	if err := p.state.DB.PutDeliveryFailure(ctx, failure); err != nil {
+		log.Errorf(ctx, "error storing failed delivery to %s: %v", failure.RequestURL, err)
+	}
+}
+
+// DeliveryFailuresGet returns a page of stored delivery
+// failures, optionally filtered by the given target host.
+func (p *Processor) DeliveryFailuresGet(
+	ctx context.Context,
+	targetHost string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	failures, err := p.state.DB.GetDeliveryFailures(ctx, targetHost, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting delivery failures: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(failures)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := failures[count-1].ID
+	hi := failures[0].ID
+
+	// Convert each failure to API model.
+	items := make([]interface{}, 0, count)
+	for _, f := range failures {
+		item, err := p.converter.DeliveryFailureToAdminAPIDeliveryFailure(ctx, f)
+		if err != nil {
+			err := gtserror.Newf("error converting delivery failure to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items = append(items, item)
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if targetHost != "" {
+		query.Set(apiutil.AdminTargetHostKey, targetHost)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/delivery_failures",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// DeliveryFailureGet returns one delivery failure, with the given ID.
+func (p *Processor) DeliveryFailureGet(ctx context.Context, id string) (*apimodel.AdminDeliveryFailure, gtserror.WithCode) {
+	failure, errWithCode := p.getDeliveryFailure(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFailure, err := p.converter.DeliveryFailureToAdminAPIDeliveryFailure(ctx, failure)
+	if err != nil {
+		err := gtserror.Newf("error converting delivery failure to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFailure, nil
+}
+
+// DeliveryFailureRetry re-signs and pushes the delivery failure with
+// the given ID back onto the delivery queue, removing it from the
+// database. If it fails again, it will be stored as a new failure.
+func (p *Processor) DeliveryFailureRetry(ctx context.Context, id string) (*apimodel.AdminDeliveryFailure, gtserror.WithCode) {
+	failure, errWithCode := p.getDeliveryFailure(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFailure, err := p.converter.DeliveryFailureToAdminAPIDeliveryFailure(ctx, failure)
+	if err != nil {
+		err := gtserror.Newf("error converting delivery failure to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Re-queue the stored delivery.
+	if err := p.pushDeliveryData(ctx, failure.Data); err != nil {
+		err := gtserror.Newf("error requeuing delivery: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Delivery is queued again, we can drop it.
+	if err := p.state.DB.DeleteDeliveryFailureByID(ctx, failure.ID); err != nil {
+		err := gtserror.Newf("db error deleting delivery failure: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFailure, nil
+}
+
+// DeliveryFailureDelete deletes one delivery failure with
+// the given ID, returning the deleted delivery failure.
+func (p *Processor) DeliveryFailureDelete(ctx context.Context, id string) (*apimodel.AdminDeliveryFailure, gtserror.WithCode) {
+	failure, errWithCode := p.getDeliveryFailure(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFailure, err := p.converter.DeliveryFailureToAdminAPIDeliveryFailure(ctx, failure)
+	if err != nil {
+		err := gtserror.Newf("error converting delivery failure to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteDeliveryFailureByID(ctx, failure.ID); err != nil {
+		err := gtserror.Newf("db error deleting delivery failure: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFailure, nil
+}
+
+// DeliveryFailuresDelete purges all stored delivery failures,
+// or only those for the given target host if it is set.
+func (p *Processor) DeliveryFailuresDelete(ctx context.Context, targetHost string) (*apimodel.AdminDeliveryFailuresPurgeResponse, gtserror.WithCode) {
+	deleted, err := p.state.DB.DeleteDeliveryFailures(ctx, targetHost)
+	if err != nil {
+		err := gtserror.Newf("db error deleting delivery failures: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.AdminDeliveryFailuresPurgeResponse{Deleted: deleted}, nil
+}
+
+// getDeliveryFailure fetches the delivery failure
+// with given ID, returning 404 if it doesn't exist.
+func (p *Processor) getDeliveryFailure(ctx context.Context, id string) (*gtsmodel.DeliveryFailure, gtserror.WithCode) {
+	failure, err := p.state.DB.GetDeliveryFailureByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting delivery failure %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if failure == nil {
+		err := gtserror.Newf("delivery failure %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return failure, nil
+}
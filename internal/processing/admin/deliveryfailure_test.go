@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DeliveryFailureTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DeliveryFailureTestSuite) TestDeliveryFailedRetry() {
+	ctx := context.Background()
+	dlv := testDeliveries[0]
+
+	// Record a failed delivery.
+	suite.adminProcessor.DeliveryFailed(ctx, dlv, http.StatusGone, errors.New("http response: 410 Gone"))
+
+	// It should be listed for its target host.
+	resp, errWithCode := suite.adminProcessor.DeliveryFailuresGet(ctx, "askjeeves.com", &paging.Page{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	failure := resp.Items[0].(*apimodel.AdminDeliveryFailure)
+	suite.Equal("askjeeves.com", failure.TargetHost)
+	suite.Equal("https://askjeeves.com/users/smallboy/inbox", failure.RequestURL)
+	suite.Equal(dlv.ObjectID, failure.ObjectID)
+	suite.Equal(dlv.TargetID, failure.TargetID)
+	suite.Equal(http.StatusGone, failure.StatusCode)
+	suite.Equal("http response: 410 Gone", failure.Error)
+
+	// But not for another host.
+	resp, errWithCode = suite.adminProcessor.DeliveryFailuresGet(ctx, "google.com", &paging.Page{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(resp.Items)
+
+	// Retry the failure, it should be requeued.
+	if _, errWithCode := suite.adminProcessor.DeliveryFailureRetry(ctx, failure.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	queued, ok := suite.state.Workers.Delivery.Queue.Pop()
+	if !ok {
+		suite.FailNow("expected requeued delivery")
+	}
+	suite.NoError(containsSerializable(testDeliveries, queued))
+
+	// And no longer be stored.
+	_, errWithCode = suite.adminProcessor.DeliveryFailureGet(ctx, failure.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *DeliveryFailureTestSuite) TestDeliveryFailuresDelete() {
+	ctx := context.Background()
+
+	for _, dlv := range testDeliveries {
+		suite.adminProcessor.DeliveryFailed(ctx, dlv, 0, errors.New("max retries reached"))
+	}
+
+	resp, errWithCode := suite.adminProcessor.DeliveryFailuresDelete(ctx, "google.com")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(1, resp.Deleted)
+
+	resp, errWithCode = suite.adminProcessor.DeliveryFailuresDelete(ctx, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(1, resp.Deleted)
+}
+
+func TestDeliveryFailureTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryFailureTestSuite))
+}
@@ -0,0 +1,312 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"codeberg.org/gruf/go-kv"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DomainLimitCreate creates an instance-level domain limit
+// targeting the domain given in the form, and then processes
+// side effects of the limit asynchronously.
+//
+// Return values for this function are the new domain limit,
+// the ID of the admin action resulting from this call,
+// and/or an error if something goes wrong.
+func (p *Processor) DomainLimitCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.DomainLimitRequest,
+) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+	if form.Domain == nil || *form.Domain == "" {
+		const errText = "empty domain provided"
+		return nil, "", gtserror.NewErrorBadRequest(errors.New(errText), errText)
+	}
+	domain := *form.Domain
+
+	// Check if a limit already exists for this domain.
+	existing, err := p.state.DB.GetDomainLimit(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// Something went wrong in the DB.
+		err = gtserror.Newf("db error getting domain limit %s: %w", domain, err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		err := fmt.Errorf("a domain limit for %s already exists with id %s, update it instead", domain, existing.ID)
+		return nil, "", gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	domainLimit := &gtsmodel.DomainLimit{
+		ID:                 id.NewULID(),
+		Domain:             domain,
+		CreatedByAccountID: adminAcct.ID,
+		Obfuscate:          util.Ptr(util.PtrOrZero(form.Obfuscate)),
+		MediaSensitive:     util.Ptr(util.PtrOrZero(form.MediaSensitive)),
+		HideFromPublic:     util.Ptr(util.PtrOrZero(form.HideFromPublic)),
+	}
+
+	if form.PrivateComment != nil {
+		domainLimit.PrivateComment = text.SanitizeToPlaintext(*form.PrivateComment)
+	}
+
+	if form.PublicComment != nil {
+		domainLimit.PublicComment = text.SanitizeToPlaintext(*form.PublicComment)
+	}
+
+	if form.ContentWarning != nil {
+		domainLimit.ContentWarning = text.SanitizeToPlaintext(*form.ContentWarning)
+	}
+
+	// Insert the new limit into the database.
+	if err := p.state.DB.CreateDomainLimit(ctx, domainLimit); err != nil {
+		err = gtserror.Newf("db error putting domain limit %s: %w", domain, err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	actionID, errWithCode := p.runDomainLimitAction(ctx,
+		adminAcct,
+		domainLimit,
+		gtsmodel.AdminActionSilence,
+	)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	apiDomainLimit, errWithCode := p.apiDomainPerm(ctx, domainLimit, false)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	return apiDomainLimit, actionID, nil
+}
+
+// DomainLimitUpdate updates the domain limit with the given ID,
+// setting any fields that are set in the form, and then processes
+// side effects of the updated limit asynchronously.
+//
+// Return values for this function are the updated domain limit,
+// the ID of the admin action resulting from this call,
+// and/or an error if something goes wrong.
+func (p *Processor) DomainLimitUpdate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domainLimitID string,
+	form *apimodel.DomainLimitRequest,
+) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+	domainLimit, errWithCode := p.getDomainLimit(ctx, domainLimitID)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	var columns []string
+
+	if form.Obfuscate != nil {
+		domainLimit.Obfuscate = form.Obfuscate
+		columns = append(columns, "obfuscate")
+	}
+
+	if form.PrivateComment != nil {
+		domainLimit.PrivateComment = text.SanitizeToPlaintext(*form.PrivateComment)
+		columns = append(columns, "private_comment")
+	}
+
+	if form.PublicComment != nil {
+		domainLimit.PublicComment = text.SanitizeToPlaintext(*form.PublicComment)
+		columns = append(columns, "public_comment")
+	}
+
+	if form.MediaSensitive != nil {
+		domainLimit.MediaSensitive = form.MediaSensitive
+		columns = append(columns, "media_sensitive")
+	}
+
+	if form.ContentWarning != nil {
+		domainLimit.ContentWarning = text.SanitizeToPlaintext(*form.ContentWarning)
+		columns = append(columns, "content_warning")
+	}
+
+	if form.HideFromPublic != nil {
+		domainLimit.HideFromPublic = form.HideFromPublic
+		columns = append(columns, "hide_from_public")
+	}
+
+	if len(columns) == 0 {
+		const errText = "no updateable fields set on request"
+		return nil, "", gtserror.NewErrorBadRequest(errors.New(errText), errText)
+	}
+
+	if err := p.state.DB.UpdateDomainLimit(ctx, domainLimit, columns...); err != nil {
+		err = gtserror.Newf("db error updating domain limit: %w", err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	actionID, errWithCode := p.runDomainLimitAction(ctx,
+		adminAcct,
+		domainLimit,
+		gtsmodel.AdminActionSilence,
+	)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	apiDomainLimit, errWithCode := p.apiDomainPerm(ctx, domainLimit, false)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	return apiDomainLimit, actionID, nil
+}
+
+func (p *Processor) deleteDomainLimit(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domainLimitID string,
+) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+	domainLimit, errWithCode := p.getDomainLimit(ctx, domainLimitID)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	// Prepare the domain limit to return, *before* the deletion goes through.
+	apiDomainLimit, errWithCode := p.apiDomainPerm(ctx, domainLimit, false)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	// Delete the original domain limit.
+	if err := p.state.DB.DeleteDomainLimit(ctx, domainLimit.Domain); err != nil {
+		err = gtserror.Newf("db error deleting domain limit: %w", err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	actionID, errWithCode := p.runDomainLimitAction(ctx,
+		adminAcct,
+		domainLimit,
+		gtsmodel.AdminActionUnsilence,
+	)
+	if errWithCode != nil {
+		return nil, actionID, errWithCode
+	}
+
+	return apiDomainLimit, actionID, nil
+}
+
+// getDomainLimit gets the domain limit with the given
+// ID, returning 404 if it doesn't exist.
+func (p *Processor) getDomainLimit(
+	ctx context.Context,
+	domainLimitID string,
+) (*gtsmodel.DomainLimit, gtserror.WithCode) {
+	domainLimit, err := p.state.DB.GetDomainLimitByID(ctx, domainLimitID)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// Real error.
+			err = gtserror.Newf("db error getting domain limit: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// There are just no entries for this ID.
+		err = fmt.Errorf("no domain limit entry exists with ID %s", domainLimitID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return domainLimit, nil
+}
+
+// runDomainLimitAction processes side effects of
+// creating, updating, or deleting the given domain
+// limit asynchronously, as an admin action of the
+// given type, returning the ID of the admin action.
+func (p *Processor) runDomainLimitAction(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domainLimit *gtsmodel.DomainLimit,
+	actionType gtsmodel.AdminActionType,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	var text string
+	if actionType == gtsmodel.AdminActionSilence {
+		text = domainLimit.PrivateComment
+	}
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryDomain,
+			TargetID:       domainLimit.Domain,
+			Type:           actionType,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			// Log start + finish.
+			l := log.WithFields(kv.Fields{
+				{"domain", domainLimit.Domain},
+				{"actionID", actionID},
+			}...).WithContext(ctx)
+
+			l.Info("processing domain limit side effects")
+			defer func() { l.Info("finished processing domain limit side effects") }()
+
+			return p.domainLimitSideEffects(ctx, domainLimit)
+		},
+	)
+
+	return actionID, errWithCode
+}
+
+// domainLimitSideEffects makes sure that a changed domain limit
+// is reflected wherever statuses from the domain were already
+// prepared or checked for visibility before the change.
+func (p *Processor) domainLimitSideEffects(
+	ctx context.Context,
+	domainLimit *gtsmodel.DomainLimit,
+) gtserror.MultiError {
+	var errs gtserror.MultiError
+
+	// Whether statuses are shown on public
+	// and tag timelines may have changed.
+	p.state.Caches.Visibility.Clear()
+
+	// Unprepare statuses from accounts on this domain from timelines,
+	// so they're prepared again next time with the limit (not) applied.
+	if err := p.rangeDomainAccounts(ctx, domainLimit.Domain, func(account *gtsmodel.Account) {
+		if err := p.unprepareAccountStatuses(ctx, account.ID, false); err != nil {
+			errs.Append(err)
+		}
+	}); err != nil {
+		errs.Append(err)
+	}
+
+	return errs
+}
@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DomainLimitTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainLimitTestSuite) waitForActions() {
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin actions")
+	}
+}
+
+func (suite *DomainLimitTestSuite) TestDomainLimitCreateUpdateDelete() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+	// Use a public copy of this status, so it
+	// would otherwise be on the public timeline.
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["remote_account_1_status_1"]
+	status.Visibility = gtsmodel.VisibilityPublic
+
+	limit, actionID, errWithCode := suite.adminProcessor.DomainLimitCreate(ctx,
+		adminAcct,
+		&apimodel.DomainLimitRequest{
+			Domain:         util.Ptr("fossbros-anonymous.io"),
+			ContentWarning: util.Ptr("from a limited instance"),
+			HideFromPublic: util.Ptr(true),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.waitForActions()
+	suite.Equal("fossbros-anonymous.io", limit.Domain.Domain)
+	suite.Equal("from a limited instance", limit.ContentWarning)
+	suite.True(limit.HideFromPublic)
+	suite.False(limit.MediaSensitive)
+
+	action, err := suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AdminActionSilence, action.Type)
+	suite.Equal("fossbros-anonymous.io", action.TargetID)
+
+	// Statuses from the domain should now
+	// be hidden from the public timeline.
+	timelineable, err := visibility.NewFilter(&suite.state).StatusPublicTimelineable(ctx, nil, status)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(timelineable)
+
+	// The content warning should be prepended
+	// when converting statuses from the domain.
+	apiStatus, err := suite.tc.StatusToAPIStatus(ctx, status, nil, "", nil, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("from a limited instance", apiStatus.SpoilerText)
+
+	// Creating another limit for the domain should conflict.
+	_, _, errWithCode = suite.adminProcessor.DomainLimitCreate(ctx,
+		adminAcct,
+		&apimodel.DomainLimitRequest{Domain: util.Ptr("fossbros-anonymous.io")},
+	)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Update the limit to stop hiding statuses.
+	limit, _, errWithCode = suite.adminProcessor.DomainLimitUpdate(ctx,
+		adminAcct,
+		limit.ID,
+		&apimodel.DomainLimitRequest{
+			MediaSensitive: util.Ptr(true),
+			HideFromPublic: util.Ptr(false),
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.waitForActions()
+	suite.Equal("from a limited instance", limit.ContentWarning)
+	suite.True(limit.MediaSensitive)
+	suite.False(limit.HideFromPublic)
+
+	timelineable, err = visibility.NewFilter(&suite.state).StatusPublicTimelineable(ctx, nil, status)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(timelineable)
+
+	// Delete the limit, an unsilence should be logged.
+	_, actionID, errWithCode = suite.adminProcessor.DomainPermissionDelete(ctx,
+		gtsmodel.DomainPermissionLimit,
+		adminAcct,
+		limit.ID,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.waitForActions()
+
+	action, err = suite.db.GetAdminAction(ctx, actionID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AdminActionUnsilence, action.Type)
+
+	_, errWithCode = suite.adminProcessor.DomainPermissionGet(ctx,
+		gtsmodel.DomainPermissionLimit,
+		limit.ID,
+		false,
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestDomainLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainLimitTestSuite))
+}
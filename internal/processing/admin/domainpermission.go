@@ -33,7 +33,7 @@
 
 // apiDomainPerm is a cheeky shortcut for returning
 // the API version of the given domain permission
-// (*gtsmodel.DomainBlock or *gtsmodel.DomainAllow),
+// (*gtsmodel.DomainBlock, *gtsmodel.DomainAllow, etc.),
 // or an appropriate error if something goes wrong.
 func (p *Processor) apiDomainPerm(
 	ctx context.Context,
@@ -131,6 +131,14 @@ func (p *Processor) DomainPermissionDelete(
 			domainBlockID,
 		)
 
+	// Delete domain limit.
+	case gtsmodel.DomainPermissionLimit:
+		return p.deleteDomainLimit(
+			ctx,
+			adminAcct,
+			domainBlockID,
+		)
+
 	// You do the hokey-cokey and you turn
 	// around, that's what it's all about.
 	default:
@@ -144,6 +152,11 @@ func (p *Processor) DomainPermissionDelete(
 // function for each domain in the provided file. Will return
 // a slice of processed domain permissions.
 //
+// If asDraft is true, domain permission drafts will be
+// created instead, which have no effect until accepted.
+// Domains on the domain permission excludes list are
+// skipped, and reported as failures in the MultiStatus.
+//
 // In the case of total failure, a gtserror.WithCode will be
 // returned so that the caller can respond appropriately. In
 // the case of partial or total success, a MultiStatus model
@@ -155,6 +168,7 @@ func (p *Processor) DomainPermissionsImport(
 	permissionType gtsmodel.DomainPermissionType,
 	account *gtsmodel.Account,
 	domainsF *multipart.FileHeader,
+	asDraft bool,
 ) (*apimodel.MultiStatus, gtserror.WithCode) {
 	// Ensure known permission type.
 	if permissionType != gtsmodel.DomainPermissionBlock &&
@@ -199,16 +213,37 @@ func (p *Processor) DomainPermissionsImport(
 			errWithCode    gtserror.WithCode
 		)
 
-		domainPerm, _, errWithCode = p.DomainPermissionCreate(
-			ctx,
-			permissionType,
-			account,
-			domain,
-			obfuscate,
-			publicComment,
-			privateComment,
-			subscriptionID,
-		)
+		if asDraft {
+			// Create a draft, to be accepted
+			// or rejected by an admin later.
+			var draft *gtsmodel.DomainPermissionDraft
+			draft, errWithCode = p.createDomainPermDraft(
+				ctx,
+				permissionType,
+				account,
+				domain,
+				obfuscate,
+				publicComment,
+				privateComment,
+				subscriptionID,
+			)
+			if errWithCode == nil {
+				domainPerm, errWithCode = p.apiDomainPerm(ctx, draft, false)
+			}
+		} else if errWithCode = p.checkDomainPermExcluded(ctx, domain); errWithCode == nil {
+			// Not excluded, create the
+			// perm and process side effects.
+			domainPerm, _, errWithCode = p.DomainPermissionCreate(
+				ctx,
+				permissionType,
+				account,
+				domain,
+				obfuscate,
+				publicComment,
+				privateComment,
+				subscriptionID,
+			)
+		}
 
 		var entry *apimodel.MultiStatusEntry
 
@@ -274,6 +309,18 @@ func (p *Processor) DomainPermissionsGet(
 			domainPerms = append(domainPerms, allow)
 		}
 
+	case gtsmodel.DomainPermissionLimit:
+		var limits []*gtsmodel.DomainLimit
+
+		limits, err = p.state.DB.GetDomainLimits(ctx)
+		if err != nil {
+			break
+		}
+
+		for _, limit := range limits {
+			domainPerms = append(domainPerms, limit)
+		}
+
 	default:
 		err = errors.New("unrecognized permission type")
 	}
@@ -317,6 +364,8 @@ func (p *Processor) DomainPermissionGet(
 		domainPerm, err = p.state.DB.GetDomainBlockByID(ctx, id)
 	case gtsmodel.DomainPermissionAllow:
 		domainPerm, err = p.state.DB.GetDomainAllowByID(ctx, id)
+	case gtsmodel.DomainPermissionLimit:
+		domainPerm, err = p.state.DB.GetDomainLimitByID(ctx, id)
 	default:
 		err = gtserror.New("unrecognized permission type")
 	}
@@ -0,0 +1,383 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// getDomainPermDraft returns the domain permission
+// draft with the given ID, or an appropriate error
+// if it doesn't exist or something goes wrong.
+func (p *Processor) getDomainPermDraft(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainPermissionDraft, gtserror.WithCode) {
+	draft, err := p.state.DB.GetDomainPermissionDraftByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no domain permission draft exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain permission draft %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return draft, nil
+}
+
+// createDomainPermDraft creates and stores a domain
+// permission draft of the given type for domain.
+//
+// A conflict error is returned if a draft of the same
+// type already exists for domain, and a forbidden error
+// if the domain is excluded from domain permissions.
+func (p *Processor) createDomainPermDraft(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+	adminAcct *gtsmodel.Account,
+	domain string,
+	obfuscate bool,
+	publicComment string,
+	privateComment string,
+	subscriptionID string,
+) (*gtsmodel.DomainPermissionDraft, gtserror.WithCode) {
+	if permType != gtsmodel.DomainPermissionBlock &&
+		permType != gtsmodel.DomainPermissionAllow {
+		err := gtserror.Newf("unrecognized permission type %d", permType)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if errWithCode := p.checkDomainPermExcluded(ctx, domain); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Check if a draft already exists for this domain.
+	_, err := p.state.DB.GetDomainPermissionDraft(ctx, permType, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting domain permission draft %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err == nil {
+		err := fmt.Errorf("a domain %s draft already exists for %s", permType.String(), domain)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	draft := &gtsmodel.DomainPermissionDraft{
+		ID:                 id.NewULID(),
+		PermissionType:     permType,
+		Domain:             domain,
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+		PrivateComment:     text.SanitizeToPlaintext(privateComment),
+		PublicComment:      text.SanitizeToPlaintext(publicComment),
+		Obfuscate:          &obfuscate,
+		SubscriptionID:     subscriptionID,
+	}
+
+	if err := p.state.DB.PutDomainPermissionDraft(ctx, draft); err != nil {
+		err = gtserror.Newf("db error putting domain permission draft %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return draft, nil
+}
+
+// DomainPermissionDraftGet returns one
+// domain permission draft with the given id.
+func (p *Processor) DomainPermissionDraftGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	draft, errWithCode := p.getDomainPermDraft(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainPerm(ctx, draft, false)
+}
+
+// DomainPermissionDraftsGet returns a page of
+// domain permission drafts, optionally filtered
+// by permission type, subscription ID, and domain.
+func (p *Processor) DomainPermissionDraftsGet(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+	subscriptionID string,
+	domain string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	drafts, err := p.state.DB.GetDomainPermissionDrafts(
+		ctx,
+		permType,
+		subscriptionID,
+		domain,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain permission drafts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(drafts)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := drafts[count-1].ID
+	hi := drafts[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, draft := range drafts {
+		apiDraft, errWithCode := p.apiDomainPerm(ctx, draft, false)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		items = append(items, apiDraft)
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 3)
+	if permType != gtsmodel.DomainPermissionUnknown {
+		query.Set("permission_type", permType.String())
+	}
+	if subscriptionID != "" {
+		query.Set("subscription_id", subscriptionID)
+	}
+	if domain != "" {
+		query.Set("domain", domain)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/domain_permission_drafts",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// DomainPermissionDraftCreate creates a new domain permission
+// draft, which will have no effect until it is accepted.
+func (p *Processor) DomainPermissionDraftCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domain string,
+	permType gtsmodel.DomainPermissionType,
+	obfuscate bool,
+	publicComment string,
+	privateComment string,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	draft, errWithCode := p.createDomainPermDraft(
+		ctx,
+		permType,
+		adminAcct,
+		domain,
+		obfuscate,
+		publicComment,
+		privateComment,
+		"", // No sub ID for manual drafts.
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainPerm(ctx, draft, false)
+}
+
+// DomainPermissionDraftAccept accepts the domain permission
+// draft with the given id, converting it into a domain
+// permission of the draft's type and processing side effects.
+//
+// If the draft's domain has since been excluded from domain
+// permissions, a forbidden error will be returned.
+//
+// If a domain permission of the same type already exists for
+// the draft's domain, a conflict error will be returned, unless
+// overwrite is true, in which case the existing permission
+// will be updated with the draft's values instead.
+//
+// Return values for this function are the new or updated
+// domain permission, the ID of the admin action resulting
+// from this call (if any), and/or an error if something goes wrong.
+func (p *Processor) DomainPermissionDraftAccept(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	overwrite bool,
+) (*apimodel.DomainPermission, string, gtserror.WithCode) {
+	draft, errWithCode := p.getDomainPermDraft(ctx, id)
+	if errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	// The domain may have been excluded
+	// since this draft was created, in
+	// which case it must not be accepted.
+	if errWithCode := p.checkDomainPermExcluded(ctx, draft.Domain); errWithCode != nil {
+		return nil, "", errWithCode
+	}
+
+	existing, err := p.getDomainPermByDomain(ctx, draft.PermissionType, draft.Domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting existing domain %s: %w", draft.PermissionType.String(), err)
+		return nil, "", gtserror.NewErrorInternalError(err)
+	}
+
+	var (
+		apiPerm  *apimodel.DomainPermission
+		actionID string
+	)
+
+	if existing == nil {
+		// No perm exists yet,
+		// create + process it.
+		apiPerm, actionID, errWithCode = p.DomainPermissionCreate(
+			ctx,
+			draft.PermissionType,
+			adminAcct,
+			draft.Domain,
+			*draft.Obfuscate,
+			draft.PublicComment,
+			draft.PrivateComment,
+			draft.SubscriptionID,
+		)
+		if errWithCode != nil {
+			return nil, actionID, errWithCode
+		}
+	} else {
+		if !overwrite {
+			err := fmt.Errorf(
+				"a domain %s already exists for %s, set overwrite to true to update it from this draft",
+				draft.PermissionType.String(), draft.Domain,
+			)
+			return nil, "", gtserror.NewErrorConflict(err, err.Error())
+		}
+
+		// Perm already in force, just
+		// update it from the draft, no
+		// need to redo side effects.
+		if err := p.overwriteDomainPerm(ctx, existing, draft); err != nil {
+			err := gtserror.Newf("db error updating domain %s: %w", draft.PermissionType.String(), err)
+			return nil, "", gtserror.NewErrorInternalError(err)
+		}
+
+		apiPerm, errWithCode = p.apiDomainPerm(ctx, existing, false)
+		if errWithCode != nil {
+			return nil, "", errWithCode
+		}
+	}
+
+	// Draft has done its job.
+	if err := p.state.DB.DeleteDomainPermissionDraft(ctx, draft.ID); err != nil {
+		err := gtserror.Newf("db error deleting domain permission draft: %w", err)
+		return nil, actionID, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPerm, actionID, nil
+}
+
+// overwriteDomainPerm updates the given existing
+// domain permission with values from the draft.
+func (p *Processor) overwriteDomainPerm(
+	ctx context.Context,
+	existing gtsmodel.DomainPermission,
+	draft *gtsmodel.DomainPermissionDraft,
+) error {
+	columns := []string{
+		"obfuscate",
+		"public_comment",
+		"private_comment",
+		"subscription_id",
+	}
+
+	switch perm := existing.(type) {
+	case *gtsmodel.DomainBlock:
+		perm.Obfuscate = draft.Obfuscate
+		perm.PublicComment = draft.PublicComment
+		perm.PrivateComment = draft.PrivateComment
+		perm.SubscriptionID = draft.SubscriptionID
+		return p.state.DB.UpdateDomainBlock(ctx, perm, columns...)
+
+	case *gtsmodel.DomainAllow:
+		perm.Obfuscate = draft.Obfuscate
+		perm.PublicComment = draft.PublicComment
+		perm.PrivateComment = draft.PrivateComment
+		perm.SubscriptionID = draft.SubscriptionID
+		return p.state.DB.UpdateDomainAllow(ctx, perm, columns...)
+
+	default:
+		return gtserror.Newf("unexpected domain permission type %T", perm)
+	}
+}
+
+// DomainPermissionDraftReject rejects the domain permission
+// draft with the given id, removing it without side effects.
+//
+// If excludeTarget is true, the draft's domain will also be
+// added to the domain permission excludes list, so that no
+// import or subscription will create drafts for it again.
+func (p *Processor) DomainPermissionDraftReject(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	excludeTarget bool,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	draft, errWithCode := p.getDomainPermDraft(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteDomainPermissionDraft(ctx, draft.ID); err != nil {
+		err := gtserror.Newf("db error deleting domain permission draft: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if excludeTarget {
+		// Exclude the domain, ignoring
+		// it if it's already excluded.
+		_, errWithCode := p.DomainPermissionExcludeCreate(
+			ctx,
+			adminAcct,
+			draft.Domain,
+			draft.PrivateComment,
+		)
+		if errWithCode != nil && errWithCode.Code() != http.StatusConflict {
+			return nil, errWithCode
+		}
+	}
+
+	return p.apiDomainPerm(ctx, draft, false)
+}
@@ -0,0 +1,285 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type DomainPermissionDraftTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainPermissionDraftTestSuite) TestDomainPermissionDraftAcceptReject() {
+	var (
+		ctx   = context.Background()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	// Exclude one of the domains
+	// before processing the list.
+	if _, errWithCode := suite.adminProcessor.DomainPermissionExcludeCreate(
+		ctx, admin, "nothanks.com", "they're alright",
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	permSub, errWithCode := suite.adminProcessor.DomainPermissionSubscriptionCreate(
+		ctx,
+		admin,
+		0,
+		"baddies",
+		"https://lists.example.org/baddies.txt",
+		gtsmodel.DomainPermSubContentTypePlain,
+		gtsmodel.DomainPermissionBlock,
+		true,
+		false,
+		"",
+		"",
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.adminProcessor.DomainPermissionSubscriptionsProcess(ctx)
+
+	// Excluded domain should
+	// not have a draft created.
+	_, err := suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, "nothanks.com")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	accept, err := suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, "peepee.poopoo")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reject, err := suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, "bumfaces.net")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Accept one draft, it should
+	// become a block owned by the sub.
+	apiBlock, _, errWithCode := suite.adminProcessor.DomainPermissionDraftAccept(ctx, admin, accept.ID, false)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("peepee.poopoo", apiBlock.Domain.Domain)
+	suite.Equal(permSub.ID, apiBlock.SubscriptionID)
+
+	_, err = suite.db.GetDomainPermissionDraftByID(ctx, accept.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Reject the other draft,
+	// and exclude its domain.
+	if _, errWithCode := suite.adminProcessor.DomainPermissionDraftReject(ctx, admin, reject.ID, true); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, err = suite.db.GetDomainPermissionDraftByID(ctx, reject.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetDomainBlock(ctx, "bumfaces.net")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	excluded, err := suite.db.IsDomainPermissionExcluded(ctx, "bumfaces.net")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(excluded)
+
+	// Reprocessing shouldn't
+	// recreate the draft.
+	suite.adminProcessor.DomainPermissionSubscriptionsProcess(ctx)
+	_, err = suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, "bumfaces.net")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *DomainPermissionDraftTestSuite) TestDomainPermissionDraftAcceptOverwrite() {
+	var (
+		ctx   = context.Background()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	block := &gtsmodel.DomainBlock{
+		ID:                 "01JBAJ1DC3CXQVPHH8FJNSZMQF",
+		Domain:             "peepee.poopoo",
+		CreatedByAccountID: admin.ID,
+		PublicComment:      "old comment",
+		Obfuscate:          new(bool),
+	}
+	if err := suite.db.CreateDomainBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	draft, errWithCode := suite.adminProcessor.DomainPermissionDraftCreate(
+		ctx,
+		admin,
+		"peepee.poopoo",
+		gtsmodel.DomainPermissionBlock,
+		true,
+		"new comment",
+		"",
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("block", draft.PermissionType)
+
+	// Same draft again should conflict.
+	_, errWithCode = suite.adminProcessor.DomainPermissionDraftCreate(
+		ctx,
+		admin,
+		"peepee.poopoo",
+		gtsmodel.DomainPermissionBlock,
+		true,
+		"new comment",
+		"",
+	)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Block exists already, so accepting
+	// without overwrite should conflict.
+	_, _, errWithCode = suite.adminProcessor.DomainPermissionDraftAccept(ctx, admin, draft.ID, false)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	apiBlock, _, errWithCode := suite.adminProcessor.DomainPermissionDraftAccept(ctx, admin, draft.ID, true)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(block.ID, apiBlock.ID)
+	suite.Equal("new comment", apiBlock.PublicComment)
+	suite.True(apiBlock.Obfuscate)
+}
+
+func (suite *DomainPermissionDraftTestSuite) TestDomainPermissionDraftAcceptExcluded() {
+	var (
+		ctx   = context.Background()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	createDraft := func(domain string) string {
+		draft, errWithCode := suite.adminProcessor.DomainPermissionDraftCreate(
+			ctx,
+			admin,
+			domain,
+			gtsmodel.DomainPermissionBlock,
+			false,
+			"",
+			"",
+		)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+		return draft.ID
+	}
+
+	// Create drafts before
+	// any exclude exists.
+	excludedID := createDraft("sub.nothanks.com")
+	otherID := createDraft("peepee.poopoo")
+
+	// Exclude the parent domain, the pending draft
+	// for its subdomain should be deleted, and
+	// the unrelated draft should remain.
+	if _, errWithCode := suite.adminProcessor.DomainPermissionExcludeCreate(
+		ctx, admin, "nothanks.com", "they're alright",
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, err := suite.db.GetDomainPermissionDraftByID(ctx, excludedID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetDomainPermissionDraftByID(ctx, otherID)
+	suite.NoError(err)
+
+	_, _, errWithCode := suite.adminProcessor.DomainPermissionDraftAccept(ctx, admin, excludedID, false)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Even if a draft for an excluded domain
+	// slips through, it can't be accepted.
+	draft := &gtsmodel.DomainPermissionDraft{
+		ID:                 "01JCZN614XG85GCGAMSV9ZZAEJ",
+		PermissionType:     gtsmodel.DomainPermissionBlock,
+		Domain:             "nothanks.com",
+		CreatedByAccountID: admin.ID,
+		CreatedByAccount:   admin,
+		Obfuscate:          new(bool),
+	}
+	if err := suite.db.PutDomainPermissionDraft(ctx, draft); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, _, errWithCode = suite.adminProcessor.DomainPermissionDraftAccept(ctx, admin, draft.ID, false)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	_, err = suite.db.GetDomainBlock(ctx, "nothanks.com")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *DomainPermissionDraftTestSuite) TestDomainPermissionExcludeSubdomain() {
+	var (
+		ctx   = context.Background()
+		admin = suite.testAccounts["admin_account"]
+	)
+
+	exclude, errWithCode := suite.adminProcessor.DomainPermissionExcludeCreate(ctx, admin, "example.org", "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Drafts can't be created for
+	// subdomains of excluded domains.
+	_, errWithCode = suite.adminProcessor.DomainPermissionDraftCreate(
+		ctx,
+		admin,
+		"sub.example.org",
+		gtsmodel.DomainPermissionBlock,
+		false,
+		"",
+		"",
+	)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Remove the exclude, should work now.
+	if _, errWithCode := suite.adminProcessor.DomainPermissionExcludeRemove(ctx, exclude.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if _, errWithCode := suite.adminProcessor.DomainPermissionDraftCreate(
+		ctx,
+		admin,
+		"sub.example.org",
+		gtsmodel.DomainPermissionBlock,
+		false,
+		"",
+		"",
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
+func TestDomainPermissionDraftTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainPermissionDraftTestSuite))
+}
@@ -0,0 +1,254 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// apiDomainPermExclude is a cheeky shortcut for returning
+// the API version of the given domain permission exclude,
+// or an appropriate error if something goes wrong.
+func (p *Processor) apiDomainPermExclude(
+	ctx context.Context,
+	exclude *gtsmodel.DomainPermissionExclude,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	apiExclude, err := p.converter.DomainPermExcludeToAPIDomainPerm(ctx, exclude)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting domain permission exclude to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiExclude, nil
+}
+
+// checkDomainPermExcluded returns a forbidden error if
+// the given domain is excluded from domain permissions.
+func (p *Processor) checkDomainPermExcluded(
+	ctx context.Context,
+	domain string,
+) gtserror.WithCode {
+	excluded, err := p.state.DB.IsDomainPermissionExcluded(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error checking domain permission exclude for %s: %w", domain, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if excluded {
+		err := fmt.Errorf("domain %s is excluded from domain permissions", domain)
+		return gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	return nil
+}
+
+// DomainPermissionExcludeCreate creates a domain permission
+// exclude for the given domain, preventing domain permission
+// imports and subscriptions from targeting it or its subdomains.
+func (p *Processor) DomainPermissionExcludeCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	domain string,
+	privateComment string,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	// Check if an exclude already exists for this domain.
+	excludes, err := p.state.DB.GetDomainPermissionExcludes(ctx, domain, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting domain permission exclude %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(excludes) != 0 {
+		err := fmt.Errorf("a domain permission exclude already exists for %s", domain)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	exclude := &gtsmodel.DomainPermissionExclude{
+		ID:                 id.NewULID(),
+		Domain:             domain,
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+		PrivateComment:     text.SanitizeToPlaintext(privateComment),
+	}
+
+	if err := p.state.DB.PutDomainPermissionExclude(ctx, exclude); err != nil {
+		err = gtserror.Newf("db error putting domain permission exclude %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Drafts created for this domain (or its subdomains)
+	// before the exclude existed should no longer be
+	// acceptable, so clear them out of the way now.
+	if err := p.deleteExcludedDomainPermDrafts(ctx); err != nil {
+		err = gtserror.Newf("error deleting excluded domain permission drafts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermExclude(ctx, exclude)
+}
+
+// deleteExcludedDomainPermDrafts deletes all pending
+// domain permission drafts targeting a domain that
+// is now excluded from domain permissions.
+func (p *Processor) deleteExcludedDomainPermDrafts(ctx context.Context) error {
+	var page paging.Page
+
+	// Set page select limit.
+	page.Limit = 100
+
+	for {
+		// Fetch the next batch of drafts to next max ID.
+		drafts, err := p.state.DB.GetDomainPermissionDrafts(
+			ctx,
+			gtsmodel.DomainPermissionUnknown,
+			"", "",
+			&page,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting drafts: %w", err)
+		}
+
+		if len(drafts) == 0 {
+			// Reached end.
+			return nil
+		}
+
+		// Use last ID as the next 'maxID'.
+		page.Max = paging.MaxID(drafts[len(drafts)-1].ID)
+
+		for _, draft := range drafts {
+			excluded, err := p.state.DB.IsDomainPermissionExcluded(ctx, draft.Domain)
+			if err != nil {
+				return gtserror.Newf("db error checking exclude for %s: %w", draft.Domain, err)
+			}
+
+			if !excluded {
+				continue
+			}
+
+			if err := p.state.DB.DeleteDomainPermissionDraft(ctx, draft.ID); err != nil {
+				return gtserror.Newf("db error deleting draft %s: %w", draft.ID, err)
+			}
+		}
+	}
+}
+
+// DomainPermissionExcludeGet returns one
+// domain permission exclude with the given id.
+func (p *Processor) DomainPermissionExcludeGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	exclude, err := p.state.DB.GetDomainPermissionExcludeByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no domain permission exclude exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain permission exclude %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermExclude(ctx, exclude)
+}
+
+// DomainPermissionExcludesGet returns a page of
+// domain permission excludes, optionally filtered
+// by domain.
+func (p *Processor) DomainPermissionExcludesGet(
+	ctx context.Context,
+	domain string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	excludes, err := p.state.DB.GetDomainPermissionExcludes(ctx, domain, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain permission excludes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(excludes)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := excludes[count-1].ID
+	hi := excludes[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, exclude := range excludes {
+		apiExclude, errWithCode := p.apiDomainPermExclude(ctx, exclude)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		items = append(items, apiExclude)
+	}
+
+	// Assemble next/prev page queries.
+	query := make(url.Values, 1)
+	if domain != "" {
+		query.Set("domain", domain)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/domain_permission_excludes",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// DomainPermissionExcludeRemove removes one
+// domain permission exclude with the given id.
+func (p *Processor) DomainPermissionExcludeRemove(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainPermission, gtserror.WithCode) {
+	exclude, err := p.state.DB.GetDomainPermissionExcludeByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no domain permission exclude exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain permission exclude %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteDomainPermissionExclude(ctx, exclude.ID); err != nil {
+		err := gtserror.Newf("db error deleting domain permission exclude: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermExclude(ctx, exclude)
+}
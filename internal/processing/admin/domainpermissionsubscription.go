@@ -0,0 +1,333 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// apiDomainPermSub is a shortcut for returning the
+// API version of the given domain permission subscription,
+// or an appropriate error if something goes wrong.
+func (p *Processor) apiDomainPermSub(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	apiPermSub, err := p.converter.DomainPermSubToAPIDomainPermSub(ctx, permSub)
+	if err != nil {
+		err := gtserror.NewfAt(3, "error converting domain permission subscription to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPermSub, nil
+}
+
+// getDomainPermSub returns the domain permission
+// subscription with the given ID, or an appropriate
+// error if it doesn't exist or something goes wrong.
+func (p *Processor) getDomainPermSub(
+	ctx context.Context,
+	id string,
+) (*gtsmodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, err := p.state.DB.GetDomainPermissionSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no domain permission subscription exists with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain permission subscription %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return permSub, nil
+}
+
+// validateDomainPermSubURI checks that the given
+// string is an absolute http(s) URI, and returns
+// a bad request error if not.
+func validateDomainPermSubURI(uri string) gtserror.WithCode {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() || u.Host == "" ||
+		(u.Scheme != "http" && u.Scheme != "https") {
+		err := fmt.Errorf("invalid uri %s: must be an absolute http or https uri", uri)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return nil
+}
+
+// DomainPermissionSubscriptionGet returns one
+// domain permission subscription with the given id.
+func (p *Processor) DomainPermissionSubscriptionGet(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionsGet returns all domain permission
+// subscriptions of the given type (or of all types, if permission
+// type is unknown), sorted by priority, highest first.
+func (p *Processor) DomainPermissionSubscriptionsGet(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+) ([]*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSubs, err := p.state.DB.GetDomainPermissionSubscriptions(ctx, permType)
+	if err != nil {
+		err := gtserror.Newf("db error getting domain permission subscriptions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiPermSubs := make([]*apimodel.DomainPermissionSubscription, 0, len(permSubs))
+	for _, permSub := range permSubs {
+		apiPermSub, errWithCode := p.apiDomainPermSub(ctx, permSub)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiPermSubs = append(apiPermSubs, apiPermSub)
+	}
+
+	return apiPermSubs, nil
+}
+
+// DomainPermissionSubscriptionCreate creates a new domain
+// permission subscription with the given parameters. The
+// subscription will be fetched and processed the next time
+// the domain permission subscriptions job runs.
+func (p *Processor) DomainPermissionSubscriptionCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	priority uint8,
+	title string,
+	uri string,
+	contentType gtsmodel.DomainPermSubContentType,
+	permType gtsmodel.DomainPermissionType,
+	asDraft bool,
+	adoptOrphans bool,
+	fetchUsername string,
+	fetchPassword string,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	if errWithCode := validateDomainPermSubURI(uri); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	permSub := &gtsmodel.DomainPermissionSubscription{
+		ID:                 id.NewULID(),
+		Priority:           priority,
+		Title:              title,
+		PermissionType:     permType,
+		AsDraft:            &asDraft,
+		AdoptOrphans:       &adoptOrphans,
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+		URI:                uri,
+		ContentType:        contentType,
+		FetchUsername:      fetchUsername,
+		FetchPassword:      fetchPassword,
+	}
+
+	err := p.state.DB.PutDomainPermissionSubscription(ctx, permSub)
+	if err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Unique constraint conflict.
+			const errText = "domain permission subscription with given URI already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(errText), errText)
+		}
+
+		// Real database error.
+		err := gtserror.Newf("db error creating domain permission subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionUpdate updates the domain
+// permission subscription with the given id, setting
+// only the fields which are not nil.
+func (p *Processor) DomainPermissionSubscriptionUpdate(
+	ctx context.Context,
+	id string,
+	priority *uint8,
+	title *string,
+	uri *string,
+	contentType *gtsmodel.DomainPermSubContentType,
+	asDraft *bool,
+	adoptOrphans *bool,
+	fetchUsername *string,
+	fetchPassword *string,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := make([]string, 0, 8)
+
+	if priority != nil {
+		permSub.Priority = *priority
+		columns = append(columns, "priority")
+	}
+
+	if title != nil {
+		permSub.Title = *title
+		columns = append(columns, "title")
+	}
+
+	if uri != nil && *uri != permSub.URI {
+		if errWithCode := validateDomainPermSubURI(*uri); errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		// Changing the URI means cached
+		// fetch details no longer apply.
+		permSub.URI = *uri
+		permSub.ETag = ""
+		permSub.LastModified = time.Time{}
+		columns = append(columns, "uri", "etag", "last_modified")
+	}
+
+	if contentType != nil {
+		permSub.ContentType = *contentType
+		columns = append(columns, "content_type")
+	}
+
+	if asDraft != nil {
+		permSub.AsDraft = asDraft
+		columns = append(columns, "as_draft")
+	}
+
+	if adoptOrphans != nil {
+		permSub.AdoptOrphans = adoptOrphans
+		columns = append(columns, "adopt_orphans")
+	}
+
+	if fetchUsername != nil {
+		permSub.FetchUsername = *fetchUsername
+		columns = append(columns, "fetch_username")
+	}
+
+	if fetchPassword != nil {
+		permSub.FetchPassword = *fetchPassword
+		columns = append(columns, "fetch_password")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to update.
+		return p.apiDomainPermSub(ctx, permSub)
+	}
+
+	err := p.state.DB.UpdateDomainPermissionSubscription(ctx, permSub, columns...)
+	if err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Unique constraint conflict.
+			const errText = "domain permission subscription with given URI already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(errText), errText)
+		}
+
+		// Real database error.
+		err := gtserror.Newf("db error updating domain permission subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionRemove removes the domain
+// permission subscription with the given id. Domain
+// permissions created by the subscription are not removed,
+// but are orphaned instead (ie., their subscription ID is
+// unset), so that they remain in force until an admin
+// removes them manually.
+func (p *Processor) DomainPermissionSubscriptionRemove(
+	ctx context.Context,
+	id string,
+) (*apimodel.DomainPermissionSubscription, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Orphan all perms owned by this subscription.
+	if err := p.orphanDomainPerms(ctx, permSub, nil); err != nil {
+		err := gtserror.Newf("error orphaning domain permissions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteDomainPermissionSubscription(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting domain permission subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainPermSub(ctx, permSub)
+}
+
+// DomainPermissionSubscriptionTest fetches and parses the
+// list at the given subscription's URI, and returns the
+// domain permissions that would be created from it, without
+// actually creating or updating anything.
+func (p *Processor) DomainPermissionSubscriptionTest(
+	ctx context.Context,
+	id string,
+) ([]*apimodel.DomainPermission, gtserror.WithCode) {
+	permSub, errWithCode := p.getDomainPermSub(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Always fetch the whole list,
+	// ignoring any cache headers.
+	const skipCache = true
+
+	perms, err := p.fetchDomainPerms(ctx, permSub, skipCache)
+	if err != nil {
+		err := fmt.Errorf("error fetching or parsing list at %s: %w", permSub.URI, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	apiPerms := make([]*apimodel.DomainPermission, 0, len(perms))
+	for _, perm := range perms {
+		// Use export format, as these
+		// perms don't exist in the db.
+		apiPerm, errWithCode := p.apiDomainPerm(ctx, perm, true)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiPerms = append(apiPerms, apiPerm)
+	}
+
+	return apiPerms, nil
+}
@@ -0,0 +1,183 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type DomainPermissionSubscriptionTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) createSub(
+	uri string,
+	contentType gtsmodel.DomainPermSubContentType,
+	priority uint8,
+	asDraft bool,
+	adoptOrphans bool,
+) string {
+	permSub, errWithCode := suite.adminProcessor.DomainPermissionSubscriptionCreate(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		priority,
+		"baddies",
+		uri,
+		contentType,
+		gtsmodel.DomainPermissionBlock,
+		asDraft,
+		adoptOrphans,
+		"",
+		"",
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	return permSub.ID
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestDomainPermissionSubscriptionTest() {
+	for _, test := range []struct {
+		uri         string
+		contentType gtsmodel.DomainPermSubContentType
+	}{
+		{"https://lists.example.org/baddies.csv", gtsmodel.DomainPermSubContentTypeCSV},
+		{"https://lists.example.org/baddies.json", gtsmodel.DomainPermSubContentTypeJSON},
+		{"https://lists.example.org/baddies.txt", gtsmodel.DomainPermSubContentTypePlain},
+	} {
+		id := suite.createSub(test.uri, test.contentType, 0, false, false)
+
+		perms, errWithCode := suite.adminProcessor.DomainPermissionSubscriptionTest(context.Background(), id)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		// Silenced domain in
+		// csv should be skipped.
+		domains := make([]string, len(perms))
+		for i, perm := range perms {
+			domains[i] = perm.Domain.Domain
+		}
+		suite.Equal([]string{"bumfaces.net", "peepee.poopoo", "nothanks.com"}, domains, test.uri)
+	}
+
+	// Subscription to a list that
+	// doesn't exist should error.
+	id := suite.createSub("https://lists.example.org/nope.csv", gtsmodel.DomainPermSubContentTypeCSV, 0, false, false)
+	_, errWithCode := suite.adminProcessor.DomainPermissionSubscriptionTest(context.Background(), id)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestDomainPermissionSubscriptionsProcess() {
+	ctx := context.Background()
+
+	// Manually block one of the domains
+	// in the list, so it can be adopted.
+	orphan := &gtsmodel.DomainBlock{
+		ID:                 "01JBAJ1DC3CXQVPHH8FJNSZMQF",
+		Domain:             "bumfaces.net",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Obfuscate:          new(bool),
+	}
+	if err := suite.db.CreateDomainBlock(ctx, orphan); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	id := suite.createSub("https://lists.example.org/baddies.csv", gtsmodel.DomainPermSubContentTypeCSV, 0, false, true)
+	suite.adminProcessor.DomainPermissionSubscriptionsProcess(ctx)
+
+	// All domains should now be blocked
+	// and owned by the subscription.
+	blocks, err := suite.db.GetDomainBlocksBySubscriptionID(ctx, id)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(blocks, 3)
+
+	// Fetch details should be stored.
+	permSub, err := suite.db.GetDomainPermissionSubscriptionByID(ctx, id)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(permSub.SuccessfullyFetchedAt.IsZero())
+	suite.Empty(permSub.Error)
+
+	// Removing the subscription should
+	// orphan rather than remove its blocks.
+	if _, errWithCode := suite.adminProcessor.DomainPermissionSubscriptionRemove(ctx, id); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	orphan, err = suite.db.GetDomainBlock(ctx, "bumfaces.net")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(orphan.SubscriptionID)
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestDomainPermissionSubscriptionsProcessAsDraft() {
+	ctx := context.Background()
+
+	id := suite.createSub("https://lists.example.org/baddies.txt", gtsmodel.DomainPermSubContentTypePlain, 0, true, false)
+	suite.adminProcessor.DomainPermissionSubscriptionsProcess(ctx)
+
+	// No blocks should be created.
+	blocks, err := suite.db.GetDomainBlocksBySubscriptionID(ctx, id)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(blocks)
+
+	// Only a draft.
+	draft, err := suite.db.GetDomainPermissionDraft(ctx, gtsmodel.DomainPermissionBlock, "peepee.poopoo")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(id, draft.SubscriptionID)
+}
+
+func (suite *DomainPermissionSubscriptionTestSuite) TestDomainPermissionSubscriptionsProcessPriority() {
+	ctx := context.Background()
+
+	low := suite.createSub("https://lists.example.org/baddies.txt", gtsmodel.DomainPermSubContentTypePlain, 10, false, false)
+	high := suite.createSub("https://lists.example.org/baddies.json", gtsmodel.DomainPermSubContentTypeJSON, 100, false, false)
+	suite.adminProcessor.DomainPermissionSubscriptionsProcess(ctx)
+
+	// Higher priority subscription
+	// should own all the blocks.
+	blocks, err := suite.db.GetDomainBlocksBySubscriptionID(ctx, high)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(blocks, 3)
+
+	blocks, err = suite.db.GetDomainBlocksBySubscriptionID(ctx, low)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(blocks)
+}
+
+func TestDomainPermissionSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainPermissionSubscriptionTestSuite))
+}
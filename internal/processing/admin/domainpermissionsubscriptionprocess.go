@@ -0,0 +1,608 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-kv"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DomainPermissionSubscriptionsProcess fetches and processes
+// all domain permission subscriptions, in order of priority,
+// creating, adopting, and orphaning domain permissions (or
+// drafts) according to the contents of each subscribed list.
+//
+// This function is intended to be run periodically by the
+// scheduler. Errors are logged, and stored on the relevant
+// subscription so that admins can see them via the API.
+func (p *Processor) DomainPermissionSubscriptionsProcess(ctx context.Context) {
+	for _, permType := range []gtsmodel.DomainPermissionType{
+		gtsmodel.DomainPermissionBlock,
+		gtsmodel.DomainPermissionAllow,
+	} {
+		permSubs, err := p.state.DB.GetDomainPermissionSubscriptions(ctx, permType)
+		if err != nil {
+			log.Errorf(ctx, "db error getting domain %s subscriptions: %v", permType.String(), err)
+			continue
+		}
+
+		for i, permSub := range permSubs {
+			// Subscriptions are sorted by priority,
+			// so all subscriptions before this one
+			// have equal or higher priority.
+			higherPrios := permSubs[:i]
+			p.processDomainPermSub(ctx, permSub, higherPrios)
+		}
+	}
+}
+
+// processDomainPermSub fetches and processes the list
+// at the given domain permission subscription's URI,
+// taking account of higher priority subscriptions
+// of the same type, then stores the fetch result.
+func (p *Processor) processDomainPermSub(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	higherPrios []*gtsmodel.DomainPermissionSubscription,
+) {
+	l := log.WithContext(ctx).WithFields(kv.Fields{
+		{"subscriptionID", permSub.ID},
+		{"uri", permSub.URI},
+	}...)
+
+	// Store fetch results once we're done.
+	columns := []string{"fetched_at", "error"}
+	defer func() {
+		if err := p.state.DB.UpdateDomainPermissionSubscription(
+			ctx,
+			permSub,
+			columns...,
+		); err != nil {
+			l.Errorf("db error updating domain permission subscription: %v", err)
+		}
+	}()
+
+	// Fetch using cache headers (if any), so
+	// we don't redo work for unchanged lists.
+	const skipCache = false
+
+	permSub.FetchedAt = time.Now()
+	perms, err := p.fetchDomainPerms(ctx, permSub, skipCache)
+	if err != nil {
+		l.Warnf("error fetching or parsing list: %v", err)
+		permSub.Error = err.Error()
+		return
+	}
+
+	// Fetch was a success.
+	permSub.SuccessfullyFetchedAt = permSub.FetchedAt
+	permSub.Error = ""
+	columns = append(columns, "successfully_fetched_at")
+
+	if perms == nil {
+		l.Debug("list not modified since last fetch")
+		return
+	}
+
+	// Store cache headers from this fetch.
+	columns = append(columns, "etag", "last_modified")
+
+	// Domain permissions created by this
+	// subscription are attributed to the
+	// admin account that created it.
+	adminAcct, err := p.state.DB.GetAccountByID(ctx, permSub.CreatedByAccountID)
+	if err != nil {
+		l.Errorf("db error getting subscription creator account: %v", err)
+		permSub.Error = "error getting subscription creator account"
+		return
+	}
+
+	// Process each perm in the list, keeping
+	// track of which domains are present.
+	domains := make(map[string]struct{}, len(perms))
+	for _, perm := range perms {
+		domain := perm.GetDomain()
+		domains[domain] = struct{}{}
+
+		if err := p.processDomainPerm(
+			ctx,
+			permSub,
+			higherPrios,
+			adminAcct,
+			perm,
+		); err != nil {
+			l.Errorf("error processing domain permission for %s: %v", domain, err)
+		}
+	}
+
+	// Orphan any perms owned by this subscription
+	// which are no longer present in the list.
+	if err := p.orphanDomainPerms(ctx, permSub, domains); err != nil {
+		l.Errorf("error orphaning retracted domain permissions: %v", err)
+	}
+}
+
+// processDomainPerm processes one domain permission
+// parsed from the given subscription's list, creating,
+// adopting, or leaving alone the permission as appropriate.
+func (p *Processor) processDomainPerm(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	higherPrios []*gtsmodel.DomainPermissionSubscription,
+	adminAcct *gtsmodel.Account,
+	perm gtsmodel.DomainPermission,
+) error {
+	domain := perm.GetDomain()
+
+	// Never touch excluded domains.
+	excluded, err := p.state.DB.IsDomainPermissionExcluded(ctx, domain)
+	if err != nil {
+		return err
+	}
+
+	if excluded {
+		return nil
+	}
+
+	existing, err := p.getDomainPermByDomain(ctx, permSub.PermissionType, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	if existing != nil {
+		subID := existing.GetSubscriptionID()
+
+		switch {
+		case subID == permSub.ID:
+			// Already owned by
+			// this subscription.
+			return nil
+
+		case subID == "":
+			// Manually created perm,
+			// only take ownership if
+			// we're adopting orphans.
+			if !*permSub.AdoptOrphans {
+				return nil
+			}
+
+		case slices.ContainsFunc(
+			higherPrios,
+			func(s *gtsmodel.DomainPermissionSubscription) bool {
+				return s.ID == subID
+			},
+		):
+			// Owned by a subscription
+			// with higher priority.
+			return nil
+		}
+
+		// Owned by nobody, by a lower priority
+		// subscription, or by a subscription
+		// that no longer exists: take it over.
+		return p.setDomainPermSubscriptionID(ctx, existing, permSub.ID)
+	}
+
+	if *permSub.AsDraft {
+		// Create a draft to be
+		// reviewed by an admin,
+		// unless one exists already.
+		_, errWithCode := p.createDomainPermDraft(
+			ctx,
+			permSub.PermissionType,
+			adminAcct,
+			domain,
+			*perm.GetObfuscate(),
+			perm.GetPublicComment(),
+			perm.GetPrivateComment(),
+			permSub.ID,
+		)
+		if errWithCode != nil && errWithCode.Code() != http.StatusConflict {
+			return errWithCode
+		}
+
+		return nil
+	}
+
+	// Create the perm straight
+	// away, and process side effects.
+	_, _, errWithCode := p.DomainPermissionCreate(
+		ctx,
+		permSub.PermissionType,
+		adminAcct,
+		domain,
+		*perm.GetObfuscate(),
+		perm.GetPublicComment(),
+		perm.GetPrivateComment(),
+		permSub.ID,
+	)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	return nil
+}
+
+// getDomainPermByDomain returns the domain
+// permission of the given type for domain.
+func (p *Processor) getDomainPermByDomain(
+	ctx context.Context,
+	permType gtsmodel.DomainPermissionType,
+	domain string,
+) (gtsmodel.DomainPermission, error) {
+	switch permType {
+	case gtsmodel.DomainPermissionBlock:
+		block, err := p.state.DB.GetDomainBlock(ctx, domain)
+		if err != nil {
+			return nil, err
+		}
+		return block, nil
+
+	case gtsmodel.DomainPermissionAllow:
+		allow, err := p.state.DB.GetDomainAllow(ctx, domain)
+		if err != nil {
+			return nil, err
+		}
+		return allow, nil
+
+	default:
+		return nil, gtserror.Newf("unrecognized permission type %d", permType)
+	}
+}
+
+// setDomainPermSubscriptionID updates the
+// subscription ID of the given domain permission.
+func (p *Processor) setDomainPermSubscriptionID(
+	ctx context.Context,
+	perm gtsmodel.DomainPermission,
+	subscriptionID string,
+) error {
+	switch perm := perm.(type) {
+	case *gtsmodel.DomainBlock:
+		perm.SubscriptionID = subscriptionID
+		return p.state.DB.UpdateDomainBlock(ctx, perm, "subscription_id")
+
+	case *gtsmodel.DomainAllow:
+		perm.SubscriptionID = subscriptionID
+		return p.state.DB.UpdateDomainAllow(ctx, perm, "subscription_id")
+
+	default:
+		return gtserror.Newf("unexpected domain permission type %T", perm)
+	}
+}
+
+// orphanDomainPerms unsets the subscription ID of all domain
+// permissions owned by the given subscription whose domain
+// is not contained in keep. If keep is nil, all of the
+// subscription's domain permissions will be orphaned.
+func (p *Processor) orphanDomainPerms(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	keep map[string]struct{},
+) error {
+	var perms []gtsmodel.DomainPermission
+
+	switch permSub.PermissionType {
+	case gtsmodel.DomainPermissionBlock:
+		blocks, err := p.state.DB.GetDomainBlocksBySubscriptionID(ctx, permSub.ID)
+		if err != nil {
+			return gtserror.Newf("db error getting domain blocks: %w", err)
+		}
+
+		for _, block := range blocks {
+			perms = append(perms, block)
+		}
+
+	case gtsmodel.DomainPermissionAllow:
+		allows, err := p.state.DB.GetDomainAllowsBySubscriptionID(ctx, permSub.ID)
+		if err != nil {
+			return gtserror.Newf("db error getting domain allows: %w", err)
+		}
+
+		for _, allow := range allows {
+			perms = append(perms, allow)
+		}
+	}
+
+	var errs gtserror.MultiError
+	for _, perm := range perms {
+		if _, ok := keep[perm.GetDomain()]; ok {
+			continue
+		}
+
+		if err := p.setDomainPermSubscriptionID(ctx, perm, ""); err != nil {
+			errs.Appendf("error orphaning domain permission %s: %w", perm.GetID(), err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// fetchDomainPerms fetches and parses the list at the
+// given subscription's URI into domain permissions.
+//
+// If the list hasn't changed since the last fetch, a
+// nil slice and nil error will be returned. Otherwise
+// the subscription's ETag and LastModified fields will
+// be updated (but not stored) from the response.
+func (p *Processor) fetchDomainPerms(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	skipCache bool,
+) ([]gtsmodel.DomainPermission, error) {
+	// Fetch list using the instance account transport.
+	tsport, err := p.transport.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return nil, gtserror.Newf("error getting transport: %w", err)
+	}
+
+	rsp, err := tsport.DereferenceDomainPermissions(ctx, permSub, skipCache)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp == nil {
+		// Not modified.
+		return nil, nil
+	}
+
+	defer rsp.Body.Close()
+
+	// Update cache headers from response.
+	permSub.ETag = rsp.Header.Get("ETag")
+	permSub.LastModified, _ = http.ParseTime(rsp.Header.Get("Last-Modified"))
+
+	var perms []gtsmodel.DomainPermission
+
+	switch permSub.ContentType {
+	case gtsmodel.DomainPermSubContentTypeCSV:
+		perms, err = parseDomainPermsCSV(rsp.Body, permSub.PermissionType)
+
+	case gtsmodel.DomainPermSubContentTypeJSON:
+		perms, err = parseDomainPermsJSON(rsp.Body, permSub.PermissionType)
+
+	case gtsmodel.DomainPermSubContentTypePlain:
+		perms, err = parseDomainPermsPlain(rsp.Body, permSub.PermissionType)
+
+	default:
+		err = gtserror.Newf("unrecognized content type %s", permSub.ContentType.String())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Treat an empty list as an error, rather
+	// than orphaning every existing permission
+	// because of a broken or truncated list.
+	if len(perms) == 0 {
+		return nil, errors.New("list contained no valid domains")
+	}
+
+	return perms, nil
+}
+
+// parseDomainPermsCSV parses the given reader as a CSV domain
+// permissions list, in the format exported by Mastodon, ie.,
+// with a header row containing at least a "#domain" column,
+// and optional "#severity", "#public_comment", and "#obfuscate"
+// columns. For block lists, only "suspend" entries are used.
+func parseDomainPermsCSV(
+	r io.Reader,
+	permType gtsmodel.DomainPermissionType,
+) ([]gtsmodel.DomainPermission, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = -1 // Allow variable columns.
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, gtserror.Newf("error reading csv: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// Find the columns we're
+	// interested in from header.
+	var (
+		domainIdx    = -1
+		severityIdx  = -1
+		publicIdx    = -1
+		obfuscateIdx = -1
+	)
+
+	for i, column := range records[0] {
+		switch strings.TrimPrefix(strings.TrimSpace(column), "#") {
+		case "domain":
+			domainIdx = i
+		case "severity":
+			severityIdx = i
+		case "public_comment":
+			publicIdx = i
+		case "obfuscate":
+			obfuscateIdx = i
+		}
+	}
+
+	if domainIdx == -1 {
+		return nil, errors.New("csv header row has no domain column")
+	}
+
+	perms := make([]gtsmodel.DomainPermission, 0, len(records)-1)
+	for _, record := range records[1:] {
+		field := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		// Block lists may contain other severities
+		// we don't support (eg., "silence"), skip them.
+		if permType == gtsmodel.DomainPermissionBlock {
+			if severity := field(severityIdx); severity != "" && severity != "suspend" {
+				continue
+			}
+		}
+
+		obfuscate, _ := strconv.ParseBool(field(obfuscateIdx))
+		perm := newDomainPerm(
+			permType,
+			field(domainIdx),
+			obfuscate,
+			field(publicIdx),
+		)
+		if perm != nil {
+			perms = append(perms, perm)
+		}
+	}
+
+	return perms, nil
+}
+
+// parseDomainPermsJSON parses the given reader as a
+// JSON array of domain permissions, in the format
+// used by GoToSocial's domain permission exports.
+func parseDomainPermsJSON(
+	r io.Reader,
+	permType gtsmodel.DomainPermissionType,
+) ([]gtsmodel.DomainPermission, error) {
+	apiPerms := make([]*apimodel.DomainPermission, 0)
+	if err := json.NewDecoder(r).Decode(&apiPerms); err != nil {
+		return nil, gtserror.Newf("error decoding json: %w", err)
+	}
+
+	perms := make([]gtsmodel.DomainPermission, 0, len(apiPerms))
+	for _, apiPerm := range apiPerms {
+		perm := newDomainPerm(
+			permType,
+			apiPerm.Domain.Domain,
+			apiPerm.Obfuscate,
+			apiPerm.PublicComment,
+		)
+		if perm != nil {
+			perms = append(perms, perm)
+		}
+	}
+
+	return perms, nil
+}
+
+// parseDomainPermsPlain parses the given reader as a
+// plaintext list of domains, one per line. Empty lines
+// and lines starting with "#" are ignored.
+func parseDomainPermsPlain(
+	r io.Reader,
+	permType gtsmodel.DomainPermissionType,
+) ([]gtsmodel.DomainPermission, error) {
+	var perms []gtsmodel.DomainPermission
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		perm := newDomainPerm(permType, line, false, "")
+		if perm != nil {
+			perms = append(perms, perm)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, gtserror.Newf("error reading plaintext: %w", err)
+	}
+
+	return perms, nil
+}
+
+// newDomainPerm returns a new, unstored domain permission
+// of the given type for domain, or nil if domain is not
+// valid (eg., because it's obfuscated in the source list).
+func newDomainPerm(
+	permType gtsmodel.DomainPermissionType,
+	domain string,
+	obfuscate bool,
+	publicComment string,
+) gtsmodel.DomainPermission {
+	domain, err := util.Punify(domain)
+	if err != nil || !validDomainPermDomain(domain) {
+		log.Debugf(nil, "skipping invalid domain %q", domain)
+		return nil
+	}
+
+	switch permType {
+	case gtsmodel.DomainPermissionBlock:
+		return &gtsmodel.DomainBlock{
+			Domain:        domain,
+			Obfuscate:     &obfuscate,
+			PublicComment: publicComment,
+		}
+
+	case gtsmodel.DomainPermissionAllow:
+		return &gtsmodel.DomainAllow{
+			Domain:        domain,
+			Obfuscate:     &obfuscate,
+			PublicComment: publicComment,
+		}
+
+	default:
+		return nil
+	}
+}
+
+// validDomainPermDomain returns whether the given
+// punycode domain looks like a valid hostname.
+func validDomainPermDomain(domain string) bool {
+	if domain == "" ||
+		!strings.Contains(domain, ".") ||
+		strings.HasPrefix(domain, ".") ||
+		strings.HasSuffix(domain, ".") {
+		return false
+	}
+
+	for _, r := range domain {
+		if (r < 'a' || r > 'z') &&
+			(r < '0' || r > '9') &&
+			r != '-' && r != '.' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// FeaturedAccountsGet returns all accounts featured
+// in follow suggestions, most recently featured first.
+func (p *Processor) FeaturedAccountsGet(
+	ctx context.Context,
+) ([]*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	featured, err := p.state.DB.GetFeaturedAccounts(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]*apimodel.AdminAccountInfo, 0, len(featured))
+	for _, f := range featured {
+		apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, f.Account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", f.AccountID, err)
+			continue
+		}
+
+		items = append(items, apiAccount)
+	}
+
+	return items, nil
+}
+
+// FeaturedAccountCreate features the account with the given
+// ID in the follow suggestions shown to users of this instance.
+func (p *Processor) FeaturedAccountCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	accountID string,
+) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("account %s not found", accountID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account.IsSuspended() || account.IsInstance() {
+		const text = "account cannot be featured"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	existing, err := p.state.DB.GetFeaturedAccount(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error checking featured account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if existing != nil {
+		err := fmt.Errorf("account %s is already featured", accountID)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	if err := p.state.DB.PutFeaturedAccount(ctx, &gtsmodel.FeaturedAccount{
+		ID:                 id.NewULID(),
+		AccountID:          account.ID,
+		Account:            account,
+		CreatedByAccountID: adminAcct.ID,
+	}); err != nil {
+		err := gtserror.Newf("db error putting featured account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, account)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// FeaturedAccountDelete stops featuring the account with
+// the given ID in follow suggestions. Users who already
+// follow the account will continue to do so.
+func (p *Processor) FeaturedAccountDelete(
+	ctx context.Context,
+	accountID string,
+) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	featured, err := p.state.DB.GetFeaturedAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("featured account %s not found", accountID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err := gtserror.Newf("db error getting featured account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteFeaturedAccount(ctx, accountID); err != nil {
+		err := gtserror.Newf("db error deleting featured account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, featured.Account)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
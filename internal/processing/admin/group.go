@@ -0,0 +1,440 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// GroupsGet returns all local groups, ordered by username.
+func (p *Processor) GroupsGet(
+	ctx context.Context,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	groups, err := p.state.DB.GetLocalGroupAccounts(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting groups: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]*apimodel.Account, 0, len(groups))
+	for _, group := range groups {
+		apiGroup, err := p.converter.AccountToAPIAccountPublic(ctx, group)
+		if err != nil {
+			log.Errorf(ctx, "error converting group %s: %v", group.ID, err)
+			continue
+		}
+
+		items = append(items, apiGroup)
+	}
+
+	return items, nil
+}
+
+// GroupCreate creates a new local group account with
+// the given username, moderated by the requester.
+// Groups have no user, and so can't log in: they're
+// managed by admins through the admin API, and by
+// their moderators through the groups API.
+func (p *Processor) GroupCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	form *apimodel.AdminGroupRequest,
+) (*apimodel.Account, gtserror.WithCode) {
+	if err := validate.Username(form.Username); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	usernameAvailable, err := p.state.DB.IsUsernameAvailable(ctx, form.Username)
+	if err != nil {
+		err := gtserror.Newf("db error checking username availability: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	if !usernameAvailable {
+		err := fmt.Errorf("username %s is not available", form.Username)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		err := gtserror.Newf("error creating new rsa private key: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	accountURIs := uris.GenerateURIsForAccount(form.Username)
+	group := &gtsmodel.Account{
+		ID:                    id.NewULID(),
+		Username:              form.Username,
+		DisplayName:           form.Username,
+		URI:                   accountURIs.UserURI,
+		URL:                   accountURIs.UserURL,
+		InboxURI:              accountURIs.InboxURI,
+		OutboxURI:             accountURIs.OutboxURI,
+		FollowingURI:          accountURIs.FollowingURI,
+		FollowersURI:          accountURIs.FollowersURI,
+		FeaturedCollectionURI: accountURIs.FeaturedCollectionURI,
+		ActorType:             ap.ActorGroup,
+		PrivateKey:            privKey,
+		PublicKey:             &privKey.PublicKey,
+		PublicKeyURI:          accountURIs.PublicKeyURI,
+	}
+
+	if err := p.state.DB.PutAccount(ctx, group); err != nil {
+		err := gtserror.Newf("db error inserting group: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Insert basic settings for the group.
+	group.Settings = &gtsmodel.AccountSettings{
+		AccountID: group.ID,
+		Privacy:   gtsmodel.VisibilityDefault,
+	}
+	if err := p.state.DB.PutAccountSettings(ctx, group.Settings); err != nil {
+		err := gtserror.Newf("db error inserting group settings: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Stub empty stats for the group.
+	if err := p.state.DB.StubAccountStats(ctx, group); err != nil {
+		err := gtserror.Newf("db error stubbing group stats: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// The creating account
+	// moderates the group.
+	if err := p.state.DB.PutGroupModerator(ctx, &gtsmodel.GroupModerator{
+		ID:        id.NewULID(),
+		GroupID:   group.ID,
+		AccountID: requester.ID,
+	}); err != nil {
+		err := gtserror.Newf("db error inserting group moderator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if form.DisplayName == nil &&
+		form.Note == nil &&
+		form.Locked == nil {
+		// Nothing else to set.
+		return p.apiGroup(ctx, group)
+	}
+
+	// Set remaining fields
+	// same as any update.
+	return p.groupUpdate(ctx, group, form)
+}
+
+// GroupUpdate updates the display name, note,
+// and/or locked status of the given local group.
+func (p *Processor) GroupUpdate(
+	ctx context.Context,
+	groupID string,
+	form *apimodel.AdminGroupRequest,
+) (*apimodel.Account, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.groupUpdate(ctx, group, form)
+}
+
+func (p *Processor) groupUpdate(
+	ctx context.Context,
+	group *gtsmodel.Account,
+	form *apimodel.AdminGroupRequest,
+) (*apimodel.Account, gtserror.WithCode) {
+	// Update the group the same way as any
+	// other account: this handles formatting
+	// and emojis, and federates the update.
+	if _, errWithCode := p.account.Update(ctx, group,
+		&apimodel.UpdateCredentialsRequest{
+			DisplayName: form.DisplayName,
+			Note:        form.Note,
+			Locked:      form.Locked,
+		},
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiGroup(ctx, group)
+}
+
+// GroupMembersGet returns a page of
+// accounts which are members of the group.
+func (p *Processor) GroupMembersGet(
+	ctx context.Context,
+	groupID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.groups.MembersPage(ctx, group, "/api/v1/admin/groups/"+groupID+"/members", page)
+}
+
+// GroupRequestsGet returns a page of accounts which
+// have requested to join the group, pending approval.
+func (p *Processor) GroupRequestsGet(
+	ctx context.Context,
+	groupID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.groups.RequestsPage(ctx, group, "/api/v1/admin/groups/"+groupID+"/requests", page)
+}
+
+// GroupBansGet returns a page of
+// accounts banned from the group.
+func (p *Processor) GroupBansGet(
+	ctx context.Context,
+	groupID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.groups.BansPage(ctx, group, "/api/v1/admin/groups/"+groupID+"/bans", page)
+}
+
+// GroupRequestAuthorize accepts the given
+// account's pending request to join the group.
+func (p *Processor) GroupRequestAuthorize(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.account.FollowRequestAccept(ctx, group, accountID)
+}
+
+// GroupRequestReject rejects the given
+// account's pending request to join the group.
+func (p *Processor) GroupRequestReject(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.account.FollowRequestReject(ctx, group, accountID)
+}
+
+// GroupMemberKick removes the given account from the
+// group's members. Unlike a ban, the account may ask
+// to join (or, if the group isn't locked, just join)
+// the group again later.
+func (p *Processor) GroupMemberKick(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.groups.Kick(ctx, group, accountID)
+}
+
+// GroupBanCreate bans the given account from
+// the group. Banned accounts are removed from
+// the group and can't request to join it again.
+func (p *Processor) GroupBanCreate(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// A ban is a block by the group.
+	return p.account.BlockCreate(ctx, group, accountID)
+}
+
+// GroupBanDelete lifts the ban of the
+// given account from the group.
+func (p *Processor) GroupBanDelete(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.groups.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.account.BlockRemove(ctx, group, accountID)
+}
+
+// GroupModeratorsGet returns the
+// local accounts moderating the group.
+func (p *Processor) GroupModeratorsGet(
+	ctx context.Context,
+	groupID string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	if _, errWithCode := p.groups.GetLocalGroup(ctx, groupID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.groupModerators(ctx, groupID)
+}
+
+// GroupModeratorAdd makes the given local account
+// a moderator of the group, returning the group's
+// moderators. Adding an existing moderator is a no-op.
+func (p *Processor) GroupModeratorAdd(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	if _, errWithCode := p.groups.GetLocalGroup(ctx, groupID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Only local user accounts can use
+	// the client API to moderate groups.
+	if account == nil || !account.IsLocal() ||
+		account.IsGroup() || account.IsInstance() ||
+		account.IsSuspended() {
+		err := fmt.Errorf("local account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	moderator, err := p.state.DB.IsGroupModerator(ctx, groupID, accountID)
+	if err != nil {
+		err := gtserror.Newf("db error checking group moderator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !moderator {
+		if err := p.state.DB.PutGroupModerator(ctx, &gtsmodel.GroupModerator{
+			ID:        id.NewULID(),
+			GroupID:   groupID,
+			AccountID: accountID,
+		}); err != nil {
+			err := gtserror.Newf("db error inserting group moderator: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.groupModerators(ctx, groupID)
+}
+
+// GroupModeratorRemove removes the given account from
+// the group's moderators, returning the group's moderators.
+func (p *Processor) GroupModeratorRemove(
+	ctx context.Context,
+	groupID string,
+	accountID string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	if _, errWithCode := p.groups.GetLocalGroup(ctx, groupID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	moderator, err := p.state.DB.IsGroupModerator(ctx, groupID, accountID)
+	if err != nil {
+		err := gtserror.Newf("db error checking group moderator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !moderator {
+		err := fmt.Errorf("account %s is not a moderator of group %s", accountID, groupID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if err := p.state.DB.DeleteGroupModerator(ctx, groupID, accountID); err != nil {
+		err := gtserror.Newf("db error deleting group moderator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.groupModerators(ctx, groupID)
+}
+
+func (p *Processor) groupModerators(
+	ctx context.Context,
+	groupID string,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	moderators, err := p.state.DB.GetGroupModerators(ctx, groupID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting group moderators: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]*apimodel.Account, 0, len(moderators))
+	for _, moderator := range moderators {
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, moderator.Account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", moderator.AccountID, err)
+			continue
+		}
+
+		items = append(items, apiAccount)
+	}
+
+	return items, nil
+}
+
+func (p *Processor) apiGroup(
+	ctx context.Context,
+	group *gtsmodel.Account,
+) (*apimodel.Account, gtserror.WithCode) {
+	apiGroup, err := p.converter.AccountToAPIAccountPublic(ctx, group)
+	if err != nil {
+		err := gtserror.Newf("error converting group: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiGroup, nil
+}
@@ -0,0 +1,289 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type GroupTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *GroupTestSuite) TestGroupCreate() {
+	ctx := context.Background()
+
+	group, errWithCode := suite.adminProcessor.GroupCreate(ctx, suite.testAccounts["admin_account"], &apimodel.AdminGroupRequest{
+		Username:    "cool_group",
+		DisplayName: util.Ptr("Cool Group"),
+		Locked:      util.Ptr(true),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("cool_group", group.Username)
+	suite.Equal("Cool Group", group.DisplayName)
+	suite.True(group.Group)
+	suite.True(group.Locked)
+
+	dbGroup, err := suite.db.GetAccountByID(ctx, group.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbGroup.IsGroup())
+	suite.False(dbGroup.IsInstance())
+
+	groups, errWithCode := suite.adminProcessor.GroupsGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(groups, 1)
+	suite.Equal(group.ID, groups[0].ID)
+
+	// Username is now taken.
+	_, errWithCode = suite.adminProcessor.GroupCreate(ctx, suite.testAccounts["admin_account"], &apimodel.AdminGroupRequest{
+		Username: "cool_group",
+	})
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Username must be valid.
+	_, errWithCode = suite.adminProcessor.GroupCreate(ctx, suite.testAccounts["admin_account"], &apimodel.AdminGroupRequest{
+		Username: "not a valid username!",
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *GroupTestSuite) TestGroupMembership() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_1"]
+
+	group, errWithCode := suite.adminProcessor.GroupCreate(ctx, suite.testAccounts["admin_account"], &apimodel.AdminGroupRequest{
+		Username: "cool_group",
+		Locked:   util.Ptr(true),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Request to join the group.
+	if err := suite.db.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+		ID:              id.NewULID(),
+		URI:             account.URI + "/follow/" + id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: group.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requests, errWithCode := suite.adminProcessor.GroupRequestsGet(ctx, group.ID, &paging.Page{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(requests.Items, 1)
+
+	relationship, errWithCode := suite.adminProcessor.GroupRequestAuthorize(ctx, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(relationship.FollowedBy)
+
+	members, errWithCode := suite.adminProcessor.GroupMembersGet(ctx, group.ID, &paging.Page{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(members.Items, 1)
+
+	// Kick the member.
+	relationship, errWithCode = suite.adminProcessor.GroupMemberKick(ctx, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(relationship.FollowedBy)
+	suite.False(relationship.Blocking)
+
+	// Can't kick a non-member.
+	_, errWithCode = suite.adminProcessor.GroupMemberKick(ctx, group.ID, account.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Ban the account.
+	relationship, errWithCode = suite.adminProcessor.GroupBanCreate(ctx, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(relationship.Blocking)
+
+	bans, errWithCode := suite.adminProcessor.GroupBansGet(ctx, group.ID, &paging.Page{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(bans.Items, 1)
+
+	// Lift the ban.
+	relationship, errWithCode = suite.adminProcessor.GroupBanDelete(ctx, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(relationship.Blocking)
+}
+
+func (suite *GroupTestSuite) TestGroupModerators() {
+	var (
+		ctx       = context.Background()
+		admin     = suite.testAccounts["admin_account"]
+		moderator = suite.testAccounts["local_account_1"]
+		other     = suite.testAccounts["local_account_2"]
+		account   = suite.testAccounts["remote_account_1"]
+	)
+
+	group, errWithCode := suite.adminProcessor.GroupCreate(ctx, admin, &apimodel.AdminGroupRequest{
+		Username: "cool_group",
+		Locked:   util.Ptr(true),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Creating admin should be a moderator.
+	moderators, errWithCode := suite.adminProcessor.GroupModeratorsGet(ctx, group.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(moderators, 1)
+	suite.Equal(admin.ID, moderators[0].ID)
+
+	// Add another moderator.
+	moderators, errWithCode = suite.adminProcessor.GroupModeratorAdd(ctx, group.ID, moderator.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(moderators, 2)
+
+	// Remote accounts can't be moderators.
+	_, errWithCode = suite.adminProcessor.GroupModeratorAdd(ctx, group.ID, account.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Moderator should see the group.
+	groups, errWithCode := suite.processor.Groups().ModeratedGet(ctx, moderator)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(groups, 1)
+	suite.Equal(group.ID, groups[0].ID)
+
+	// Request to join the group.
+	if err := suite.db.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+		ID:              id.NewULID(),
+		URI:             account.URI + "/follow/" + id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: group.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Non-moderators can't see or act on the request.
+	_, errWithCode = suite.processor.Groups().RequestsGet(ctx, other, group.ID, &paging.Page{})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	_, errWithCode = suite.processor.Groups().RequestAuthorize(ctx, other, group.ID, account.ID)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Moderator can.
+	requests, errWithCode := suite.processor.Groups().RequestsGet(ctx, moderator, group.ID, &paging.Page{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(requests.Items, 1)
+	suite.Contains(requests.NextLink, "/api/v1/groups/"+group.ID+"/requests")
+
+	relationship, errWithCode := suite.processor.Groups().RequestAuthorize(ctx, moderator, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(relationship.FollowedBy)
+
+	// Non-moderators can't kick or ban.
+	_, errWithCode = suite.processor.Groups().MemberKick(ctx, other, group.ID, account.ID)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	_, errWithCode = suite.processor.Groups().BanCreate(ctx, other, group.ID, account.ID)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// Moderator can't ban the group's owner.
+	_, errWithCode = suite.processor.Groups().BanCreate(ctx, moderator, group.ID, admin.ID)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Nor another moderator.
+	if _, errWithCode := suite.adminProcessor.GroupModeratorAdd(ctx, group.ID, other.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.processor.Groups().BanCreate(ctx, moderator, group.ID, other.ID)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	if _, errWithCode := suite.adminProcessor.GroupModeratorRemove(ctx, group.ID, other.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Moderator can ban.
+	relationship, errWithCode = suite.processor.Groups().BanCreate(ctx, moderator, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.True(relationship.Blocking)
+
+	// Once removed, the moderator can't
+	// lift the ban, but an admin still can.
+	moderators, errWithCode = suite.adminProcessor.GroupModeratorRemove(ctx, group.ID, moderator.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(moderators, 1)
+
+	_, errWithCode = suite.processor.Groups().BanDelete(ctx, moderator, group.ID, account.ID)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	relationship, errWithCode = suite.adminProcessor.GroupBanDelete(ctx, group.ID, account.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(relationship.Blocking)
+}
+
+func (suite *GroupTestSuite) TestGroupNotGroup() {
+	ctx := context.Background()
+
+	// Ordinary accounts can't be managed as groups.
+	_, errWithCode := suite.adminProcessor.GroupMembersGet(ctx,
+		suite.testAccounts["local_account_1"].ID,
+		&paging.Page{},
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupTestSuite))
+}
@@ -0,0 +1,237 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// RelaysGet returns all relays that
+// this instance is subscribed to.
+func (p *Processor) RelaysGet(ctx context.Context) ([]*apimodel.AdminRelay, gtserror.WithCode) {
+	relays, err := p.state.DB.GetRelays(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relays: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRelays := make([]*apimodel.AdminRelay, 0, len(relays))
+	for _, relay := range relays {
+		apiRelay, errWithCode := p.apiRelay(ctx, relay)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiRelays = append(apiRelays, apiRelay)
+	}
+
+	return apiRelays, nil
+}
+
+// RelayGet returns one relay with the given id.
+func (p *Processor) RelayGet(ctx context.Context, id string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayCreate subscribes to the relay with the given inbox
+// URL, by sending a Follow from the instance actor. The
+// relay will be in pending state until it accepts the Follow.
+func (p *Processor) RelayCreate(ctx context.Context, inboxURL string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	inboxIRI, err := url.Parse(inboxURL)
+	if err != nil || !inboxIRI.IsAbs() || inboxIRI.Host == "" ||
+		(inboxIRI.Scheme != "http" && inboxIRI.Scheme != "https") {
+		err := fmt.Errorf("invalid inbox_url %s: must be an absolute http or https uri", inboxURL)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if inboxIRI.Host == config.GetHost() ||
+		inboxIRI.Host == config.GetAccountDomain() {
+		const text = "inbox_url must not point to this instance"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		err := gtserror.Newf("db error getting instance account: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	relayID := id.NewULID()
+	relay := &gtsmodel.Relay{
+		ID:        relayID,
+		InboxURI:  inboxIRI.String(),
+		FollowURI: uris.GenerateURIForFollow(instanceAcct.Username, relayID),
+		State:     gtsmodel.RelayStatePending,
+	}
+
+	if err := p.state.DB.PutRelay(ctx, relay); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Unique constraint conflict.
+			const text = "relay with given inbox_url already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+
+		err := gtserror.Newf("db error putting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	follow, err := p.converter.RelayToASFollow(ctx, relay, instanceAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting relay to follow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.deliverToRelay(ctx, instanceAcct, relay, follow); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayDelete unsubscribes from the relay with the given
+// id, by sending an Undo of the instance actor's Follow,
+// and removes the relay, returning the removed relay.
+func (p *Processor) RelayDelete(ctx context.Context, id string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiRelay, errWithCode := p.apiRelay(ctx, relay)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if relay.State != gtsmodel.RelayStateRejected {
+		// Relay may still be delivering to us,
+		// so let it know we're unsubscribing.
+		instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+		if err != nil {
+			err := gtserror.Newf("db error getting instance account: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		follow, err := p.converter.RelayToASFollow(ctx, relay, instanceAcct)
+		if err != nil {
+			err := gtserror.Newf("error converting relay to follow: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Wrap the recreated Follow in an Undo. This is delivered
+		// directly rather than via the outbox, so it needs an ID.
+		undoIRI, err := url.Parse(relay.FollowURI + "#Undo")
+		if err != nil {
+			err := gtserror.Newf("error parsing relay follow uri: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		undo := streams.NewActivityStreamsUndo()
+		ap.SetJSONLDId(undo, undoIRI)
+		undo.SetActivityStreamsActor(follow.GetActivityStreamsActor())
+		undoObject := streams.NewActivityStreamsObjectProperty()
+		undoObject.AppendActivityStreamsFollow(follow)
+		undo.SetActivityStreamsObject(undoObject)
+
+		if err := p.deliverToRelay(ctx, instanceAcct, relay, undo); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if err := p.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		err := gtserror.Newf("db error deleting relay: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
+
+// deliverToRelay queues delivery of the given
+// activity to the inbox of the given relay, on
+// behalf of the given instance account.
+func (p *Processor) deliverToRelay(
+	ctx context.Context,
+	instanceAcct *gtsmodel.Account,
+	relay *gtsmodel.Relay,
+	t vocab.Type,
+) error {
+	inboxIRI, err := url.Parse(relay.InboxURI)
+	if err != nil {
+		return gtserror.Newf("error parsing relay inbox uri: %w", err)
+	}
+
+	tsport, err := p.transport.NewTransportForUsername(ctx, instanceAcct.Username)
+	if err != nil {
+		return gtserror.Newf("error getting instance transport: %w", err)
+	}
+
+	m, err := ap.Serialize(t)
+	if err != nil {
+		return gtserror.Newf("error serializing %T: %w", t, err)
+	}
+
+	if err := tsport.Deliver(ctx, m, inboxIRI); err != nil {
+		return gtserror.Newf("error delivering %T to relay inbox %s: %w", t, relay.InboxURI, err)
+	}
+
+	return nil
+}
+
+// getRelay fetches the relay with the
+// given id, returning 404 if it doesn't exist.
+func (p *Processor) getRelay(ctx context.Context, id string) (*gtsmodel.Relay, gtserror.WithCode) {
+	relay, err := p.state.DB.GetRelayByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting relay %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if relay == nil {
+		err := gtserror.Newf("relay %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return relay, nil
+}
+
+func (p *Processor) apiRelay(ctx context.Context, relay *gtsmodel.Relay) (*apimodel.AdminRelay, gtserror.WithCode) {
+	apiRelay, err := p.converter.RelayToAdminAPIRelay(ctx, relay)
+	if err != nil {
+		err := gtserror.Newf("error converting relay to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
@@ -0,0 +1,130 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type RelayTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *RelayTestSuite) TestRelayCreateDelete() {
+	ctx := context.Background()
+	instanceAccount := suite.testAccounts["instance_account"]
+
+	relay, errWithCode := suite.adminProcessor.RelayCreate(ctx, "https://relay.example.org/inbox")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("https://relay.example.org/inbox", relay.InboxURL)
+	suite.Equal("pending", relay.State)
+	suite.Empty(relay.ActorURI)
+
+	// A Follow of the public collection should
+	// have been sent to the relay by the instance actor.
+	dlv, ok := suite.state.Workers.Delivery.Queue.Pop()
+	if !ok {
+		suite.FailNow("expected queued delivery")
+	}
+	suite.Equal("https://relay.example.org/inbox", dlv.Request.URL.String())
+	suite.Equal(instanceAccount.URI, dlv.ActorID)
+	suite.Equal(pub.PublicActivityPubIRI, dlv.ObjectID)
+
+	// Creating the same relay again should conflict.
+	_, errWithCode = suite.adminProcessor.RelayCreate(ctx, "https://relay.example.org/inbox")
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	relays, errWithCode := suite.adminProcessor.RelaysGet(ctx)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(relays, 1)
+
+	dbRelay, err := suite.db.GetRelayByID(ctx, relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Delete the relay, an Undo
+	// of the Follow should be sent.
+	if _, errWithCode := suite.adminProcessor.RelayDelete(ctx, relay.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	dlv, ok = suite.state.Workers.Delivery.Queue.Pop()
+	if !ok {
+		suite.FailNow("expected queued delivery")
+	}
+	suite.Equal("https://relay.example.org/inbox", dlv.Request.URL.String())
+	suite.Equal(dbRelay.FollowURI, dlv.ObjectID)
+
+	_, errWithCode = suite.adminProcessor.RelayGet(ctx, relay.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RelayTestSuite) TestRelayDeleteRejected() {
+	ctx := context.Background()
+
+	relay := &gtsmodel.Relay{
+		ID:        "01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+		InboxURI:  "https://relay.example.org/inbox",
+		FollowURI: "http://localhost:8080/users/localhost:8080/follow/01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+		ActorURI:  "https://relay.example.org/actor",
+		State:     gtsmodel.RelayStateRejected,
+	}
+	if err := suite.db.PutRelay(ctx, relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiRelay, errWithCode := suite.adminProcessor.RelayDelete(ctx, relay.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("rejected", apiRelay.State)
+
+	// Relay rejected us, so
+	// nothing should be sent.
+	_, ok := suite.state.Workers.Delivery.Queue.Pop()
+	suite.False(ok)
+}
+
+func (suite *RelayTestSuite) TestRelayCreateInvalid() {
+	ctx := context.Background()
+
+	for _, inboxURL := range []string{
+		"not a url",
+		"/inbox",
+		"ftp://relay.example.org/inbox",
+		"http://localhost:8080/inbox",
+	} {
+		_, errWithCode := suite.adminProcessor.RelayCreate(ctx, inboxURL)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code(), inboxURL)
+	}
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
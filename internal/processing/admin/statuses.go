@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// StatusesPrune triggers a non-blocking prune of stale remote statuses
+// that no local account has interacted with, followed by a prune of
+// remote accounts left unused, both older than the given number of days.
+func (p *Processor) StatusesPrune(ctx context.Context, statusesRemotePruneDays int) gtserror.WithCode {
+	if statusesRemotePruneDays < 0 {
+		err := fmt.Errorf("StatusesPrune: invalid value for statusesRemotePruneDays prune: value was %d, cannot be less than 0", statusesRemotePruneDays)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Start background task performing all status cleanup tasks.
+	go func() {
+		ctx := context.Background()
+		p.cleaner.Statuses().All(ctx, statusesRemotePruneDays)
+		p.cleaner.Accounts().All(ctx, statusesRemotePruneDays)
+	}()
+
+	return nil
+}
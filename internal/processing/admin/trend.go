@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// TrendsGet returns up to limit trends of the given type
+// (and optionally state) that have been used within the
+// trending window, skipping the first offset, ordered by
+// how many accounts recently used them.
+func (p *Processor) TrendsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	trendType gtsmodel.TrendType,
+	state gtsmodel.TrendState,
+	limit int,
+	offset int,
+) ([]*apimodel.AdminTrend, gtserror.WithCode) {
+	trends, err := p.state.DB.GetTrends(ctx,
+		trendType,
+		state,
+		time.Now().Add(-gtsmodel.TrendWindow),
+		limit,
+		offset,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting %s trends: %w", trendType, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]*apimodel.AdminTrend, 0, len(trends))
+	for _, trend := range trends {
+		apiTrend, err := p.converter.TrendToAdminAPITrend(ctx, trend, requester)
+		if err != nil {
+			log.Errorf(ctx, "error converting trend %s: %v", trend.ID, err)
+			continue
+		}
+
+		items = append(items, apiTrend)
+	}
+
+	return items, nil
+}
+
+// TrendReview approves or rejects the trend with the given
+// ID on behalf of the given admin account, controlling
+// whether it's shown via the public trends endpoints.
+func (p *Processor) TrendReview(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	approve bool,
+) (*apimodel.AdminTrend, gtserror.WithCode) {
+	trend, err := p.state.DB.GetTrendByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("trend %s not found", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		err := gtserror.Newf("db error getting trend %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	trend.State = gtsmodel.TrendStateRejected
+	if approve {
+		trend.State = gtsmodel.TrendStateApproved
+	}
+	trend.ReviewedByAccountID = adminAcct.ID
+	trend.ReviewedAt = time.Now()
+
+	if err := p.state.DB.UpdateTrend(ctx, trend,
+		"state",
+		"reviewed_by_account_id",
+		"reviewed_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating trend %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTrend, err := p.converter.TrendToAdminAPITrend(ctx, trend, adminAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting trend %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiTrend, nil
+}
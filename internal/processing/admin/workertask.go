@@ -62,6 +62,7 @@ func (p *Processor) FillWorkerQueues(ctx context.Context) error {
 		delivery  int
 		federator int
 		client    int
+		imports   int
 
 		// Failed recoveries.
 		errors int
@@ -95,6 +96,9 @@ func (p *Processor) FillWorkerQueues(ctx context.Context) error {
 		case gtsmodel.ClientWorker:
 			err = p.pushClient(ctx, task)
 			counter = &client
+		case gtsmodel.ImportWorker:
+			err = p.pushImport(ctx, task)
+			counter = &imports
 		default:
 			err = fmt.Errorf("invalid worker type %d", task.WorkerType)
 		}
@@ -130,6 +134,7 @@ func (p *Processor) FillWorkerQueues(ctx context.Context) error {
 		WithField("delivery", delivery).
 		WithField("federator", federator).
 		WithField("client", client).
+		WithField("imports", imports).
 		WithField("errors", errors).
 		Info("recovered queued tasks")
 
@@ -147,6 +152,7 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 		delivery  int
 		federator int
 		client    int
+		imports   int
 
 		// Failed persists.
 		errors int
@@ -214,6 +220,21 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 		client++ // incr count
 	}
 
+	// Import jobs can't be popped from the processing
+	// queue, which just holds function ptrs, so instead
+	// persist a task for every job that isn't finished.
+	jobs, err := p.state.DB.GetUnfinishedImportJobs(ctx)
+	if err != nil {
+		log.Errorf(ctx, "error getting unfinished import jobs: %v", err)
+		errors++ // incr count
+	}
+
+	for _, job := range jobs {
+		// Append serialized task.
+		tasks = append(tasks, popImport(job))
+		imports++ // incr count
+	}
+
 	// Persist all serialized queued worker tasks to database.
 	if err := p.state.DB.PutWorkerTasks(ctx, tasks); err != nil {
 		return gtserror.Newf("error putting tasks in db: %w", err)
@@ -224,6 +245,7 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 		WithField("delivery", delivery).
 		WithField("federator", federator).
 		WithField("client", client).
+		WithField("imports", imports).
 		WithField("errors", errors).
 		Info("persisted queued tasks")
 
@@ -232,10 +254,15 @@ func (p *Processor) PersistWorkerQueues(ctx context.Context) error {
 
 // pushDelivery parses a valid delivery.Delivery{} from serialized task data and pushes to queue.
 func (p *Processor) pushDelivery(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	return p.pushDeliveryData(ctx, task.TaskData)
+}
+
+// pushDeliveryData parses a valid delivery.Delivery{} from serialized data, signs and pushes to queue.
+func (p *Processor) pushDeliveryData(ctx context.Context, data []byte) error {
 	dlv := new(delivery.Delivery)
 
 	// Deserialize the raw worker task data into delivery.
-	if err := dlv.Deserialize(task.TaskData); err != nil {
+	if err := dlv.Deserialize(data); err != nil {
 		return gtserror.Newf("error deserializing delivery: %w", err)
 	}
 
@@ -424,3 +451,35 @@ func (p *Processor) popClient() (*gtsmodel.WorkerTask, error) {
 		CreatedAt:  time.Now(),
 	}, nil
 }
+
+// pushImport fetches the import job referenced by serialized task data, and queues it for processing.
+func (p *Processor) pushImport(ctx context.Context, task *gtsmodel.WorkerTask) error {
+	jobID := string(task.TaskData)
+
+	// Fetch the persisted import job by ID from database.
+	job, err := p.state.DB.GetImportJobByID(ctx, jobID)
+	if err != nil {
+		return gtserror.Newf("error fetching import job %s from db: %w", jobID, err)
+	}
+
+	if job.Finished() || job.Failed() {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	// Queue import job to restart processing.
+	p.account.QueueImportJob(job)
+
+	return nil
+}
+
+// popImport serializes the given unfinished import job as valid task data.
+func popImport(job *gtsmodel.ImportJob) *gtsmodel.WorkerTask {
+	return &gtsmodel.WorkerTask{
+		// ID is autoincrement
+		WorkerType: gtsmodel.ImportWorker,
+		TaskData:   []byte(job.ID),
+		CreatedAt:  time.Now(),
+	}
+}
@@ -34,6 +34,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -335,6 +336,47 @@ func (suite *WorkerTaskTestSuite) TestPersistWorkerQueues() {
 	suite.Equal(len(testClientMsgs), nclient)
 }
 
+func (suite *WorkerTaskTestSuite) TestPersistFillImportJobs() {
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+
+	// Put an unfinished import job in the database.
+	job := &gtsmodel.ImportJob{
+		ID:        "01JC4A1B2C3D4E5F6G7H8J9K0M",
+		AccountID: suite.testAccounts["local_account_1"].ID,
+		Type:      "blocks",
+		Overwrite: util.Ptr(false),
+		Data:      []byte("admin@localhost:8080\n"),
+		State:     gtsmodel.ImportJobStateProcessing,
+		TotalRows: 1,
+	}
+	err := suite.state.DB.PutImportJob(ctx, job)
+	suite.NoError(err)
+
+	// Persist the worker queued tasks to database.
+	err = suite.adminProcessor.PersistWorkerQueues(ctx)
+	suite.NoError(err)
+
+	// There should be a single import task
+	// persisted, referencing the job by ID.
+	tasks, err := suite.state.DB.GetWorkerTasks(ctx)
+	suite.NoError(err)
+	suite.Len(tasks, 1)
+	suite.Equal(gtsmodel.ImportWorker, tasks[0].WorkerType)
+	suite.Equal(job.ID, string(tasks[0].TaskData))
+
+	// Recover the persisted tasks from database.
+	err = suite.adminProcessor.FillWorkerQueues(ctx)
+	suite.NoError(err)
+
+	// The import job should now be queued
+	// again, and the task no longer persisted.
+	suite.Equal(1, suite.state.Workers.Processing.Queue.Len())
+	tasks, err = suite.state.DB.GetWorkerTasks(ctx)
+	suite.NoError(err)
+	suite.Empty(tasks)
+}
+
 func (suite *WorkerTaskTestSuite) SetupTest() {
 	suite.AdminStandardTestSuite.SetupTest()
 	// we don't want workers running
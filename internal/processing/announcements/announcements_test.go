@@ -0,0 +1,280 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/announcements"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	gtsstream "github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AnnouncementsTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+
+	testAccounts map[string]*gtsmodel.Account
+
+	stream        stream.Processor
+	announcements announcements.Processor
+}
+
+func (suite *AnnouncementsTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *AnnouncementsTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.state.Storage = testrig.NewInMemoryStorage()
+
+	converter := typeutils.NewConverter(&suite.state)
+	transportController := testrig.NewTestTransportController(&suite.state, testrig.NewMockHTTPClient(nil, "../../../testrig/media"))
+	mediaManager := testrig.NewTestMediaManager(&suite.state)
+	federator := testrig.NewTestFederator(&suite.state, transportController, mediaManager)
+
+	suite.stream = stream.New(&suite.state, testrig.NewTestOauthServer(suite.db))
+	suite.announcements = announcements.New(
+		&suite.state,
+		converter,
+		&suite.stream,
+		processing.GetParseMentionFunc(&suite.state, federator),
+	)
+
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *AnnouncementsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StopWorkers(&suite.state)
+}
+
+// newAnnouncement formats and stores a new,
+// unpublished announcement with the given text.
+func (suite *AnnouncementsTestSuite) newAnnouncement(text string) *gtsmodel.Announcement {
+	announcement := &gtsmodel.Announcement{
+		ID:                 id.NewULID(),
+		Text:               text,
+		AllDay:             util.Ptr(false),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	suite.announcements.Format(context.Background(), announcement)
+
+	if err := suite.db.PutAnnouncement(context.Background(), announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return announcement
+}
+
+func (suite *AnnouncementsTestSuite) TestPublish() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_1"]
+	)
+
+	openStream, errWithCode := suite.stream.Open(ctx, requester, gtsstream.TimelineHome)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	announcement := suite.newAnnouncement("hey @the_mighty_zork, check out #welcome :rainbow:")
+	suite.Equal([]string{requester.ID}, announcement.MentionedAccountIDs)
+	suite.Len(announcement.TagIDs, 1)
+	suite.Len(announcement.EmojiIDs, 1)
+
+	// Not yet published.
+	apiAnnouncements, errWithCode := suite.announcements.Get(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAnnouncements)
+
+	if err := suite.announcements.Publish(ctx, announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Publishing streams the announcement out.
+	msg, ok := openStream.Recv(ctx)
+	suite.True(ok)
+	suite.Equal(gtsstream.EventTypeAnnouncement, msg.Event)
+
+	streamed := new(apimodel.Announcement)
+	if err := json.Unmarshal([]byte(msg.Payload), streamed); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(announcement.ID, streamed.ID)
+	suite.True(streamed.Published)
+	suite.Len(streamed.Mentions, 1)
+	suite.Len(streamed.Tags, 1)
+	suite.Len(streamed.Emojis, 1)
+
+	apiAnnouncements, errWithCode = suite.announcements.Get(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiAnnouncements, 1)
+	suite.False(apiAnnouncements[0].Read)
+
+	// Once ended, it's no longer shown.
+	announcement.EndsAt = time.Now().Add(-time.Second)
+	if err := suite.db.UpdateAnnouncement(ctx, announcement, "ends_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiAnnouncements, errWithCode = suite.announcements.Get(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAnnouncements)
+}
+
+func (suite *AnnouncementsTestSuite) TestDismiss() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["local_account_1"]
+		announcement = suite.newAnnouncement("hello world")
+	)
+
+	// Can't dismiss unpublished announcement.
+	errWithCode := suite.announcements.Dismiss(ctx, requester, announcement.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	if err := suite.announcements.Publish(ctx, announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if errWithCode := suite.announcements.Dismiss(ctx, requester, announcement.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	apiAnnouncements, errWithCode := suite.announcements.Get(ctx, requester)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiAnnouncements, 1)
+	suite.True(apiAnnouncements[0].Read)
+
+	// Other users haven't read it.
+	apiAnnouncements, errWithCode = suite.announcements.Get(ctx, suite.testAccounts["local_account_2"])
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiAnnouncements, 1)
+	suite.False(apiAnnouncements[0].Read)
+}
+
+func (suite *AnnouncementsTestSuite) TestReactions() {
+	var (
+		ctx          = context.Background()
+		requester1   = suite.testAccounts["local_account_1"]
+		requester2   = suite.testAccounts["local_account_2"]
+		announcement = suite.newAnnouncement("hello world")
+	)
+
+	if err := suite.announcements.Publish(ctx, announcement); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, r := range []struct {
+		requester *gtsmodel.Account
+		name      string
+	}{
+		{requester1, "👍"},
+		{requester1, "👍"},
+		{requester2, "👍"},
+		{requester2, "rainbow"},
+		{requester2, "1️⃣"},
+		{requester2, "👩🏽‍💻"},
+	} {
+		if errWithCode := suite.announcements.ReactionAdd(ctx, r.requester, announcement.ID, r.name); errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+	}
+
+	for _, name := range []string{
+		"hello",                 // not an existing custom emoji
+		"yell",                  // not a local custom emoji
+		"hi 👍",                  // not just an emoji
+		strings.Repeat("👍", 17), // too long
+	} {
+		errWithCode := suite.announcements.ReactionAdd(ctx, requester1, announcement.ID, name)
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code(), name)
+	}
+
+	apiAnnouncements, errWithCode := suite.announcements.Get(ctx, requester1)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiAnnouncements, 1)
+
+	reactions := reactionsByName(apiAnnouncements[0].Reactions)
+	suite.Len(reactions, 4)
+	suite.Equal(2, reactions["👍"].Count)
+	suite.True(reactions["👍"].Me)
+	suite.Equal(1, reactions["rainbow"].Count)
+	suite.False(reactions["rainbow"].Me)
+	suite.NotEmpty(reactions["rainbow"].URL)
+
+	if errWithCode := suite.announcements.ReactionRemove(ctx, requester1, announcement.ID, "👍"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	apiAnnouncements, errWithCode = suite.announcements.Get(ctx, requester1)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	reactions = reactionsByName(apiAnnouncements[0].Reactions)
+	suite.Equal(1, reactions["👍"].Count)
+	suite.False(reactions["👍"].Me)
+}
+
+func reactionsByName(reactions []apimodel.AnnouncementReaction) map[string]apimodel.AnnouncementReaction {
+	byName := make(map[string]apimodel.AnnouncementReaction, len(reactions))
+	for _, r := range reactions {
+		byName[r.Name] = r
+	}
+	return byName
+}
+
+func TestAnnouncementsTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementsTestSuite))
+}
@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Get returns all currently active announcements,
+// from the perspective of the given requester.
+func (p *Processor) Get(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetActiveAnnouncements(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, requester)
+		if err != nil {
+			log.Errorf(ctx, "error converting announcement to api announcement: %v", err)
+			continue
+		}
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// getActive fetches the announcement with the given ID,
+// returning 404 if it doesn't exist or isn't currently
+// active, ie., users aren't able to see it.
+func (p *Processor) getActive(
+	ctx context.Context,
+	announcementID string,
+) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, announcementID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement %s: %w", announcementID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if announcement == nil || !announcement.IsActive() {
+		err := gtserror.Newf("announcement %s not found", announcementID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return announcement, nil
+}
@@ -0,0 +1,176 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Format parses the announcement's markdown Text, setting
+// its html Content, and any emojis, tags and mentions used.
+func (p *Processor) Format(ctx context.Context, announcement *gtsmodel.Announcement) {
+	result := p.formatter.FromMarkdown(ctx,
+		p.parseMention,
+		announcement.CreatedByAccountID,
+		"",
+		announcement.Text,
+	)
+
+	announcement.Content = result.HTML
+
+	announcement.EmojiIDs = make([]string, 0, len(result.Emojis))
+	announcement.Emojis = result.Emojis
+	for _, emoji := range result.Emojis {
+		announcement.EmojiIDs = append(announcement.EmojiIDs, emoji.ID)
+	}
+
+	announcement.TagIDs = make([]string, 0, len(result.Tags))
+	announcement.Tags = result.Tags
+	for _, tag := range result.Tags {
+		announcement.TagIDs = append(announcement.TagIDs, tag.ID)
+	}
+
+	announcement.MentionedAccountIDs = make([]string, 0, len(result.Mentions))
+	for _, mention := range result.Mentions {
+		announcement.MentionedAccountIDs = append(announcement.MentionedAccountIDs, mention.TargetAccountID)
+	}
+}
+
+// Publish marks the given announcement as published
+// now, and streams it out to all open user streams.
+func (p *Processor) Publish(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	announcement.PublishedAt = time.Now()
+	if err := p.state.DB.UpdateAnnouncement(ctx,
+		announcement,
+		"published_at",
+	); err != nil {
+		return gtserror.Newf("db error updating announcement: %w", err)
+	}
+
+	p.StreamUpdate(ctx, announcement)
+	return nil
+}
+
+// StreamUpdate streams the given announcement out
+// to all open user streams, if it's currently active.
+func (p *Processor) StreamUpdate(ctx context.Context, announcement *gtsmodel.Announcement) {
+	if !announcement.IsActive() {
+		return
+	}
+
+	// Users may each have read or reacted to the announcement,
+	// but we're sending the same event to everyone, so convert
+	// it from the perspective of no-one in particular.
+	apiAnnouncement, err := p.converter.AnnouncementToAPIAnnouncement(ctx, announcement, nil)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement to api announcement: %v", err)
+		return
+	}
+
+	p.stream.Announcement(ctx, apiAnnouncement)
+}
+
+// StreamDelete streams the removal of the announcement
+// with the given ID out to all open user streams.
+func (p *Processor) StreamDelete(ctx context.Context, announcementID string) {
+	p.stream.AnnouncementDelete(ctx, announcementID)
+}
+
+// ScheduleAll schedules publication of all announcements
+// currently scheduled in the database, used on startup
+// so that scheduled announcements survive restarts.
+func (p *Processor) ScheduleAll(ctx context.Context) error {
+	announcements, err := p.state.DB.GetScheduledAnnouncements(gtscontext.SetBarebones(ctx))
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting scheduled announcements from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, announcement := range announcements {
+		if err := p.Schedule(ctx, announcement); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// Schedule adds the given announcement to the scheduler, to be
+// published at its scheduled time. Announcements whose time has
+// already passed (eg., while the instance was down) are published
+// immediately. Any existing schedule for the announcement is replaced.
+func (p *Processor) Schedule(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	p.Unschedule(announcement)
+
+	at := announcement.ScheduledAt
+	if now := time.Now(); at.Before(now) {
+		at = now
+	}
+
+	if !p.state.Workers.Scheduler.AddOnce(
+		announcement.ID,
+		at,
+		p.onScheduled(announcement.ID),
+	) {
+		// Failed to add the announcement to the scheduler, either it
+		// was starting / stopping or there already exists a task for it.
+		return gtserror.Newf("failed adding announcement %s to scheduler", announcement.ID)
+	}
+
+	atStr := at.Local().Format("Jan _2 2006 15:04:05")
+	log.Infof(ctx, "scheduled announcement %s for publication at '%s'", announcement.ID, atStr)
+	return nil
+}
+
+// Unschedule cancels any scheduled
+// publication of the given announcement.
+func (p *Processor) Unschedule(announcement *gtsmodel.Announcement) {
+	p.state.Workers.Scheduler.Cancel(announcement.ID)
+}
+
+// onScheduled returns a callback function to be used by the
+// scheduler when the given announcement is to be published.
+func (p *Processor) onScheduled(announcementID string) func(context.Context, time.Time) {
+	return func(ctx context.Context, _ time.Time) {
+		// Get the latest version of the announcement from the database.
+		announcement, err := p.state.DB.GetAnnouncementByID(ctx, announcementID)
+		if err != nil {
+			log.Errorf(ctx, "error getting announcement %s from db: %v", announcementID, err)
+			return
+		}
+
+		if announcement.IsPublished() || announcement.ScheduledAt.IsZero() {
+			// Published or unscheduled in
+			// the meantime, nothing to do.
+			return
+		}
+
+		if err := p.Publish(ctx, announcement); err != nil {
+			log.Errorf(ctx, "error publishing announcement %s: %v", announcementID, err)
+		}
+	}
+}
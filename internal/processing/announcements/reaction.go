@@ -0,0 +1,216 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"unicode"
+	"unicode/utf8"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// maxReactionNames is the maximum number of different
+	// reactions which can be added to one announcement,
+	// as in the Mastodon API.
+	maxReactionNames = 8
+
+	// maxUnicodeEmojiRunes is the maximum length of a
+	// unicode emoji reaction in runes, which leaves
+	// room for zero-width-joined emoji sequences.
+	maxUnicodeEmojiRunes = 16
+)
+
+// ReactionAdd adds a reaction with the given name, by the given
+// requester, to the announcement with the given ID. The name should
+// be either a unicode emoji, or the shortcode of a local custom emoji.
+func (p *Processor) ReactionAdd(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	announcement, errWithCode := p.getActive(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: announcement.ID,
+		AccountID:      requester.ID,
+		Name:           name,
+	}
+
+	if regexes.EmojiValidator.MatchString(name) {
+		// Looks like a shortcode, so
+		// this must be a custom emoji.
+		emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, name, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting emoji %s: %w", name, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if emoji == nil || util.PtrOrZero(emoji.Disabled) {
+			const text = "name is not a recognized emoji"
+			return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+
+		reaction.EmojiID = emoji.ID
+	} else if !isUnicodeEmoji(name) {
+		const text = "name is not a recognized emoji"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Count existing reactions per name.
+	counts := make(map[string]int, len(reactions))
+	for _, r := range reactions {
+		if r.Name == name && r.AccountID == requester.ID {
+			// Already reacted,
+			// nothing to do.
+			return nil
+		}
+		counts[r.Name]++
+	}
+
+	if _, ok := counts[name]; !ok && len(counts) >= maxReactionNames {
+		const text = "maximum number of different reactions reached"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if err := p.state.DB.PutAnnouncementReaction(ctx, reaction); err != nil {
+		err := gtserror.Newf("db error putting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.stream.AnnouncementReaction(ctx, &apimodel.AnnouncementReactionEvent{
+		Name:           name,
+		Count:          counts[name] + 1,
+		AnnouncementID: announcement.ID,
+	})
+
+	return nil
+}
+
+// ReactionRemove removes the reaction with the given name, by the
+// given requester, from the announcement with the given ID.
+func (p *Processor) ReactionRemove(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	announcementID string,
+	name string,
+) gtserror.WithCode {
+	announcement, errWithCode := p.getActive(ctx, announcementID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reactions, err := p.state.DB.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting announcement reactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	var (
+		count   int
+		reacted bool
+	)
+
+	for _, r := range reactions {
+		if r.Name != name {
+			continue
+		}
+
+		if r.AccountID == requester.ID {
+			reacted = true
+			continue
+		}
+
+		count++
+	}
+
+	if !reacted {
+		// Nothing to remove.
+		return nil
+	}
+
+	if err := p.state.DB.DeleteAnnouncementReaction(ctx,
+		announcement.ID,
+		requester.ID,
+		name,
+	); err != nil {
+		err := gtserror.Newf("db error deleting announcement reaction: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.stream.AnnouncementReaction(ctx, &apimodel.AnnouncementReactionEvent{
+		Name:           name,
+		Count:          count,
+		AnnouncementID: announcement.ID,
+	})
+
+	return nil
+}
+
+// isUnicodeEmoji returns whether the given string looks like
+// a single unicode emoji (or emoji sequence), ie., it consists
+// only of symbols, modifiers, joiners and keycap characters,
+// and contains at least one symbol or keycap.
+func isUnicodeEmoji(s string) bool {
+	if s == "" || utf8.RuneCountInString(s) > maxUnicodeEmojiRunes {
+		return false
+	}
+
+	var symbol bool
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.So, r),
+			unicode.Is(unicode.Me, r):
+			// Symbols and enclosing keycaps.
+			symbol = true
+
+		case unicode.Is(unicode.Sk, r),
+			unicode.Is(unicode.Mn, r),
+			unicode.Is(unicode.Cf, r):
+			// Skin tone modifiers, variation
+			// selectors and zero-width joiners.
+
+		case r == '#' || r == '*' || ('0' <= r && r <= '9'):
+			// Keycap bases.
+
+		default:
+			return false
+		}
+	}
+
+	return symbol
+}
@@ -30,6 +30,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -443,3 +444,34 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUser str
 
 	return data, nil
 }
+
+// FeaturedTagsCollectionGet returns a collection of the requested username's featured tags.
+// The returned collection has an `items` property which contains a list of Hashtags.
+func (p *Processor) FeaturedTagsCollectionGet(ctx context.Context, requestedUser string) (interface{}, gtserror.WithCode) {
+	// Authenticate incoming request, getting related accounts.
+	auth, errWithCode := p.authenticate(ctx, requestedUser)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	receivingAcct := auth.receivingAcct
+
+	featuredTags, err := p.state.DB.GetFeaturedTagsByAccountID(ctx, receivingAcct.ID)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	featuredTagsURI := uris.GenerateURIsForAccount(receivingAcct.Username).FeaturedTagsURI
+	collection, err := p.converter.FeaturedTagsToASCollection(ctx, featuredTagsURI, featuredTags)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	data, err := ap.Serialize(collection)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return data, nil
+}
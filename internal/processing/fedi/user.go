@@ -23,7 +23,6 @@
 	"fmt"
 	"net/url"
 
-	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -110,7 +109,7 @@ func (p *Processor) UserGet(ctx context.Context, requestedUsername string, reque
 	return data(person)
 }
 
-func data(requestedPerson vocab.ActivityStreamsPerson) (interface{}, gtserror.WithCode) {
+func data(requestedPerson ap.Accountable) (interface{}, gtserror.WithCode) {
 	data, err := ap.Serialize(requestedPerson)
 	if err != nil {
 		err := gtserror.Newf("error serializing person: %w", err)
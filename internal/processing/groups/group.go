@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// ModeratedGet returns all local groups
+// moderated by the requesting account.
+func (p *Processor) ModeratedGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.Account, gtserror.WithCode) {
+	groupIDs, err := p.state.DB.GetModeratedGroupIDs(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting moderated groups: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]*apimodel.Account, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		group, errWithCode := p.GetLocalGroup(ctx, groupID)
+		if errWithCode != nil {
+			// Group may have
+			// been suspended.
+			continue
+		}
+
+		apiGroup, err := p.converter.AccountToAPIAccountPublic(ctx, group)
+		if err != nil {
+			log.Errorf(ctx, "error converting group %s: %v", group.ID, err)
+			continue
+		}
+
+		items = append(items, apiGroup)
+	}
+
+	return items, nil
+}
+
+// GetLocalGroup gets the local group account with
+// the given ID, returning 404 if it's not a group.
+func (p *Processor) GetLocalGroup(
+	ctx context.Context,
+	groupID string,
+) (*gtsmodel.Account, gtserror.WithCode) {
+	group, err := p.state.DB.GetAccountByID(ctx, groupID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting group %s: %w", groupID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if group == nil || !group.IsLocal() ||
+		!group.IsGroup() || group.IsSuspended() {
+		err := fmt.Errorf("group %s not found", groupID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return group, nil
+}
+
+// getModeratedGroup gets the local group account with
+// the given ID, returning 404 if it's not a group, or
+// 403 if it's not moderated by the requesting account.
+func (p *Processor) getModeratedGroup(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+) (*gtsmodel.Account, gtserror.WithCode) {
+	group, errWithCode := p.GetLocalGroup(ctx, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	moderator, err := p.state.DB.IsGroupModerator(ctx, group.ID, requester.ID)
+	if err != nil {
+		err := gtserror.Newf("db error checking group moderator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !moderator {
+		const text = "you are not a moderator of this group"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	return group, nil
+}
@@ -0,0 +1,349 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// MembersGet returns a page of accounts which are
+// members of the group moderated by requester.
+func (p *Processor) MembersGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.MembersPage(ctx, group, "/api/v1/groups/"+group.ID+"/members", page)
+}
+
+// RequestsGet returns a page of accounts which have requested
+// to join the group moderated by requester, pending approval.
+func (p *Processor) RequestsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.RequestsPage(ctx, group, "/api/v1/groups/"+group.ID+"/requests", page)
+}
+
+// BansGet returns a page of accounts banned
+// from the group moderated by requester.
+func (p *Processor) BansGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.BansPage(ctx, group, "/api/v1/groups/"+group.ID+"/bans", page)
+}
+
+// RequestAuthorize accepts the given account's pending
+// request to join the group moderated by requester.
+func (p *Processor) RequestAuthorize(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.account.FollowRequestAccept(ctx, group, accountID)
+}
+
+// RequestReject rejects the given account's pending
+// request to join the group moderated by requester.
+func (p *Processor) RequestReject(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.account.FollowRequestReject(ctx, group, accountID)
+}
+
+// MemberKick removes the given account from the
+// members of the group moderated by requester.
+func (p *Processor) MemberKick(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.Kick(ctx, group, accountID)
+}
+
+// BanCreate bans the given account from
+// the group moderated by requester.
+func (p *Processor) BanCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if accountID == requester.ID {
+		const text = "you can't ban yourself from a group you moderate"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Moderators can't ban each other, nor the group's
+	// owner, who moderates the group from its creation.
+	// Only an admin can remove a moderator from a group.
+	moderator, err := p.state.DB.IsGroupModerator(ctx, group.ID, accountID)
+	if err != nil {
+		err := gtserror.Newf("db error checking group moderator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if moderator {
+		const text = "you can't ban the owner or a moderator of a group"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// A ban is a block by the group.
+	return p.account.BlockCreate(ctx, group, accountID)
+}
+
+// BanDelete lifts the ban of the given account
+// from the group moderated by requester.
+func (p *Processor) BanDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	groupID string,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	group, errWithCode := p.getModeratedGroup(ctx, requester, groupID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.account.BlockRemove(ctx, group, accountID)
+}
+
+// MembersPage returns a page of accounts which are members
+// of the given group, with paging links under path.
+func (p *Processor) MembersPage(
+	ctx context.Context,
+	group *gtsmodel.Account,
+	path string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	follows, err := p.state.DB.GetAccountFollowers(ctx, group.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting group members: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(follows)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	return p.packageGroupAccounts(ctx,
+		count,
+		func(i int) (string, *gtsmodel.Account) {
+			return follows[i].ID, follows[i].Account
+		},
+		path,
+		page,
+	), nil
+}
+
+// RequestsPage returns a page of accounts which have requested
+// to join the given group, with paging links under path.
+func (p *Processor) RequestsPage(
+	ctx context.Context,
+	group *gtsmodel.Account,
+	path string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	followReqs, err := p.state.DB.GetAccountFollowRequests(ctx, group.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting group requests: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(followReqs)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	return p.packageGroupAccounts(ctx,
+		count,
+		func(i int) (string, *gtsmodel.Account) {
+			return followReqs[i].ID, followReqs[i].Account
+		},
+		path,
+		page,
+	), nil
+}
+
+// BansPage returns a page of accounts banned from
+// the given group, with paging links under path.
+func (p *Processor) BansPage(
+	ctx context.Context,
+	group *gtsmodel.Account,
+	path string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetAccountBlocks(ctx, group.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting group bans: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(blocks)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	return p.packageGroupAccounts(ctx,
+		count,
+		func(i int) (string, *gtsmodel.Account) {
+			return blocks[i].ID, blocks[i].TargetAccount
+		},
+		path,
+		page,
+	), nil
+}
+
+// Kick removes the given account from the given
+// group's members. Unlike a ban, the account may ask
+// to join (or, if the group isn't locked, just join)
+// the group again later.
+func (p *Processor) Kick(
+	ctx context.Context,
+	group *gtsmodel.Account,
+	accountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	follow, err := p.state.DB.GetFollow(ctx, accountID, group.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting follow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if follow == nil {
+		err := fmt.Errorf("account %s is not a member of group %s", accountID, group.ID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if err := p.state.DB.DeleteFollowByID(ctx, follow.ID); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error deleting follow: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process side effects, letting the
+	// (ex-)member know they were removed.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ActivityFollow,
+		APActivityType: ap.ActivityReject,
+		GTSModel:       follow,
+		Origin:         group,
+		Target:         follow.Account,
+	})
+
+	return p.account.RelationshipGet(ctx, group, accountID)
+}
+
+// packageGroupAccounts packages a page of accounts
+// related to a group, where getIdx returns the ID
+// of the relationship model (used for paging), and
+// the related account, at the given index.
+func (p *Processor) packageGroupAccounts(
+	ctx context.Context,
+	count int,
+	getIdx func(int) (string, *gtsmodel.Account),
+	path string,
+	page *paging.Page,
+) *apimodel.PageableResponse {
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo, _ := getIdx(count - 1)
+	hi, _ := getIdx(0)
+
+	items := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		_, account := getIdx(i)
+		if account == nil {
+			// Account may have
+			// since been deleted.
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", account.ID, err)
+			continue
+		}
+
+		items = append(items, apiAccount)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  path,
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	})
+}
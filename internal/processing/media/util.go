@@ -23,7 +23,10 @@
 	"strings"
 )
 
-func parseFocus(focus string) (focusx, focusy float32, err error) {
+// ParseFocus parses the given focus string, in the form
+// of two comma-separated floats between -1 and 1, into
+// its x and y components. Empty string returns zeroes.
+func ParseFocus(focus string) (focusx, focusy float32, err error) {
 	if focus == "" {
 		return
 	}
@@ -18,9 +18,14 @@
 package processing
 
 import (
+	"context"
+	"crypto/subtle"
+	"errors"
 	"net/http"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -38,3 +43,48 @@ func (p *Processor) OAuthValidateBearerToken(r *http.Request) (oauth2.TokenInfo,
 	// todo: some kind of metrics stuff here
 	return p.oauthServer.ValidationBearerToken(r)
 }
+
+// OAuthRevokeAccessToken revokes the given access token, provided
+// it was issued to the client with the given ID and secret.
+//
+// As per RFC 7009, attempting to revoke a token which does not
+// exist, or which was issued to a different client, is not an
+// error: in that case this function just returns nil without
+// doing anything, so as not to leak the existence of tokens.
+func (p *Processor) OAuthRevokeAccessToken(
+	ctx context.Context,
+	clientID string,
+	clientSecret string,
+	accessToken string,
+) gtserror.WithCode {
+	// Authenticate the client before anything else.
+	app, err := p.state.DB.GetApplicationByClientID(ctx, clientID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting app for client id %s: %w", clientID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if app == nil ||
+		subtle.ConstantTimeCompare([]byte(app.ClientSecret), []byte(clientSecret)) != 1 {
+		const help = "client authentication failed: client_id or client_secret incorrect"
+		return gtserror.NewErrorForbidden(oauth.ErrUnauthorizedClient, help)
+	}
+
+	token, err := p.state.DB.GetTokenByAccess(ctx, accessToken)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting token: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if token == nil || token.ClientID != clientID {
+		// Nothing to do.
+		return nil
+	}
+
+	if err := p.state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+		err := gtserror.Newf("db error deleting token %s: %w", token.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
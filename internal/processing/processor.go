@@ -29,26 +29,32 @@
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/advancedmigrations"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/announcements"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/fedi"
 	filtersv1 "github.com/superseriousbusiness/gotosocial/internal/processing/filters/v1"
 	filtersv2 "github.com/superseriousbusiness/gotosocial/internal/processing/filters/v2"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/groups"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/interactionrequests"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/list"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/markers"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/push"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/report"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/search"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/suggestions"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/tags"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
@@ -78,21 +84,26 @@ type Processor struct {
 	account             account.Processor
 	admin               admin.Processor
 	advancedmigrations  advancedmigrations.Processor
+	announcements       announcements.Processor
 	conversations       conversations.Processor
 	fedi                fedi.Processor
 	filtersv1           filtersv1.Processor
 	filtersv2           filtersv2.Processor
+	groups              groups.Processor
 	interactionRequests interactionrequests.Processor
 	list                list.Processor
 	markers             markers.Processor
 	media               media.Processor
 	polls               polls.Processor
+	push                push.Processor
 	report              report.Processor
 	search              search.Processor
 	status              status.Processor
 	stream              stream.Processor
+	suggestions         suggestions.Processor
 	tags                tags.Processor
 	timeline            timeline.Processor
+	trends              trends.Processor
 	user                user.Processor
 	workers             workers.Processor
 }
@@ -109,6 +120,10 @@ func (p *Processor) AdvancedMigrations() *advancedmigrations.Processor {
 	return &p.advancedmigrations
 }
 
+func (p *Processor) Announcements() *announcements.Processor {
+	return &p.announcements
+}
+
 func (p *Processor) Conversations() *conversations.Processor {
 	return &p.conversations
 }
@@ -125,6 +140,10 @@ func (p *Processor) FiltersV2() *filtersv2.Processor {
 	return &p.filtersv2
 }
 
+func (p *Processor) Groups() *groups.Processor {
+	return &p.groups
+}
+
 func (p *Processor) InteractionRequests() *interactionrequests.Processor {
 	return &p.interactionRequests
 }
@@ -145,6 +164,10 @@ func (p *Processor) Polls() *polls.Processor {
 	return &p.polls
 }
 
+func (p *Processor) Push() *push.Processor {
+	return &p.push
+}
+
 func (p *Processor) Report() *report.Processor {
 	return &p.report
 }
@@ -161,6 +184,10 @@ func (p *Processor) Stream() *stream.Processor {
 	return &p.stream
 }
 
+func (p *Processor) Suggestions() *suggestions.Processor {
+	return &p.suggestions
+}
+
 func (p *Processor) Tags() *tags.Processor {
 	return &p.tags
 }
@@ -169,6 +196,10 @@ func (p *Processor) Timeline() *timeline.Processor {
 	return &p.timeline
 }
 
+func (p *Processor) Trends() *trends.Processor {
+	return &p.trends
+}
+
 func (p *Processor) User() *user.Processor {
 	return &p.user
 }
@@ -206,11 +237,14 @@ func NewProcessor(
 	processor.account = account.New(&common, state, converter, mediaManager, federator, visFilter, parseMentionFunc)
 	processor.media = media.New(&common, state, converter, federator, mediaManager, federator.TransportController())
 	processor.stream = stream.New(state, oauthServer)
+	processor.trends = trends.New(state, converter, visFilter)
 
 	// Instantiate the rest of the sub
 	// processors + pin them to this struct.
 	processor.account = account.New(&common, state, converter, mediaManager, federator, visFilter, parseMentionFunc)
-	processor.admin = admin.New(&common, state, cleaner, federator, converter, mediaManager, federator.TransportController(), emailSender)
+	processor.announcements = announcements.New(state, converter, &processor.stream, parseMentionFunc)
+	processor.groups = groups.New(state, converter, &processor.account)
+	processor.admin = admin.New(&common, &processor.account, &processor.announcements, &processor.groups, state, cleaner, federator, converter, mediaManager, federator.TransportController(), emailSender)
 	processor.conversations = conversations.New(state, converter, visFilter)
 	processor.fedi = fedi.New(state, &common, converter, federator, visFilter)
 	processor.filtersv1 = filtersv1.New(state, converter, &processor.stream)
@@ -219,11 +253,13 @@ func NewProcessor(
 	processor.list = list.New(state, converter)
 	processor.markers = markers.New(state, converter)
 	processor.polls = polls.New(&common, state, converter)
+	processor.push = push.New(state, converter)
 	processor.report = report.New(state, converter)
 	processor.tags = tags.New(state, converter)
 	processor.timeline = timeline.New(state, converter, visFilter)
 	processor.search = search.New(state, federator, converter, visFilter)
-	processor.status = status.New(state, &common, &processor.polls, &processor.interactionRequests, federator, converter, visFilter, intFilter, parseMentionFunc)
+	processor.status = status.New(state, &common, &processor.polls, &processor.interactionRequests, federator, converter, visFilter, intFilter, translate.New(), parseMentionFunc)
+	processor.suggestions = suggestions.New(state, converter, visFilter)
 	processor.user = user.New(state, converter, oauthServer, emailSender)
 
 	// The advanced migrations processor sequences advanced migrations from all other processors.
@@ -238,11 +274,13 @@ func NewProcessor(
 		federator,
 		converter,
 		visFilter,
+		intFilter,
 		emailSender,
 		&processor.account,
 		&processor.media,
 		&processor.stream,
 		&processor.conversations,
+		&processor.trends,
 	)
 
 	return processor
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// CreateOrReplace creates a new Web Push subscription for the
+// given access token, replacing any existing subscription of
+// that token. Alerts not given in the request are disabled.
+func (p *Processor) CreateOrReplace(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	accessToken string,
+	form *apimodel.PushSubscriptionCreateRequest,
+) (*apimodel.PushSubscription, gtserror.WithCode) {
+	tokenID, errWithCode := p.getTokenID(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := validateSubscription(form.Subscription); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		TokenID:   tokenID,
+		Endpoint:  form.Subscription.Endpoint,
+		Auth:      form.Subscription.Keys.Auth,
+		P256dh:    form.Subscription.Keys.P256dh,
+		Policy:    gtsmodel.WebPushSubscriptionPolicyAll,
+	}
+
+	if errWithCode := applyData(subscription, form.Data); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.PutWebPushSubscription(ctx, subscription); err != nil {
+		err := gtserror.Newf("db error putting push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+// Delete deletes the Web Push subscription of
+// the given access token, if it has one.
+func (p *Processor) Delete(ctx context.Context, accessToken string) gtserror.WithCode {
+	tokenID, errWithCode := p.getTokenID(ctx, accessToken)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, tokenID); err != nil {
+		err := gtserror.Newf("db error deleting push subscription: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// DeliveryFailed handles a Web Push delivery that could
+// not be delivered. If the push service responded that
+// the subscription no longer exists (404 / 410), any
+// subscriptions with that endpoint are deleted.
+func (p *Processor) DeliveryFailed(ctx context.Context, dlv *delivery.Delivery, statusCode int, err error) {
+	endpoint := dlv.Request.URL.String()
+
+	if statusCode != http.StatusNotFound &&
+		statusCode != http.StatusGone {
+		log.Warnf(ctx, "web push delivery to %s failed: %v", endpoint, err)
+		return
+	}
+
+	log.Infof(ctx, "web push endpoint %s expired, deleting subscriptions", endpoint)
+	if err := p.state.DB.DeleteWebPushSubscriptionsByEndpoint(ctx, endpoint); err != nil {
+		log.Errorf(ctx, "db error deleting web push subscriptions: %v", err)
+	}
+}
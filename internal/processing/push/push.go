@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor struct {
+	state     *state.State
+	converter *typeutils.Converter
+}
+
+func New(state *state.State, converter *typeutils.Converter) Processor {
+	return Processor{
+		state:     state,
+		converter: converter,
+	}
+}
+
+// getTokenID returns the database ID of the given access token.
+func (p *Processor) getTokenID(ctx context.Context, accessToken string) (string, gtserror.WithCode) {
+	token, err := p.state.DB.GetTokenByAccess(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			const text = "access token not found"
+			return "", gtserror.NewErrorUnauthorized(errors.New(text), text)
+		}
+		err := gtserror.Newf("db error getting token: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+	return token.ID, nil
+}
+
+// getSubscription returns the Web Push
+// subscription of the given access token.
+func (p *Processor) getSubscription(
+	ctx context.Context,
+	accessToken string,
+) (*gtsmodel.WebPushSubscription, gtserror.WithCode) {
+	tokenID, errWithCode := p.getTokenID(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscription, err := p.state.DB.GetWebPushSubscriptionByTokenID(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			const text = "push subscription not found"
+			return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+		}
+		err := gtserror.Newf("db error getting push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return subscription, nil
+}
+
+// apiSubscription converts the given subscription to its API model.
+func (p *Processor) apiSubscription(
+	ctx context.Context,
+	subscription *gtsmodel.WebPushSubscription,
+) (*apimodel.PushSubscription, gtserror.WithCode) {
+	apiSubscription, err := p.converter.WebPushSubscriptionToAPIWebPushSubscription(ctx, subscription)
+	if err != nil {
+		err := gtserror.Newf("error converting push subscription to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiSubscription, nil
+}
+
+// applyData applies the given request data to
+// the notification flags and policy of a subscription.
+func applyData(
+	subscription *gtsmodel.WebPushSubscription,
+	data *apimodel.PushSubscriptionRequestData,
+) gtserror.WithCode {
+	if data == nil {
+		return nil
+	}
+
+	if policy := data.Policy; policy != nil {
+		switch p := gtsmodel.WebPushSubscriptionPolicy(*policy); p {
+		case gtsmodel.WebPushSubscriptionPolicyAll,
+			gtsmodel.WebPushSubscriptionPolicyFollowed,
+			gtsmodel.WebPushSubscriptionPolicyFollower,
+			gtsmodel.WebPushSubscriptionPolicyNone:
+			subscription.Policy = p
+		default:
+			text := "policy must be one of all, followed, follower, none"
+			return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+	}
+
+	if alerts := data.Alerts; alerts != nil {
+		var flags gtsmodel.WebPushNotificationFlags
+		flags.Set(gtsmodel.NotificationFollow, alerts.Follow)
+		flags.Set(gtsmodel.NotificationFollowRequest, alerts.FollowRequest)
+		flags.Set(gtsmodel.NotificationFave, alerts.Favourite)
+		flags.Set(gtsmodel.NotificationMention, alerts.Mention)
+		flags.Set(gtsmodel.NotificationReblog, alerts.Reblog)
+		flags.Set(gtsmodel.NotificationPoll, alerts.Poll)
+		flags.Set(gtsmodel.NotificationStatus, alerts.Status)
+		flags.Set(gtsmodel.NotificationSignup, alerts.AdminSignup)
+		flags.Set(gtsmodel.NotificationPendingFave, alerts.PendingFavourite)
+		flags.Set(gtsmodel.NotificationPendingReply, alerts.PendingReply)
+		flags.Set(gtsmodel.NotificationPendingReblog, alerts.PendingReblog)
+		subscription.NotificationFlags = flags
+	}
+
+	return nil
+}
+
+// validateSubscription checks the user agent part
+// of a new push subscription, normalizing keys
+// to unpadded base64url encoding.
+func validateSubscription(sub *apimodel.PushSubscriptionRequestSubscription) gtserror.WithCode {
+	if sub == nil || sub.Keys == nil {
+		const text = "subscription endpoint and keys must be provided"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		const text = "subscription endpoint must be an https URL"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	p256dh, err := decodeKey(sub.Keys.P256dh)
+	if err != nil || len(p256dh) != 65 || p256dh[0] != 0x04 {
+		const text = "subscription p256dh key must be an uncompressed P-256 public key"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	auth, err := decodeKey(sub.Keys.Auth)
+	if err != nil || len(auth) != 16 {
+		const text = "subscription auth secret must be 16 bytes"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	sub.Keys.P256dh = base64.RawURLEncoding.EncodeToString(p256dh)
+	sub.Keys.Auth = base64.RawURLEncoding.EncodeToString(auth)
+	return nil
+}
+
+// decodeKey decodes a base64url key, being lenient
+// about padding and standard base64 alphabet, as
+// user agents and clients vary in what they send.
+func decodeKey(key string) ([]byte, error) {
+	for _, enc := range []*base64.Encoding{
+		base64.RawURLEncoding,
+		base64.URLEncoding,
+		base64.RawStdEncoding,
+		base64.StdEncoding,
+	} {
+		if b, err := enc.DecodeString(key); err == nil {
+			return b, nil
+		}
+	}
+	return nil, errors.New("invalid base64")
+}
@@ -0,0 +1,205 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/push"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const (
+	testEndpoint = "https://push.example.org/send/abcdef"
+	testP256dh   = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	testAuth     = "BTBZMqHH6r4Tts7J_aSIgg"
+)
+
+type PushTestSuite struct {
+	suite.Suite
+	state state.State
+	push  push.Processor
+
+	testAccounts map[string]*gtsmodel.Account
+	testTokens   map[string]*gtsmodel.Token
+}
+
+func (suite *PushTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+	testrig.NewTestDB(&suite.state)
+	testrig.StandardDBSetup(suite.state.DB, nil)
+	converter := typeutils.NewConverter(&suite.state)
+	suite.push = push.New(&suite.state, converter)
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testTokens = testrig.NewTestTokens()
+}
+
+func (suite *PushTestSuite) TearDownTest() {
+	testrig.StopWorkers(&suite.state)
+	testrig.StandardDBTeardown(suite.state.DB)
+}
+
+func (suite *PushTestSuite) create(policy *string) *apimodel.PushSubscription {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		token   = suite.testTokens["local_account_1"]
+	)
+
+	subscription, errWithCode := suite.push.CreateOrReplace(ctx, account, token.Access, &apimodel.PushSubscriptionCreateRequest{
+		Subscription: &apimodel.PushSubscriptionRequestSubscription{
+			Endpoint: testEndpoint,
+			Keys: &apimodel.PushSubscriptionKeys{
+				Auth:   testAuth,
+				P256dh: testP256dh,
+			},
+		},
+		PushSubscriptionRequestDataForm: apimodel.PushSubscriptionRequestDataForm{
+			Data: &apimodel.PushSubscriptionRequestData{
+				Alerts: &apimodel.PushSubscriptionAlerts{
+					Mention: true,
+					Follow:  true,
+				},
+				Policy: policy,
+			},
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	return subscription
+}
+
+func (suite *PushTestSuite) TestCreateGetUpdateDelete() {
+	var (
+		ctx   = context.Background()
+		token = suite.testTokens["local_account_1"]
+	)
+
+	created := suite.create(util.Ptr("followed"))
+	suite.Equal(testEndpoint, created.Endpoint)
+	suite.Equal("followed", created.Policy)
+	suite.NotEmpty(created.ServerKey)
+	suite.True(created.Alerts.Mention)
+	suite.True(created.Alerts.Follow)
+	suite.False(created.Alerts.Favourite)
+
+	got, errWithCode := suite.push.Get(ctx, token.Access)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(created, got)
+
+	// Update policy only; alerts should be unchanged.
+	updated, errWithCode := suite.push.Update(ctx, token.Access, &apimodel.PushSubscriptionUpdateRequest{
+		PushSubscriptionRequestDataForm: apimodel.PushSubscriptionRequestDataForm{
+			Data: &apimodel.PushSubscriptionRequestData{
+				Policy: util.Ptr("none"),
+			},
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("none", updated.Policy)
+	suite.True(updated.Alerts.Mention)
+
+	if errWithCode := suite.push.Delete(ctx, token.Access); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	_, errWithCode = suite.push.Get(ctx, token.Access)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *PushTestSuite) TestCreateInvalid() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		token   = suite.testTokens["local_account_1"]
+	)
+
+	for _, sub := range []*apimodel.PushSubscriptionRequestSubscription{
+		nil,
+		{Endpoint: "http://push.example.org/insecure", Keys: &apimodel.PushSubscriptionKeys{Auth: testAuth, P256dh: testP256dh}},
+		{Endpoint: testEndpoint},
+		{Endpoint: testEndpoint, Keys: &apimodel.PushSubscriptionKeys{Auth: "nope", P256dh: testP256dh}},
+		{Endpoint: testEndpoint, Keys: &apimodel.PushSubscriptionKeys{Auth: testAuth, P256dh: testAuth}},
+	} {
+		_, errWithCode := suite.push.CreateOrReplace(ctx, account, token.Access, &apimodel.PushSubscriptionCreateRequest{
+			Subscription: sub,
+		})
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+
+	_, errWithCode := suite.push.CreateOrReplace(ctx, account, token.Access, &apimodel.PushSubscriptionCreateRequest{
+		Subscription: &apimodel.PushSubscriptionRequestSubscription{
+			Endpoint: testEndpoint,
+			Keys:     &apimodel.PushSubscriptionKeys{Auth: testAuth, P256dh: testP256dh},
+		},
+		PushSubscriptionRequestDataForm: apimodel.PushSubscriptionRequestDataForm{
+			Data: &apimodel.PushSubscriptionRequestData{Policy: util.Ptr("everyone")},
+		},
+	})
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *PushTestSuite) TestDeliveryFailedExpires() {
+	var (
+		ctx   = context.Background()
+		token = suite.testTokens["local_account_1"]
+	)
+
+	created := suite.create(nil)
+
+	// A generic failure should leave the subscription alone.
+	dlv := &delivery.Delivery{
+		Request: httpclient.WrapRequest(httptest.NewRequest(http.MethodPost, created.Endpoint, nil)),
+	}
+	suite.push.DeliveryFailed(ctx, dlv, http.StatusInternalServerError, nil)
+
+	if _, err := suite.state.DB.GetWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// A 410 Gone means the subscription has expired.
+	suite.push.DeliveryFailed(ctx, dlv, http.StatusGone, nil)
+
+	_, err := suite.state.DB.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestPushTestSuite(t *testing.T) {
+	suite.Run(t, new(PushTestSuite))
+}
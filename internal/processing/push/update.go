@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// Update updates the alerts and / or policy of the Web
+// Push subscription of the given access token. Fields
+// not given in the request are left unchanged.
+func (p *Processor) Update(
+	ctx context.Context,
+	accessToken string,
+	form *apimodel.PushSubscriptionUpdateRequest,
+) (*apimodel.PushSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getSubscription(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := applyData(subscription, form.Data); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.UpdateWebPushSubscription(
+		ctx,
+		subscription,
+		"notification_flags",
+		"policy",
+	); err != nil {
+		err := gtserror.Newf("db error updating push subscription: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
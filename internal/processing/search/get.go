@@ -24,6 +24,7 @@
 	"net/mail"
 	"net/url"
 	"strings"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -834,22 +835,20 @@ func (p *Processor) statusesByText(
 	fromAccountID string,
 	appendStatus func(*gtsmodel.Status),
 ) error {
-	parsed, err := p.parseQuery(ctx, query)
+	params, err := p.parseQuery(ctx, query)
 	if err != nil {
 		return err
 	}
-	query = parsed.query
-	// If the owning account for statuses was not provided as the account_id query parameter,
-	// it may still have been provided as a search operator in the query string.
-	if fromAccountID == "" {
-		fromAccountID = parsed.fromAccountID
+	// If the owning account for statuses was provided as the account_id query parameter,
+	// it takes precedence over any account provided as a search operator in the query string.
+	if fromAccountID != "" {
+		params.FromAccountID = fromAccountID
 	}
 
 	statuses, err := p.state.DB.SearchForStatuses(
 		ctx,
 		requestingAccountID,
-		query,
-		fromAccountID,
+		&params,
 		maxID,
 		minID,
 		limit,
@@ -866,33 +865,71 @@ func (p *Processor) statusesByText(
 	return nil
 }
 
-// parsedQuery represents the results of parsing the search operator terms within a query.
-type parsedQuery struct {
-	// query is the original search query text with operator terms removed.
-	query string
-	// fromAccountID is the account from a successfully resolved `from:` operator, if present.
-	fromAccountID string
-}
-
-// parseQuery parses query text and handles any search operator terms present.
-func (p *Processor) parseQuery(ctx context.Context, query string) (parsed parsedQuery, err error) {
+// parseQuery parses query text and handles any search operator terms present,
+// returning params containing the query text with operator terms removed:
+//
+//   - `from:account` only matches statuses by the given account.
+//   - `has:media` only matches statuses with media attachments.
+//   - `before:YYYY-MM-DD` only matches statuses created before the given date.
+//   - `after:YYYY-MM-DD` only matches statuses created after the given date.
+//   - `in:library` only matches statuses created, faved, boosted, or bookmarked by the requester.
+func (p *Processor) parseQuery(ctx context.Context, query string) (params db.StatusSearchParams, err error) {
 	queryPartSeparator := " "
 	queryParts := strings.Split(query, queryPartSeparator)
 	nonOperatorQueryParts := make([]string, 0, len(queryParts))
 	for _, queryPart := range queryParts {
 		if arg, hasPrefix := strings.CutPrefix(queryPart, "from:"); hasPrefix {
-			parsed.fromAccountID, err = p.parseFromOperatorArg(ctx, arg)
+			params.FromAccountID, err = p.parseFromOperatorArg(ctx, arg)
+			if err != nil {
+				return
+			}
+		} else if arg, hasPrefix := strings.CutPrefix(queryPart, "before:"); hasPrefix {
+			params.Before, err = parseDateOperatorArg("before:", arg)
+			if err != nil {
+				return
+			}
+		} else if arg, hasPrefix := strings.CutPrefix(queryPart, "after:"); hasPrefix {
+			params.After, err = parseDateOperatorArg("after:", arg)
 			if err != nil {
 				return
 			}
+			// after: is exclusive of the given day,
+			// so start from the following midnight.
+			params.After = params.After.AddDate(0, 0, 1)
+		} else if queryPart == "has:media" {
+			params.HasMedia = true
+		} else if queryPart == "in:library" {
+			params.InLibrary = true
 		} else {
 			nonOperatorQueryParts = append(nonOperatorQueryParts, queryPart)
 		}
 	}
-	parsed.query = strings.Join(nonOperatorQueryParts, queryPartSeparator)
+	params.Query = strings.TrimSpace(strings.Join(nonOperatorQueryParts, queryPartSeparator))
 	return
 }
 
+// parseDateOperatorArg attempts to parse the argument of a date operator
+// such as before: or after: as a YYYY-MM-DD date, and returns the start of
+// that day in UTC if possible.
+func parseDateOperatorArg(operator string, date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, gtserror.Newf(
+			"the '%s' search operator requires a date, but it wasn't provided",
+			operator,
+		)
+	}
+
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, gtserror.Newf(
+			"the '%s' search operator couldn't parse its argument as a YYYY-MM-DD date: %w",
+			operator, err,
+		)
+	}
+
+	return t, nil
+}
+
 // parseFromOperatorArg attempts to parse the from: operator's argument as an account name,
 // and returns the account ID if possible. Allows specifying an account name with or without a leading @.
 func (p *Processor) parseFromOperatorArg(ctx context.Context, namestring string) (string, error) {
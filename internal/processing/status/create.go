@@ -49,6 +49,23 @@ func (p *Processor) Create(
 ) (
 	*apimodel.Status,
 	gtserror.WithCode,
+) {
+	return p.create(ctx, requester, application, form, nil)
+}
+
+// create performs the logic of Create(). If scheduled is set, the
+// status is being published from that scheduled status: media attached
+// to the scheduled status will be accepted for the new status, and the
+// scheduled status is deleted once the new status has been stored.
+func (p *Processor) create(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	form *apimodel.StatusCreateRequest,
+	scheduled *gtsmodel.ScheduledStatus,
+) (
+	*apimodel.Status,
+	gtserror.WithCode,
 ) {
 	// Ensure account populated; we'll need settings.
 	if err := p.state.DB.PopulateAccount(ctx, requester); err != nil {
@@ -117,7 +134,12 @@ func (p *Processor) Create(
 		return nil, errWithCode
 	}
 
-	if errWithCode := p.processMediaIDs(ctx, form, requester.ID, status); errWithCode != nil {
+	var scheduledStatusID string
+	if scheduled != nil {
+		scheduledStatusID = scheduled.ID
+	}
+
+	if errWithCode := p.processMediaIDs(ctx, form, requester.ID, scheduledStatusID, status); errWithCode != nil {
 		return nil, errWithCode
 	}
 
@@ -152,6 +174,14 @@ func (p *Processor) Create(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if scheduled != nil {
+		// The scheduled status has now been
+		// published, so it mustn't hang around.
+		if err := p.deleteScheduledStatus(ctx, scheduled); err != nil {
+			log.Errorf(ctx, "error deleting published scheduled status %s: %v", scheduled.ID, err)
+		}
+	}
+
 	// send it back to the client API worker for async side-effects.
 	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
 		APObjectType:   ap.ObjectNote,
@@ -311,7 +341,7 @@ func (p *Processor) processThreadID(ctx context.Context, status *gtsmodel.Status
 	return nil
 }
 
-func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCreateRequest, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode {
+func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCreateRequest, thisAccountID string, scheduledStatusID string, status *gtsmodel.Status) gtserror.WithCode {
 	if form.MediaIDs == nil {
 		return nil
 	}
@@ -339,11 +369,16 @@ func (p *Processor) processMediaIDs(ctx context.Context, form *apimodel.StatusCr
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
-		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+		if attachment.StatusID != "" ||
+			(attachment.ScheduledStatusID != "" && attachment.ScheduledStatusID != scheduledStatusID) {
 			text := fmt.Sprintf("media %s already attached to status", mediaID)
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
 		}
 
+		// Media is moving from the scheduled
+		// status (if any) onto the new status.
+		attachment.ScheduledStatusID = ""
+
 		if length := len([]rune(attachment.Description)); length < minChars {
 			text := fmt.Sprintf("media %s description too short, at least %d required", mediaID, minChars)
 			return gtserror.NewErrorBadRequest(errors.New(text), text)
@@ -473,6 +508,89 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 		form.ContentType = contentType
 	}
 
+	// Gather poll options (if any) to format.
+	var pollOptions []string
+	if status.Poll != nil {
+		pollOptions = status.Poll.Options
+	}
+
+	// Format the status content, CW and poll options.
+	content, err := p.formatContent(ctx,
+		parseMention,
+		status.AccountID,
+		status.ID,
+		form.ContentType,
+		form.Status,
+		form.SpoilerText,
+		pollOptions,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Collect formatted results.
+	status.Content = content.Content
+	status.ContentWarning = content.ContentWarning
+	status.Mentions = append(status.Mentions, content.Mentions...)
+	status.Emojis = append(status.Emojis, content.Emojis...)
+	status.Tags = append(status.Tags, content.Tags...)
+
+	if status.Poll != nil {
+		// Set formatted poll options.
+		status.Poll.Options = content.PollOptions
+	}
+
+	// Gather all the database IDs from each of the gathered status mentions, tags, and emojis.
+	status.MentionIDs = util.Gather(nil, status.Mentions, func(mention *gtsmodel.Mention) string { return mention.ID })
+	status.TagIDs = util.Gather(nil, status.Tags, func(tag *gtsmodel.Tag) string { return tag.ID })
+	status.EmojiIDs = util.Gather(nil, status.Emojis, func(emoji *gtsmodel.Emoji) string { return emoji.ID })
+
+	if status.ContentWarning != "" && len(status.AttachmentIDs) > 0 {
+		// If a content-warning is set, and
+		// the status contains media, always
+		// set the status sensitive flag.
+		status.Sensitive = util.Ptr(true)
+	}
+
+	if status.Account.IsSensitized() && len(status.AttachmentIDs) > 0 {
+		// If the author has been set to have all
+		// their media marked as sensitive, and the
+		// status contains media, always set the
+		// status sensitive flag.
+		status.Sensitive = util.Ptr(true)
+	}
+
+	return nil
+}
+
+// statusContent wraps the formatted
+// results of status content, content
+// warning and any poll options.
+type statusContent struct {
+	Content        string
+	ContentWarning string
+	PollOptions    []string
+	Mentions       []*gtsmodel.Mention
+	Emojis         []*gtsmodel.Emoji
+	Tags           []*gtsmodel.Tag
+}
+
+// formatContent formats the given status text according to contentType,
+// and the given content warning and poll options as emoji-only plaintext,
+// returning the formatted results along with gathered mentions, tags, emojis.
+func (p *Processor) formatContent(
+	ctx context.Context,
+	parseMention gtsmodel.ParseMentionFunc,
+	authorID string,
+	statusID string,
+	contentType apimodel.StatusContentType,
+	content string,
+	contentWarning string,
+	pollOptions []string,
+) (
+	*statusContent,
+	error,
+) {
 	// format is the currently set text formatting
 	// function, according to the provided content-type.
 	var format text.FormatFunc
@@ -480,10 +598,10 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 	// formatInput is a shorthand function to format the given input string with the
 	// currently set 'formatFunc', passing in all required args and returning result.
 	formatInput := func(formatFunc text.FormatFunc, input string) *text.FormatResult {
-		return formatFunc(ctx, parseMention, status.AccountID, status.ID, input)
+		return formatFunc(ctx, parseMention, authorID, statusID, input)
 	}
 
-	switch form.ContentType {
+	switch contentType {
 	// None given / set,
 	// use default (plain).
 	case "":
@@ -499,53 +617,44 @@ func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.Pa
 
 	// Unknown.
 	default:
-		return fmt.Errorf("invalid status format: %q", form.ContentType)
+		return nil, fmt.Errorf("invalid status format: %q", contentType)
 	}
 
 	// Sanitize status text and format.
-	contentRes := formatInput(format, form.Status)
+	contentRes := formatInput(format, content)
 
 	// Collect formatted results.
-	status.Content = contentRes.HTML
-	status.Mentions = append(status.Mentions, contentRes.Mentions...)
-	status.Emojis = append(status.Emojis, contentRes.Emojis...)
-	status.Tags = append(status.Tags, contentRes.Tags...)
+	result := &statusContent{
+		Content:  contentRes.HTML,
+		Mentions: contentRes.Mentions,
+		Emojis:   contentRes.Emojis,
+		Tags:     contentRes.Tags,
+	}
 
 	// From here-on-out just use emoji-only
 	// plain-text formatting as the FormatFunc.
 	format = p.formatter.FromPlainEmojiOnly
 
 	// Sanitize content warning and format.
-	spoiler := text.SanitizeToPlaintext(form.SpoilerText)
+	spoiler := text.SanitizeToPlaintext(contentWarning)
 	warningRes := formatInput(format, spoiler)
 
 	// Collect formatted results.
-	status.ContentWarning = warningRes.HTML
-	status.Emojis = append(status.Emojis, warningRes.Emojis...)
+	result.ContentWarning = warningRes.HTML
+	result.Emojis = append(result.Emojis, warningRes.Emojis...)
 
-	if status.Poll != nil {
-		for i := range status.Poll.Options {
+	if len(pollOptions) > 0 {
+		result.PollOptions = make([]string, len(pollOptions))
+		for i, option := range pollOptions {
 			// Sanitize each option title name and format.
-			option := text.SanitizeToPlaintext(status.Poll.Options[i])
+			option = text.SanitizeToPlaintext(option)
 			optionRes := formatInput(format, option)
 
 			// Collect each formatted result.
-			status.Poll.Options[i] = optionRes.HTML
-			status.Emojis = append(status.Emojis, optionRes.Emojis...)
+			result.PollOptions[i] = optionRes.HTML
+			result.Emojis = append(result.Emojis, optionRes.Emojis...)
 		}
 	}
 
-	// Gather all the database IDs from each of the gathered status mentions, tags, and emojis.
-	status.MentionIDs = util.Gather(nil, status.Mentions, func(mention *gtsmodel.Mention) string { return mention.ID })
-	status.TagIDs = util.Gather(nil, status.Tags, func(tag *gtsmodel.Tag) string { return tag.ID })
-	status.EmojiIDs = util.Gather(nil, status.Emojis, func(emoji *gtsmodel.Emoji) string { return emoji.ID })
-
-	if status.ContentWarning != "" && len(status.AttachmentIDs) > 0 {
-		// If a content-warning is set, and
-		// the status contains media, always
-		// set the status sensitive flag.
-		status.Sensitive = util.Ptr(true)
-	}
-
-	return nil
+	return result, nil
 }
@@ -0,0 +1,538 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Edit performs all necessary steps to edit the given status with the form
+// parameters, storing a historical revision of the status as it was before
+// the edit, and queueing side-effects (federating an Update, streaming, etc).
+//
+// Precondition: the form's fields should have already been validated and normalized by the caller.
+func (p *Processor) Edit(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	statusID string,
+	form *apimodel.StatusEditRequest,
+) (
+	*apimodel.Status,
+	gtserror.WithCode,
+) {
+	// Lock on the status ID, to
+	// prevent concurrent edits of
+	// the same status clobbering.
+	unlock := p.state.ProcessingLocks.Lock(statusID)
+	defer unlock()
+
+	// Fetch the status to be edited, and
+	// ensure it's owned by the requester.
+	status, errWithCode := p.getEditableStatus(ctx,
+		requester,
+		statusID,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Ensure account populated; we'll need settings.
+	if err := p.state.DB.PopulateAccount(ctx, requester); err != nil {
+		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
+	}
+
+	// Process incoming attachments for
+	// the edit, including updated attributes.
+	attachments, errWithCode := p.processEditMedia(ctx,
+		requester,
+		status,
+		form,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.ContentType == "" {
+		// If content type wasn't specified, use the author's preferred content-type.
+		form.ContentType = apimodel.StatusContentType(requester.Settings.StatusContentType)
+	}
+
+	// Gather poll options (if any) to format.
+	var pollOptions []string
+	if form.Poll != nil {
+		pollOptions = form.Poll.Options
+	}
+
+	// Format the edited status content, CW and poll options.
+	content, err := p.formatContent(ctx,
+		p.parseMention,
+		requester.ID,
+		status.ID,
+		form.ContentType,
+		form.Status,
+		form.SpoilerText,
+		pollOptions,
+	)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Diff the newly formatted mentions against
+	// those already present on the status, so that
+	// we don't end up with duplicate mention models.
+	mentions, errWithCode := p.processEditMentions(ctx,
+		status,
+		content.Mentions,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Take edited language if provided,
+	// else stick with status language.
+	language := status.Language
+	if form.Language != "" {
+		language = form.Language
+	}
+
+	// If a content-warning is set, and the
+	// status contains media, always set the
+	// status sensitive flag.
+	sensitive := form.Sensitive
+	if content.ContentWarning != "" && len(attachments) > 0 {
+		sensitive = true
+	}
+
+	// If the author has been set to have all
+	// their media marked as sensitive, and the
+	// status contains media, always set the
+	// status sensitive flag.
+	if requester.IsSensitized() && len(attachments) > 0 {
+		sensitive = true
+	}
+
+	// Check whether the poll has been changed
+	// by this edit, and create new poll if so.
+	poll, pollChanged := p.processEditPoll(status, form, content)
+
+	// Gather the IDs of all
+	// newly edited status fields.
+	attachmentIDs := util.Gather(nil, attachments, func(a *gtsmodel.MediaAttachment) string { return a.ID })
+	mentionIDs := util.Gather(nil, mentions, func(m *gtsmodel.Mention) string { return m.ID })
+	tagIDs := util.Deduplicate(util.Gather(nil, content.Tags, func(t *gtsmodel.Tag) string { return t.ID }))
+	emojiIDs := util.Deduplicate(util.Gather(nil, content.Emojis, func(e *gtsmodel.Emoji) string { return e.ID }))
+
+	if !pollChanged &&
+		status.Content == content.Content &&
+		status.ContentWarning == content.ContentWarning &&
+		status.Language == language &&
+		*status.Sensitive == sensitive &&
+		slices.Equal(status.AttachmentIDs, attachmentIDs) &&
+		!attributesChanged(form.MediaAttributes) {
+		// Nothing of note changed
+		// in the edit, just return
+		// current version of status.
+		return p.c.GetAPIStatus(ctx, requester, status)
+	}
+
+	// Snapshot the status as it was before this edit,
+	// dated at the time that version was created.
+	edit := &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		Content:        status.Content,
+		ContentWarning: status.ContentWarning,
+		Text:           status.Text,
+		Language:       status.Language,
+		Sensitive:      status.Sensitive,
+		AttachmentIDs:  status.AttachmentIDs,
+		Attachments:    status.Attachments,
+		StatusID:       status.ID,
+		CreatedAt:      status.CreatedAt,
+	}
+
+	if !status.EditedAt.IsZero() {
+		// Status has been edited before,
+		// so the version being replaced
+		// was created at last edit time.
+		edit.CreatedAt = status.EditedAt
+	}
+
+	if status.Poll != nil {
+		// Store the previous poll options.
+		edit.PollOptions = status.Poll.Options
+
+		if pollChanged {
+			// Poll votes are about to be
+			// reset, so keep track of
+			// the votes the old one had.
+			edit.PollVotes = status.Poll.Votes
+		}
+	}
+
+	// Insert historical edit of status into the database.
+	if err := p.state.DB.PutStatusEdit(ctx, edit); err != nil {
+		err := gtserror.Newf("error putting edit in database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if pollChanged {
+		if status.PollID != "" {
+			// Delete the old poll (and its votes) from
+			// the database, and cancel any expiry task.
+			if err := p.state.DB.DeletePollByID(ctx, status.PollID); err != nil {
+				err := gtserror.Newf("error deleting old poll from database: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+			_ = p.state.Workers.Scheduler.Cancel(status.PollID)
+		}
+
+		if poll != nil {
+			// Insert the newly edited poll into the database.
+			if err := p.state.DB.PutPoll(ctx, poll); err != nil {
+				err := gtserror.Newf("error putting poll in database: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		// Set poll on status.
+		status.Poll = poll
+		status.PollID = ""
+		status.ActivityStreamsType = ap.ObjectNote
+		if poll != nil {
+			status.PollID = poll.ID
+			status.ActivityStreamsType = ap.ActivityQuestion
+		}
+	}
+
+	// Update the status model itself.
+	status.Content = content.Content
+	status.ContentWarning = content.ContentWarning
+	status.Text = form.Status
+	status.Language = language
+	status.Sensitive = &sensitive
+	status.AttachmentIDs = attachmentIDs
+	status.Attachments = attachments
+	status.MentionIDs = mentionIDs
+	status.Mentions = mentions
+	status.TagIDs = tagIDs
+	status.Tags = nil // repopulated below
+	status.EmojiIDs = emojiIDs
+	status.Emojis = nil // repopulated below
+	status.EditIDs = append(status.EditIDs, edit.ID)
+	status.Edits = append(status.Edits, edit)
+	status.EditedAt = time.Now()
+
+	// Update the status in the database with edited fields.
+	if err := p.state.DB.UpdateStatus(ctx, status,
+		"content",
+		"content_warning",
+		"text",
+		"language",
+		"sensitive",
+		"attachments",
+		"mentions",
+		"tags",
+		"emojis",
+		"poll_id",
+		"activity_streams_type",
+		"edits",
+		"edited_at",
+	); err != nil {
+		err := gtserror.Newf("error updating status in database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Repopulate any of the fields we
+	// unset above, now updated in db.
+	if err := p.state.DB.PopulateStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error(s) populating status, will continue: %v", err)
+	}
+
+	if pollChanged && poll != nil {
+		// Now that the status is updated, attempt to
+		// schedule an expiry handler for the new poll.
+		if err := p.polls.ScheduleExpiry(ctx, poll); err != nil {
+			log.Errorf(ctx, "error scheduling poll expiry: %v", err)
+		}
+	}
+
+	// Send it to the client API worker for async side-effects.
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       status,
+		Origin:         requester,
+	})
+
+	return p.c.GetAPIStatus(ctx, requester, status)
+}
+
+// getEditableStatus fetches the target status with
+// given ID, ensuring it can be edited by requester.
+func (p *Processor) getEditableStatus(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	statusID string,
+) (
+	*gtsmodel.Status,
+	gtserror.WithCode,
+) {
+	status, err := p.state.DB.GetStatusByID(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("error getting status from database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil || status.AccountID != requester.ID {
+		// Either the status doesn't exist, or it
+		// isn't ours; don't leak which it is.
+		const text = "target status not found"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if status.BoostOfID != "" {
+		const text = "cannot edit a boost"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return status, nil
+}
+
+// processEditMedia gathers the media attachments for an
+// edited status, checking that any newly attached media
+// can be attached, and applying any attribute updates.
+func (p *Processor) processEditMedia(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+	form *apimodel.StatusEditRequest,
+) (
+	[]*gtsmodel.MediaAttachment,
+	gtserror.WithCode,
+) {
+	// Get minimum allowed char descriptions.
+	minChars := config.GetMediaDescriptionMinChars()
+
+	attachments := make([]*gtsmodel.MediaAttachment, 0, len(form.MediaIDs))
+	for _, mediaID := range form.MediaIDs {
+		if slices.ContainsFunc(attachments, func(a *gtsmodel.MediaAttachment) bool {
+			return a.ID == mediaID
+		}) {
+			text := fmt.Sprintf("media %s attached more than once", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error fetching media from db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if attachment == nil {
+			text := fmt.Sprintf("media %s not found", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.AccountID != requester.ID {
+			text := fmt.Sprintf("media %s does not belong to account", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.StatusID != status.ID &&
+			(attachment.StatusID != "" || attachment.ScheduledStatusID != "") {
+			text := fmt.Sprintf("media %s already attached to status", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	for _, attr := range form.MediaAttributes {
+		i := slices.IndexFunc(attachments, func(a *gtsmodel.MediaAttachment) bool {
+			return a.ID == attr.ID
+		})
+		if i < 0 {
+			text := fmt.Sprintf("media_attributes given for media %s not in media_ids", attr.ID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		var (
+			attachment = attachments[i]
+			columns    []string
+		)
+
+		if attr.Description != "" {
+			attachment.Description = text.SanitizeToPlaintext(attr.Description)
+			columns = append(columns, "description")
+		}
+
+		if attr.Focus != "" {
+			focusx, focusy, err := media.ParseFocus(attr.Focus)
+			if err != nil {
+				return nil, gtserror.NewErrorBadRequest(err, err.Error())
+			}
+			attachment.FileMeta.Focus.X = focusx
+			attachment.FileMeta.Focus.Y = focusy
+			columns = append(columns, "focus_x", "focus_y")
+		}
+
+		if len(columns) == 0 {
+			// Nothing
+			// to update.
+			continue
+		}
+
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, columns...); err != nil {
+			err := gtserror.Newf("error updating media in db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	for _, attachment := range attachments {
+		if attachment.StatusID == status.ID {
+			// Already attached
+			// to this status.
+			continue
+		}
+
+		if length := len([]rune(attachment.Description)); length < minChars {
+			text := fmt.Sprintf("media %s description too short, at least %d required", attachment.ID, minChars)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	return attachments, nil
+}
+
+// processEditMentions diffs the newly formatted mentions of an
+// edited status against the mentions it already has, reusing
+// existing mention models where the target is unchanged and
+// deleting those that are no longer needed.
+func (p *Processor) processEditMentions(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	newMentions []*gtsmodel.Mention,
+) (
+	[]*gtsmodel.Mention,
+	gtserror.WithCode,
+) {
+	mentions := make([]*gtsmodel.Mention, 0, len(newMentions))
+	for _, mention := range newMentions {
+		i := slices.IndexFunc(status.Mentions, func(m *gtsmodel.Mention) bool {
+			return m.TargetAccountID == mention.TargetAccountID
+		})
+
+		if i < 0 {
+			// Newly mentioned
+			// account, keep it.
+			mentions = append(mentions, mention)
+			continue
+		}
+
+		// Account was already mentioned, delete
+		// the freshly created duplicate mention.
+		if err := p.state.DB.DeleteMentionByID(ctx, mention.ID); err != nil {
+			err := gtserror.Newf("error deleting duplicate mention: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		mentions = append(mentions, status.Mentions[i])
+	}
+
+	for _, mention := range status.Mentions {
+		if slices.Contains(mentions, mention) {
+			continue
+		}
+
+		// Mention was removed by this
+		// edit, so delete the model.
+		if err := p.state.DB.DeleteMentionByID(ctx, mention.ID); err != nil {
+			err := gtserror.Newf("error deleting removed mention: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return mentions, nil
+}
+
+// processEditPoll checks whether the edit form changes
+// the status poll, returning a newly created poll (or nil
+// if removed) and true if so, else the existing and false.
+func (p *Processor) processEditPoll(
+	status *gtsmodel.Status,
+	form *apimodel.StatusEditRequest,
+	content *statusContent,
+) (
+	*gtsmodel.Poll,
+	bool,
+) {
+	if form.Poll == nil {
+		// Poll removed, or
+		// never existed.
+		return nil, status.Poll != nil
+	}
+
+	if status.Poll != nil &&
+		slices.Equal(status.Poll.Options, content.PollOptions) &&
+		*status.Poll.Multiple == form.Poll.Multiple &&
+		*status.Poll.HideCounts == form.Poll.HideTotals {
+		// Poll unchanged,
+		// keep it as-is.
+		return status.Poll, false
+	}
+
+	// Create new poll for status from form;
+	// votes are reset and expiry starts afresh.
+	secs := time.Duration(form.Poll.ExpiresIn)
+	poll := &gtsmodel.Poll{
+		ID:         id.NewULID(),
+		Multiple:   &form.Poll.Multiple,
+		HideCounts: &form.Poll.HideTotals,
+		Options:    content.PollOptions,
+		StatusID:   status.ID,
+		Status:     status,
+		ExpiresAt:  time.Now().Add(secs * time.Second),
+	}
+
+	return poll, true
+}
+
+// attributesChanged returns whether any media
+// attributes will be changed by given edits.
+func attributesChanged(attrs []apimodel.AttachmentAttributesRequest) bool {
+	return slices.ContainsFunc(attrs, func(attr apimodel.AttachmentAttributesRequest) bool {
+		return attr.Description != "" || attr.Focus != ""
+	})
+}
@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) TestSimpleEdit() {
+	ctx := context.Background()
+
+	// Edit a status owned by the requester.
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, requester, targetStatus.ID, &apimodel.StatusEditRequest{
+		Status:      "hello everyone! (edited)",
+		SpoilerText: "introduction post",
+		Sensitive:   true,
+		ContentType: apimodel.StatusContentTypePlain,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("<p>hello everyone! (edited)</p>", apiStatus.Content)
+	suite.Equal("hello everyone! (edited)", apiStatus.Text)
+	suite.NotNil(apiStatus.EditedAt)
+
+	// Status in the database should now have an edit.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Len(dbStatus.EditIDs, 1)
+	suite.False(dbStatus.EditedAt.IsZero())
+
+	// The edit should contain the original content.
+	edit, err := suite.db.GetStatusEditByID(ctx, dbStatus.EditIDs[0])
+	suite.NoError(err)
+	suite.Equal(targetStatus.Content, edit.Content)
+	suite.Equal(targetStatus.Text, edit.Text)
+	suite.Equal(targetStatus.ID, edit.StatusID)
+	suite.True(targetStatus.CreatedAt.Equal(edit.CreatedAt))
+
+	// History should contain the original and the edit.
+	history, errWithCode := suite.status.HistoryGet(ctx, requester, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Len(history, 2)
+	suite.Equal(targetStatus.Content, history[0].Content)
+	suite.Equal("<p>hello everyone! (edited)</p>", history[1].Content)
+}
+
+func (suite *StatusEditTestSuite) TestEditUnchanged() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	form := &apimodel.StatusEditRequest{
+		Status:      "hello everyone! (edited)",
+		SpoilerText: "introduction post",
+		Sensitive:   true,
+		ContentType: apimodel.StatusContentTypePlain,
+	}
+
+	// Edit the status once.
+	_, errWithCode := suite.status.Edit(ctx, requester, targetStatus.ID, form)
+	suite.NoError(errWithCode)
+
+	// Edit the status again with the same form.
+	_, errWithCode = suite.status.Edit(ctx, requester, targetStatus.ID, form)
+	suite.NoError(errWithCode)
+
+	// Only the first edit should have been stored.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Len(dbStatus.EditIDs, 1)
+}
+
+func (suite *StatusEditTestSuite) TestEditAddPoll() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_2"]
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, requester, targetStatus.ID, &apimodel.StatusEditRequest{
+		Status:      targetStatus.Text,
+		ContentType: apimodel.StatusContentTypePlain,
+		Poll: &apimodel.PollRequest{
+			Options:   []string{"yes", "no"},
+			ExpiresIn: 60,
+		},
+	})
+	suite.NoError(errWithCode)
+	suite.NotNil(apiStatus.Poll)
+	suite.Len(apiStatus.Poll.Options, 2)
+
+	// Status should now be a question with poll.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.NotEmpty(dbStatus.PollID)
+	suite.Equal("Question", dbStatus.ActivityStreamsType)
+	suite.Len(dbStatus.EditIDs, 1)
+}
+
+func (suite *StatusEditTestSuite) TestEditNotOwned() {
+	ctx := context.Background()
+
+	// Attempt to edit a status owned by someone else.
+	requester := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, requester, targetStatus.ID, &apimodel.StatusEditRequest{
+		Status:    "pwned",
+		Sensitive: util.PtrOrZero(targetStatus.Sensitive),
+	})
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Status should be unchanged.
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Empty(dbStatus.EditIDs)
+	suite.Equal(targetStatus.Content, dbStatus.Content)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
@@ -0,0 +1,646 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// scheduledStatusMinOffset is the minimum amount
+	// of time into the future that a status can be
+	// scheduled for, as in the Mastodon API.
+	scheduledStatusMinOffset = 5 * time.Minute
+
+	// scheduledStatusMaxPerAccount is the maximum number
+	// of scheduled statuses one account can have pending.
+	scheduledStatusMaxPerAccount = 300
+)
+
+// ScheduledStatusCreate processes the given form to create a new
+// scheduled status, which will be published as a status at the
+// form's scheduled_at time. It returns the API model representation
+// of the scheduled status if it's OK.
+//
+// Precondition: the form's fields should have already been validated and normalized by the caller.
+func (p *Processor) ScheduledStatusCreate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	application *gtsmodel.Application,
+	form *apimodel.StatusCreateRequest,
+) (
+	*apimodel.ScheduledStatus,
+	gtserror.WithCode,
+) {
+	scheduledAt, errWithCode := parseScheduledAt(form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Ensure account populated; we'll need settings.
+	if err := p.state.DB.PopulateAccount(ctx, requester); err != nil {
+		log.Errorf(ctx, "error(s) populating account, will continue: %s", err)
+	}
+
+	// Check the requester hasn't scheduled too many statuses already.
+	existing, err := p.state.DB.GetScheduledStatusesForAcct(
+		gtscontext.SetBarebones(ctx),
+		requester.ID,
+		nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting scheduled statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(existing) >= scheduledStatusMaxPerAccount {
+		text := fmt.Sprintf("you cannot have more than %d scheduled statuses", scheduledStatusMaxPerAccount)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if form.InReplyToID != "" {
+		// Ensure the in-reply-to status is visible to the
+		// requester now; replyability is checked again
+		// when the status is actually published.
+		if _, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+			requester,
+			form.InReplyToID,
+			nil,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	// Fetch + check any media to attach.
+	attachments, errWithCode := p.scheduledStatusMedia(ctx, requester.ID, form.MediaIDs)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduled := &gtsmodel.ScheduledStatus{
+		ID:               id.NewULID(),
+		AccountID:        requester.ID,
+		Account:          requester,
+		ScheduledAt:      scheduledAt,
+		Text:             form.Status,
+		ContentWarning:   form.SpoilerText,
+		ContentType:      string(form.ContentType),
+		Sensitive:        &form.Sensitive,
+		LocalOnly:        util.Ptr(util.PtrOrValue(form.LocalOnly, false)),
+		Language:         form.Language,
+		InReplyToID:      form.InReplyToID,
+		MediaIDs:         form.MediaIDs,
+		MediaAttachments: attachments,
+		ApplicationID:    application.ID,
+		Application:      application,
+	}
+
+	// Set visibility now, so that any interaction
+	// policy on the form can be parsed against it.
+	switch {
+	case form.Visibility != "":
+		scheduled.Visibility = typeutils.APIVisToVis(form.Visibility)
+	case requester.Settings.Privacy != "":
+		scheduled.Visibility = requester.Settings.Privacy
+	default:
+		scheduled.Visibility = gtsmodel.VisibilityDefault
+	}
+	form.Visibility = p.converter.VisToAPIVis(ctx, scheduled.Visibility)
+
+	if form.InteractionPolicy != nil {
+		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
+			form.InteractionPolicy,
+			form.Visibility,
+		)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		scheduled.InteractionPolicy = policy
+	}
+
+	if form.Poll != nil {
+		scheduled.PollOptions = form.Poll.Options
+		scheduled.PollExpiresIn = form.Poll.ExpiresIn
+		scheduled.PollMultiple = &form.Poll.Multiple
+		scheduled.PollHideTotals = &form.Poll.HideTotals
+	}
+
+	// Insert the new scheduled status in the database.
+	if err := p.state.DB.PutScheduledStatus(ctx, scheduled); err != nil {
+		err := gtserror.Newf("db error inserting scheduled status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Mark attachments as belonging to the scheduled status,
+	// so they can't be used elsewhere in the meantime.
+	for _, attachment := range attachments {
+		attachment.ScheduledStatusID = scheduled.ID
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, "scheduled_status_id"); err != nil {
+			err := gtserror.Newf("db error updating attachment: %w", err)
+			p.discardScheduledStatus(ctx, scheduled)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if err := p.ScheduledStatusSchedule(ctx, scheduled); err != nil {
+		p.discardScheduledStatus(ctx, scheduled)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiScheduledStatus(ctx, scheduled)
+}
+
+// discardScheduledStatus deletes the given scheduled status
+// that could not be fully created, releasing any of its
+// media, so that the caller can safely try again.
+func (p *Processor) discardScheduledStatus(ctx context.Context, scheduled *gtsmodel.ScheduledStatus) {
+	if err := p.deleteScheduledStatus(ctx, scheduled); err != nil {
+		log.Errorf(ctx, "error deleting scheduled status %s: %v", scheduled.ID, err)
+	}
+}
+
+// ScheduledStatusesGetPage returns a page of scheduled statuses owned by the requester.
+func (p *Processor) ScheduledStatusesGetPage(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	scheduled, err := p.state.DB.GetScheduledStatusesForAcct(ctx, requester.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting scheduled statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(scheduled)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	var (
+		// Get the lowest and highest
+		// ID values, used for paging.
+		lo = scheduled[count-1].ID
+		hi = scheduled[0].ID
+
+		// Best-guess items length.
+		items = make([]interface{}, 0, count)
+	)
+
+	for _, s := range scheduled {
+		apiScheduled, err := p.converter.ScheduledStatusToAPIScheduledStatus(ctx, s)
+		if err != nil {
+			log.Errorf(ctx, "error converting scheduled status to api scheduled status: %v", err)
+			continue
+		}
+
+		// Append scheduled status to return items.
+		items = append(items, apiScheduled)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/scheduled_statuses",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// ScheduledStatusGet returns the scheduled status with
+// the given ID, if it is owned by the requester.
+func (p *Processor) ScheduledStatusGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	scheduledStatusID string,
+) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduled, errWithCode := p.getOwnScheduledStatus(ctx, requester, scheduledStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiScheduledStatus(ctx, scheduled)
+}
+
+// ScheduledStatusUpdate updates the publication time of the scheduled
+// status with the given ID, if it is owned by the requester. If publishing
+// the scheduled status previously failed, it will be tried again at the
+// new time.
+func (p *Processor) ScheduledStatusUpdate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	scheduledStatusID string,
+	form *apimodel.ScheduledStatusUpdateRequest,
+) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledAt, errWithCode := parseScheduledAt(form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduled, errWithCode := p.getOwnScheduledStatus(ctx, requester, scheduledStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Cancel the currently scheduled job,
+	// before rescheduling at the new time.
+	p.state.Workers.Scheduler.Cancel(scheduled.ID)
+
+	scheduled.ScheduledAt = scheduledAt
+	scheduled.Error = ""
+	if err := p.state.DB.UpdateScheduledStatus(ctx,
+		scheduled,
+		"scheduled_at",
+		"error",
+	); err != nil {
+		err := gtserror.Newf("db error updating scheduled status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.ScheduledStatusSchedule(ctx, scheduled); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiScheduledStatus(ctx, scheduled)
+}
+
+// ScheduledStatusDelete cancels and deletes the scheduled status
+// with the given ID, if it is owned by the requester. Any media
+// attached to the scheduled status is released for reuse.
+func (p *Processor) ScheduledStatusDelete(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	scheduledStatusID string,
+) gtserror.WithCode {
+	scheduled, errWithCode := p.getOwnScheduledStatus(ctx, requester, scheduledStatusID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Make sure it's not published after all.
+	p.state.Workers.Scheduler.Cancel(scheduled.ID)
+
+	if err := p.deleteScheduledStatus(ctx, scheduled); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// ScheduledStatusesScheduleAll schedules publication of
+// all scheduled statuses currently stored in the database,
+// used on startup so that scheduled statuses survive restarts.
+// Scheduled statuses that failed to publish are left alone.
+func (p *Processor) ScheduledStatusesScheduleAll(ctx context.Context) error {
+	// Fetch all scheduled statuses from the database (barebones models are enough).
+	scheduled, err := p.state.DB.GetAllScheduledStatuses(gtscontext.SetBarebones(ctx))
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting scheduled statuses from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, s := range scheduled {
+		if s.Failed() {
+			// Waiting on its owner
+			// to retry or delete it.
+			continue
+		}
+
+		// Schedule each of the statuses and catch any errors.
+		if err := p.ScheduledStatusSchedule(ctx, s); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// ScheduledStatusSchedule adds the given scheduled status to the scheduler,
+// to be published at its scheduled time. Scheduled statuses whose time has
+// already passed (eg., while the instance was down) are published immediately.
+func (p *Processor) ScheduledStatusSchedule(ctx context.Context, scheduled *gtsmodel.ScheduledStatus) error {
+	at := scheduled.ScheduledAt
+	if now := time.Now(); at.Before(now) {
+		at = now
+	}
+
+	// Add the given scheduled status to the scheduler.
+	ok := p.state.Workers.Scheduler.AddOnce(
+		scheduled.ID,
+		at,
+		p.onScheduledStatus(scheduled.ID),
+	)
+
+	if !ok {
+		// Failed to add the status to the scheduler, either it was
+		// starting / stopping or there already exists a task for it.
+		return gtserror.Newf("failed adding scheduled status %s to scheduler", scheduled.ID)
+	}
+
+	atStr := at.Local().Format("Jan _2 2006 15:04:05")
+	log.Infof(ctx, "scheduled status %s for publication at '%s'", scheduled.ID, atStr)
+	return nil
+}
+
+// onScheduledStatus returns a callback function to be used by
+// the scheduler when the given scheduled status is to be published.
+func (p *Processor) onScheduledStatus(scheduledStatusID string) func(context.Context, time.Time) {
+	return func(ctx context.Context, _ time.Time) {
+		// Get the latest version of scheduled status from database.
+		scheduled, err := p.state.DB.GetScheduledStatusByID(
+			gtscontext.SetBarebones(ctx),
+			scheduledStatusID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "error getting scheduled status %s from db: %v", scheduledStatusID, err)
+			return
+		}
+
+		// Populate as much as we can; application
+		// may have since been deleted, for example.
+		if err := p.state.DB.PopulateScheduledStatus(ctx, scheduled); err != nil {
+			log.Errorf(ctx, "error(s) populating scheduled status %s, will continue: %v", scheduledStatusID, err)
+		}
+
+		if scheduled.Account == nil {
+			// cannot continue without status account
+			// author, so there's no-one to keep it for.
+			log.Errorf(ctx, "scheduled status %s account not found", scheduledStatusID)
+			p.discardScheduledStatus(ctx, scheduled)
+			return
+		}
+
+		application := scheduled.Application
+		if application == nil {
+			// Application may since have been
+			// deleted, just carry the ID over.
+			application = &gtsmodel.Application{ID: scheduled.ApplicationID}
+		}
+
+		form, err := p.scheduledStatusToForm(ctx, scheduled)
+		if err != nil {
+			err := gtserror.Newf("error rebuilding form: %w", err)
+			p.failScheduledStatus(ctx, scheduled, err)
+			return
+		}
+
+		// On success, create() takes care
+		// of deleting the scheduled status.
+		if _, errWithCode := p.create(ctx,
+			scheduled.Account,
+			application,
+			form,
+			scheduled,
+		); errWithCode != nil {
+			p.failScheduledStatus(ctx, scheduled, errWithCode)
+			return
+		}
+	}
+}
+
+// failScheduledStatus marks the given scheduled status as failed with
+// the given publishing error, keeping it (and its media) around so that
+// its owner can see what went wrong, and reschedule or delete it. If the
+// failure was down to the instance shutting down, the scheduled status
+// is left as-is, to be scheduled again on startup.
+func (p *Processor) failScheduledStatus(ctx context.Context, scheduled *gtsmodel.ScheduledStatus, err error) {
+	if ctx.Err() != nil {
+		log.Warnf(ctx, "scheduled status %s interrupted, will retry on startup: %v", scheduled.ID, err)
+		return
+	}
+
+	log.Errorf(ctx, "error publishing scheduled status %s: %v", scheduled.ID, err)
+
+	// Check the scheduled status is still there; if the
+	// error came after the status was stored, the scheduled
+	// status will already have been deleted as published.
+	if _, err := p.state.DB.GetScheduledStatusByID(
+		gtscontext.SetBarebones(ctx),
+		scheduled.ID,
+	); err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "error getting scheduled status %s from db: %v", scheduled.ID, err)
+		}
+		return
+	}
+
+	// Prefer the safe, user-facing
+	// error text where there is one.
+	var errWithCode gtserror.WithCode
+	if errors.As(err, &errWithCode) {
+		scheduled.Error = errWithCode.Safe()
+	} else {
+		scheduled.Error = err.Error()
+	}
+
+	if err := p.state.DB.UpdateScheduledStatus(ctx, scheduled, "error"); err != nil {
+		log.Errorf(ctx, "error marking scheduled status %s as failed: %v", scheduled.ID, err)
+	}
+}
+
+// scheduledStatusToForm rebuilds a status creation
+// form from the parameters of the scheduled status.
+func (p *Processor) scheduledStatusToForm(
+	ctx context.Context,
+	scheduled *gtsmodel.ScheduledStatus,
+) (*apimodel.StatusCreateRequest, error) {
+	form := &apimodel.StatusCreateRequest{
+		Status:      scheduled.Text,
+		MediaIDs:    scheduled.MediaIDs,
+		InReplyToID: scheduled.InReplyToID,
+		Sensitive:   util.PtrOrZero(scheduled.Sensitive),
+		SpoilerText: scheduled.ContentWarning,
+		LocalOnly:   util.Ptr(util.PtrOrZero(scheduled.LocalOnly)),
+		Language:    scheduled.Language,
+		ContentType: apimodel.StatusContentType(scheduled.ContentType),
+	}
+
+	if scheduled.Visibility != "" {
+		form.Visibility = p.converter.VisToAPIVis(ctx, scheduled.Visibility)
+	}
+
+	if scheduled.HasPoll() {
+		form.Poll = &apimodel.PollRequest{
+			Options:    scheduled.PollOptions,
+			ExpiresIn:  scheduled.PollExpiresIn,
+			Multiple:   util.PtrOrZero(scheduled.PollMultiple),
+			HideTotals: util.PtrOrZero(scheduled.PollHideTotals),
+		}
+	}
+
+	if scheduled.InteractionPolicy != nil {
+		policy, err := p.converter.InteractionPolicyToAPIInteractionPolicy(ctx,
+			scheduled.InteractionPolicy,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error converting interaction policy: %w", err)
+		}
+		form.InteractionPolicy = policy
+	}
+
+	return form, nil
+}
+
+// scheduledStatusMedia fetches the media attachments with given
+// IDs, checking they're suitable for attaching to a scheduled status.
+func (p *Processor) scheduledStatusMedia(
+	ctx context.Context,
+	requesterID string,
+	mediaIDs []string,
+) ([]*gtsmodel.MediaAttachment, gtserror.WithCode) {
+	// Get minimum allowed char descriptions.
+	minChars := config.GetMediaDescriptionMinChars()
+
+	attachments := make([]*gtsmodel.MediaAttachment, 0, len(mediaIDs))
+	for _, mediaID := range mediaIDs {
+		attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error fetching media from db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if attachment == nil {
+			text := fmt.Sprintf("media %s not found", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.AccountID != requesterID {
+			text := fmt.Sprintf("media %s does not belong to account", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+			text := fmt.Sprintf("media %s already attached to status", mediaID)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if length := len([]rune(attachment.Description)); length < minChars {
+			text := fmt.Sprintf("media %s description too short, at least %d required", mediaID, minChars)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+// getOwnScheduledStatus fetches the scheduled status with given ID,
+// returning 404 Not Found if it isn't owned by the requester.
+func (p *Processor) getOwnScheduledStatus(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	scheduledStatusID string,
+) (*gtsmodel.ScheduledStatus, gtserror.WithCode) {
+	scheduled, err := p.state.DB.GetScheduledStatusByID(ctx, scheduledStatusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting scheduled status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if scheduled == nil {
+		err := gtserror.New("scheduled status not found")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if scheduled.AccountID != requester.ID {
+		err := gtserror.Newf(
+			"scheduled status %s does not belong to account %s",
+			scheduled.ID, requester.ID,
+		)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return scheduled, nil
+}
+
+// deleteScheduledStatus deletes the given scheduled status from the
+// database, first unsetting the scheduled status ID on any of its media
+// that hasn't already been attached to a published status.
+func (p *Processor) deleteScheduledStatus(ctx context.Context, scheduled *gtsmodel.ScheduledStatus) error {
+	// Fetch attachments fresh, as they may have
+	// since been moved onto a published status.
+	attachments, err := p.state.DB.GetAttachmentsByIDs(ctx, scheduled.MediaIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting attachments: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		if attachment.ScheduledStatusID != scheduled.ID {
+			// Already moved on.
+			continue
+		}
+
+		attachment.ScheduledStatusID = ""
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, "scheduled_status_id"); err != nil {
+			return gtserror.Newf("db error updating attachment: %w", err)
+		}
+	}
+
+	if err := p.state.DB.DeleteScheduledStatusByID(ctx, scheduled.ID); err != nil {
+		return gtserror.Newf("db error deleting scheduled status: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Processor) apiScheduledStatus(
+	ctx context.Context,
+	scheduled *gtsmodel.ScheduledStatus,
+) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	apiScheduled, err := p.converter.ScheduledStatusToAPIScheduledStatus(ctx, scheduled)
+	if err != nil {
+		err := gtserror.Newf("error converting scheduled status to api scheduled status: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiScheduled, nil
+}
+
+// parseScheduledAt parses the given scheduled_at
+// string, checking it's far enough into the future.
+func parseScheduledAt(scheduledAtStr string) (time.Time, gtserror.WithCode) {
+	scheduledAt, err := time.Parse(time.RFC3339, scheduledAtStr)
+	if err != nil {
+		text := fmt.Sprintf("scheduled_at %s could not be parsed as an ISO 8601 datetime", scheduledAtStr)
+		return time.Time{}, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if time.Until(scheduledAt) < scheduledStatusMinOffset {
+		const text = "scheduled_at must be at least 5 minutes in the future"
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return scheduledAt, nil
+}
@@ -0,0 +1,269 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusScheduledTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusScheduledTestSuite) TearDownTest() {
+	suite.StatusStandardTestSuite.TearDownTest()
+
+	// The scheduler's run routine finishes stopping
+	// asynchronously; give it a moment so that it doesn't
+	// race with the scheduler being started for the next test.
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *StatusScheduledTestSuite) scheduledAt(in time.Duration) string {
+	return time.Now().Add(in).UTC().Format(time.RFC3339)
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledStatusCreate() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	apiScheduled, errWithCode := suite.status.ScheduledStatusCreate(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:      "this is a status from the future",
+		MediaIDs:    []string{attachment.ID},
+		Visibility:  apimodel.VisibilityPublic,
+		ScheduledAt: suite.scheduledAt(time.Hour),
+		Poll: &apimodel.PollRequest{
+			Options:   []string{"yes", "no"},
+			ExpiresIn: 600,
+		},
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("this is a status from the future", apiScheduled.Params.Text)
+	suite.Equal(util.Ptr(apimodel.VisibilityPublic), apiScheduled.Params.Visibility)
+	suite.Equal([]string{"yes", "no"}, apiScheduled.Params.Poll.Options)
+	suite.Len(apiScheduled.MediaAttachments, 1)
+
+	// Scheduled status should be stored in the database.
+	dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+	suite.NoError(err)
+	suite.Equal(requester.ID, dbScheduled.AccountID)
+	suite.Equal(application.ID, dbScheduled.ApplicationID)
+
+	// Attachment should now belong to the scheduled status.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Equal(apiScheduled.ID, dbAttachment.ScheduledStatusID)
+
+	// And it shouldn't be usable in another status.
+	_, errWithCode = suite.status.Create(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:   "stealing media",
+		MediaIDs: []string{attachment.ID},
+	})
+	suite.EqualError(errWithCode, "media 01F8MH8RMYQ6MSNY3JM2XT1CQ5 already attached to status")
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledStatusCreateTooSoon() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+
+	_, errWithCode := suite.status.ScheduledStatusCreate(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:      "this is a status from the very near future",
+		ScheduledAt: suite.scheduledAt(time.Minute),
+	})
+	suite.EqualError(errWithCode, "scheduled_at must be at least 5 minutes in the future")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledStatusUpdateDelete() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	apiScheduled, errWithCode := suite.status.ScheduledStatusCreate(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:      "this is a status from the future",
+		MediaIDs:    []string{attachment.ID},
+		ScheduledAt: suite.scheduledAt(time.Hour),
+	})
+	suite.NoError(errWithCode)
+
+	// Move the scheduled time back a bit.
+	newScheduledAt := suite.scheduledAt(2 * time.Hour)
+	apiScheduled, errWithCode = suite.status.ScheduledStatusUpdate(ctx, requester, apiScheduled.ID, &apimodel.ScheduledStatusUpdateRequest{
+		ScheduledAt: newScheduledAt,
+	})
+	suite.NoError(errWithCode)
+
+	dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+	suite.NoError(err)
+	suite.Equal(newScheduledAt, dbScheduled.ScheduledAt.UTC().Format(time.RFC3339))
+
+	// Someone else can't see or delete it.
+	otherAccount := suite.testAccounts["local_account_2"]
+	_, errWithCode = suite.status.ScheduledStatusGet(ctx, otherAccount, apiScheduled.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+	errWithCode = suite.status.ScheduledStatusDelete(ctx, otherAccount, apiScheduled.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// Owner can delete it.
+	errWithCode = suite.status.ScheduledStatusDelete(ctx, requester, apiScheduled.ID)
+	suite.NoError(errWithCode)
+
+	_, err = suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Attachment should be usable again.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledStatusPublish() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	apiScheduled, errWithCode := suite.status.ScheduledStatusCreate(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:      "this is a status from the future",
+		MediaIDs:    []string{attachment.ID},
+		ScheduledAt: suite.scheduledAt(time.Hour),
+	})
+	suite.NoError(errWithCode)
+
+	// Pretend the scheduled time was missed
+	// (eg., instance was down), and reschedule.
+	suite.state.Workers.Scheduler.Cancel(apiScheduled.ID)
+	dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+	suite.NoError(err)
+	dbScheduled.ScheduledAt = time.Now().Add(-time.Minute)
+	if err := suite.db.UpdateScheduledStatus(ctx, dbScheduled, "scheduled_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.status.ScheduledStatusesScheduleAll(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Scheduled status should be published right
+	// away, and removed from the database.
+	if !testrig.WaitFor(func() bool {
+		_, err := suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+		return errors.Is(err, db.ErrNoEntries)
+	}) {
+		suite.FailNow("timed out waiting for scheduled status to be published")
+	}
+
+	// Attachment should now belong to a real status.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+	suite.NotEmpty(dbAttachment.StatusID)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, dbAttachment.StatusID)
+	suite.NoError(err)
+	suite.Equal("this is a status from the future", dbStatus.Text)
+	suite.Equal(application.ID, dbStatus.CreatedWithApplicationID)
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledStatusPublishFailed() {
+	ctx := context.Background()
+
+	requester := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	apiScheduled, errWithCode := suite.status.ScheduledStatusCreate(ctx, requester, application, &apimodel.StatusCreateRequest{
+		Status:      "replying from the future",
+		MediaIDs:    []string{attachment.ID},
+		InReplyToID: suite.testStatuses["local_account_2_status_1"].ID,
+		ScheduledAt: suite.scheduledAt(time.Hour),
+	})
+	suite.NoError(errWithCode)
+
+	// Pretend the replied-to status has since been
+	// deleted, and the scheduled time has arrived.
+	suite.state.Workers.Scheduler.Cancel(apiScheduled.ID)
+	dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+	suite.NoError(err)
+	dbScheduled.InReplyToID = "01J9CY3ZCJ5VEYQ4W8ZC6M3NQ5"
+	dbScheduled.ScheduledAt = time.Now().Add(-time.Minute)
+	if err := suite.db.UpdateScheduledStatus(ctx, dbScheduled, "in_reply_to_id", "scheduled_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.status.ScheduledStatusesScheduleAll(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Publishing should fail, but the scheduled
+	// status should be kept and marked as failed.
+	if !testrig.WaitFor(func() bool {
+		dbScheduled, err := suite.db.GetScheduledStatusByID(ctx, apiScheduled.ID)
+		return err == nil && dbScheduled.Failed()
+	}) {
+		suite.FailNow("timed out waiting for scheduled status to fail")
+	}
+
+	apiScheduled, errWithCode = suite.status.ScheduledStatusGet(ctx, requester, apiScheduled.ID)
+	suite.NoError(errWithCode)
+	suite.NotNil(apiScheduled.Error)
+	suite.Equal("Not Found: target status not found", *apiScheduled.Error)
+
+	// Its media should still be reserved for it.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Equal(apiScheduled.ID, dbAttachment.ScheduledStatusID)
+	suite.Empty(dbAttachment.StatusID)
+
+	// Failed scheduled statuses aren't retried on startup.
+	suite.state.Workers.Scheduler.Cancel(apiScheduled.ID)
+	if err := suite.status.ScheduledStatusesScheduleAll(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(suite.state.Workers.Scheduler.Cancel(apiScheduled.ID))
+
+	// Rescheduling should clear the failure.
+	apiScheduled, errWithCode = suite.status.ScheduledStatusUpdate(ctx, requester, apiScheduled.ID, &apimodel.ScheduledStatusUpdateRequest{
+		ScheduledAt: suite.scheduledAt(time.Hour),
+	})
+	suite.NoError(errWithCode)
+	suite.Nil(apiScheduled.Error)
+	suite.True(suite.state.Workers.Scheduler.Cancel(apiScheduled.ID))
+}
+
+func TestStatusScheduledTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusScheduledTestSuite))
+}
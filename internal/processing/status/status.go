@@ -27,6 +27,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
@@ -40,6 +41,7 @@ type Processor struct {
 	visFilter    *visibility.Filter
 	intFilter    *interaction.Filter
 	formatter    *text.Formatter
+	translator   translate.Translator
 	parseMention gtsmodel.ParseMentionFunc
 
 	// other processors
@@ -57,6 +59,7 @@ func New(
 	converter *typeutils.Converter,
 	visFilter *visibility.Filter,
 	intFilter *interaction.Filter,
+	translator translate.Translator,
 	parseMention gtsmodel.ParseMentionFunc,
 ) Processor {
 	return Processor{
@@ -67,6 +70,7 @@ func New(
 		visFilter:    visFilter,
 		intFilter:    intFilter,
 		formatter:    text.NewFormatter(state.DB),
+		translator:   translator,
 		parseMention: parseMention,
 		polls:        polls,
 		intReqs:      intReqs,
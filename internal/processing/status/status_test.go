@@ -32,6 +32,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -46,6 +47,7 @@ type StatusStandardTestSuite struct {
 	state         state.State
 	mediaManager  *media.Manager
 	federator     *federation.Federator
+	translator    translate.Translator
 
 	// standard suite models
 	testTokens       map[string]*gtsmodel.Token
@@ -112,6 +114,7 @@ func (suite *StatusStandardTestSuite) SetupTest() {
 		suite.typeConverter,
 		visFilter,
 		intFilter,
+		suite.translator,
 		processing.GetParseMentionFunc(
 			&suite.state,
 			suite.federator,
@@ -0,0 +1,235 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/language"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+)
+
+// Translate translates the content, content warning, media descriptions
+// and poll options of the target status into the given target language,
+// taking account of privacy settings and blocks etc.
+//
+// If targetLang is empty, the requester's default posting language
+// will be used, falling back to the instance's default language.
+func (p *Processor) Translate(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetStatusID string,
+	targetLang string,
+) (*apimodel.Translation, gtserror.WithCode) {
+	if p.translator == nil {
+		const text = "translation is not enabled on this instance"
+		return nil, gtserror.NewErrorNotImplemented(errors.New(text), text)
+	}
+
+	targetStatus, errWithCode := p.c.GetVisibleTargetStatus(ctx,
+		requester,
+		targetStatusID,
+		nil, // default freshness
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Redirect to wrapped status if boost.
+	targetStatus, errWithCode = p.c.UnwrapIfBoost(
+		ctx,
+		requester,
+		targetStatus,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only translate statuses that are publicly
+	// visible anyway, to avoid sending private
+	// statuses to a (possibly) third party service.
+	if v := targetStatus.Visibility; v != gtsmodel.VisibilityPublic &&
+		v != gtsmodel.VisibilityUnlocked {
+		const text = "only public or unlisted statuses can be translated"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	// Figure out which language to translate into.
+	target, errWithCode := translateTargetLang(requester, targetLang)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Use status language as source language if
+	// set, else let the backend try to detect it.
+	source := translate.SourceAuto
+	if targetStatus.Language != "" {
+		if lang, err := language.Parse(targetStatus.Language); err == nil {
+			source = lang.BaseStr()
+		}
+	}
+
+	if source == target {
+		const text = "status is already in the target language"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Key translation based on ID + last updated time + target
+	// language, to ensure we always translate latest version.
+	cacheKey := targetStatus.ID +
+		strconv.FormatInt(targetStatus.UpdatedAt.Unix(), 10) +
+		target
+
+	// Check if we have a translation cached for this status.
+	cache := p.state.Caches.StatusesTranslations
+	if translation, ok := cache.Get(cacheKey); ok {
+		return translation, nil
+	}
+
+	// Gather translatable texts from the status,
+	// keeping track of which index is which field.
+	texts := []string{
+		targetStatus.Content,
+		targetStatus.ContentWarning,
+	}
+
+	for _, attachment := range targetStatus.Attachments {
+		texts = append(texts, attachment.Description)
+	}
+
+	if poll := targetStatus.Poll; poll != nil {
+		texts = append(texts, poll.Options...)
+	}
+
+	// Translate with configured timeout, so a slow
+	// backend doesn't keep the client waiting forever.
+	tctx, cancel := context.WithTimeout(ctx, config.GetTranslationTimeout())
+	defer cancel()
+
+	result, err := p.translator.Translate(tctx, &translate.Request{
+		Texts:  texts,
+		Source: source,
+		Target: target,
+	})
+	if err != nil {
+		err := gtserror.Newf("error translating status %s: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorServiceUnavailable(err, "translation backend unavailable")
+	}
+
+	// Take detected source language from backend if
+	// given, else fall back to status / instance language.
+	detected := detectedSourceLang(ctx, result.DetectedLanguage, source)
+
+	// Unpack translated
+	// texts into model.
+	translated := result.Texts
+	translation := &apimodel.Translation{
+		Content:                translated[0],
+		SpoilerText:            translated[1],
+		MediaAttachments:       make([]apimodel.TranslationAttachment, 0, len(targetStatus.Attachments)),
+		DetectedSourceLanguage: detected,
+		Language:               target,
+		Provider:               p.translator.Provider(),
+	}
+	translated = translated[2:]
+
+	for i, attachment := range targetStatus.Attachments {
+		translation.MediaAttachments = append(
+			translation.MediaAttachments,
+			apimodel.TranslationAttachment{
+				ID:          attachment.ID,
+				Description: translated[i],
+			},
+		)
+	}
+	translated = translated[len(targetStatus.Attachments):]
+
+	if poll := targetStatus.Poll; poll != nil {
+		translation.Poll = &apimodel.TranslationPoll{
+			ID:      poll.ID,
+			Options: make([]apimodel.TranslationPollOption, len(poll.Options)),
+		}
+
+		for i := range poll.Options {
+			translation.Poll.Options[i].Title = translated[i]
+		}
+	}
+
+	cache.Set(cacheKey, translation)
+	return translation, nil
+}
+
+// translateTargetLang returns the ISO 639 code of the language
+// to translate into: the given language if set, else requester's
+// default posting language, else the instance's default language.
+func translateTargetLang(requester *gtsmodel.Account, targetLang string) (string, gtserror.WithCode) {
+	if targetLang != "" {
+		lang, err := language.Parse(targetLang)
+		if err != nil {
+			const text = "lang must be a valid language tag"
+			return "", gtserror.NewErrorBadRequest(err, text)
+		}
+		return lang.BaseStr(), nil
+	}
+
+	if requester.Settings != nil && requester.Settings.Language != "" {
+		lang, err := language.Parse(requester.Settings.Language)
+		if err == nil {
+			return lang.BaseStr(), nil
+		}
+	}
+
+	return instanceLang(), nil
+}
+
+// detectedSourceLang returns the ISO 639 code of the detected
+// source language of a translation: the language reported by
+// the backend if given, else the source language if known,
+// else the instance's default language.
+func detectedSourceLang(ctx context.Context, backendLang string, source string) string {
+	if backendLang != "" {
+		lang, err := language.Parse(backendLang)
+		if err == nil {
+			return lang.BaseStr()
+		}
+
+		log.Warnf(ctx, "translation backend returned invalid language %s: %v", backendLang, err)
+	}
+
+	if source != translate.SourceAuto {
+		return source
+	}
+
+	return instanceLang()
+}
+
+// instanceLang returns the ISO 639 code of the
+// instance's most-preferred language, or "en".
+func instanceLang() string {
+	if langs := config.GetInstanceLanguages(); len(langs) != 0 {
+		return langs[0].BaseStr()
+	}
+	return "en"
+}
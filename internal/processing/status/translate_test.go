@@ -0,0 +1,165 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+)
+
+// fakeTranslator "translates" texts by
+// prefixing them with the target language.
+type fakeTranslator struct {
+	requests []*translate.Request
+	err      error
+}
+
+func (f *fakeTranslator) Provider() string {
+	return "Fake"
+}
+
+func (f *fakeTranslator) Translate(_ context.Context, req *translate.Request) (*translate.Result, error) {
+	f.requests = append(f.requests, req)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	texts := make([]string, len(req.Texts))
+	for i, text := range req.Texts {
+		texts[i] = req.Target + ": " + text
+	}
+
+	return &translate.Result{Texts: texts}, nil
+}
+
+type StatusTranslateTestSuite struct {
+	StatusStandardTestSuite
+	fake *fakeTranslator
+}
+
+func (suite *StatusTranslateTestSuite) SetupTest() {
+	suite.fake = new(fakeTranslator)
+	suite.translator = suite.fake
+	suite.StatusStandardTestSuite.SetupTest()
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslate() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["remote_account_1_status_2"]
+	)
+
+	translation, errWithCode := suite.status.Translate(ctx, requester, targetStatus.ID, "de-AT")
+	suite.NoError(errWithCode)
+
+	// Status language should be sent as source,
+	// and target language reduced to its base.
+	suite.Len(suite.fake.requests, 1)
+	suite.Equal("en", suite.fake.requests[0].Source)
+	suite.Equal("de", suite.fake.requests[0].Target)
+
+	suite.Equal("de: "+targetStatus.Content, translation.Content)
+	suite.Equal("de: ", translation.SpoilerText)
+	suite.Equal("en", translation.DetectedSourceLanguage)
+	suite.Equal("de", translation.Language)
+	suite.Equal("Fake", translation.Provider)
+
+	suite.Len(translation.MediaAttachments, 1)
+	suite.Equal(targetStatus.AttachmentIDs[0], translation.MediaAttachments[0].ID)
+
+	suite.NotNil(translation.Poll)
+	suite.Equal(targetStatus.PollID, translation.Poll.ID)
+	suite.Len(translation.Poll.Options, 3)
+	for _, option := range translation.Poll.Options {
+		suite.Contains(option.Title, "de: ")
+	}
+
+	// Translating again should
+	// be served from the cache.
+	translation2, errWithCode := suite.status.Translate(ctx, requester, targetStatus.ID, "de")
+	suite.NoError(errWithCode)
+	suite.Same(translation, translation2)
+	suite.Len(suite.fake.requests, 1)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateDefaultLang() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["admin_account"]
+		targetStatus = suite.testStatuses["admin_account_status_5"]
+	)
+
+	// No lang given, and status language not set, so
+	// requester's posting language should be used as
+	// target, and backend should detect the source.
+	translation, errWithCode := suite.status.Translate(ctx, requester, targetStatus.ID, "")
+	suite.NoError(errWithCode)
+	suite.Equal(translate.SourceAuto, suite.fake.requests[0].Source)
+	suite.Equal("en", suite.fake.requests[0].Target)
+
+	// Backend detected nothing, so detected
+	// language falls back to instance language.
+	suite.Equal("en", translation.DetectedSourceLanguage)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateSameLang() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["admin_account_status_1"]
+	)
+
+	_, errWithCode := suite.status.Translate(ctx, requester, targetStatus.ID, "en-GB")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Empty(suite.fake.requests)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateNotPublic() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["local_account_1_status_5"]
+	)
+
+	_, errWithCode := suite.status.Translate(ctx, requester, targetStatus.ID, "de")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.Empty(suite.fake.requests)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateBackendError() {
+	var (
+		ctx          = context.Background()
+		requester    = suite.testAccounts["local_account_1"]
+		targetStatus = suite.testStatuses["admin_account_status_1"]
+	)
+
+	suite.fake.err = errors.New("connection refused")
+
+	_, errWithCode := suite.status.Translate(ctx, requester, targetStatus.ID, "de")
+	suite.Equal(http.StatusServiceUnavailable, errWithCode.Code())
+}
+
+func TestStatusTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTranslateTestSuite))
+}
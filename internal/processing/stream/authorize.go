@@ -24,6 +24,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
 // Authorize returns an oauth2 token info in response to an access token query from the streaming API
@@ -40,6 +41,13 @@ func (p *Processor) Authorize(ctx context.Context, accessToken string) (*gtsmode
 		return nil, gtserror.NewErrorUnauthorized(err)
 	}
 
+	// Tokens passed outside the Authorization header
+	// skip route scope checks, so check scope here.
+	if !oauth.ScopeReadStreaming.PermittedBy(ti.GetScope()) {
+		err := fmt.Errorf("token does not have required scope %s", oauth.ScopeReadStreaming)
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
 	user, err := p.state.DB.GetUserByID(ctx, uid)
 	if err != nil {
 		if err == db.ErrNoEntries {
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Dismiss removes the given target account from the
+// requester's follow suggestions, so that it won't
+// be suggested to the requester again.
+func (p *Processor) Dismiss(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountID string,
+) gtserror.WithCode {
+	if _, err := p.state.DB.GetAccountByID(ctx, targetAccountID); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("account %s not found", targetAccountID)
+			return gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.PutSuggestionDismissal(ctx, &gtsmodel.SuggestionDismissal{
+		ID:              id.NewULID(),
+		AccountID:       requester.ID,
+		TargetAccountID: targetAccountID,
+	}); err != nil {
+		err := gtserror.Newf("db error putting suggestion dismissal: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// activeWindow is how recently a local account
+	// must have created a status to be suggested
+	// as recently active on this instance.
+	activeWindow = 30 * 24 * time.Hour
+
+	// candidatesLimit is the maximum number
+	// of candidate accounts to select from
+	// each friends-of-friends / active source.
+	candidatesLimit = 80
+)
+
+// Suggestion sources, in order of priority.
+const (
+	sourceFeatured         = "featured"
+	sourceFriendsOfFriends = "friends_of_friends"
+	sourceMostInteractions = "most_interactions"
+)
+
+// Get returns up to limit accounts suggested for the requester to
+// follow: first those featured by an admin, then those followed by
+// accounts the requester follows, then recently active local accounts.
+// Accounts the requester already follows, has requested to follow,
+// mutes, or has dismissed from their suggestions are never included.
+func (p *Processor) Get(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+) ([]*apimodel.Suggestion, gtserror.WithCode) {
+	var (
+		// Candidate account IDs in
+		// order of first suggestion.
+		candidateIDs []string
+
+		// Sources suggesting each candidate.
+		sources = make(map[string][]string)
+	)
+
+	add := func(source string, accountIDs ...string) {
+		for _, accountID := range accountIDs {
+			if _, ok := sources[accountID]; !ok {
+				candidateIDs = append(candidateIDs, accountID)
+			}
+			sources[accountID] = append(sources[accountID], source)
+		}
+	}
+
+	featured, err := p.state.DB.GetFeaturedAccounts(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting featured accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, f := range featured {
+		add(sourceFeatured, f.AccountID)
+	}
+
+	fofIDs, err := p.state.DB.GetFriendsOfFriendsIDs(ctx, requester.ID, candidatesLimit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting friends of friends: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	add(sourceFriendsOfFriends, fofIDs...)
+
+	activeIDs, err := p.state.DB.GetRecentlyActiveLocalAccountIDs(ctx,
+		time.Now().Add(-activeWindow),
+		candidatesLimit,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting recently active accounts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	add(sourceMostInteractions, activeIDs...)
+
+	dismissedIDs, err := p.state.DB.GetSuggestionDismissedIDs(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting dismissed suggestions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	dismissed := make(map[string]struct{}, len(dismissedIDs))
+	for _, id := range dismissedIDs {
+		dismissed[id] = struct{}{}
+	}
+
+	suggestions := make([]*apimodel.Suggestion, 0, limit)
+	for _, accountID := range candidateIDs {
+		if len(suggestions) >= limit {
+			break
+		}
+
+		if accountID == requester.ID {
+			continue
+		}
+
+		if _, ok := dismissed[accountID]; ok {
+			continue
+		}
+
+		account, err := p.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				continue
+			}
+			err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		accountSources := sources[accountID]
+		suggestable, err := p.visFilter.AccountSuggestable(ctx,
+			requester,
+			account,
+			accountSources[0] == sourceFeatured,
+		)
+		if err != nil {
+			err := gtserror.Newf("error checking account %s suggestability: %w", accountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if !suggestable {
+			continue
+		}
+
+		rel, err := p.state.DB.GetRelationship(ctx, requester.ID, accountID)
+		if err != nil {
+			err := gtserror.Newf("db error getting relationship with %s: %w", accountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if rel.Following || rel.Requested || rel.Muting {
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", accountID, err)
+			continue
+		}
+
+		suggestions = append(suggestions, &apimodel.Suggestion{
+			Source:  legacySource(accountSources[0]),
+			Sources: accountSources,
+			Account: apiAccount,
+		})
+	}
+
+	return suggestions, nil
+}
+
+// legacySource maps the given suggestion
+// source to its equivalent legacy source.
+func legacySource(source string) string {
+	switch source {
+	case sourceFeatured:
+		return "staff"
+	case sourceFriendsOfFriends:
+		return "past_interactions"
+	default:
+		return "global"
+	}
+}
@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package suggestions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/suggestions"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SuggestionsTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+
+	testAccounts map[string]*gtsmodel.Account
+
+	suggestions suggestions.Processor
+}
+
+func (suite *SuggestionsTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *SuggestionsTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.state.Storage = testrig.NewInMemoryStorage()
+
+	converter := typeutils.NewConverter(&suite.state)
+	visFilter := visibility.NewFilter(&suite.state)
+	suite.suggestions = suggestions.New(&suite.state, converter, visFilter)
+
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *SuggestionsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StopWorkers(&suite.state)
+}
+
+// feature features the given test account.
+func (suite *SuggestionsTestSuite) feature(key string) {
+	if err := suite.db.PutFeaturedAccount(context.Background(), &gtsmodel.FeaturedAccount{
+		ID:                 id.NewULID(),
+		AccountID:          suite.testAccounts[key].ID,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+// suggestedIDs returns the IDs of accounts suggested
+// to requester, along with the suggestions themselves.
+func (suite *SuggestionsTestSuite) suggestedIDs(requester *gtsmodel.Account) ([]string, []*apimodel.Suggestion) {
+	items, errWithCode := suite.suggestions.Get(context.Background(), requester, 40)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Account.ID)
+	}
+	return ids, items
+}
+
+func (suite *SuggestionsTestSuite) TestGetSuggestions() {
+	requester := suite.testAccounts["local_account_2"]
+
+	// remote_account_1 is blocked by requester,
+	// so won't be suggested despite featured.
+	suite.feature("remote_account_2")
+	suite.feature("remote_account_1")
+
+	ids, items := suite.suggestedIDs(requester)
+	suite.Equal([]string{
+		suite.testAccounts["remote_account_2"].ID,
+		suite.testAccounts["admin_account"].ID,
+	}, ids)
+
+	suite.Equal("staff", items[0].Source)
+	suite.Equal([]string{"featured"}, items[0].Sources)
+	suite.Equal("past_interactions", items[1].Source)
+	suite.Equal([]string{"friends_of_friends"}, items[1].Sources)
+}
+
+func (suite *SuggestionsTestSuite) TestGetSuggestionsNotDiscoverable() {
+	// local_account_1 (followed by admin) follows local_account_2,
+	// but local_account_2 isn't discoverable, so isn't suggested.
+	ids, _ := suite.suggestedIDs(suite.testAccounts["admin_account"])
+	suite.Empty(ids)
+
+	// Unless an admin features them.
+	suite.feature("local_account_2")
+
+	ids, _ = suite.suggestedIDs(suite.testAccounts["admin_account"])
+	suite.Equal([]string{suite.testAccounts["local_account_2"].ID}, ids)
+}
+
+func (suite *SuggestionsTestSuite) TestDismissSuggestion() {
+	ctx := context.Background()
+	requester := suite.testAccounts["local_account_2"]
+
+	if errWithCode := suite.suggestions.Dismiss(ctx, requester, suite.testAccounts["admin_account"].ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	ids, _ := suite.suggestedIDs(requester)
+	suite.Empty(ids)
+
+	// Dismissing nonexistent account is not found.
+	errWithCode := suite.suggestions.Dismiss(ctx, requester, id.NewULID())
+	suite.NotNil(errWithCode)
+	suite.Equal(404, errWithCode.Code())
+}
+
+func TestSuggestionsTestSuite(t *testing.T) {
+	suite.Run(t, new(SuggestionsTestSuite))
+}
@@ -0,0 +1,228 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	// maxFeaturedTags is the maximum number of tags an account may feature.
+	// Keep in sync with max_featured_tags served in the instance response.
+	maxFeaturedTags = 10
+
+	// maxFeaturedTagSuggestions is the maximum
+	// number of featured tag suggestions returned.
+	maxFeaturedTagSuggestions = 10
+)
+
+// FeaturedTagsGet returns the API representations of
+// all the tags featured by the given account ID, including
+// the account's usage statistics of each tag.
+func (p *Processor) FeaturedTagsGet(
+	ctx context.Context,
+	accountID string,
+) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	featuredTags, err := p.state.DB.GetFeaturedTagsByAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting featured tags for account %s: %w", accountID, err),
+		)
+	}
+
+	apiFeaturedTags := make([]*apimodel.FeaturedTag, 0, len(featuredTags))
+	for _, featuredTag := range featuredTags {
+		apiFeaturedTag, err := p.converter.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+		if err != nil {
+			log.Errorf(ctx, "error converting featured tag %s to API representation: %v", featuredTag.ID, err)
+			continue
+		}
+		apiFeaturedTags = append(apiFeaturedTags, apiFeaturedTag)
+	}
+
+	return apiFeaturedTags, nil
+}
+
+// FeaturedTagCreate features the tag with the given name on the given
+// account's profile. If there is no tag with that name, it creates a tag.
+func (p *Processor) FeaturedTagCreate(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	name string,
+) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	// Normalize the tag name in the same way
+	// as we do when extracting tags from statuses.
+	name, ok := text.NormalizeHashtag(name)
+	if !ok {
+		const text = "name was not a valid hashtag"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Try to get an existing tag with that name.
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting tag with name %s: %w", name, err),
+		)
+	}
+
+	// If there is no such tag, create it.
+	if tag == nil {
+		tag = &gtsmodel.Tag{
+			ID:   id.NewULID(),
+			Name: name,
+		}
+		if err := p.state.DB.PutTag(ctx, tag); err != nil {
+			return nil, gtserror.NewErrorInternalError(
+				gtserror.Newf("DB error creating tag with name %s: %w", name, err),
+			)
+		}
+	}
+
+	// Check tag isn't already featured by account.
+	featuredTag, err := p.state.DB.GetFeaturedTag(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+		tag.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error checking featured tag: %w", err),
+		)
+	}
+
+	if featuredTag != nil {
+		const text = "tag is already featured"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Check account isn't already at the featured tag limit.
+	featuredTags, err := p.state.DB.GetFeaturedTagsByAccountID(
+		gtscontext.SetBarebones(ctx),
+		account.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting featured tags for account %s: %w", account.ID, err),
+		)
+	}
+
+	if len(featuredTags) >= maxFeaturedTags {
+		text := fmt.Sprintf("cannot feature more than %d tags", maxFeaturedTags)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Feature the tag.
+	featuredTag = &gtsmodel.FeaturedTag{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Account:   account,
+		TagID:     tag.ID,
+		Tag:       tag,
+	}
+
+	if err := p.state.DB.PutFeaturedTag(ctx, featuredTag); err != nil {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error featuring tag %s: %w", tag.ID, err),
+		)
+	}
+
+	apiFeaturedTag, err := p.converter.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("error converting featured tag %s to API representation: %w", featuredTag.ID, err),
+		)
+	}
+
+	return apiFeaturedTag, nil
+}
+
+// FeaturedTagDelete stops featuring the featured tag
+// with the given ID on the given account's profile.
+func (p *Processor) FeaturedTagDelete(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+) gtserror.WithCode {
+	featuredTag, err := p.state.DB.GetFeaturedTagByID(
+		gtscontext.SetBarebones(ctx),
+		id,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting featured tag %s: %w", id, err),
+		)
+	}
+
+	if featuredTag == nil || featuredTag.AccountID != account.ID {
+		// Don't leak existence of other accounts' featured tags.
+		const text = "featured tag not found"
+		return gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	if err := p.state.DB.DeleteFeaturedTagByID(ctx, featuredTag.ID); err != nil {
+		return gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error deleting featured tag %s: %w", featuredTag.ID, err),
+		)
+	}
+
+	return nil
+}
+
+// FeaturedTagSuggestions returns the given account's most
+// used tags which it hasn't featured yet, most used first.
+func (p *Processor) FeaturedTagSuggestions(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) ([]*apimodel.Tag, gtserror.WithCode) {
+	tags, err := p.state.DB.GetFeaturedTagSuggestions(ctx, account.ID, maxFeaturedTagSuggestions)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(
+			gtserror.Newf("DB error getting featured tag suggestions for account %s: %w", account.ID, err),
+		)
+	}
+
+	apiTags := make([]*apimodel.Tag, 0, len(tags))
+	for _, tag := range tags {
+		following, err := p.state.DB.IsAccountFollowingTag(ctx, account.ID, tag.ID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(
+				gtserror.Newf("DB error checking whether account %s follows tag %s: %w", account.ID, tag.ID, err),
+			)
+		}
+
+		apiTag, errWithCode := p.apiTag(ctx, tag, following)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiTags = append(apiTags, apiTag)
+	}
+
+	return apiTags, nil
+}
@@ -0,0 +1,238 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/usermute"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// TagsGet returns up to limit approved tags which
+// are currently trending, skipping the first offset,
+// ordered by how many accounts recently used them.
+func (p *Processor) TagsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+	offset int,
+) ([]*apimodel.Tag, gtserror.WithCode) {
+	trends, errWithCode := p.getTrending(ctx, gtsmodel.TrendTypeTag, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	items := make([]*apimodel.Tag, 0, len(trends))
+	for _, trend := range trends {
+		tag, err := p.state.DB.GetTag(ctx, trend.TargetID)
+		if err != nil {
+			p.targetError(ctx, trend, err)
+			continue
+		}
+
+		if !*tag.Listable {
+			// Tag was unlisted
+			// since it trended.
+			continue
+		}
+
+		var following *bool
+		if requester != nil {
+			f, err := p.state.DB.IsAccountFollowingTag(ctx, requester.ID, tag.ID)
+			if err != nil {
+				err := gtserror.Newf("db error checking whether account %s follows tag %s: %w", requester.ID, tag.ID, err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+			following = &f
+		}
+
+		apiTag, err := p.converter.TagToAPITag(ctx, tag, false, following)
+		if err != nil {
+			log.Errorf(ctx, "error converting tag %s: %v", tag.ID, err)
+			continue
+		}
+
+		history, err := p.converter.TrendToAPIHistory(ctx, trend)
+		if err != nil {
+			log.Errorf(ctx, "error getting history of trend %s: %v", trend.ID, err)
+			continue
+		}
+		apiTag.History = &history
+
+		items = append(items, &apiTag)
+	}
+
+	return items, nil
+}
+
+// StatusesGet returns up to limit approved statuses which
+// are currently trending, and visible to the requester,
+// skipping the first offset, ordered by how many accounts
+// recently boosted or faved them.
+func (p *Processor) StatusesGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	limit int,
+	offset int,
+) ([]*apimodel.Status, gtserror.WithCode) {
+	trends, errWithCode := p.getTrending(ctx, gtsmodel.TrendTypeStatus, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	var filters []*gtsmodel.Filter
+	var compiledMutes *usermute.CompiledUserMuteList
+	if requester != nil {
+		var err error
+		filters, err = p.state.DB.GetFiltersForAccountID(ctx, requester.ID)
+		if err != nil {
+			err = gtserror.Newf("couldn't retrieve filters for account %s: %w", requester.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		mutes, err := p.state.DB.GetAccountMutes(gtscontext.SetBarebones(ctx), requester.ID, nil)
+		if err != nil {
+			err = gtserror.Newf("couldn't retrieve mutes for account %s: %w", requester.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		compiledMutes = usermute.NewCompiledUserMuteList(mutes)
+	}
+
+	items := make([]*apimodel.Status, 0, len(trends))
+	for _, trend := range trends {
+		status, err := p.state.DB.GetStatusByID(ctx, trend.TargetID)
+		if err != nil {
+			p.targetError(ctx, trend, err)
+			continue
+		}
+
+		// Check the status is still trendable, and
+		// that it's visible to the requester, given
+		// blocks, domain limits, edits etc.
+		trendable, err := p.visFilter.StatusTrendable(ctx, requester, status)
+		if err != nil {
+			log.Errorf(ctx, "error checking status trendability: %v", err)
+			continue
+		}
+
+		if !trendable {
+			continue
+		}
+
+		apiStatus, err := p.converter.StatusToAPIStatus(ctx, status, requester, statusfilter.FilterContextPublic, filters, compiledMutes)
+		if errors.Is(err, statusfilter.ErrHideStatus) {
+			continue
+		}
+		if err != nil {
+			log.Errorf(ctx, "error converting to api status: %v", err)
+			continue
+		}
+
+		items = append(items, apiStatus)
+	}
+
+	return items, nil
+}
+
+// LinksGet returns up to limit approved links which
+// are currently trending, skipping the first offset,
+// ordered by how many accounts recently shared them.
+func (p *Processor) LinksGet(
+	ctx context.Context,
+	limit int,
+	offset int,
+) ([]*apimodel.TrendsLink, gtserror.WithCode) {
+	trends, errWithCode := p.getTrending(ctx, gtsmodel.TrendTypeLink, limit, offset)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	items := make([]*apimodel.TrendsLink, 0, len(trends))
+	for _, trend := range trends {
+		card, err := p.state.DB.GetCardByID(ctx, trend.TargetID)
+		if err != nil {
+			p.targetError(ctx, trend, err)
+			continue
+		}
+
+		apiCard, err := p.converter.CardToAPICard(ctx, card)
+		if err != nil {
+			log.Errorf(ctx, "error converting card %s: %v", card.ID, err)
+			continue
+		}
+
+		history, err := p.converter.TrendToAPIHistory(ctx, trend)
+		if err != nil {
+			log.Errorf(ctx, "error getting history of trend %s: %v", trend.ID, err)
+			continue
+		}
+
+		items = append(items, &apimodel.TrendsLink{
+			Card:    *apiCard,
+			History: history,
+		})
+	}
+
+	return items, nil
+}
+
+// getTrending fetches approved trends of the given type
+// which have been used within the trending window.
+func (p *Processor) getTrending(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	limit int,
+	offset int,
+) ([]*gtsmodel.Trend, gtserror.WithCode) {
+	trends, err := p.state.DB.GetTrends(ctx,
+		trendType,
+		gtsmodel.TrendStateApproved,
+		time.Now().Add(-gtsmodel.TrendWindow),
+		limit,
+		offset,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting %s trends: %w", trendType, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return trends, nil
+}
+
+// targetError handles an error fetching the target of
+// the given trend, removing the trend if its target
+// has since been deleted, or just logging otherwise.
+func (p *Processor) targetError(ctx context.Context, trend *gtsmodel.Trend, err error) {
+	if !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting target of trend %s: %v", trend.ID, err)
+		return
+	}
+
+	if err := p.state.DB.DeleteTrendByID(ctx, trend.ID); err != nil {
+		log.Errorf(ctx, "error deleting trend %s: %v", trend.ID, err)
+	}
+}
@@ -0,0 +1,291 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// StatusCreated records a use of each listable tag,
+// and of the link preview card, of the given newly
+// created status, provided the status is eligible
+// to contribute to trends.
+func (p *Processor) StatusCreated(ctx context.Context, status *gtsmodel.Status) error {
+	if time.Since(status.CreatedAt) > gtsmodel.TrendWindow {
+		// Old statuses being dereferenced
+		// for the first time shouldn't
+		// have any bearing on trends.
+		return nil
+	}
+
+	trendable, err := p.visFilter.StatusTrendable(ctx, nil, status)
+	if err != nil {
+		return gtserror.Newf("error checking status trendability: %w", err)
+	}
+
+	if !trendable {
+		return nil
+	}
+
+	tags := status.Tags
+	if len(tags) != len(status.TagIDs) {
+		// Tags not (fully) populated, fetch from database.
+		tags, err = p.state.DB.GetTags(ctx, status.TagIDs)
+		if err != nil {
+			return gtserror.Newf("error getting status tags: %w", err)
+		}
+	}
+
+	for _, tag := range tags {
+		if !*tag.Listable {
+			// Unlisted tags
+			// never trend.
+			continue
+		}
+
+		if err := p.recordUse(ctx,
+			gtsmodel.TrendTypeTag,
+			tag.ID,
+			status.AccountID,
+			status.ID,
+			status.CreatedAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	if status.CardID != "" {
+		if err := p.recordUse(ctx,
+			gtsmodel.TrendTypeLink,
+			status.CardID,
+			status.AccountID,
+			status.ID,
+			status.CreatedAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StatusBoosted records a use of the status
+// targeted by the given boost, provided the
+// booster and the boosted status are both
+// eligible to contribute to trends.
+func (p *Processor) StatusBoosted(ctx context.Context, boost *gtsmodel.Status) error {
+	if boost.BoostOf == nil {
+		var err error
+
+		// Boosted status isn't populated, fetch from database.
+		boost.BoostOf, err = p.state.DB.GetStatusByID(ctx, boost.BoostOfID)
+		if err != nil {
+			return gtserror.Newf("error getting boosted status: %w", err)
+		}
+	}
+
+	return p.interaction(ctx,
+		boost.Account,
+		boost.AccountID,
+		boost.BoostOf,
+		boost.ID,
+		boost.CreatedAt,
+	)
+}
+
+// StatusFaved records a use of the status
+// targeted by the given fave, provided the
+// faver and the faved status are both
+// eligible to contribute to trends.
+func (p *Processor) StatusFaved(ctx context.Context, fave *gtsmodel.StatusFave) error {
+	if fave.Status == nil {
+		var err error
+
+		// Faved status isn't populated, fetch from database.
+		fave.Status, err = p.state.DB.GetStatusByID(ctx, fave.StatusID)
+		if err != nil {
+			return gtserror.Newf("error getting faved status: %w", err)
+		}
+	}
+
+	return p.interaction(ctx,
+		fave.Account,
+		fave.AccountID,
+		fave.Status,
+		fave.ID,
+		fave.CreatedAt,
+	)
+}
+
+// interaction records a use of the given status
+// by the given account, through the interaction
+// (ie., boost or fave) with ID interactionID.
+func (p *Processor) interaction(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	accountID string,
+	status *gtsmodel.Status,
+	interactionID string,
+	at time.Time,
+) error {
+	if time.Since(status.CreatedAt) > gtsmodel.TrendWindow {
+		// Only recent statuses
+		// are eligible to trend.
+		return nil
+	}
+
+	if account == nil {
+		var err error
+
+		// Interacting account isn't populated, fetch from database.
+		account, err = p.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), accountID)
+		if err != nil {
+			return gtserror.Newf("error getting account %s: %w", accountID, err)
+		}
+	}
+
+	if account.ID == status.AccountID {
+		// Self-interactions
+		// don't count.
+		return nil
+	}
+
+	trendable, err := p.visFilter.AccountTrendable(ctx, account)
+	if err != nil {
+		return gtserror.Newf("error checking account trendability: %w", err)
+	}
+
+	if !trendable {
+		return nil
+	}
+
+	trendable, err = p.visFilter.StatusTrendable(ctx, nil, status)
+	if err != nil {
+		return gtserror.Newf("error checking status trendability: %w", err)
+	}
+
+	if !trendable {
+		return nil
+	}
+
+	return p.recordUse(ctx,
+		gtsmodel.TrendTypeStatus,
+		status.ID,
+		account.ID,
+		interactionID,
+		at,
+	)
+}
+
+// recordUse records a use of the trend with given type and target
+// by the given account, via the item with ID sourceID, creating
+// the trend (pending admin review) if it doesn't exist yet.
+func (p *Processor) recordUse(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	targetID string,
+	accountID string,
+	sourceID string,
+	at time.Time,
+) error {
+	trend, err := p.getOrCreateTrend(ctx, trendType, targetID)
+	if err != nil {
+		return err
+	}
+
+	use := &gtsmodel.TrendUse{
+		ID:        id.NewULID(),
+		CreatedAt: at,
+		TrendID:   trend.ID,
+		AccountID: accountID,
+		SourceID:  sourceID,
+	}
+
+	if err := p.state.DB.PutTrendUse(ctx, use); err != nil {
+		return gtserror.Newf("error putting %s trend use: %w", trendType, err)
+	}
+
+	return nil
+}
+
+// getOrCreateTrend fetches the trend with given type
+// and target from the database, creating it if necessary.
+func (p *Processor) getOrCreateTrend(
+	ctx context.Context,
+	trendType gtsmodel.TrendType,
+	targetID string,
+) (*gtsmodel.Trend, error) {
+	trend, err := p.state.DB.GetTrend(ctx, trendType, targetID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting %s trend %s: %w", trendType, targetID, err)
+	}
+
+	if trend != nil {
+		return trend, nil
+	}
+
+	trend = &gtsmodel.Trend{
+		ID:       id.NewULID(),
+		Type:     trendType,
+		TargetID: targetID,
+		State:    gtsmodel.TrendStatePending,
+	}
+
+	err = p.state.DB.PutTrend(ctx, trend)
+	if errors.Is(err, db.ErrAlreadyExists) {
+		// Trend was created in the meantime
+		// by another worker, just fetch that.
+		trend, err = p.state.DB.GetTrend(ctx, trendType, targetID)
+	}
+
+	if err != nil {
+		return nil, gtserror.Newf("error putting %s trend %s: %w", trendType, targetID, err)
+	}
+
+	return trend, nil
+}
+
+// Prune removes trend uses that have aged out of
+// the daily usage history, along with any trends
+// left without uses that were never reviewed.
+func (p *Processor) Prune(ctx context.Context) {
+	olderThan := time.Now().Add(-gtsmodel.TrendHistoryDays * 24 * time.Hour)
+
+	uses, err := p.state.DB.DeleteTrendUsesOlderThan(ctx, olderThan)
+	if err != nil {
+		log.Errorf(ctx, "error pruning trend uses: %v", err)
+		return
+	}
+
+	trends, err := p.state.DB.DeleteUnusedTrends(ctx, gtsmodel.TrendStatePending)
+	if err != nil {
+		log.Errorf(ctx, "error pruning unused trends: %v", err)
+		return
+	}
+
+	log.Infof(ctx, "pruned %d trend uses and %d unused trends", uses, trends)
+}
@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package trends_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/trends"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TrendsTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+
+	testAccounts map[string]*gtsmodel.Account
+	testStatuses map[string]*gtsmodel.Status
+
+	trends trends.Processor
+}
+
+func (suite *TrendsTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
+}
+
+func (suite *TrendsTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartNoopWorkers(&suite.state)
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.state.Storage = testrig.NewInMemoryStorage()
+
+	converter := typeutils.NewConverter(&suite.state)
+	visFilter := visibility.NewFilter(&suite.state)
+	suite.trends = trends.New(&suite.state, converter, visFilter)
+
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *TrendsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StopWorkers(&suite.state)
+}
+
+// recentStatus returns a copy of the given test
+// status, as though it had just been created.
+func (suite *TrendsTestSuite) recentStatus(key string) *gtsmodel.Status {
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses[key]
+	status.CreatedAt = time.Now().Add(-time.Minute)
+	return status
+}
+
+// approve approves the trend of
+// given type targeting targetID.
+func (suite *TrendsTestSuite) approve(trendType gtsmodel.TrendType, targetID string) {
+	ctx := context.Background()
+
+	trend, err := suite.db.GetTrend(ctx, trendType, targetID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	trend.State = gtsmodel.TrendStateApproved
+	if err := suite.db.UpdateTrend(ctx, trend, "state"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *TrendsTestSuite) TestTrendingTag() {
+	ctx := context.Background()
+	status := suite.recentStatus("admin_account_status_1")
+
+	if err := suite.trends.StatusCreated(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Tag trend is pending
+	// review, so not shown.
+	tags, errWithCode := suite.trends.TagsGet(ctx, nil, 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(tags)
+
+	suite.approve(gtsmodel.TrendTypeTag, status.TagIDs[0])
+
+	tags, errWithCode = suite.trends.TagsGet(ctx, suite.testAccounts["local_account_1"], 10, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if !suite.Len(tags, 1) {
+		suite.FailNow("")
+	}
+
+	tag := tags[0]
+	suite.Equal("welcome", tag.Name)
+	suite.NotNil(tag.Following)
+	if suite.NotNil(tag.History) && suite.Len(*tag.History, gtsmodel.TrendHistoryDays) {
+		today := (*tag.History)[0]
+		suite.Equal("1", today.Uses)
+		suite.Equal("1", today.Accounts)
+	}
+}
+
+func (suite *TrendsTestSuite) TestTrendingStatus() {
+	var (
+		ctx      = context.Background()
+		status   = suite.recentStatus("admin_account_status_1")
+		account1 = suite.testAccounts["local_account_1"]
+		account2 = suite.testAccounts["local_account_2"]
+	)
+
+	for _, account := range []*gtsmodel.Account{account1, account2} {
+		fave := &gtsmodel.StatusFave{
+			ID:        id.NewULID(),
+			CreatedAt: time.Now(),
+			AccountID: account.ID,
+			Account:   account,
+			StatusID:  status.ID,
+			Status:    status,
+		}
+
+		if err := suite.trends.StatusFaved(ctx, fave); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// A fave by a silenced account doesn't count.
+	silenced := new(gtsmodel.Account)
+	*silenced = *suite.testAccounts["remote_account_1"]
+	silenced.SilencedAt = time.Now()
+
+	if err := suite.trends.StatusFaved(ctx, &gtsmodel.StatusFave{
+		ID:        id.NewULID(),
+		CreatedAt: time.Now(),
+		AccountID: silenced.ID,
+		Account:   silenced,
+		StatusID:  status.ID,
+		Status:    status,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	trend, err := suite.db.GetTrend(ctx, gtsmodel.TrendTypeStatus, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	uses, err := suite.db.GetTrendUses(ctx, trend.ID, time.Time{})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(uses, 2)
+
+	suite.approve(gtsmodel.TrendTypeStatus, status.ID)
+
+	statuses, errWithCode := suite.trends.StatusesGet(ctx, account1, 20, 0)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(statuses, 1) {
+		suite.Equal(status.ID, statuses[0].ID)
+	}
+}
+
+func (suite *TrendsTestSuite) TestUntrendableStatus() {
+	ctx := context.Background()
+
+	// This status is marked sensitive,
+	// so it can never be trending.
+	status := suite.recentStatus("local_account_1_status_1")
+	account := suite.testAccounts["admin_account"]
+
+	if err := suite.trends.StatusFaved(ctx, &gtsmodel.StatusFave{
+		ID:        id.NewULID(),
+		CreatedAt: time.Now(),
+		AccountID: account.ID,
+		Account:   account,
+		StatusID:  status.ID,
+		Status:    status,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetTrend(ctx, gtsmodel.TrendTypeStatus, status.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestTrendsTestSuite(t *testing.T) {
+	suite.Run(t, new(TrendsTestSuite))
+}
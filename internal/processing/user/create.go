@@ -110,6 +110,20 @@ func (p *Processor) Create(
 		}
 	}
 
+	// If an invite code was given, check it and
+	// mark it as used. Do this after checking the
+	// username + email are available, so that uses
+	// aren't wasted on sign-ups that would fail;
+	// the use is refunded if the sign-up fails.
+	var inviteID string
+	if form.InviteCode != "" {
+		invite, errWithCode := p.useInvite(ctx, form.InviteCode)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		inviteID = invite.ID
+	}
+
 	user, err := p.state.DB.NewSignup(ctx, gtsmodel.NewSignup{
 		Username:      form.Username,
 		Email:         form.Email,
@@ -120,8 +134,15 @@ func (p *Processor) Create(
 		AppID:         app.ID,
 		PreApproved:   true,
 		EmailVerified: true, // 用户注册并激活
+		InviteID:      inviteID,
 	})
 	if err != nil {
+		if inviteID != "" {
+			// Sign-up didn't go through,
+			// so give back the invite use.
+			p.refundInvite(ctx, inviteID)
+		}
+
 		err := fmt.Errorf("db error creating new signup: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -0,0 +1,260 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// inviteCodeBytes is the number of random bytes
+// used to generate an invite code. 12 bytes gives
+// a 16 character code when base64 (URL) encoded.
+const inviteCodeBytes = 12
+
+// InvitesGet returns all invites created by the given user, newest first.
+func (p *Processor) InvitesGet(
+	ctx context.Context,
+	user *gtsmodel.User,
+) ([]*apimodel.Invite, gtserror.WithCode) {
+	invites, err := p.state.DB.GetInvitesByAccountID(ctx, user.AccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invites: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInvites := make([]*apimodel.Invite, 0, len(invites))
+	for _, invite := range invites {
+		apiInvite, err := p.converter.InviteToAPIInvite(ctx, invite)
+		if err != nil {
+			log.Errorf(ctx, "error converting invite to api invite: %v", err)
+			continue
+		}
+		apiInvites = append(apiInvites, apiInvite)
+	}
+
+	return apiInvites, nil
+}
+
+// InviteCreate creates a new invite owned by the given user.
+//
+// Users who aren't admins can only create invites if
+// invites are enabled on this instance, and are subject
+// to the instance's limits on max uses and expiry.
+func (p *Processor) InviteCreate(
+	ctx context.Context,
+	user *gtsmodel.User,
+	form *apimodel.InviteCreateRequest,
+) (*apimodel.Invite, gtserror.WithCode) {
+	admin := util.PtrOrZero(user.Admin)
+	if !admin && !config.GetAccountsInvitesEnabled() {
+		const text = "invites are not enabled on this instance"
+		return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	if form.MaxUses < 0 {
+		const text = "max_uses must not be negative"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if form.ExpiresIn < 0 {
+		const text = "expires_in must not be negative"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	expiresIn := time.Duration(form.ExpiresIn) * time.Second
+
+	if !admin {
+		// Check instance limits on invites.
+		maxUses := config.GetAccountsInviteMaxUses()
+		if maxUses != 0 && (form.MaxUses == 0 || form.MaxUses > maxUses) {
+			text := fmt.Sprintf("max_uses must be between 1 and %d", maxUses)
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		maxExpiry := config.GetAccountsInviteMaxExpiry()
+		if maxExpiry != 0 && (expiresIn == 0 || expiresIn > maxExpiry) {
+			text := fmt.Sprintf("expires_in must be between 1 and %d seconds", int(maxExpiry.Seconds()))
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		err := gtserror.Newf("error generating invite code: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	invite := &gtsmodel.Invite{
+		ID:                 id.NewULID(),
+		Code:               code,
+		CreatedByAccountID: user.AccountID,
+		MaxUses:            form.MaxUses,
+	}
+
+	if expiresIn != 0 {
+		invite.ExpiresAt = time.Now().Add(expiresIn)
+	}
+
+	if err := p.state.DB.PutInvite(ctx, invite); err != nil {
+		err := gtserror.Newf("db error storing invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInvite, err := p.converter.InviteToAPIInvite(ctx, invite)
+	if err != nil {
+		err := gtserror.Newf("error converting invite to api invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInvite, nil
+}
+
+// InviteRevoke revokes the invite with the given ID, so
+// that it can no longer be used to sign up. Users can only
+// revoke their own invites, but admins can revoke any invite.
+func (p *Processor) InviteRevoke(
+	ctx context.Context,
+	user *gtsmodel.User,
+	inviteID string,
+) (*apimodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByID(ctx, inviteID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite %s: %w", inviteID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil ||
+		(invite.CreatedByAccountID != user.AccountID && !util.PtrOrZero(user.Admin)) {
+		// Don't leak whether the invite exists at all.
+		err := gtserror.Newf("invite %s not found", inviteID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if !invite.Revoked() {
+		invite.RevokedAt = time.Now()
+		if err := p.state.DB.UpdateInvite(ctx, invite, "revoked_at"); err != nil {
+			err := gtserror.Newf("db error updating invite %s: %w", inviteID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	apiInvite, err := p.converter.InviteToAPIInvite(ctx, invite)
+	if err != nil {
+		err := gtserror.Newf("error converting invite to api invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInvite, nil
+}
+
+// InviteGetUsable returns the invite with the given
+// code, if it exists and can currently be used to
+// sign up, else a 404 error.
+func (p *Processor) InviteGetUsable(
+	ctx context.Context,
+	code string,
+) (*gtsmodel.Invite, gtserror.WithCode) {
+	invite, err := p.state.DB.GetInviteByCode(ctx, code)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite == nil || !invite.Usable() {
+		const text = "invite not found, or no longer valid"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	// Make sure the invite's creator is
+	// still in good standing, as invites
+	// from suspended or disabled accounts
+	// shouldn't let anyone in.
+	creator, err := p.state.DB.GetUserByAccountID(ctx, invite.CreatedByAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting invite creator: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if creator == nil ||
+		util.PtrOrZero(creator.Disabled) ||
+		(creator.Account != nil && creator.Account.IsSuspended()) {
+		const text = "invite not found, or no longer valid"
+		return nil, gtserror.NewErrorNotFound(errors.New(text), text)
+	}
+
+	return invite, nil
+}
+
+// useInvite checks the invite with the given code,
+// and marks it as used once, returning the invite.
+func (p *Processor) useInvite(
+	ctx context.Context,
+	code string,
+) (*gtsmodel.Invite, gtserror.WithCode) {
+	invite, errWithCode := p.InviteGetUsable(ctx, code)
+	if errWithCode != nil {
+		// Not usable, so sign-up shouldn't go
+		// ahead; make the error less confusing.
+		const text = "invite code is not valid"
+		return nil, gtserror.NewErrorForbidden(errWithCode, text)
+	}
+
+	if err := p.state.DB.IncrementInviteUses(ctx, invite.ID); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Used up or revoked in the meantime.
+			const text = "invite code is not valid"
+			return nil, gtserror.NewErrorForbidden(errors.New(text), text)
+		}
+
+		err := gtserror.Newf("db error incrementing invite uses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return invite, nil
+}
+
+// refundInvite gives back an invite use taken by
+// useInvite, for when the sign-up didn't go through.
+func (p *Processor) refundInvite(ctx context.Context, inviteID string) {
+	if err := p.state.DB.DecrementInviteUses(ctx, inviteID); err != nil {
+		log.Errorf(ctx, "db error refunding invite %s use: %v", inviteID, err)
+	}
+}
+
+// newInviteCode returns a new random, URL-safe invite code.
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
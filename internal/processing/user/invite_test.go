@@ -0,0 +1,259 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InviteTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *InviteTestSuite) SetupTest() {
+	suite.UserStandardTestSuite.SetupTest()
+	config.SetAccountsInvitesEnabled(true)
+}
+
+func (suite *InviteTestSuite) TestInviteCreateLimits() {
+	var (
+		ctx   = context.Background()
+		user  = suite.testUsers["local_account_1"]
+		admin = suite.testUsers["admin_account"]
+	)
+
+	// No max uses / expiry isn't
+	// permitted for normal users.
+	_, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: max_uses must be between 1 and 10", errWithCode.Safe())
+
+	_, errWithCode = suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{MaxUses: 5})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	suite.Equal("Bad Request: expires_in must be between 1 and 604800 seconds", errWithCode.Safe())
+
+	// Within limits is fine.
+	invite, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{
+		MaxUses:   5,
+		ExpiresIn: 3600,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(5, *invite.MaxUses)
+	suite.NotNil(invite.ExpiresAt)
+	suite.True(invite.Usable)
+	suite.Equal("http://localhost:8080/invite/"+invite.Code, invite.URL)
+
+	// Admins aren't subject to limits.
+	invite, errWithCode = suite.user.InviteCreate(ctx, admin, &apimodel.InviteCreateRequest{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Nil(invite.MaxUses)
+	suite.Nil(invite.ExpiresAt)
+
+	// With invites disabled, only
+	// admins can create invites.
+	config.SetAccountsInvitesEnabled(false)
+
+	_, errWithCode = suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{
+		MaxUses:   5,
+		ExpiresIn: 3600,
+	})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	_, errWithCode = suite.user.InviteCreate(ctx, admin, &apimodel.InviteCreateRequest{})
+	suite.Nil(errWithCode)
+
+	// Each user only sees their own invites.
+	invites, errWithCode := suite.user.InvitesGet(ctx, user)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(invites, 1)
+}
+
+func (suite *InviteTestSuite) TestInviteRevoke() {
+	var (
+		ctx   = context.Background()
+		user1 = suite.testUsers["local_account_1"]
+		user2 = suite.testUsers["local_account_2"]
+		admin = suite.testUsers["admin_account"]
+	)
+
+	create := func() string {
+		invite, errWithCode := suite.user.InviteCreate(ctx, user1, &apimodel.InviteCreateRequest{
+			MaxUses:   1,
+			ExpiresIn: 3600,
+		})
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+		return invite.ID
+	}
+
+	// Other users can't revoke the invite.
+	inviteID := create()
+	_, errWithCode := suite.user.InviteRevoke(ctx, user2, inviteID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// The creator can.
+	invite, errWithCode := suite.user.InviteRevoke(ctx, user1, inviteID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotNil(invite.RevokedAt)
+	suite.False(invite.Usable)
+
+	// So can admins.
+	invite, errWithCode = suite.user.InviteRevoke(ctx, admin, create())
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(invite.Usable)
+
+	// Revoked invites can't be used.
+	_, errWithCode = suite.user.InviteGetUsable(ctx, invite.Code)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *InviteTestSuite) TestSignUpWithInvite() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	invite, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{
+		MaxUses:   1,
+		ExpiresIn: 3600,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Registration is closed,
+	// but invite lets us in.
+	config.SetAccountsRegistrationOpen(false)
+
+	form := &apimodel.AccountCreateRequest{
+		Username:   "invited_user",
+		Email:      "invited@example.org",
+		Password:   "verygoodpassword!!!1",
+		Agreement:  true,
+		Locale:     "en",
+		InviteCode: invite.Code,
+		IP:         net.ParseIP("192.0.2.1"),
+	}
+	if err := validate.CreateAccount(form); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	newUser, errWithCode := suite.user.Create(ctx, nil, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(invite.ID, newUser.InviteID)
+
+	// Invite should now be used up.
+	dbInvite, err := suite.db.GetInviteByID(ctx, invite.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, dbInvite.Uses)
+	suite.False(dbInvite.Usable())
+
+	// So next sign-up should fail.
+	form.Username = "another_invited_user"
+	form.Email = "invited2@example.org"
+	_, errWithCode = suite.user.Create(ctx, nil, form)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// And without an invite, registration is closed.
+	form.InviteCode = ""
+	suite.EqualError(validate.CreateAccount(form), "registration is not open for this server")
+}
+
+func (suite *InviteTestSuite) TestSignUpWithInviteFailed() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	invite, errWithCode := suite.user.InviteCreate(ctx, user, &apimodel.InviteCreateRequest{
+		MaxUses:   1,
+		ExpiresIn: 3600,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Put a remote account that already has
+	// the URI the new account would get, so
+	// that creating the new account fails.
+	conflict := new(gtsmodel.Account)
+	*conflict = *testrig.NewTestAccounts()["remote_account_1"]
+	conflict.ID = "01JD2J0ETR3XDBJN6XSKKDJ5GC"
+	conflict.Username = "someone_else"
+	conflict.URI = "http://localhost:8080/users/invited_user"
+	conflict.URL = ""
+	conflict.InboxURI = ""
+	conflict.OutboxURI = ""
+	conflict.FollowingURI = ""
+	conflict.FollowersURI = ""
+	conflict.FeaturedCollectionURI = ""
+	conflict.PublicKeyURI = "http://fossbros-anonymous.io/users/someone_else#main-key"
+	if err := suite.db.PutAccount(ctx, conflict); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := &apimodel.AccountCreateRequest{
+		Username:   "invited_user",
+		Email:      "invited@example.org",
+		Password:   "verygoodpassword!!!1",
+		Agreement:  true,
+		Locale:     "en",
+		InviteCode: invite.Code,
+		IP:         net.ParseIP("192.0.2.1"),
+	}
+
+	_, errWithCode = suite.user.Create(ctx, nil, form)
+	suite.Equal(http.StatusInternalServerError, errWithCode.Code())
+
+	// Invite use should have been given back.
+	dbInvite, err := suite.db.GetInviteByID(ctx, invite.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(0, dbInvite.Uses)
+	suite.True(dbInvite.Usable())
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// TokensGet returns a page of access tokens owned by the given user.
+func (p *Processor) TokensGet(
+	ctx context.Context,
+	userID string,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	tokens, err := p.state.DB.GetAccessTokens(ctx, userID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tokens: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(tokens)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := tokens[count-1].ID
+	hi := tokens[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, token := range tokens {
+		apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+		if err != nil {
+			log.Errorf(ctx, "error converting token to api token: %v", err)
+			continue
+		}
+		items = append(items, apiToken)
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/tokens",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// TokenGet returns one access token owned by the given user.
+func (p *Processor) TokenGet(
+	ctx context.Context,
+	userID string,
+	tokenID string,
+) (*apimodel.TokenInfo, gtserror.WithCode) {
+	token, errWithCode := p.getOwnToken(ctx, userID, tokenID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+	if err != nil {
+		err := gtserror.Newf("error converting token to api token: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiToken, nil
+}
+
+// TokenInvalidate deletes one access token owned by the given user,
+// returning the (now invalid) token's details on success.
+func (p *Processor) TokenInvalidate(
+	ctx context.Context,
+	userID string,
+	tokenID string,
+) (*apimodel.TokenInfo, gtserror.WithCode) {
+	token, errWithCode := p.getOwnToken(ctx, userID, tokenID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before deletion so we
+	// can still return token details.
+	apiToken, err := p.converter.TokenToAPITokenInfo(ctx, token)
+	if err != nil {
+		err := gtserror.Newf("error converting token to api token: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+		err := gtserror.Newf("db error deleting token %s: %w", token.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiToken, nil
+}
+
+// getOwnToken fetches the access token with the given ID,
+// returning 404 if it does not exist or is not owned by userID.
+func (p *Processor) getOwnToken(
+	ctx context.Context,
+	userID string,
+	tokenID string,
+) (*gtsmodel.Token, gtserror.WithCode) {
+	token, err := p.state.DB.GetTokenByID(ctx, tokenID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting token %s: %w", tokenID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if token == nil || token.UserID != userID || token.Access == "" {
+		// Don't leak whether the token exists at all.
+		err := gtserror.Newf("token %s not found", tokenID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return token, nil
+}
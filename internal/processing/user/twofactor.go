@@ -0,0 +1,535 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// twoFactorPeriod is the TOTP time step
+// length in seconds, as per RFC 6238.
+const twoFactorPeriod = 30
+
+// twoFactorRecoveryCodes is the number of single-use
+// recovery codes generated when enabling 2FA.
+const twoFactorRecoveryCodes = 10
+
+// TwoFactorEnroll generates a new TOTP secret for the given user,
+// after checking the user's password, and stores it (encrypted) on
+// the user. The secret is not in force until the user confirms
+// enrollment with TwoFactorConfirm.
+func (p *Processor) TwoFactorEnroll(
+	ctx context.Context,
+	user *gtsmodel.User,
+	password string,
+) (*apimodel.TwoFactorEnrollment, gtserror.WithCode) {
+	if errWithCode := checkTwoFactorAvailable(); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := bcrypt.CompareHashAndPassword(
+		[]byte(user.EncryptedPassword),
+		[]byte(password),
+	); err != nil {
+		err := gtserror.Newf("%w", err)
+		return nil, gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if user.TwoFactorEnabled() {
+		const help = "two-factor authentication is already enabled for this user"
+		return nil, gtserror.NewErrorConflict(errors.New(help), help)
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      config.GetHost(),
+		AccountName: user.Email,
+	})
+	if err != nil {
+		err := gtserror.Newf("error generating totp secret: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	encrypted, err := encryptTwoFactorSecret(key.Secret())
+	if err != nil {
+		err := gtserror.Newf("error encrypting totp secret: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Store the pending secret on the user,
+	// replacing any previous pending secret.
+	user.TwoFactorSecret = encrypted
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.TwoFactorEnrollment{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+	}, nil
+}
+
+// TwoFactorConfirm confirms two-factor enrollment for
+// the given user, by checking the given TOTP code against
+// the user's pending secret. On success, 2FA is enabled
+// for the user, and a set of single-use recovery codes
+// is returned, which will not be retrievable again.
+func (p *Processor) TwoFactorConfirm(
+	ctx context.Context,
+	user *gtsmodel.User,
+	code string,
+) (*apimodel.TwoFactorRecoveryCodes, gtserror.WithCode) {
+	if errWithCode := checkTwoFactorAvailable(); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if user.TwoFactorEnabled() {
+		const help = "two-factor authentication is already enabled for this user"
+		return nil, gtserror.NewErrorConflict(errors.New(help), help)
+	}
+
+	if user.TwoFactorSecret == "" {
+		const help = "two-factor authentication enrollment has not been started for this user"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(help), help)
+	}
+
+	secret, err := decryptTwoFactorSecret(user.TwoFactorSecret)
+	if err != nil {
+		err := gtserror.Newf("error decrypting totp secret: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	const help = "two-factor authentication code was incorrect"
+	ok, errWithCode := p.useTOTPCode(ctx, user, normalizeTwoFactorCode(code), secret)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !ok {
+		return nil, gtserror.NewErrorUnauthorized(errors.New(help), help)
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		err := gtserror.Newf("error generating recovery codes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user.TwoFactorRecoveryCodes = hashes
+	user.TwoFactorEnabledAt = time.Now()
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_recovery_codes",
+		"two_factor_enabled_at",
+	); err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.TwoFactorRecoveryCodes{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// TwoFactorDisable disables two-factor authentication for
+// the given user, after checking the user's password, and
+// removes the user's secret and recovery codes.
+func (p *Processor) TwoFactorDisable(
+	ctx context.Context,
+	user *gtsmodel.User,
+	password string,
+) gtserror.WithCode {
+	if err := bcrypt.CompareHashAndPassword(
+		[]byte(user.EncryptedPassword),
+		[]byte(password),
+	); err != nil {
+		err := gtserror.Newf("%w", err)
+		return gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if !user.TwoFactorEnabled() {
+		const help = "two-factor authentication is not enabled for this user"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(help), help)
+	}
+
+	if err := p.TwoFactorReset(ctx, user); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// TwoFactorReset unsets the two-factor authentication
+// secret, recovery codes, enabled time and last used
+// TOTP time step of the given user, without performing
+// any checks.
+func (p *Processor) TwoFactorReset(
+	ctx context.Context,
+	user *gtsmodel.User,
+) error {
+	user.TwoFactorSecret = ""
+	user.TwoFactorRecoveryCodes = nil
+	user.TwoFactorEnabledAt = time.Time{}
+	user.TwoFactorLastUsedStep = 0
+	if err := p.state.DB.UpdateUser(
+		ctx, user,
+		"two_factor_secret",
+		"two_factor_recovery_codes",
+		"two_factor_enabled_at",
+		"two_factor_last_used_step",
+	); err != nil {
+		return gtserror.Newf("db error updating user: %w", err)
+	}
+
+	return nil
+}
+
+// TwoFactorCheck checks the given code for a user with
+// two-factor authentication enabled, as the second step
+// of signing in. The code may be either a current TOTP
+// code, or one of the user's unused recovery codes, in
+// which case the recovery code will be used up.
+func (p *Processor) TwoFactorCheck(
+	ctx context.Context,
+	user *gtsmodel.User,
+	code string,
+) gtserror.WithCode {
+	const help = "two-factor authentication code was incorrect"
+
+	if !user.TwoFactorEnabled() {
+		err := gtserror.Newf("two-factor authentication not enabled for user %s", user.ID)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	code = normalizeTwoFactorCode(code)
+	if code == "" {
+		return gtserror.NewErrorUnauthorized(errors.New(help), help)
+	}
+
+	secret, err := decryptTwoFactorSecret(user.TwoFactorSecret)
+	if err != nil {
+		err := gtserror.Newf("error decrypting totp secret for user %s: %w", user.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if step, ok := validateTOTPCode(code, secret, time.Now()); ok {
+		if errWithCode := p.useTOTPStep(ctx, user, step); errWithCode != nil {
+			// Don't fall through to recovery
+			// codes for replayed TOTP codes.
+			return errWithCode
+		}
+
+		// TOTP code OK.
+		return nil
+	}
+
+	// Not a valid TOTP code,
+	// check recovery codes.
+	i := slices.IndexFunc(
+		user.TwoFactorRecoveryCodes,
+		func(hash string) bool {
+			return bcrypt.CompareHashAndPassword(
+				[]byte(hash),
+				[]byte(code),
+			) == nil
+		},
+	)
+	if i == -1 {
+		return gtserror.NewErrorUnauthorized(errors.New(help), help)
+	}
+
+	return p.useRecoveryCode(ctx, user, user.TwoFactorRecoveryCodes[i])
+}
+
+// useRecoveryCode removes the given recovery code hash
+// from the user, as recovery codes are single-use. The
+// removal is conditional on the user's recovery codes
+// not having changed since they were read, so that
+// concurrent sign-ins can't both use the same code.
+func (p *Processor) useRecoveryCode(
+	ctx context.Context,
+	user *gtsmodel.User,
+	hash string,
+) gtserror.WithCode {
+	const help = "two-factor authentication code was already used"
+
+	// Retry a few times if the user's codes change
+	// under us, eg., if another (different) code is
+	// being used at the same time.
+	const attempts = 3
+
+	for i := 0; ; i++ {
+		err := p.state.DB.UseUserTwoFactorRecoveryCode(ctx,
+			user.ID,
+			user.TwoFactorRecoveryCodes,
+			hash,
+		)
+		if err == nil {
+			// Code used up.
+			break
+		}
+
+		if !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error updating user: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if i == attempts-1 {
+			err := gtserror.Newf("could not use recovery code for user %s after %d attempts", user.ID, attempts)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Recovery codes changed under
+		// us; reload them to see whether
+		// this code is still available.
+		latest, err := p.state.DB.GetUserByID(ctx, user.ID)
+		if err != nil {
+			err := gtserror.Newf("db error getting user: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if !slices.Contains(latest.TwoFactorRecoveryCodes, hash) {
+			// Raced with another
+			// use of the same code.
+			return gtserror.NewErrorUnauthorized(errors.New(help), help)
+		}
+
+		user.TwoFactorRecoveryCodes = latest.TwoFactorRecoveryCodes
+	}
+
+	user.TwoFactorRecoveryCodes = slices.DeleteFunc(
+		slices.Clone(user.TwoFactorRecoveryCodes),
+		func(code string) bool { return code == hash },
+	)
+	return nil
+}
+
+// useTOTPCode checks the given TOTP code against the
+// given secret, and if it's valid, marks its time step
+// as used for the user. Returns false if the code is
+// not valid, or an error if the code was already used.
+func (p *Processor) useTOTPCode(
+	ctx context.Context,
+	user *gtsmodel.User,
+	code string,
+	secret string,
+) (bool, gtserror.WithCode) {
+	step, ok := validateTOTPCode(code, secret, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	if errWithCode := p.useTOTPStep(ctx, user, step); errWithCode != nil {
+		return false, errWithCode
+	}
+
+	return true, nil
+}
+
+// useTOTPStep marks the given TOTP time step as used for
+// the user, returning an unauthorized error if a code
+// of the same or a later time step was already used,
+// so that codes can't be replayed (RFC 6238 section 5.2).
+func (p *Processor) useTOTPStep(
+	ctx context.Context,
+	user *gtsmodel.User,
+	step int64,
+) gtserror.WithCode {
+	const help = "two-factor authentication code was already used"
+
+	if step <= user.TwoFactorLastUsedStep {
+		return gtserror.NewErrorUnauthorized(errors.New(help), help)
+	}
+
+	err := p.state.DB.UseUserTwoFactorStep(ctx, user.ID, step)
+	if errors.Is(err, db.ErrNoEntries) {
+		// Raced with another
+		// use of the same code.
+		return gtserror.NewErrorUnauthorized(errors.New(help), help)
+	} else if err != nil {
+		err := gtserror.Newf("db error updating user: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	user.TwoFactorLastUsedStep = step
+	return nil
+}
+
+// validateTOTPCode checks the given code against the
+// given secret, allowing one time step of clock skew
+// either side of now. If the code is valid, the time
+// step it was generated for is returned.
+func validateTOTPCode(code string, secret string, now time.Time) (int64, bool) {
+	current := now.Unix() / twoFactorPeriod
+	for _, step := range []int64{current, current - 1, current + 1} {
+		ok, _ := totp.ValidateCustom(
+			code, secret,
+			time.Unix(step*twoFactorPeriod, 0).UTC(),
+			totp.ValidateOpts{
+				Period:    twoFactorPeriod,
+				Skew:      0,
+				Digits:    otp.DigitsSix,
+				Algorithm: otp.AlgorithmSHA1,
+			},
+		)
+		if ok {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// checkTwoFactorAvailable returns an error if two-factor
+// authentication cannot be enabled on this instance.
+func checkTwoFactorAvailable() gtserror.WithCode {
+	if config.GetOIDCEnabled() {
+		const help = "two-factor authentication cannot be enabled as this instance is running with OIDC enabled; use your OIDC provider's two-factor authentication instead"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(help), help)
+	}
+
+	if config.GetAccountsTwoFactorEncryptionKey() == "" {
+		const help = "two-factor authentication has not been configured on this instance"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(help), help)
+	}
+
+	return nil
+}
+
+// normalizeTwoFactorCode trims whitespace from
+// the given code, and lowercases it so that
+// recovery codes are case insensitive.
+func normalizeTwoFactorCode(code string) string {
+	code = strings.ReplaceAll(code, " ", "")
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// generateRecoveryCodes returns a set of new random
+// recovery codes, along with their bcrypt hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	var (
+		codes  = make([]string, twoFactorRecoveryCodes)
+		hashes = make([]string, twoFactorRecoveryCodes)
+		buf    = make([]byte, 5)
+	)
+
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+
+		// 5 bytes -> 8 chars of base32,
+		// formatted as "xxxx-xxxx".
+		enc := strings.ToLower(base32.StdEncoding.EncodeToString(buf))
+		code := enc[:4] + "-" + enc[4:]
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes[i] = code
+		hashes[i] = string(hash)
+	}
+
+	return codes, hashes, nil
+}
+
+// twoFactorCipher returns an AES-GCM cipher keyed
+// with the configured 2FA encryption key.
+func twoFactorCipher() (cipher.AEAD, error) {
+	secretKey := config.GetAccountsTwoFactorEncryptionKey()
+	if secretKey == "" {
+		return nil, errors.New("two-factor encryption key not set")
+	}
+
+	// Derive 256-bit key from configured value.
+	key := sha256.Sum256([]byte(secretKey))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// encryptTwoFactorSecret encrypts the given TOTP secret,
+// returning base64-encoded nonce + ciphertext.
+func encryptTwoFactorSecret(secret string) (string, error) {
+	gcm, err := twoFactorCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptTwoFactorSecret decrypts the given
+// value produced by encryptTwoFactorSecret.
+func decryptTwoFactorSecret(encrypted string) (string, error) {
+	gcm, err := twoFactorCipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("encrypted secret too short")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	secret, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
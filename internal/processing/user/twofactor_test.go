@@ -0,0 +1,258 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorEnableCheckDisable() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	// Start enrollment with wrong password.
+	_, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "nope")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Empty(user.TwoFactorSecret)
+
+	// Start enrollment.
+	enrollment, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "password")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEmpty(enrollment.Secret)
+	suite.Contains(enrollment.URI, "otpauth://totp/")
+
+	// Secret should be stored encrypted,
+	// but 2FA should not be enabled yet.
+	suite.NotEmpty(user.TwoFactorSecret)
+	suite.NotEqual(enrollment.Secret, user.TwoFactorSecret)
+	suite.False(user.TwoFactorEnabled())
+
+	// Confirm with a bad code.
+	_, errWithCode = suite.user.TwoFactorConfirm(ctx, user, "000000")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Confirm with a good code.
+	code, err := totp.GenerateCode(enrollment.Secret, time.Now())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recovery, errWithCode := suite.user.TwoFactorConfirm(ctx, user, code)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(recovery.RecoveryCodes, 10)
+	suite.True(user.TwoFactorEnabled())
+
+	// Enrolling again should conflict.
+	_, errWithCode = suite.user.TwoFactorEnroll(ctx, user, "password")
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	// Reload user from the db.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(dbUser.TwoFactorEnabled())
+	suite.Len(dbUser.TwoFactorRecoveryCodes, 10)
+
+	// Code used to confirm should not
+	// be accepted again for sign in.
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Code from the next time step
+	// (within allowed skew) should pass.
+	nextCode, err := totp.GenerateCode(enrollment.Secret, time.Now().Add(30*time.Second))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Nil(suite.user.TwoFactorCheck(ctx, dbUser, nextCode))
+
+	// Bad code should not.
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, "123")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Recovery code should pass
+	// check, but only once.
+	recoveryCode := recovery.RecoveryCodes[0]
+	suite.Nil(suite.user.TwoFactorCheck(ctx, dbUser, recoveryCode))
+	suite.Len(dbUser.TwoFactorRecoveryCodes, 9)
+
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, recoveryCode)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// Disable with wrong password.
+	errWithCode = suite.user.TwoFactorDisable(ctx, dbUser, "nope")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.True(dbUser.TwoFactorEnabled())
+
+	// Disable with correct password.
+	if errWithCode := suite.user.TwoFactorDisable(ctx, dbUser, "password"); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	dbUser, err = suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbUser.TwoFactorEnabled())
+	suite.Empty(dbUser.TwoFactorSecret)
+	suite.Empty(dbUser.TwoFactorRecoveryCodes)
+	suite.Zero(dbUser.TwoFactorLastUsedStep)
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorCheckReplay() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	enrollment, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "password")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Confirm with a code from the previous
+	// time step, so the current one is unused.
+	now := time.Now()
+	prevCode, err := totp.GenerateCode(enrollment.Secret, now.Add(-30*time.Second))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if _, errWithCode := suite.user.TwoFactorConfirm(ctx, user, prevCode); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	code, err := totp.GenerateCode(enrollment.Secret, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// First use of the code should pass.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Nil(suite.user.TwoFactorCheck(ctx, dbUser, code))
+
+	// Second use of the same code should
+	// fail, even with a freshly loaded user.
+	dbUser, err = suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(dbUser.TwoFactorLastUsedStep)
+
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// The previous (older) code
+	// should be rejected too.
+	errWithCode = suite.user.TwoFactorCheck(ctx, dbUser, prevCode)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorCheckRecoveryCodeRace() {
+	var (
+		ctx  = context.Background()
+		user = suite.testUsers["local_account_1"]
+	)
+
+	enrollment, errWithCode := suite.user.TwoFactorEnroll(ctx, user, "password")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	code, err := totp.GenerateCode(enrollment.Secret, time.Now())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recovery, errWithCode := suite.user.TwoFactorConfirm(ctx, user, code)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Load two copies of the user, as two
+	// concurrent sign-in requests would.
+	first, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	firstCopy := *first
+	second := &firstCopy
+	second.TwoFactorRecoveryCodes = slices.Clone(first.TwoFactorRecoveryCodes)
+
+	// Only one of them can use the code,
+	// even though both copies still have it.
+	recoveryCode := recovery.RecoveryCodes[0]
+	suite.Nil(suite.user.TwoFactorCheck(ctx, first, recoveryCode))
+
+	errWithCode = suite.user.TwoFactorCheck(ctx, second, recoveryCode)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// The stale copy can still use
+	// a different, unused code.
+	third := *first
+	third.TwoFactorRecoveryCodes = slices.Clone(second.TwoFactorRecoveryCodes)
+	suite.Len(third.TwoFactorRecoveryCodes, 10)
+	suite.Nil(suite.user.TwoFactorCheck(ctx, &third, recovery.RecoveryCodes[1]))
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(dbUser.TwoFactorRecoveryCodes, 8)
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorConfirmNotEnrolled() {
+	user := suite.testUsers["local_account_1"]
+
+	_, errWithCode := suite.user.TwoFactorConfirm(context.Background(), user, "123456")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *TwoFactorTestSuite) TestTwoFactorEnrollNoKey() {
+	config.SetAccountsTwoFactorEncryptionKey("")
+
+	user := suite.testUsers["local_account_1"]
+	_, errWithCode := suite.user.TwoFactorEnroll(context.Background(), user, "password")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorTestSuite))
+}
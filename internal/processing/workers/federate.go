@@ -19,12 +19,14 @@
 
 import (
 	"context"
+	"errors"
 	"net/url"
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -192,7 +194,9 @@ func (f *federate) CreateStatus(ctx context.Context, status *gtsmodel.Status) er
 	if _, err := f.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
 		return gtserror.Newf("error sending Create activity via outbox %s: %w", outboxIRI, err)
 	}
-	return nil
+
+	// Additionally send to any relays.
+	return f.deliverToRelays(ctx, status, create)
 }
 
 func (f *federate) CreatePollVote(ctx context.Context, poll *gtsmodel.Poll, vote *gtsmodel.PollVote) error {
@@ -266,7 +270,8 @@ func (f *federate) DeleteStatus(ctx context.Context, status *gtsmodel.Status) er
 		)
 	}
 
-	return nil
+	// Additionally send to any relays.
+	return f.deliverToRelays(ctx, status, delete)
 }
 
 func (f *federate) UpdateStatus(ctx context.Context, status *gtsmodel.Status) error {
@@ -305,7 +310,8 @@ func (f *federate) UpdateStatus(ctx context.Context, status *gtsmodel.Status) er
 		return gtserror.Newf("error sending Update activity via outbox %s: %w", outboxIRI, err)
 	}
 
-	return nil
+	// Additionally send to any relays.
+	return f.deliverToRelays(ctx, status, update)
 }
 
 func (f *federate) Follow(ctx context.Context, follow *gtsmodel.Follow) error {
@@ -860,6 +866,72 @@ func (f *federate) deliverToInboxOnly(
 	return nil
 }
 
+// deliverToRelays additionally delivers the given activity
+// about a public, local status to the inbox of each relay
+// that has accepted this instance's subscription, on behalf
+// of the status author.
+func (f *federate) deliverToRelays(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	t vocab.Type,
+) error {
+	if status.Visibility != gtsmodel.VisibilityPublic {
+		// Only public
+		// posts are relayed.
+		return nil
+	}
+
+	relays, err := f.state.DB.GetRelays(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting relays: %w", err)
+	}
+
+	inboxes := make([]*url.URL, 0, len(relays))
+	for _, relay := range relays {
+		if !relay.Accepted() {
+			continue
+		}
+
+		inboxIRI, err := parseURI(relay.InboxURI)
+		if err != nil {
+			return err
+		}
+
+		inboxes = append(inboxes, inboxIRI)
+	}
+
+	if len(inboxes) == 0 {
+		// No relays to
+		// deliver to.
+		return nil
+	}
+
+	tsport, err := f.TransportController().NewTransportForUsername(
+		ctx,
+		status.Account.Username,
+	)
+	if err != nil {
+		return gtserror.Newf(
+			"error getting transport to deliver activity %T to relays: %w",
+			t, err,
+		)
+	}
+
+	m, err := ap.Serialize(t)
+	if err != nil {
+		return err
+	}
+
+	if err := tsport.BatchDeliver(ctx, m, inboxes); err != nil {
+		return gtserror.Newf(
+			"error delivering activity %T to relays: %w",
+			t, err,
+		)
+	}
+
+	return nil
+}
+
 func (f *federate) UpdateAccount(ctx context.Context, account *gtsmodel.Account) error {
 	// Populate model.
 	if err := f.state.DB.PopulateAccount(ctx, account); err != nil {
@@ -33,6 +33,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
@@ -50,6 +51,7 @@ type clientAPI struct {
 	account   *account.Processor
 	common    *common.Processor
 	utils     *utils
+	trends    *trends.Processor
 }
 
 func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.FromClientAPI) error {
@@ -158,8 +160,12 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 	case ap.ActivityReject:
 		switch cMsg.APObjectType { //nolint:gocritic
 
-		// REJECT FOLLOW (request)
+		// REJECT FOLLOW (request), or REJECT
+		// existing follow (ie., remove follower)
 		case ap.ActivityFollow:
+			if _, ok := cMsg.GTSModel.(*gtsmodel.Follow); ok {
+				return p.clientAPI.RejectFollow(ctx, cMsg)
+			}
 			return p.clientAPI.RejectFollowRequest(ctx, cMsg)
 
 		// REJECT USER (ie., new user+account sign-up)
@@ -339,6 +345,17 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		// Don't return, just continue as normal.
 	}
 
+	// Fetch link preview card
+	// for the status, if any.
+	if err := p.federate.DereferenceStatusCard(ctx, status); err != nil {
+		log.Errorf(ctx, "error dereferencing status card: %v", err)
+	}
+
+	// Count status tags and link towards trends.
+	if err := p.trends.StatusCreated(ctx, status); err != nil {
+		log.Errorf(ctx, "error recording status trends: %v", err)
+	}
+
 	// Update stats for the actor account.
 	if err := p.utils.incrementStatusesCount(ctx, cMsg.Origin, status); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
@@ -348,6 +365,12 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		log.Errorf(ctx, "error timelining and notifying status: %v", err)
 	}
 
+	// Boost status from any local
+	// groups it's been posted to.
+	if err := p.utils.announceToGroups(ctx, status); err != nil {
+		log.Errorf(ctx, "error announcing status to groups: %v", err)
+	}
+
 	if err := p.federate.CreateStatus(ctx, status); err != nil {
 		log.Errorf(ctx, "error federating status: %v", err)
 	}
@@ -547,6 +570,11 @@ func (p *clientAPI) CreateLike(ctx context.Context, cMsg *messages.FromClientAPI
 		log.Errorf(ctx, "error federating like: %v", err)
 	}
 
+	// Count fave towards trends.
+	if err := p.trends.StatusFaved(ctx, fave); err != nil {
+		log.Errorf(ctx, "error recording fave trends: %v", err)
+	}
+
 	// Interaction counts changed on the faved status;
 	// uncache the prepared version from all timelines.
 	p.surface.invalidateStatusFromTimelines(ctx, fave.StatusID)
@@ -653,6 +681,11 @@ func (p *clientAPI) CreateAnnounce(ctx context.Context, cMsg *messages.FromClien
 		log.Errorf(ctx, "error federating announce: %v", err)
 	}
 
+	// Count boost towards trends.
+	if err := p.trends.StatusBoosted(ctx, boost); err != nil {
+		log.Errorf(ctx, "error recording boost trends: %v", err)
+	}
+
 	// Interaction counts changed on the boosted status;
 	// uncache the prepared version from all timelines.
 	p.surface.invalidateStatusFromTimelines(ctx, boost.BoostOfID)
@@ -715,6 +748,19 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 		}
 	}
 
+	// Notify any *new* mentions added
+	// by the editor; accounts already
+	// notified will not be renotified.
+	if err := p.surface.notifyMentions(ctx, status); err != nil {
+		log.Errorf(ctx, "error notifying mentions: %v", err)
+	}
+
+	// Fetch link preview card
+	// for the edited status.
+	if err := p.federate.DereferenceStatusCard(ctx, status); err != nil {
+		log.Errorf(ctx, "error dereferencing status card: %v", err)
+	}
+
 	// Push message that the status has been edited to streams.
 	if err := p.surface.timelineStatusUpdate(ctx, status); err != nil {
 		log.Errorf(ctx, "error streaming status edit: %v", err)
@@ -826,6 +872,31 @@ func (p *clientAPI) RejectFollowRequest(ctx context.Context, cMsg *messages.From
 	return nil
 }
 
+func (p *clientAPI) RejectFollow(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	follow, ok := cMsg.GTSModel.(*gtsmodel.Follow)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Follow", cMsg.GTSModel)
+	}
+
+	// Update stats for the origin
+	// (ie., previously followed) account.
+	if err := p.utils.decrementFollowersCount(ctx, cMsg.Origin); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	// Update stats for the target
+	// (ie., previously following) account.
+	if err := p.utils.decrementFollowingCount(ctx, cMsg.Target); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	if err := p.federate.RejectFollow(ctx, follow); err != nil {
+		log.Errorf(ctx, "error federating follow reject: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) UndoFollow(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	follow, ok := cMsg.GTSModel.(*gtsmodel.Follow)
 	if !ok {
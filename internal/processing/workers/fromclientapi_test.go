@@ -26,6 +26,7 @@
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
@@ -1972,6 +1973,171 @@ func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	}
 }
 
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusRelays() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = context.Background()
+		postingAccount = suite.testAccounts["admin_account"]
+	)
+
+	// Subscribe to one accepted and one pending relay.
+	for _, relay := range []*gtsmodel.Relay{
+		{
+			ID:        "01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+			InboxURI:  "https://relay.example.org/inbox",
+			FollowURI: "http://localhost:8080/users/localhost:8080/follow/01JBMZ9ZVJ5KX3QYHRN0T7F6CW",
+			ActorURI:  "https://relay.example.org/actor",
+			State:     gtsmodel.RelayStateAccepted,
+		},
+		{
+			ID:        "01JBMZBQ7ZK1C0XQ1B6YJ3GZ9N",
+			InboxURI:  "https://pending.relay.example.org/inbox",
+			FollowURI: "http://localhost:8080/users/localhost:8080/follow/01JBMZBQ7ZK1C0XQ1B6YJ3GZ9N",
+			State:     gtsmodel.RelayStatePending,
+		},
+	} {
+		if err := testStructs.State.DB.PutRelay(ctx, relay); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// relayed returns the IDs of objects
+	// delivered to any relay inbox so far.
+	relayed := func() map[string][]string {
+		delivered := make(map[string][]string)
+		for {
+			dlv, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+			if !ok {
+				return delivered
+			}
+			inbox := dlv.Request.URL.String()
+			if inbox == "https://relay.example.org/inbox" ||
+				inbox == "https://pending.relay.example.org/inbox" {
+				delivered[inbox] = append(delivered[inbox], dlv.ObjectID)
+			}
+		}
+	}
+
+	for _, visibility := range []gtsmodel.Visibility{
+		gtsmodel.VisibilityPublic,
+		gtsmodel.VisibilityUnlocked,
+	} {
+		status := suite.newStatus(
+			ctx,
+			testStructs.State,
+			postingAccount,
+			visibility,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+
+		if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityCreate,
+				GTSModel:       status,
+				Origin:         postingAccount,
+			},
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		delivered := relayed()
+		if visibility == gtsmodel.VisibilityPublic {
+			// Public status should only be
+			// delivered to the accepted relay.
+			suite.Equal(map[string][]string{
+				"https://relay.example.org/inbox": {status.URI},
+			}, delivered)
+		} else {
+			// Unlisted status
+			// is never relayed.
+			suite.Empty(delivered)
+		}
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusMentioningGroup() {
+	testStructs := testrig.SetupTestStructs(rMediaPath, rTemplatePath)
+	defer testrig.TearDownTestStructs(testStructs)
+
+	var (
+		ctx           = context.Background()
+		memberAccount = suite.testAccounts["local_account_1"]
+		otherAccount  = suite.testAccounts["local_account_2"]
+	)
+
+	apiGroup, errWithCode := testStructs.Processor.Admin().GroupCreate(ctx,
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminGroupRequest{Username: "cool_group"},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	group, err := testStructs.State.DB.GetAccountByID(ctx, apiGroup.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Make member account a member of the group.
+	if err := testStructs.State.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              id.NewULID(),
+		URI:             memberAccount.URI + "/follow/" + id.NewULID(),
+		AccountID:       memberAccount.ID,
+		TargetAccountID: group.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, test := range []struct {
+		account *gtsmodel.Account
+		boosted bool
+	}{
+		// Group members' posts are boosted.
+		{account: memberAccount, boosted: true},
+
+		// Non-members' posts aren't.
+		{account: otherAccount, boosted: false},
+	} {
+		status := suite.newStatus(
+			ctx,
+			testStructs.State,
+			test.account,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+			[]*gtsmodel.Account{group},
+			false,
+			nil,
+		)
+
+		if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityCreate,
+				GTSModel:       status,
+				Origin:         test.account,
+			},
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		boosted, err := testStructs.State.DB.IsStatusBoostedBy(ctx, status.ID, group.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(test.boosted, boosted)
+	}
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
@@ -37,6 +37,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -51,6 +52,7 @@ type fediAPI struct {
 	account  *account.Processor
 	common   *common.Processor
 	utils    *utils
+	trends   *trends.Processor
 }
 
 func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromFediAPI) error {
@@ -321,6 +323,17 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		// Don't return, just continue as normal.
 	}
 
+	// Fetch link preview card
+	// for the status, if any.
+	if err := p.federate.DereferenceStatusCard(ctx, status); err != nil {
+		log.Errorf(ctx, "error dereferencing status card: %v", err)
+	}
+
+	// Count status tags and link towards trends.
+	if err := p.trends.StatusCreated(ctx, status); err != nil {
+		log.Errorf(ctx, "error recording status trends: %v", err)
+	}
+
 	// Update stats for the remote account.
 	if err := p.utils.incrementStatusesCount(ctx, fMsg.Requesting, status); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
@@ -330,6 +343,12 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		log.Errorf(ctx, "error timelining and notifying status: %v", err)
 	}
 
+	// Boost status from any local
+	// groups it's been posted to.
+	if err := p.utils.announceToGroups(ctx, status); err != nil {
+		log.Errorf(ctx, "error announcing status to groups: %v", err)
+	}
+
 	if status.InReplyToID != "" {
 		// Interaction counts changed on the replied status; uncache the
 		// prepared version from all timelines. The status dereferencer
@@ -523,6 +542,11 @@ func (p *fediAPI) CreateLike(ctx context.Context, fMsg *messages.FromFediAPI) er
 		log.Errorf(ctx, "error notifying fave: %v", err)
 	}
 
+	// Count fave towards trends.
+	if err := p.trends.StatusFaved(ctx, fave); err != nil {
+		log.Errorf(ctx, "error recording fave trends: %v", err)
+	}
+
 	// Interaction counts changed on the faved status;
 	// uncache the prepared version from all timelines.
 	p.surface.invalidateStatusFromTimelines(ctx, fave.StatusID)
@@ -631,6 +655,11 @@ func (p *fediAPI) CreateAnnounce(ctx context.Context, fMsg *messages.FromFediAPI
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
+	// Count boost towards trends.
+	if err := p.trends.StatusBoosted(ctx, boost); err != nil {
+		log.Errorf(ctx, "error recording boost trends: %v", err)
+	}
+
 	// Timeline and notify the announce.
 	if err := p.surface.timelineAndNotifyStatus(ctx, boost); err != nil {
 		log.Errorf(ctx, "error timelining and notifying status: %v", err)
@@ -942,6 +971,12 @@ func (p *fediAPI) UpdateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		}
 	}
 
+	// Fetch link preview card
+	// for the edited status.
+	if err := p.federate.DereferenceStatusCard(ctx, status); err != nil {
+		log.Errorf(ctx, "error dereferencing status card: %v", err)
+	}
+
 	// Push message that the status has been edited to streams.
 	if err := p.surface.timelineStatusUpdate(ctx, status); err != nil {
 		log.Errorf(ctx, "error streaming status edit: %v", err)
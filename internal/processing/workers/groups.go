@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// announceToGroups boosts the given status
+// from each local group account that it
+// mentions, provided the status author is
+// a member (follower) of that group, and
+// the status is public or unlisted.
+//
+// This is how posts are distributed to group
+// members: they see the group's boost of the
+// status in their home timelines.
+func (u *utils) announceToGroups(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	if status.BoostOfID != "" {
+		// Don't re-boost boosts.
+		return nil
+	}
+
+	if status.Visibility != gtsmodel.VisibilityPublic &&
+		status.Visibility != gtsmodel.VisibilityUnlocked {
+		// Groups only distribute
+		// publicly visible posts.
+		return nil
+	}
+
+	var errs gtserror.MultiError
+
+	for _, mention := range status.Mentions {
+		// Set status on the mention (stops
+		// the below function populating it).
+		mention.Status = status
+
+		// Ensure the passed mention is fully populated.
+		if err := u.state.DB.PopulateMention(ctx, mention); err != nil {
+			errs.Appendf("error populating mention %s: %w", mention.ID, err)
+			continue
+		}
+
+		group := mention.TargetAccount
+		if !group.IsLocal() || !group.IsGroup() ||
+			group.IsSuspended() || group.ID == status.AccountID {
+			// Only local groups
+			// boost mentions.
+			continue
+		}
+
+		if err := u.announceToGroup(ctx, group, status); err != nil {
+			errs.Appendf("error announcing status %s to group %s: %w",
+				status.ID, group.Username, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// announceToGroup boosts the given
+// status from the given local group.
+func (u *utils) announceToGroup(
+	ctx context.Context,
+	group *gtsmodel.Account,
+	status *gtsmodel.Status,
+) error {
+	// Only members of the group (ie.,
+	// accepted followers) can post to it.
+	member, err := u.state.DB.IsFollowing(ctx,
+		status.AccountID,
+		group.ID,
+	)
+	if err != nil {
+		return gtserror.Newf("db error checking membership: %w", err)
+	}
+
+	if !member {
+		log.Debugf(ctx, "%s is not a member of group %s",
+			status.AccountID, group.Username)
+		return nil
+	}
+
+	// Don't boost twice, eg., when
+	// a status create is reprocessed.
+	boosted, err := u.state.DB.IsStatusBoostedBy(ctx,
+		status.ID,
+		group.ID,
+	)
+	if err != nil {
+		return gtserror.Newf("db error checking boost: %w", err)
+	}
+
+	if boosted {
+		return nil
+	}
+
+	// Ensure the status author's interaction
+	// policy lets the group boost without needing
+	// approval, we don't wait for approvals here.
+	policyResult, err := u.intFilter.StatusBoostable(ctx,
+		group,
+		status,
+	)
+	if err != nil {
+		return gtserror.Newf("error checking boostable: %w", err)
+	}
+
+	if !policyResult.Permitted() ||
+		policyResult.MatchedOnCollection() {
+		log.Debugf(ctx, "group %s may not boost %s without approval",
+			group.Username, status.URI)
+		return nil
+	}
+
+	boost, err := u.converter.StatusToBoost(ctx,
+		status,
+		group,
+		"", // no application
+	)
+	if err != nil {
+		return gtserror.Newf("error converting boost: %w", err)
+	}
+	boost.PendingApproval = util.Ptr(false)
+
+	// Store the new boost.
+	if err := u.state.DB.PutStatus(ctx, boost); err != nil {
+		return gtserror.Newf("db error putting boost: %w", err)
+	}
+
+	// Process side effects of the
+	// boost as any other boost.
+	u.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APObjectType:   ap.ActivityAnnounce,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       boost,
+		Origin:         group,
+		Target:         status.Account,
+	})
+
+	return nil
+}
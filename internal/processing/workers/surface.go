@@ -24,6 +24,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 )
 
 // Surface wraps functions for 'surfacing' the result
@@ -39,4 +40,5 @@ type Surface struct {
 	VisFilter     *visibility.Filter
 	EmailSender   email.Sender
 	Conversations *conversations.Processor
+	WebPush       *webpush.Sender
 }
@@ -574,6 +574,24 @@ func (s *Surface) Notify(
 		return nil
 	}
 
+	if originAccount.IsSilenced() &&
+		notificationType != gtsmodel.NotificationFollowRequest {
+		// Silenced accounts can only notify accounts
+		// that follow them, except for follow requests
+		// (else target would never find out about them).
+		follows, err := s.State.DB.IsFollowing(ctx,
+			targetAccount.ID,
+			originAccount.ID,
+		)
+		if err != nil {
+			return gtserror.Newf("error checking follow %s->%s: %w", targetAccount.ID, originAccount.ID, err)
+		}
+
+		if !follows {
+			return nil
+		}
+	}
+
 	// We're doing state-y stuff so get a
 	// lock on this combo of notif params.
 	lockURI := getNotifyLockURI(
@@ -647,5 +665,11 @@ func (s *Surface) Notify(
 	}
 	s.Stream.Notify(ctx, targetAccount, apiNotif)
 
+	// Push notification to any Web Push
+	// subscriptions of the user that want it.
+	if err := s.WebPush.Send(ctx, notif, apiNotif); err != nil {
+		return gtserror.Newf("error sending web push for notification %s: %w", notif.ID, err)
+	}
+
 	return nil
 }
@@ -28,6 +28,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -46,6 +47,7 @@ func (suite *SurfaceNotifyTestSuite) TestSpamNotifs() {
 		VisFilter:     visibility.NewFilter(testStructs.State),
 		EmailSender:   testStructs.EmailSender,
 		Conversations: testStructs.Processor.Conversations(),
+		WebPush:       webpush.NewSender(testStructs.State),
 	}
 
 	var (
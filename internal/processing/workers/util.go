@@ -20,9 +20,11 @@
 import (
 	"context"
 	"errors"
+	"slices"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/interaction"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -42,6 +44,7 @@ type utils struct {
 	account   *account.Processor
 	surface   *Surface
 	converter *typeutils.Converter
+	intFilter *interaction.Filter
 }
 
 // wipeStatus encapsulates common logic used to
@@ -97,6 +100,41 @@ func (u *utils) wipeStatus(
 		}
 	}
 
+	if len(status.EditIDs) > 0 {
+		// Fetch any historical edits of this status.
+		edits, err := u.state.DB.GetStatusEditsByIDs(
+			gtscontext.SetBarebones(ctx),
+			status.EditIDs,
+		)
+		if err != nil {
+			errs.Appendf("error getting status edits: %w", err)
+		}
+
+		// Media that was only ever attached to previous
+		// versions of this status can't be reattached
+		// elsewhere, so it can always be deleted outright.
+		var editMediaIDs []string
+		for _, edit := range edits {
+			for _, id := range edit.AttachmentIDs {
+				if !slices.Contains(status.AttachmentIDs, id) &&
+					!slices.Contains(editMediaIDs, id) {
+					editMediaIDs = append(editMediaIDs, id)
+				}
+			}
+		}
+
+		for _, id := range editMediaIDs {
+			if err := u.media.Delete(ctx, id); err != nil {
+				errs.Appendf("error deleting edit media: %w", err)
+			}
+		}
+
+		// Delete all the historical edits of this status.
+		if err := u.state.DB.DeleteStatusEdits(ctx, status.EditIDs); err != nil {
+			errs.Appendf("error deleting status edits: %w", err)
+		}
+	}
+
 	// Delete all mentions generated by this status.
 	// todo:u.state.DB.DeleteMentionsForStatus
 	for _, id := range status.MentionIDs {
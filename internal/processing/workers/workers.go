@@ -20,14 +20,17 @@
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/filter/interaction"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/trends"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/internal/workers"
 )
 
@@ -43,11 +46,13 @@ func New(
 	federator *federation.Federator,
 	converter *typeutils.Converter,
 	visFilter *visibility.Filter,
+	intFilter *interaction.Filter,
 	emailSender email.Sender,
 	account *account.Processor,
 	media *media.Processor,
 	stream *stream.Processor,
 	conversations *conversations.Processor,
+	trends *trends.Processor,
 ) Processor {
 	// Init federate logic
 	// wrapper struct.
@@ -66,6 +71,7 @@ func New(
 		VisFilter:     visFilter,
 		EmailSender:   emailSender,
 		Conversations: conversations,
+		WebPush:       webpush.NewSender(state),
 	}
 
 	// Init shared util funcs.
@@ -75,6 +81,7 @@ func New(
 		account:   account,
 		surface:   surface,
 		converter: converter,
+		intFilter: intFilter,
 	}
 
 	return Processor{
@@ -87,6 +94,7 @@ func New(
 			account:   account,
 			common:    common,
 			utils:     utils,
+			trends:    trends,
 		},
 		fediAPI: fediAPI{
 			state:    state,
@@ -95,6 +103,7 @@ func New(
 			account:  account,
 			common:   common,
 			utils:    utils,
+			trends:   trends,
 		},
 	}
 }
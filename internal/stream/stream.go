@@ -50,6 +50,18 @@
 	// EventTypeConversation -- a user
 	// should be shown an updated conversation.
 	EventTypeConversation = "conversation"
+
+	// EventTypeAnnouncement -- an instance
+	// announcement was published or edited.
+	EventTypeAnnouncement = "announcement"
+
+	// EventTypeAnnouncementReaction -- reactions
+	// to an instance announcement have changed.
+	EventTypeAnnouncementReaction = "announcement.reaction"
+
+	// EventTypeAnnouncementDelete -- an instance
+	// announcement should no longer be shown.
+	EventTypeAnnouncementDelete = "announcement.delete"
 )
 
 const (
@@ -163,6 +163,20 @@
 // Source: https://github.com/microcosm-cc/bluemonday#usage
 var strict *bluemonday.Policy = bluemonday.StrictPolicy()
 
+// embed is a very restrictive policy for oEmbed
+// html fetched for preview cards, which only lets
+// through iframes with a fully qualified https src.
+var embed *bluemonday.Policy = func() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("iframe")
+	p.AllowAttrs("src").Matching(regexp.MustCompile(`^https://`)).OnElements("iframe")
+	p.AllowAttrs("width", "height").Matching(bluemonday.Integer).OnElements("iframe")
+	p.AllowAttrs("allowfullscreen", "frameborder", "allow", "title").OnElements("iframe")
+	p.RequireParseableURLs(true)
+	p.AllowURLSchemes("https")
+	return p
+}()
+
 // removeHTML strictly removes *all* recognized
 // HTML elements from the given string.
 func removeHTML(in string) string {
@@ -175,6 +189,12 @@ func SanitizeToHTML(in string) string {
 	return regular.Sanitize(in)
 }
 
+// SanitizeToEmbedHTML sanitizes the given oEmbed html
+// string, removing everything but embeddable iframes.
+func SanitizeToEmbedHTML(in string) string {
+	return strings.TrimSpace(embed.Sanitize(in))
+}
+
 // SanitizeToPlaintext runs text through basic sanitization.
 // This removes any html elements that were in the string,
 // and returns clean plaintext.
@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// command is a Translator that runs a
+// local command for each translation,
+// for example a wrapper around a local
+// machine translation model.
+type command struct {
+	args []string
+}
+
+// NewCommand returns a Translator that runs the given
+// command (and arguments) for each translation request.
+//
+// The command is given a LibreTranslate-style JSON
+// request on stdin, and must write a LibreTranslate-style
+// JSON response to stdout before exiting with code 0.
+func NewCommand(args []string) Translator {
+	return &command{args: args}
+}
+
+func (c *command) Provider() string {
+	return filepath.Base(c.args[0])
+}
+
+func (c *command) Translate(ctx context.Context, req *Request) (*Result, error) {
+	if len(req.Texts) == 0 {
+		return new(Result), nil
+	}
+
+	b, err := json.Marshal(newLTRequest(req, ""))
+	if err != nil {
+		return nil, gtserror.Newf("error encoding request: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	// #nosec G204 -- Command is set by the instance admin.
+	cmd := exec.CommandContext(ctx, c.args[0], c.args[1:]...)
+	cmd.Stdin = bytes.NewReader(b)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, gtserror.Newf(
+			"error running %s: %w: %s",
+			c.args[0], err, strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	return parseLTResponse(stdout.Bytes(), len(req.Texts))
+}
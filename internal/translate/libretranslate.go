@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// libreTranslate is a Translator that uses
+// a LibreTranslate-compatible HTTP API.
+type libreTranslate struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+// NewLibreTranslate returns a Translator that
+// uses the LibreTranslate-compatible HTTP API
+// at the given base URL, sending apiKey if set.
+//
+// Unlike federation requests, the configured
+// URL is trusted, so requests to it are not
+// subject to the httpclient IP restrictions.
+func NewLibreTranslate(url string, apiKey string) Translator {
+	return &libreTranslate{
+		url:    strings.TrimSuffix(url, "/"),
+		apiKey: apiKey,
+		client: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+func (l *libreTranslate) Provider() string {
+	return "LibreTranslate"
+}
+
+func (l *libreTranslate) Translate(ctx context.Context, req *Request) (*Result, error) {
+	if len(req.Texts) == 0 {
+		return new(Result), nil
+	}
+
+	b, err := json.Marshal(newLTRequest(req, l.apiKey))
+	if err != nil {
+		return nil, gtserror.Newf("error encoding request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx,
+		http.MethodPost,
+		l.url+"/translate",
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error creating request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	rsp, err := l.client.Do(httpReq)
+	if err != nil {
+		return nil, gtserror.Newf("error doing request: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	// Limit how much we'll read, a translation
+	// response should never be anywhere near this.
+	b, err = io.ReadAll(io.LimitReader(rsp.Body, 1<<22))
+	if err != nil {
+		return nil, gtserror.Newf("error reading response: %w", err)
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		// Try to get a helpful error
+		// message from the response.
+		var errRsp ltResponse
+		_ = json.Unmarshal(b, &errRsp)
+		return nil, gtserror.Newf(
+			"%s returned %s: %s",
+			httpReq.URL, rsp.Status, errRsp.Error,
+		)
+	}
+
+	return parseLTResponse(b, len(req.Texts))
+}
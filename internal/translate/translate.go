@@ -0,0 +1,214 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// SourceAuto can be used as the source language of a
+// Request when the language of the texts is not known,
+// asking the backend to detect the language itself.
+const SourceAuto = "auto"
+
+// Request models a request to translate
+// one or more HTML texts between languages.
+type Request struct {
+	// Texts to translate. Results will be
+	// returned in the same order as given.
+	Texts []string
+
+	// Source language of the texts,
+	// as an ISO 639 language code,
+	// or SourceAuto if not known.
+	Source string
+
+	// Target language to translate
+	// into, as an ISO 639 language code.
+	Target string
+}
+
+// Result models the result
+// of a translation Request.
+type Result struct {
+	// Translated texts, in the same
+	// order as the Request texts.
+	Texts []string
+
+	// Language that the backend detected
+	// the Request texts to be written in,
+	// if any. May be empty.
+	DetectedLanguage string
+}
+
+// Translator translates texts from
+// one language to another using
+// some translation backend.
+type Translator interface {
+	// Translate translates the texts of the given request,
+	// returning an error if the backend can't be reached
+	// or the translation otherwise fails.
+	Translate(ctx context.Context, req *Request) (*Result, error)
+
+	// Provider returns a human-readable
+	// name for the translation backend.
+	Provider() string
+}
+
+// New returns a new Translator using the
+// configured translation backend, or nil if
+// translation is not enabled on this instance.
+func New() Translator {
+	switch config.GetTranslationBackend() {
+	case config.TranslationBackendLibreTranslate:
+		return NewLibreTranslate(
+			config.GetTranslationLibreTranslateURL(),
+			config.GetTranslationLibreTranslateAPIKey(),
+		)
+
+	case config.TranslationBackendCommand:
+		return NewCommand(config.GetTranslationCommand())
+
+	default:
+		return nil
+	}
+}
+
+// ltRequest is the JSON request body format
+// used by LibreTranslate's /translate endpoint.
+type ltRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+	APIKey string   `json:"api_key,omitempty"`
+}
+
+// ltResponse is the JSON response body format
+// used by LibreTranslate's /translate endpoint.
+//
+// Depending on whether one or many texts were
+// given, translatedText and detectedLanguage
+// may be either single values or arrays.
+type ltResponse struct {
+	TranslatedText   json.RawMessage `json:"translatedText"`
+	DetectedLanguage json.RawMessage `json:"detectedLanguage"`
+	Error            string          `json:"error"`
+}
+
+// ltDetectedLanguage models one
+// detected language in an ltResponse.
+type ltDetectedLanguage struct {
+	Confidence float64 `json:"confidence"`
+	Language   string  `json:"language"`
+}
+
+// newLTRequest wraps the given
+// request as an ltRequest.
+func newLTRequest(req *Request, apiKey string) *ltRequest {
+	source := req.Source
+	if source == "" {
+		source = SourceAuto
+	}
+
+	return &ltRequest{
+		Q:      req.Texts,
+		Source: source,
+		Target: req.Target,
+		Format: "html",
+		APIKey: apiKey,
+	}
+}
+
+// parseLTResponse parses the given LibreTranslate
+// style response body into a Result, checking that
+// the expected number of texts were returned.
+func parseLTResponse(b []byte, expect int) (*Result, error) {
+	var resp ltResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, gtserror.Newf("error decoding response: %w", err)
+	}
+
+	if resp.Error != "" {
+		return nil, gtserror.Newf("backend returned error: %s", resp.Error)
+	}
+
+	if len(resp.TranslatedText) == 0 {
+		return nil, gtserror.New("response contained no translatedText")
+	}
+
+	var texts []string
+	if resp.TranslatedText[0] == '[' {
+		if err := json.Unmarshal(resp.TranslatedText, &texts); err != nil {
+			return nil, gtserror.Newf("error decoding translatedText: %w", err)
+		}
+	} else {
+		var text string
+		if err := json.Unmarshal(resp.TranslatedText, &text); err != nil {
+			return nil, gtserror.Newf("error decoding translatedText: %w", err)
+		}
+		texts = []string{text}
+	}
+
+	if len(texts) != expect {
+		return nil, gtserror.Newf(
+			"expected %d translated texts, got %d",
+			expect, len(texts),
+		)
+	}
+
+	return &Result{
+		Texts:            texts,
+		DetectedLanguage: parseLTDetected(resp.DetectedLanguage),
+	}, nil
+}
+
+// parseLTDetected returns the detected language with
+// the highest confidence from the given detectedLanguage
+// value, which may be either a single object or an array.
+func parseLTDetected(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var detected []ltDetectedLanguage
+	if raw[0] == '[' {
+		if err := json.Unmarshal(raw, &detected); err != nil {
+			return ""
+		}
+	} else {
+		var d ltDetectedLanguage
+		if err := json.Unmarshal(raw, &d); err != nil {
+			return ""
+		}
+		detected = []ltDetectedLanguage{d}
+	}
+
+	var best ltDetectedLanguage
+	for _, d := range detected {
+		if d.Language != "" && d.Confidence >= best.Confidence {
+			best = d
+		}
+	}
+
+	return best.Language
+}
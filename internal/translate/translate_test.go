@@ -0,0 +1,163 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
+)
+
+func TestLibreTranslate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/translate" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var req struct {
+			Q      []string `json:"q"`
+			Source string   `json:"source"`
+			Target string   `json:"target"`
+			Format string   `json:"format"`
+			APIKey string   `json:"api_key"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.APIKey != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":"Invalid API key"}`))
+			return
+		}
+
+		translated := make([]string, len(req.Q))
+		for i, q := range req.Q {
+			translated[i] = "[" + req.Source + "->" + req.Target + "] " + q
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"translatedText": translated,
+			"detectedLanguage": []map[string]any{
+				{"confidence": 40.0, "language": "fr"},
+				{"confidence": 90.0, "language": "de"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	translator := translate.NewLibreTranslate(server.URL+"/", "secret")
+	if provider := translator.Provider(); provider != "LibreTranslate" {
+		t.Fatalf("unexpected provider %s", provider)
+	}
+
+	res, err := translator.Translate(context.Background(), &translate.Request{
+		Texts:  []string{"<p>hallo</p>", "welt"},
+		Target: "en",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{"[auto->en] <p>hallo</p>", "[auto->en] welt"}
+	if !slices.Equal(res.Texts, expect) {
+		t.Fatalf("expected %v, got %v", expect, res.Texts)
+	}
+
+	if res.DetectedLanguage != "de" {
+		t.Fatalf("expected detected language de, got %s", res.DetectedLanguage)
+	}
+
+	// Wrong API key should give an error.
+	translator = translate.NewLibreTranslate(server.URL, "wrong")
+	_, err = translator.Translate(context.Background(), &translate.Request{
+		Texts:  []string{"hallo"},
+		Source: "de",
+		Target: "en",
+	})
+	if err == nil || !strings.Contains(err.Error(), "Invalid API key") {
+		t.Fatalf("expected invalid api key error, got %v", err)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	for i, test := range []struct {
+		script      string
+		expectTexts []string
+		expectLang  string
+		expectErr   string
+	}{
+		{
+			// Echo back a fixed single-text response
+			// after checking the request was sent.
+			script:      `grep -q '"target":"en"' && echo '{"translatedText":"hello","detectedLanguage":{"confidence":80,"language":"de"}}'`,
+			expectTexts: []string{"hello"},
+			expectLang:  "de",
+		},
+		{
+			// Wrong number of texts.
+			script:    `cat >/dev/null; echo '{"translatedText":["a","b"]}'`,
+			expectErr: "expected 1 translated texts, got 2",
+		},
+		{
+			// Backend error message.
+			script:    `cat >/dev/null; echo '{"error":"unsupported language"}'`,
+			expectErr: "unsupported language",
+		},
+		{
+			// Command failure.
+			script:    `cat >/dev/null; echo 'model not found' >&2; exit 1`,
+			expectErr: "model not found",
+		},
+	} {
+		translator := translate.NewCommand([]string{"sh", "-c", test.script})
+
+		res, err := translator.Translate(context.Background(), &translate.Request{
+			Texts:  []string{"hallo"},
+			Target: "en",
+		})
+
+		if test.expectErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.expectErr) {
+				t.Errorf("test %d: expected error containing %q, got %v", i, test.expectErr, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+			continue
+		}
+
+		if !slices.Equal(res.Texts, test.expectTexts) {
+			t.Errorf("test %d: expected texts %v, got %v", i, test.expectTexts, res.Texts)
+		}
+
+		if res.DetectedLanguage != test.expectLang {
+			t.Errorf("test %d: expected detected language %q, got %q", i, test.expectLang, res.DetectedLanguage)
+		}
+	}
+}
@@ -28,6 +28,7 @@
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-byteutil"
 	"codeberg.org/gruf/go-cache/v3"
@@ -36,6 +37,8 @@
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 )
 
@@ -54,6 +57,7 @@ type controller struct {
 	clock     pub.Clock
 	client    pub.HttpClient
 	trspCache cache.TTLCache[string, *transport]
+	probes    cache.TTLCache[string, struct{}]
 	userAgent string
 }
 
@@ -71,12 +75,44 @@ func NewController(state *state.State, federatingDB federatingdb.DB, clock pub.C
 		clock:     clock,
 		client:    client,
 		trspCache: cache.NewTTL[string, *transport](0, 100, 0),
+		probes:    cache.NewTTL[string, struct{}](0, 512, 0),
 		userAgent: fmt.Sprintf("gotosocial/%s (+%s://%s)", version, proto, host),
 	}
 
+	// Allow one probe delivery per
+	// hour to unavailable instances.
+	c.probes.SetTTL(time.Hour, false)
+	if !c.probes.Start(time.Minute) {
+		log.Panic(nil, "failed to start transport controller probes cache")
+	}
+
 	return c
 }
 
+// skipUnavailable returns whether delivery to the given host
+// should be skipped, due to its instance having been marked as
+// unavailable. One delivery per hour is allowed through to act
+// as a probe, which will clear the mark on success.
+func (c *controller) skipUnavailable(ctx context.Context, host string) bool {
+	instance, err := c.state.DB.GetInstance(
+		gtscontext.SetBarebones(ctx),
+		host,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting instance %s: %v", host, err)
+		return false
+	}
+
+	if instance == nil || !instance.IsUnavailable() {
+		// Not marked unavailable.
+		return false
+	}
+
+	// Let through a single
+	// probe delivery per hour.
+	return !c.probes.Add(host, struct{}{})
+}
+
 func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Transport, error) {
 	// Generate public key string for cache key
 	//
@@ -30,6 +30,7 @@
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
 )
 
@@ -63,6 +64,12 @@ func (t *transport) BatchDeliver(ctx context.Context, obj map[string]interface{}
 			continue
 		}
 
+		// Skip delivery to recipient if instance is unavailable.
+		if t.controller.skipUnavailable(ctx, to.Host) {
+			log.Debugf(ctx, "skipping delivery to unavailable instance %s", to.Host)
+			continue
+		}
+
 		// Prepare http client request.
 		req, err := t.prepare(ctx,
 			actID,
@@ -93,6 +100,12 @@ func (t *transport) Deliver(ctx context.Context, obj map[string]interface{}, to
 		return nil
 	}
 
+	// Skip delivery if recipient instance is unavailable.
+	if t.controller.skipUnavailable(ctx, to.Host) {
+		log.Debugf(ctx, "skipping delivery to unavailable instance %s", to.Host)
+		return nil
+	}
+
 	// Marshal object as JSON.
 	b, err := json.Marshal(obj)
 	if err != nil {
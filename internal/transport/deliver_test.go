@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DeliverTestSuite struct {
+	TransportTestSuite
+}
+
+func (suite *DeliverTestSuite) TestDeliverUnavailable() {
+	ctx := context.Background()
+
+	// Mark an instance as unavailable.
+	instance, err := suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.UnavailableAt = time.Now()
+	if err := suite.db.UpdateInstance(ctx, instance, "unavailable_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	obj := map[string]interface{}{"type": "Create"}
+	recipients := []*url.URL{
+		testrig.URLMustParse("https://fossbros-anonymous.io/inbox"),
+		testrig.URLMustParse("https://example.org/inbox"),
+	}
+
+	// First delivery to the unavailable instance
+	// should be let through as a probe.
+	if err := suite.transport.BatchDeliver(ctx, obj, recipients); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, suite.state.Workers.Delivery.Queue.Len())
+
+	// Further deliveries should be skipped.
+	if err := suite.transport.BatchDeliver(ctx, obj, recipients); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(3, suite.state.Workers.Delivery.Queue.Len())
+}
+
+func TestDeliverTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliverTestSuite))
+}
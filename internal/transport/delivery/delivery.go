@@ -54,7 +54,8 @@ type Delivery struct {
 	Request *httpclient.Request
 
 	// internal fields.
-	next time.Time
+	next     time.Time
+	attempts int
 }
 
 // delivery is an internal type
@@ -149,6 +150,12 @@ func (dlv *Delivery) Deserialize(data []byte) error {
 	return nil
 }
 
+// Attempts returns the number of delivery
+// attempts made so far for this Delivery{}.
+func (dlv *Delivery) Attempts() int {
+	return dlv.attempts
+}
+
 // backoff returns a valid (>= 0) backoff duration.
 func (dlv *Delivery) backoff() time.Duration {
 	if dlv.next.IsZero() {
@@ -20,12 +20,14 @@
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
 	"time"
 
 	"codeberg.org/gruf/go-runners"
 	"codeberg.org/gruf/go-structr"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/queue"
@@ -44,10 +46,27 @@ type WorkerPool struct {
 	// passed to each of delivery pool Worker{}s.
 	Queue queue.StructQueue[*Delivery]
 
+	// Failed is passed to each of delivery pool
+	// Worker{}s, to be called on failed deliveries.
+	Failed FailedFunc
+
+	// Delivered is passed to each of delivery pool Worker{}s,
+	// to be called on successfully completed deliveries.
+	Delivered DeliveredFunc
+
 	// internal fields.
 	workers []*Worker
 }
 
+// FailedFunc is called with any Delivery{} that
+// could not be delivered, along with the status
+// code (if any) and error of the final attempt.
+type FailedFunc func(ctx context.Context, dlv *Delivery, statusCode int, err error)
+
+// DeliveredFunc is called with any Delivery{}
+// that was successfully delivered to its target.
+type DeliveredFunc func(ctx context.Context, dlv *Delivery)
+
 // Init will initialize the Worker{} pool
 // with given http client, request queue to pull
 // from and number of delivery workers to spawn.
@@ -79,6 +98,8 @@ func (p *WorkerPool) Start(n int) {
 		p.workers[i] = new(Worker)
 		p.workers[i].Client = p.Client
 		p.workers[i].Queue = &p.Queue
+		p.workers[i].Failed = p.Failed
+		p.workers[i].Delivered = p.Delivered
 
 		// Attempt to start worker.
 		// Return bool not useful
@@ -124,6 +145,16 @@ type Worker struct {
 	// that delivery worker will feed from.
 	Queue *queue.StructQueue[*Delivery]
 
+	// Failed is an optional function to call
+	// with deliveries that were not delivered,
+	// either because they reached max retries,
+	// or encountered a non-retryable error.
+	Failed FailedFunc
+
+	// Delivered is an optional function to
+	// call with successfully sent deliveries.
+	Delivered DeliveredFunc
+
 	// internal fields.
 	backlog []*Delivery
 	service runners.Service
@@ -202,14 +233,27 @@ func (w *Worker) process(ctx context.Context) bool {
 		}
 
 		// Attempt delivery of AP request.
+		dlv.attempts++
 		rsp, retry, err := w.Client.DoOnce(
 			dlv.Request,
 		)
 
 		switch {
+		case err == nil && rsp.StatusCode >= 400:
+			// Ensure body closed.
+			_ = rsp.Body.Close()
+
+			// Remote rejected the
+			// delivery, this will
+			// not succeed on retry.
+			err = fmt.Errorf("http response: %s", rsp.Status)
+			w.fail(ctx, dlv, rsp.StatusCode, err)
+			continue loop
+
 		case err == nil:
 			// Ensure body closed.
 			_ = rsp.Body.Close()
+			w.delivered(ctx, dlv)
 			continue loop
 
 		case errors.Is(err, context.Canceled) &&
@@ -230,6 +274,7 @@ func (w *Worker) process(ctx context.Context) bool {
 			// Drop deliveries when no
 			// retry requested, or they
 			// reached max (either).
+			w.fail(ctx, dlv, gtserror.StatusCode(err), err)
 			continue loop
 		}
 
@@ -242,6 +287,22 @@ func (w *Worker) process(ctx context.Context) bool {
 	}
 }
 
+// fail passes the given failed delivery to
+// the worker's Failed function, if it is set.
+func (w *Worker) fail(ctx context.Context, dlv *Delivery, statusCode int, err error) {
+	if w.Failed != nil {
+		w.Failed(ctx, dlv, statusCode, err)
+	}
+}
+
+// delivered passes the given delivery to the
+// worker's Delivered function, if it is set.
+func (w *Worker) delivered(ctx context.Context, dlv *Delivery) {
+	if w.Delivered != nil {
+		w.Delivered(ctx, dlv)
+	}
+}
+
 // next gets the next available delivery, blocking until available if necessary.
 func (w *Worker) next(ctx context.Context) (*Delivery, bool) {
 	// Try a fast-pop of queued
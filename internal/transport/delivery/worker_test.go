@@ -19,6 +19,7 @@
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"math/rand"
@@ -54,6 +55,114 @@ func testDeliveryWorkerPool(t *testing.T, sz int, input []*testrequest) {
 	test(t, &wp.Queue, input)
 }
 
+func TestDeliveryWorkerPoolFailed(t *testing.T) {
+	type failure struct {
+		dlv  *delivery.Delivery
+		code int
+		err  error
+	}
+
+	failures := make(chan failure, 1)
+
+	wp := new(delivery.WorkerPool)
+	wp.Init(httpclient.New(httpclient.Config{
+		AllowRanges: config.MustParseIPPrefixes([]string{
+			"127.0.0.0/8",
+		}),
+	}))
+	wp.Failed = func(_ context.Context, dlv *delivery.Delivery, code int, err error) {
+		failures <- failure{dlv, code, err}
+	}
+	wp.Start(1)
+	defer wp.Stop()
+
+	// Start an HTTP server that rejects everything.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	srv := new(http.Server)
+	srv.Addr = "http://" + l.Addr().String()
+	srv.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusGone)
+	})
+	go srv.Serve(l)
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodPost, srv.Addr+"/inbox", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dlv := new(delivery.Delivery)
+	dlv.Request = httpclient.WrapRequest(req)
+	wp.Queue.Push(dlv)
+
+	// A non-retryable error response should
+	// be passed straight to the Failed func.
+	f := <-failures
+	if f.dlv != dlv {
+		t.Errorf("unexpected delivery passed to Failed func")
+	}
+	if f.code != http.StatusGone {
+		t.Errorf("expected status code %d, got %d", http.StatusGone, f.code)
+	}
+	if f.err == nil {
+		t.Errorf("expected error, got nil")
+	}
+	if n := f.dlv.Attempts(); n != 1 {
+		t.Errorf("expected 1 attempt, got %d", n)
+	}
+}
+
+func TestDeliveryWorkerPoolDelivered(t *testing.T) {
+	delivered := make(chan *delivery.Delivery, 1)
+
+	wp := new(delivery.WorkerPool)
+	wp.Init(httpclient.New(httpclient.Config{
+		AllowRanges: config.MustParseIPPrefixes([]string{
+			"127.0.0.0/8",
+		}),
+	}))
+	wp.Delivered = func(_ context.Context, dlv *delivery.Delivery) {
+		delivered <- dlv
+	}
+	wp.Start(1)
+	defer wp.Stop()
+
+	// Start an HTTP server that accepts everything.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	srv := new(http.Server)
+	srv.Addr = "http://" + l.Addr().String()
+	srv.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusAccepted)
+	})
+	go srv.Serve(l)
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodPost, srv.Addr+"/inbox", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dlv := new(delivery.Delivery)
+	dlv.Request = httpclient.WrapRequest(req)
+	wp.Queue.Push(dlv)
+
+	// A successful response should be
+	// passed to the Delivered func.
+	if got := <-delivered; got != dlv {
+		t.Errorf("unexpected delivery passed to Delivered func")
+	}
+}
+
 func test(
 	t *testing.T,
 	queue *queue.StructQueue[*delivery.Delivery],
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"net/http"
+
+	"codeberg.org/gruf/go-bytesize"
+	"codeberg.org/gruf/go-iotools"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// maxDomainPermsSize is the maximum size
+// of domain permission list we will read.
+const maxDomainPermsSize = 16 * bytesize.MiB
+
+func (t *transport) DereferenceDomainPermissions(
+	ctx context.Context,
+	permSub *gtsmodel.DomainPermissionSubscription,
+	skipCache bool,
+) (*http.Response, error) {
+	// Prepare HTTP request to the subscription's list URI.
+	req, err := http.NewRequestWithContext(ctx, "GET", permSub.URI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set basic auth header if necessary.
+	if permSub.FetchUsername != "" || permSub.FetchPassword != "" {
+		req.SetBasicAuth(permSub.FetchUsername, permSub.FetchPassword)
+	}
+
+	// Set relevant Accept header
+	// for the subscription's
+	// configured content type.
+	req.Header.Add("Accept", permSub.ContentType.String())
+
+	if !skipCache {
+		// Set If-None-Match header if we have an ETag.
+		if permSub.ETag != "" {
+			req.Header.Set("If-None-Match", permSub.ETag)
+		}
+
+		// Set If-Modified-Since header if we have a Last-Modified.
+		if !permSub.LastModified.IsZero() {
+			req.Header.Set("If-Modified-Since", permSub.LastModified.UTC().Format(http.TimeFormat))
+		}
+	}
+
+	// Set our predefined controller user-agent.
+	req.Header.Set("User-Agent", t.controller.userAgent)
+
+	// Perform the HTTP request. Note that unlike
+	// other dereferences this request is NOT signed,
+	// as domain permission lists are not ActivityPub
+	// resources, and may not even be hosted on a
+	// fediverse instance at all.
+	rsp, err := t.controller.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the list hasn't changed
+	// since last fetch, there's
+	// nothing more for us to do.
+	if rsp.StatusCode == http.StatusNotModified {
+		_ = rsp.Body.Close()
+		return nil, nil
+	}
+
+	// Check for an expected status code.
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+
+	// Check body within size limit.
+	if rsp.ContentLength > int64(maxDomainPermsSize) {
+		_ = rsp.Body.Close()
+		return nil, gtserror.Newf("domain permissions list exceeds max size %s", maxDomainPermsSize)
+	}
+
+	// Update response body with maximum supported size.
+	rsp.Body, _, _ = iotools.UpdateReadCloserLimit(rsp.Body, int64(maxDomainPermsSize))
+
+	return rsp, nil
+}
@@ -0,0 +1,68 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"codeberg.org/gruf/go-bytesize"
+	"codeberg.org/gruf/go-iotools"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (t *transport) DereferenceLink(ctx context.Context, iri *url.URL, accept string, maxsz int64) (*http.Response, error) {
+	// Build IRI just once
+	iriStr := iri.String()
+
+	// Prepare HTTP request to this link's IRI
+	req, err := http.NewRequestWithContext(ctx, "GET", iriStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", accept)
+
+	// Set our predefined controller user-agent.
+	req.Header.Set("User-Agent", t.controller.userAgent)
+
+	// Perform the HTTP request. Note that unlike
+	// other dereferences this request is NOT signed,
+	// as linked pages are not ActivityPub resources.
+	rsp, err := t.controller.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for an expected status code
+	if rsp.StatusCode != http.StatusOK {
+		return nil, gtserror.NewFromResponse(rsp)
+	}
+
+	// Check body within size limit.
+	if rsp.ContentLength > maxsz {
+		_ = rsp.Body.Close()       // close early.
+		sz := bytesize.Size(maxsz) //nolint:gosec
+		return nil, gtserror.Newf("link body exceeds max size %s", sz)
+	}
+
+	// Update response body with maximum supported size.
+	rsp.Body, _, _ = iotools.UpdateReadCloserLimit(rsp.Body, maxsz)
+
+	return rsp, nil
+}
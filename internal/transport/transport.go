@@ -75,6 +75,22 @@ type Transport interface {
 	// DereferenceMedia fetches the given media attachment IRI, returning the reader limited to given max.
 	DereferenceMedia(ctx context.Context, iri *url.URL, maxsz int64) (io.ReadCloser, error)
 
+	// DereferenceLink fetches the web page (or other non-ActivityPub resource) at given IRI
+	// without signing the request, returning the response with body limited to given max.
+	DereferenceLink(ctx context.Context, iri *url.URL, accept string, maxsz int64) (*http.Response, error)
+
+	// DereferenceDomainPermissions dereferences the
+	// permissions list present at the given permSub's URI.
+	//
+	// If "skipCache" is true, caching headers from
+	// previous fetches will not be sent, so the list
+	// is always fetched in full.
+	//
+	// If the response status code is 304 (Not Modified),
+	// then the returned response will be nil, indicating
+	// the list hasn't changed since the last fetch.
+	DereferenceDomainPermissions(ctx context.Context, permSub *gtsmodel.DomainPermissionSubscription, skipCache bool) (*http.Response, error)
+
 	// DereferenceInstance dereferences remote instance information, first by checking /api/v1/instance, and then by checking /.well-known/nodeinfo.
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
 
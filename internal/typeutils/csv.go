@@ -20,6 +20,7 @@
 import (
 	"cmp"
 	"context"
+	"net/url"
 	"slices"
 	"strconv"
 
@@ -553,3 +554,210 @@ func (c *Converter) CSVToBlocks(
 
 	return blocks, nil
 }
+
+// CSVToMutes converts a slice of CSV records
+// to a slice of barebones *gtsmodel.UserMute's,
+// ready for further processing.
+//
+// Only TargetAccount.Username, TargetAccount.Domain,
+// and Notifications will be set on each UserMute.
+func (c *Converter) CSVToMutes(
+	ctx context.Context,
+	records [][]string,
+) ([]*gtsmodel.UserMute, error) {
+	// We need to know our own domain for this.
+	// Try account domain, fall back to host.
+	var (
+		thisHost          = config.GetHost()
+		thisAccountDomain = config.GetAccountDomain()
+		mutes             = make([]*gtsmodel.UserMute, 0, len(records))
+	)
+
+	for _, record := range records {
+		recordLen := len(record)
+
+		// Be lenient about whether or not
+		// the "Hide notifications" column
+		// is included.
+		if recordLen == 0 ||
+			recordLen > 2 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// "Account address"
+		namestring := record[0]
+		if namestring == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if namestring == "Account address" {
+			// CSV header row,
+			// skip this one.
+			continue
+		}
+
+		// Prepend with "@"
+		// if not included.
+		if namestring[0] != '@' {
+			namestring = "@" + namestring
+		}
+
+		username, domain, err := util.ExtractNamestringParts(namestring)
+		if err != nil {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if domain == thisHost || domain == thisAccountDomain {
+			// Clear the domain,
+			// since it's ours.
+			domain = ""
+		}
+
+		// "Hide notifications"
+		//
+		// Mastodon defaults to
+		// true if not included.
+		notifications := true
+		if recordLen > 1 {
+			b, err := strconv.ParseBool(record[1])
+			if err != nil {
+				// Badly formatted,
+				// skip this one.
+				continue
+			}
+			notifications = b
+		}
+
+		// Looks good, whack it in the slice.
+		mutes = append(mutes, &gtsmodel.UserMute{
+			TargetAccount: &gtsmodel.Account{
+				Username: username,
+				Domain:   domain,
+			},
+			Notifications: &notifications,
+		})
+	}
+
+	return mutes, nil
+}
+
+// CSVToLists converts a slice of CSV records
+// to a map of list titles to barebones
+// *gtsmodel.Follow's, ready for further processing.
+//
+// Only TargetAccount.Username and TargetAccount.Domain
+// will be set on each Follow.
+func (c *Converter) CSVToLists(
+	ctx context.Context,
+	records [][]string,
+) (map[string][]*gtsmodel.Follow, error) {
+	// We need to know our own domain for this.
+	// Try account domain, fall back to host.
+	var (
+		thisHost          = config.GetHost()
+		thisAccountDomain = config.GetAccountDomain()
+		lists             = make(map[string][]*gtsmodel.Follow)
+	)
+
+	for _, record := range records {
+		if len(record) != 2 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// "List title"
+		title := record[0]
+		if title == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// "Account address"
+		namestring := record[1]
+		if namestring == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// Prepend with "@"
+		// if not included.
+		if namestring[0] != '@' {
+			namestring = "@" + namestring
+		}
+
+		username, domain, err := util.ExtractNamestringParts(namestring)
+		if err != nil {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if domain == thisHost || domain == thisAccountDomain {
+			// Clear the domain,
+			// since it's ours.
+			domain = ""
+		}
+
+		// Looks good, whack it in the map.
+		lists[title] = append(lists[title], &gtsmodel.Follow{
+			TargetAccount: &gtsmodel.Account{
+				Username: username,
+				Domain:   domain,
+			},
+		})
+	}
+
+	return lists, nil
+}
+
+// CSVToBookmarks converts a slice of CSV records
+// to a slice of barebones *gtsmodel.StatusBookmark's,
+// ready for further processing.
+//
+// Only Status.URI will be set on each StatusBookmark.
+func (c *Converter) CSVToBookmarks(
+	ctx context.Context,
+	records [][]string,
+) ([]*gtsmodel.StatusBookmark, error) {
+	bookmarks := make([]*gtsmodel.StatusBookmark, 0, len(records))
+
+	for _, record := range records {
+		if len(record) != 1 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		uri := record[0]
+		if uri == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		u, err := url.Parse(uri)
+		if err != nil || u.Scheme != "https" && u.Scheme != "http" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// Looks good, whack it in the slice.
+		bookmarks = append(bookmarks, &gtsmodel.StatusBookmark{
+			Status: &gtsmodel.Status{
+				URI: uri,
+			},
+		})
+	}
+
+	return bookmarks, nil
+}
@@ -39,9 +39,28 @@
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
-// AccountToAS converts a gts model account into an activity streams person, suitable for federation
-func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsPerson, error) {
-	person := streams.NewActivityStreamsPerson()
+// asActor is an ActivityStreams actor type that local
+// accounts are serialized as, including access to any
+// unknown (extension) properties that we set by hand.
+type asActor interface {
+	ap.Accountable
+	SetActivityStreamsImage(vocab.ActivityStreamsImageProperty)
+	GetUnknownProperties() map[string]interface{}
+}
+
+// newAccountable returns a new, empty ActivityStreams
+// actor of the appropriate type for the given account.
+func newAccountable(a *gtsmodel.Account) asActor {
+	if a.IsGroup() {
+		return streams.NewActivityStreamsGroup()
+	}
+	return streams.NewActivityStreamsPerson()
+}
+
+// AccountToAS converts a gts model account into an activity streams
+// actor (a Group for group accounts, else a Person), suitable for federation.
+func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (ap.Accountable, error) {
+	accountable := newAccountable(a)
 
 	// id should be the activitypub URI of this user
 	// something like https://example.org/users/example_user
@@ -51,7 +70,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	idProp := streams.NewJSONLDIdProperty()
 	idProp.SetIRI(profileIDURI)
-	person.SetJSONLDId(idProp)
+	accountable.SetJSONLDId(idProp)
 
 	// following
 	// The URI for retrieving a list of accounts this user is following
@@ -61,7 +80,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	followingProp := streams.NewActivityStreamsFollowingProperty()
 	followingProp.SetIRI(followingURI)
-	person.SetActivityStreamsFollowing(followingProp)
+	accountable.SetActivityStreamsFollowing(followingProp)
 
 	// followers
 	// The URI for retrieving a list of this user's followers
@@ -71,7 +90,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	followersProp := streams.NewActivityStreamsFollowersProperty()
 	followersProp.SetIRI(followersURI)
-	person.SetActivityStreamsFollowers(followersProp)
+	accountable.SetActivityStreamsFollowers(followersProp)
 
 	// inbox
 	// the activitypub inbox of this user for accepting messages
@@ -81,7 +100,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	inboxProp := streams.NewActivityStreamsInboxProperty()
 	inboxProp.SetIRI(inboxURI)
-	person.SetActivityStreamsInbox(inboxProp)
+	accountable.SetActivityStreamsInbox(inboxProp)
 
 	// shared inbox -- only add this if we know for sure it has one
 	if a.SharedInboxURI != nil && *a.SharedInboxURI != "" {
@@ -95,7 +114,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 		sharedInboxProp.SetIRI(sharedInboxURI)
 		endpoints.SetActivityStreamsSharedInbox(sharedInboxProp)
 		endpointsProp.AppendActivityStreamsEndpoints(endpoints)
-		person.SetActivityStreamsEndpoints(endpointsProp)
+		accountable.SetActivityStreamsEndpoints(endpointsProp)
 	}
 
 	// outbox
@@ -106,7 +125,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	outboxProp := streams.NewActivityStreamsOutboxProperty()
 	outboxProp.SetIRI(outboxURI)
-	person.SetActivityStreamsOutbox(outboxProp)
+	accountable.SetActivityStreamsOutbox(outboxProp)
 
 	// featured posts
 	// Pinned posts.
@@ -116,16 +135,21 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	featuredProp := streams.NewTootFeaturedProperty()
 	featuredProp.SetIRI(featuredURI)
-	person.SetTootFeatured(featuredProp)
+	accountable.SetTootFeatured(featuredProp)
 
 	// featuredTags
-	// NOT IMPLEMENTED
+	// Featured hashtags, only
+	// served for local accounts.
+	if a.IsLocal() {
+		featuredTagsURI := uris.GenerateURIsForAccount(a.Username).FeaturedTagsURI
+		accountable.GetUnknownProperties()["featuredTags"] = featuredTagsURI
+	}
 
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
 	preferredUsernameProp := streams.NewActivityStreamsPreferredUsernameProperty()
 	preferredUsernameProp.SetXMLSchemaString(a.Username)
-	person.SetActivityStreamsPreferredUsername(preferredUsernameProp)
+	accountable.SetActivityStreamsPreferredUsername(preferredUsernameProp)
 
 	// name
 	// Used as profile display name.
@@ -135,14 +159,14 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	} else {
 		nameProp.AppendXMLSchemaString(a.Username)
 	}
-	person.SetActivityStreamsName(nameProp)
+	accountable.SetActivityStreamsName(nameProp)
 
 	// summary
 	// Used as profile bio.
 	if a.Note != "" {
 		summaryProp := streams.NewActivityStreamsSummaryProperty()
 		summaryProp.AppendXMLSchemaString(a.Note)
-		person.SetActivityStreamsSummary(summaryProp)
+		accountable.SetActivityStreamsSummary(summaryProp)
 	}
 
 	// url
@@ -153,19 +177,19 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	}
 	urlProp := streams.NewActivityStreamsUrlProperty()
 	urlProp.AppendIRI(profileURL)
-	person.SetActivityStreamsUrl(urlProp)
+	accountable.SetActivityStreamsUrl(urlProp)
 
 	// manuallyApprovesFollowers
 	// Will be shown as a locked account.
 	manuallyApprovesFollowersProp := streams.NewActivityStreamsManuallyApprovesFollowersProperty()
 	manuallyApprovesFollowersProp.Set(*a.Locked)
-	person.SetActivityStreamsManuallyApprovesFollowers(manuallyApprovesFollowersProp)
+	accountable.SetActivityStreamsManuallyApprovesFollowers(manuallyApprovesFollowersProp)
 
 	// discoverable
 	// Will be shown in the profile directory.
 	discoverableProp := streams.NewTootDiscoverableProperty()
 	discoverableProp.Set(*a.Discoverable)
-	person.SetTootDiscoverable(discoverableProp)
+	accountable.SetTootDiscoverable(discoverableProp)
 
 	// devices
 	// NOT IMPLEMENTED, probably won't implement
@@ -183,7 +207,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 			alsoKnownAsURIs[i] = uri
 		}
 
-		ap.SetAlsoKnownAs(person, alsoKnownAsURIs)
+		ap.SetAlsoKnownAs(accountable, alsoKnownAsURIs)
 	}
 
 	// movedTo
@@ -194,7 +218,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 			return nil, err
 		}
 
-		ap.SetMovedTo(person, movedTo)
+		ap.SetMovedTo(accountable, movedTo)
 	}
 
 	// publicKey
@@ -234,8 +258,8 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	// append the public key to the public key property
 	publicKeyProp.AppendW3IDSecurityV1PublicKey(publicKey)
 
-	// set the public key property on the Person
-	person.SetW3IDSecurityV1PublicKey(publicKeyProp)
+	// set the public key property on the actor
+	accountable.SetW3IDSecurityV1PublicKey(publicKeyProp)
 
 	// tags
 	tagProp := streams.NewActivityStreamsTagProperty()
@@ -263,7 +287,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	// tag -- hashtags
 	// TODO
 
-	person.SetActivityStreamsTag(tagProp)
+	accountable.SetActivityStreamsTag(tagProp)
 
 	// attachment
 	// Used for profile fields.
@@ -284,7 +308,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 			attachmentProp.AppendSchemaPropertyValue(propertyValue)
 		}
 
-		person.SetActivityStreamsAttachment(attachmentProp)
+		accountable.SetActivityStreamsAttachment(attachmentProp)
 	}
 
 	// endpoints
@@ -320,7 +344,7 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 			iconImage.SetActivityStreamsUrl(avatarURLProperty)
 
 			iconProperty.AppendActivityStreamsImage(iconImage)
-			person.SetActivityStreamsIcon(iconProperty)
+			accountable.SetActivityStreamsIcon(iconProperty)
 		}
 	}
 
@@ -354,20 +378,21 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 			headerImage.SetActivityStreamsUrl(headerURLProperty)
 
 			headerProperty.AppendActivityStreamsImage(headerImage)
-			person.SetActivityStreamsImage(headerProperty)
+			accountable.SetActivityStreamsImage(headerProperty)
 		}
 	}
 
-	return person, nil
+	return accountable, nil
 }
 
-// AccountToASMinimal converts a gts model account into an activity streams person, suitable for federation.
+// AccountToASMinimal converts a gts model account into an activity streams
+// actor (a Group for group accounts, else a Person), suitable for federation.
 //
 // The returned account will just have the Type, Username, PublicKey, and ID properties set. This is
 // suitable for serving to requesters to whom we want to give as little information as possible because
 // we don't trust them (yet).
-func (c *Converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsPerson, error) {
-	person := streams.NewActivityStreamsPerson()
+func (c *Converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account) (ap.Accountable, error) {
+	accountable := newAccountable(a)
 
 	// id should be the activitypub URI of this user
 	// something like https://example.org/users/example_user
@@ -377,13 +402,13 @@ func (c *Converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account)
 	}
 	idProp := streams.NewJSONLDIdProperty()
 	idProp.SetIRI(profileIDURI)
-	person.SetJSONLDId(idProp)
+	accountable.SetJSONLDId(idProp)
 
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
 	preferredUsernameProp := streams.NewActivityStreamsPreferredUsernameProperty()
 	preferredUsernameProp.SetXMLSchemaString(a.Username)
-	person.SetActivityStreamsPreferredUsername(preferredUsernameProp)
+	accountable.SetActivityStreamsPreferredUsername(preferredUsernameProp)
 
 	// publicKey
 	// Required for signatures.
@@ -422,10 +447,10 @@ func (c *Converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account)
 	// append the public key to the public key property
 	publicKeyProp.AppendW3IDSecurityV1PublicKey(publicKey)
 
-	// set the public key property on the Person
-	person.SetW3IDSecurityV1PublicKey(publicKeyProp)
+	// set the public key property on the actor
+	accountable.SetW3IDSecurityV1PublicKey(publicKeyProp)
 
-	return person, nil
+	return accountable, nil
 }
 
 // StatusToAS converts a gts model status into an ActivityStreams Statusable implementation, suitable for federation
@@ -489,6 +514,11 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 	publishedProp.Set(s.CreatedAt)
 	status.SetActivityStreamsPublished(publishedProp)
 
+	// updated
+	if !s.EditedAt.IsZero() {
+		ap.SetUpdated(status, s.EditedAt)
+	}
+
 	// url
 	if s.URL != "" {
 		sURL, err := url.Parse(s.URL)
@@ -965,6 +995,51 @@ func (c *Converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow) (vocab.A
 	return follow, nil
 }
 
+// RelayToASFollow converts a gts model relay into an AS Follow, sent by
+// the given instance account, suitable for subscribing to the relay.
+//
+// As is convention for relays, the object of the Follow is the
+// ActivityStreams Public collection rather than the relay actor.
+func (c *Converter) RelayToASFollow(
+	_ context.Context,
+	relay *gtsmodel.Relay,
+	instanceAcct *gtsmodel.Account,
+) (vocab.ActivityStreamsFollow, error) {
+	actorIRI, err := url.Parse(instanceAcct.URI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing instance account uri: %w", err)
+	}
+
+	followIRI, err := url.Parse(relay.FollowURI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing follow uri: %w", err)
+	}
+
+	publicIRI, err := url.Parse(pub.PublicActivityPubIRI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing public uri: %w", err)
+	}
+
+	follow := streams.NewActivityStreamsFollow()
+
+	// Set the id.
+	followIDProp := streams.NewJSONLDIdProperty()
+	followIDProp.SetIRI(followIRI)
+	follow.SetJSONLDId(followIDProp)
+
+	// Set the actor.
+	followActorProp := streams.NewActivityStreamsActorProperty()
+	followActorProp.AppendIRI(actorIRI)
+	follow.SetActivityStreamsActor(followActorProp)
+
+	// Set the object.
+	followObjectProp := streams.NewActivityStreamsObjectProperty()
+	followObjectProp.AppendIRI(publicIRI)
+	follow.SetActivityStreamsObject(followObjectProp)
+
+	return follow, nil
+}
+
 // MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
 func (c *Converter) MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab.ActivityStreamsMention, error) {
 	if m.TargetAccount == nil {
@@ -1647,6 +1722,44 @@ func (c *Converter) StatusesToASFeaturedCollection(ctx context.Context, featured
 	return collection, nil
 }
 
+// FeaturedTagsToASCollection converts a slice of featured tags into a collection
+// of toot Hashtags, suitable for serializing and serving via the activitypub API.
+func (c *Converter) FeaturedTagsToASCollection(ctx context.Context, featuredTagsCollectionID string, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error) {
+	collection := streams.NewActivityStreamsCollection()
+
+	collectionIDProp := streams.NewJSONLDIdProperty()
+	featuredTagsCollectionIDURI, err := url.Parse(featuredTagsCollectionID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", featuredTagsCollectionID)
+	}
+	collectionIDProp.SetIRI(featuredTagsCollectionIDURI)
+	collection.SetJSONLDId(collectionIDProp)
+
+	itemsProp := streams.NewActivityStreamsItemsProperty()
+	for _, f := range featuredTags {
+		if f.Tag == nil {
+			// Ensure featured tag's tag is populated.
+			f.Tag, err = c.state.DB.GetTag(ctx, f.TagID)
+			if err != nil {
+				return nil, gtserror.Newf("error getting featured tag tag: %w", err)
+			}
+		}
+
+		tag, err := c.TagToAS(ctx, f.Tag)
+		if err != nil {
+			return nil, gtserror.Newf("error converting tag %s to AS: %w", f.Tag.Name, err)
+		}
+		itemsProp.AppendTootHashtag(tag)
+	}
+	collection.SetActivityStreamsItems(itemsProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(featuredTags))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	return collection, nil
+}
+
 // ReportToASFlag converts a gts model report into an activitystreams FLAG, suitable for federation.
 func (c *Converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (vocab.ActivityStreamsFlag, error) {
 	flag := streams.NewActivityStreamsFlag()
@@ -57,12 +57,17 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
         "@id": "toot:featured",
         "@type": "@id"
       },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
   "discoverable": true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -117,6 +122,10 @@ func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
         "@id": "toot:featured",
         "@type": "@id"
       },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
       "schema": "http://schema.org#",
       "toot": "http://joinmastodon.org/ns#",
@@ -137,6 +146,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
   ],
   "discoverable": false,
   "featured": "http://localhost:8080/users/1happyturtle/collections/featured",
+  "featuredTags": "http://localhost:8080/users/1happyturtle/collections/tags",
   "followers": "http://localhost:8080/users/1happyturtle/followers",
   "following": "http://localhost:8080/users/1happyturtle/following",
   "id": "http://localhost:8080/users/1happyturtle",
@@ -194,6 +204,10 @@ func (suite *InternalToASTestSuite) TestAccountToASAliasedAndMoved() {
         "@id": "toot:featured",
         "@type": "@id"
       },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
       "movedTo": {
         "@id": "as:movedTo",
@@ -207,6 +221,7 @@ func (suite *InternalToASTestSuite) TestAccountToASAliasedAndMoved() {
   ],
   "discoverable": true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -264,6 +279,10 @@ func (suite *InternalToASTestSuite) TestAccountToASWithOneField() {
         "@id": "toot:featured",
         "@type": "@id"
       },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
       "schema": "http://schema.org#",
       "toot": "http://joinmastodon.org/ns#",
@@ -279,6 +298,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithOneField() {
   ],
   "discoverable": false,
   "featured": "http://localhost:8080/users/1happyturtle/collections/featured",
+  "featuredTags": "http://localhost:8080/users/1happyturtle/collections/tags",
   "followers": "http://localhost:8080/users/1happyturtle/followers",
   "following": "http://localhost:8080/users/1happyturtle/following",
   "id": "http://localhost:8080/users/1happyturtle",
@@ -324,12 +344,17 @@ func (suite *InternalToASTestSuite) TestAccountToASWithEmoji() {
         "@id": "toot:featured",
         "@type": "@id"
       },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
       "toot": "http://joinmastodon.org/ns#"
     }
   ],
   "discoverable": true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -395,6 +420,10 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
         "@id": "toot:featured",
         "@type": "@id"
       },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
       "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
       "toot": "http://joinmastodon.org/ns#"
     }
@@ -404,6 +433,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
     "sharedInbox": "http://localhost:8080/sharedInbox"
   },
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -434,6 +464,71 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestAccountToASGroup() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+	testAccount.ActorType = ap.ActorGroup
+
+	asGroup, err := suite.typeconverter.AccountToAS(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asGroup)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": [
+    "https://w3id.org/security/v1",
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "discoverable": "toot:discoverable",
+      "featured": {
+        "@id": "toot:featured",
+        "@type": "@id"
+      },
+      "featuredTags": {
+        "@id": "toot:featuredTags",
+        "@type": "@id"
+      },
+      "manuallyApprovesFollowers": "as:manuallyApprovesFollowers",
+      "toot": "http://joinmastodon.org/ns#"
+    }
+  ],
+  "discoverable": true,
+  "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
+  "followers": "http://localhost:8080/users/the_mighty_zork/followers",
+  "following": "http://localhost:8080/users/the_mighty_zork/following",
+  "icon": {
+    "mediaType": "image/jpeg",
+    "type": "Image",
+    "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/original/01F8MH58A357CV5K7R7TJMSH6S.jpg"
+  },
+  "id": "http://localhost:8080/users/the_mighty_zork",
+  "image": {
+    "mediaType": "image/jpeg",
+    "type": "Image",
+    "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/header/original/01PFPMWK2FF0D9WMHEJHR07C3Q.jpg"
+  },
+  "inbox": "http://localhost:8080/users/the_mighty_zork/inbox",
+  "manuallyApprovesFollowers": false,
+  "name": "original zork (he/they)",
+  "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
+  "preferredUsername": "the_mighty_zork",
+  "publicKey": {
+    "id": "http://localhost:8080/users/the_mighty_zork/main-key",
+    "owner": "http://localhost:8080/users/the_mighty_zork",
+    "publicKeyPem": "-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwXTcOAvM1Jiw5Ffpk0qn\nr0cwbNvFe/5zQ+Tp7tumK/ZnT37o7X0FUEXrxNi+dkhmeJ0gsaiN+JQGNUewvpSk\nPIAXKvi908aSfCGjs7bGlJCJCuDuL5d6m7hZnP9rt9fJc70GElPpG0jc9fXwlz7T\nlsPb2ecatmG05Y4jPwdC+oN4MNCv9yQzEvCVMzl76EJaM602kIHC1CISn0rDFmYd\n9rSN7XPlNJw1F6PbpJ/BWQ+pXHKw3OEwNTETAUNYiVGnZU+B7a7bZC9f6/aPbJuV\nt8Qmg+UnDvW1Y8gmfHnxaWG2f5TDBvCHmcYtucIZPLQD4trAozC4ryqlmCWQNKbt\n0wIDAQAB\n-----END PUBLIC KEY-----\n"
+  },
+  "summary": "\u003cp\u003ehey yo this is my profile!\u003c/p\u003e",
+  "tag": [],
+  "type": "Group",
+  "url": "http://localhost:8080/@the_mighty_zork"
+}`, string(bytes))
+}
+
 func (suite *InternalToASTestSuite) TestStatusToAS() {
 	testStatus := suite.testStatuses["local_account_1_status_1"]
 	ctx := context.Background()
@@ -1101,6 +1196,46 @@ func (suite *InternalToASTestSuite) TestPinnedStatusesToASOneItem() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestFeaturedTagsToASCollection() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["admin_account"]
+	featuredTags, err := suite.db.GetFeaturedTagsByAccountID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	collectionID := "http://localhost:8080/users/admin/collections/tags"
+	collection, err := suite.typeconverter.FeaturedTagsToASCollection(ctx, collectionID, featuredTags)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ser, err := ap.Serialize(collection)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "Hashtag": "as:Hashtag",
+      "toot": "http://joinmastodon.org/ns#"
+    }
+  ],
+  "id": "http://localhost:8080/users/admin/collections/tags",
+  "items": {
+    "href": "http://localhost:8080/tags/welcome",
+    "name": "#welcome",
+    "type": "Hashtag"
+  },
+  "totalItems": 1,
+  "type": "Collection"
+}`, string(bytes))
+}
+
 func (suite *InternalToASTestSuite) TestPollVoteToASCreate() {
 	vote := suite.testPollVotes["remote_account_1_status_2_poll_vote_local_account_1"]
 
@@ -98,6 +98,10 @@ func (c *Converter) UserToAPIUser(ctx context.Context, u *gtsmodel.User) *apimod
 		user.ResetPasswordSentAt = util.FormatISO8601(u.ResetPasswordSentAt)
 	}
 
+	if u.TwoFactorEnabled() {
+		user.TwoFactorEnabledAt = util.FormatISO8601(u.TwoFactorEnabledAt)
+	}
+
 	return user
 }
 
@@ -331,12 +335,16 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		// fetch more info. Skip for instance
 		// accounts since they have no user.
 		if !a.IsInstance() {
-			user, err := c.state.DB.GetUserByAccountID(ctx, a.ID)
-			if err != nil {
-				return nil, gtserror.Newf("error getting user from database for account id %s: %w", a.ID, err)
-			}
-			if role := c.UserToAPIAccountDisplayRole(user); role != nil {
-				roles = append(roles, *role)
+			// Groups have settings,
+			// but no user (or role).
+			if !a.IsGroup() {
+				user, err := c.state.DB.GetUserByAccountID(ctx, a.ID)
+				if err != nil {
+					return nil, gtserror.Newf("error getting user from database for account id %s: %w", a.ID, err)
+				}
+				if role := c.UserToAPIAccountDisplayRole(user); role != nil {
+					roles = append(roles, *role)
+				}
 			}
 
 			enableRSS = *a.Settings.EnableRSS
@@ -365,6 +373,7 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		Locked:            locked,
 		Discoverable:      discoverable,
 		Bot:               bot,
+		Group:             a.IsGroup(),
 		CreatedAt:         util.FormatISO8601(a.CreatedAt),
 		Note:              a.Note,
 		URL:               a.URL,
@@ -489,8 +498,8 @@ func (c *Converter) AccountToAPIAccountBlocked(ctx context.Context, a *gtsmodel.
 	} else {
 		// This is a local account, try to
 		// fetch more info. Skip for instance
-		// accounts since they have no user.
-		if !a.IsInstance() {
+		// accounts and groups since they have no user.
+		if !a.IsInstance() && !a.IsGroup() {
 			user, err := c.state.DB.GetUserByAccountID(ctx, a.ID)
 			if err != nil {
 				return nil, gtserror.Newf("error getting user from database for account id %s: %w", a.ID, err)
@@ -508,6 +517,7 @@ func (c *Converter) AccountToAPIAccountBlocked(ctx context.Context, a *gtsmodel.
 		Username:  a.Username,
 		Acct:      acct,
 		Bot:       *a.Bot,
+		Group:     a.IsGroup(),
 		CreatedAt: util.FormatISO8601(a.CreatedAt),
 		URL:       a.URL,
 		// Empty array (not nillable).
@@ -540,6 +550,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		disabled               bool
 		role                   = *c.APIAccountDisplayRoleToAPIAccountRoleSensitive(nil)
 		createdByApplicationID string
+		invitedByAccountID     string
 	)
 
 	if err := c.state.DB.PopulateAccount(ctx, a); err != nil {
@@ -555,9 +566,10 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		}
 
 		domain = &d
-	} else if !a.IsInstance() {
-		// This is a local, non-instance
-		// acct; we can fetch more info.
+	} else if !a.IsInstance() && !a.IsGroup() {
+		// This is a local, non-instance,
+		// non-group acct (ie., it has a
+		// user); we can fetch more info.
 		user, err := c.state.DB.GetUserByAccountID(ctx, a.ID)
 		if err != nil {
 			return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting user from database for account id %s: %w", a.ID, err)
@@ -586,6 +598,19 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		approved = *user.Approved
 		disabled = *user.Disabled
 		createdByApplicationID = user.CreatedByApplicationID
+
+		if user.InviteID != "" {
+			// User signed up with an invite,
+			// so look up who created the invite.
+			invite, err := c.state.DB.GetInviteByID(ctx, user.InviteID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, gtserror.Newf("db error getting invite %s: %w", user.InviteID, err)
+			}
+
+			if invite != nil {
+				invitedByAccountID = invite.CreatedByAccountID
+			}
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -607,15 +632,21 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Confirmed:              confirmed,
 		Approved:               approved,
 		Disabled:               disabled,
-		Silenced:               !a.SilencedAt.IsZero(),
-		Suspended:              !a.SuspendedAt.IsZero(),
+		Silenced:               a.IsSilenced(),
+		Suspended:              a.IsSuspended(),
+		Sensitized:             a.IsSensitized(),
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
-		InvitedByAccountID:     "", // not implemented (yet)
+		InvitedByAccountID:     invitedByAccountID,
 	}, nil
 }
 
 func (c *Converter) AppToAPIAppSensitive(ctx context.Context, a *gtsmodel.Application) (*apimodel.Application, error) {
+	vapidKeyPair, err := c.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting vapid key pair: %w", err)
+	}
+
 	return &apimodel.Application{
 		ID:           a.ID,
 		Name:         a.Name,
@@ -623,6 +654,7 @@ func (c *Converter) AppToAPIAppSensitive(ctx context.Context, a *gtsmodel.Applic
 		RedirectURI:  a.RedirectURI,
 		ClientID:     a.ClientID,
 		ClientSecret: a.ClientSecret,
+		VapidKey:     vapidKeyPair.PublicKey,
 	}, nil
 }
 
@@ -636,6 +668,109 @@ func (c *Converter) AppToAPIAppPublic(ctx context.Context, a *gtsmodel.Applicati
 	}, nil
 }
 
+// TokenToAPITokenInfo converts a gts model token into its api
+// representation, suitable for serving to the token's owner.
+func (c *Converter) TokenToAPITokenInfo(ctx context.Context, token *gtsmodel.Token) (*apimodel.TokenInfo, error) {
+	app, err := c.state.DB.GetApplicationByClientID(ctx, token.ClientID)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting application with client id %s: %w", token.ClientID, err)
+	}
+
+	apiApplication, err := c.AppToAPIAppPublic(ctx, app)
+	if err != nil {
+		return nil, gtserror.Newf("error converting application to api: %w", err)
+	}
+
+	var lastUsed string
+	if !token.LastUsed.IsZero() {
+		lastUsed = util.FormatISO8601(token.LastUsed)
+	}
+
+	return &apimodel.TokenInfo{
+		ID:          token.ID,
+		CreatedAt:   util.FormatISO8601(token.CreatedAt),
+		LastUsed:    lastUsed,
+		Scope:       token.Scope,
+		Application: apiApplication,
+	}, nil
+}
+
+// WebPushSubscriptionToAPIWebPushSubscription converts a gts model
+// Web Push subscription into its api representation, suitable for
+// serving to the subscription's owner.
+func (c *Converter) WebPushSubscriptionToAPIWebPushSubscription(
+	ctx context.Context,
+	subscription *gtsmodel.WebPushSubscription,
+) (*apimodel.PushSubscription, error) {
+	vapidKeyPair, err := c.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting vapid key pair: %w", err)
+	}
+
+	flags := subscription.NotificationFlags
+	return &apimodel.PushSubscription{
+		ID:        subscription.ID,
+		Endpoint:  subscription.Endpoint,
+		ServerKey: vapidKeyPair.PublicKey,
+		Alerts: &apimodel.PushSubscriptionAlerts{
+			Follow:           flags.Get(gtsmodel.NotificationFollow),
+			FollowRequest:    flags.Get(gtsmodel.NotificationFollowRequest),
+			Favourite:        flags.Get(gtsmodel.NotificationFave),
+			Mention:          flags.Get(gtsmodel.NotificationMention),
+			Reblog:           flags.Get(gtsmodel.NotificationReblog),
+			Poll:             flags.Get(gtsmodel.NotificationPoll),
+			Status:           flags.Get(gtsmodel.NotificationStatus),
+			AdminSignup:      flags.Get(gtsmodel.NotificationSignup),
+			PendingFavourite: flags.Get(gtsmodel.NotificationPendingFave),
+			PendingReply:     flags.Get(gtsmodel.NotificationPendingReply),
+			PendingReblog:    flags.Get(gtsmodel.NotificationPendingReblog),
+		},
+		Policy: string(subscription.Policy),
+	}, nil
+}
+
+// CardToAPICard converts a gts model link preview card into its api representation for serialization on the API.
+func (c *Converter) CardToAPICard(ctx context.Context, card *gtsmodel.Card) (*apimodel.Card, error) {
+	if card.ImageID != "" && card.Image == nil {
+		// Ensure card image is populated.
+		if err := c.state.DB.PopulateCard(ctx, card); err != nil {
+			return nil, gtserror.Newf("error populating card: %w", err)
+		}
+	}
+
+	apiCard := &apimodel.Card{
+		URL:          card.URL,
+		Title:        card.Title,
+		Description:  card.Description,
+		Type:         card.Type.String(),
+		AuthorName:   card.AuthorName,
+		AuthorURL:    card.AuthorURL,
+		ProviderName: card.ProviderName,
+		ProviderURL:  card.ProviderURL,
+		HTML:         card.HTML,
+		Width:        card.Width,
+		Height:       card.Height,
+		EmbedURL:     card.EmbedURL,
+	}
+
+	// Only add image details
+	// if we have stored locally.
+	if card.Image != nil &&
+		*card.Image.Cached &&
+		card.Image.URL != "" {
+		apiCard.Image = card.Image.URL
+		apiCard.Blurhash = card.Image.Blurhash
+
+		if apiCard.Width == 0 || apiCard.Height == 0 {
+			// Take dimensions from the image.
+			apiCard.Width = card.Image.FileMeta.Original.Width
+			apiCard.Height = card.Image.FileMeta.Original.Height
+		}
+	}
+
+	return apiCard, nil
+}
+
 // AttachmentToAPIAttachment converts a gts model media attacahment into its api representation for serialization on the API.
 func (c *Converter) AttachmentToAPIAttachment(ctx context.Context, media *gtsmodel.MediaAttachment) (apimodel.Attachment, error) {
 	var api apimodel.Attachment
@@ -794,18 +929,52 @@ func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistor
 	return apimodel.Tag{
 		Name: strings.ToLower(t.Name),
 		URL:  uris.URIForTag(t.Name),
-		History: func() *[]any {
+		History: func() *[]apimodel.History {
 			if !stubHistory {
 				return nil
 			}
 
-			h := make([]any, 0)
+			h := make([]apimodel.History, 0)
 			return &h
 		}(),
 		Following: following,
 	}, nil
 }
 
+// FeaturedTagToAPIFeaturedTag converts a gts model featured
+// tag into its api (frontend) representation for serialization
+// on the API, calculating usage statistics for the tag as it goes.
+func (c *Converter) FeaturedTagToAPIFeaturedTag(ctx context.Context, f *gtsmodel.FeaturedTag) (*apimodel.FeaturedTag, error) {
+	if f.Tag == nil {
+		var err error
+
+		// Ensure featured tag's tag is populated.
+		f.Tag, err = c.state.DB.GetTag(ctx, f.TagID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting featured tag tag: %w", err)
+		}
+	}
+
+	// Calculate account's usage of the tag.
+	stats, err := c.state.DB.GetFeaturedTagStats(ctx, f)
+	if err != nil {
+		return nil, gtserror.Newf("error getting featured tag stats: %w", err)
+	}
+
+	var lastStatusAt *string
+	if !stats.LastStatusAt.IsZero() {
+		lastStatusAt = util.Ptr(util.FormatISO8601Date(stats.LastStatusAt))
+	}
+
+	return &apimodel.FeaturedTag{
+		ID:            f.ID,
+		Name:          strings.ToLower(f.Tag.Name),
+		URL:           uris.URIForTag(f.Tag.Name),
+		StatusesCount: stats.StatusesCount,
+		LastStatusAt:  lastStatusAt,
+	}, nil
+}
+
 // StatusToAPIStatus converts a gts model
 // status into its api (frontend) representation
 // for serialization on the API.
@@ -1221,17 +1390,175 @@ func (c *Converter) StatusToWebStatus(
 // Callers should check beforehand whether a requester has permission to view the
 // source of the status, and ensure they're passing only a local status into this function.
 func (c *Converter) StatusToAPIStatusSource(ctx context.Context, s *gtsmodel.Status) (*apimodel.StatusSource, error) {
-	// TODO: remove this when edit support is added.
-	text := "**STATUS EDITS ARE NOT CURRENTLY SUPPORTED IN GOTOSOCIAL (coming in 2024)**\n" +
-		"You can review the original text of your status below, but you will not be able to submit this edit.\n\n---\n\n" + s.Text
-
 	return &apimodel.StatusSource{
 		ID:          s.ID,
-		Text:        text,
+		Text:        s.Text,
 		SpoilerText: s.ContentWarning,
 	}, nil
 }
 
+// StatusToAPIEdits converts a status and its historical edits into a slice of
+// *apimodel.StatusEdit, ordered oldest revision first, with the current version
+// of the status as the final entry. Callers should check beforehand whether
+// the requester has permission to view the status.
+func (c *Converter) StatusToAPIEdits(ctx context.Context, s *gtsmodel.Status) ([]*apimodel.StatusEdit, error) {
+	// Ensure the status model is populated,
+	// we need the account and edits to convert.
+	if err := c.state.DB.PopulateStatus(ctx, s); err != nil {
+		if s.Account == nil {
+			return nil, gtserror.Newf("error(s) populating status, required account not set: %w", err)
+		}
+		log.Errorf(ctx, "error(s) populating status, will continue: %v", err)
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, s.Account)
+	if err != nil {
+		return nil, gtserror.Newf("error converting account: %w", err)
+	}
+
+	// Edits don't store their own emojis, so
+	// use those of the current status version.
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, s.Emojis, s.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting status emojis: %v", err)
+	}
+
+	// Preallocate expected frontend slice, leaving room for the current version.
+	apiEdits := make([]*apimodel.StatusEdit, 0, len(s.Edits)+1)
+
+	for _, edit := range s.Edits {
+		apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, edit.Attachments, edit.AttachmentIDs)
+		if err != nil {
+			log.Errorf(ctx, "error converting edit attachments: %v", err)
+		}
+
+		var apiPoll *apimodel.Poll
+		if len(edit.PollOptions) > 0 {
+			// Edits only store the options (and
+			// votes, if they were reset), so this
+			// is a very minimal poll representation.
+			apiPoll = &apimodel.Poll{
+				Options: make([]apimodel.PollOption, len(edit.PollOptions)),
+				Emojis:  apiEmojis,
+			}
+
+			for i, title := range edit.PollOptions {
+				apiPoll.Options[i].Title = title
+				if i < len(edit.PollVotes) {
+					count := edit.PollVotes[i]
+					apiPoll.Options[i].VotesCount = &count
+					apiPoll.VotesCount += count
+				}
+			}
+		}
+
+		apiEdits = append(apiEdits, &apimodel.StatusEdit{
+			Content:          edit.Content,
+			SpoilerText:      edit.ContentWarning,
+			Sensitive:        util.PtrOrZero(edit.Sensitive),
+			CreatedAt:        util.FormatISO8601(edit.CreatedAt),
+			Account:          apiAccount,
+			Poll:             apiPoll,
+			MediaAttachments: apiAttachments,
+			Emojis:           apiEmojis,
+		})
+	}
+
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.Attachments, s.AttachmentIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting status attachments: %v", err)
+	}
+
+	var apiPoll *apimodel.Poll
+	if s.Poll != nil {
+		// Set originating
+		// status on the poll.
+		poll := s.Poll
+		poll.Status = s
+
+		apiPoll, err = c.PollToAPIPoll(ctx, nil, poll)
+		if err != nil {
+			return nil, gtserror.Newf("error converting poll: %w", err)
+		}
+	}
+
+	// The current version of the status
+	// was created at time of last edit,
+	// else at time of status creation.
+	createdAt := s.EditedAt
+	if createdAt.IsZero() {
+		createdAt = s.CreatedAt
+	}
+
+	apiEdits = append(apiEdits, &apimodel.StatusEdit{
+		Content:          s.Content,
+		SpoilerText:      s.ContentWarning,
+		Sensitive:        util.PtrOrZero(s.Sensitive),
+		CreatedAt:        util.FormatISO8601(createdAt),
+		Account:          apiAccount,
+		Poll:             apiPoll,
+		MediaAttachments: apiAttachments,
+		Emojis:           apiEmojis,
+	})
+
+	return apiEdits, nil
+}
+
+// ScheduledStatusToAPIScheduledStatus converts a scheduled status into its API model representation.
+func (c *Converter) ScheduledStatusToAPIScheduledStatus(
+	ctx context.Context,
+	s *gtsmodel.ScheduledStatus,
+) (*apimodel.ScheduledStatus, error) {
+	// Ensure the scheduled status
+	// model is populated with media.
+	if err := c.state.DB.PopulateScheduledStatus(ctx, s); err != nil {
+		log.Errorf(ctx, "error(s) populating scheduled status, will continue: %v", err)
+	}
+
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.MediaAttachments, s.MediaIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting scheduled status attachments: %v", err)
+	}
+
+	scheduledAt := util.FormatISO8601(s.ScheduledAt)
+	params := &apimodel.StatusParams{
+		Text:          s.Text,
+		InReplyToID:   util.PtrIf(s.InReplyToID),
+		MediaIDs:      s.MediaIDs,
+		Sensitive:     util.PtrOrZero(s.Sensitive),
+		SpoilerText:   util.PtrIf(s.ContentWarning),
+		LocalOnly:     util.PtrOrZero(s.LocalOnly),
+		Language:      util.PtrIf(s.Language),
+		ScheduledAt:   &scheduledAt,
+		ApplicationID: s.ApplicationID,
+	}
+
+	if s.Visibility != "" {
+		params.Visibility = util.Ptr(c.VisToAPIVis(ctx, s.Visibility))
+	}
+
+	if s.ContentType != "" {
+		params.ContentType = util.Ptr(apimodel.StatusContentType(s.ContentType))
+	}
+
+	if s.HasPoll() {
+		params.Poll = &apimodel.ScheduledStatusParamsPoll{
+			Options:    s.PollOptions,
+			ExpiresIn:  s.PollExpiresIn,
+			Multiple:   util.PtrOrZero(s.PollMultiple),
+			HideTotals: util.PtrOrZero(s.PollHideTotals),
+		}
+	}
+
+	return &apimodel.ScheduledStatus{
+		ID:               s.ID,
+		ScheduledAt:      scheduledAt,
+		Params:           params,
+		MediaAttachments: apiAttachments,
+		Error:            util.PtrIf(s.Error),
+	}, nil
+}
+
 // statusToFrontend is a package internal function for
 // parsing a status into its initial frontend representation.
 //
@@ -1357,6 +1684,14 @@ func (c *Converter) baseStatusToFrontend(
 		log.Errorf(ctx, "error converting status emojis: %v", err)
 	}
 
+	var apiCard *apimodel.Card
+	if s.Card != nil && s.Card.HasContent() {
+		apiCard, err = c.CardToAPICard(ctx, s.Card)
+		if err != nil {
+			log.Errorf(ctx, "error converting status card: %v", err)
+		}
+	}
+
 	// Take status's interaction policy, or
 	// fall back to default for its visibility.
 	var p *gtsmodel.InteractionPolicy
@@ -1376,7 +1711,7 @@ func (c *Converter) baseStatusToFrontend(
 		CreatedAt:          util.FormatISO8601(s.CreatedAt),
 		InReplyToID:        nil, // Set below.
 		InReplyToAccountID: nil, // Set below.
-		Sensitive:          *s.Sensitive,
+		Sensitive:          statusSensitive(s, requestingAccount),
 		SpoilerText:        s.ContentWarning,
 		Visibility:         c.VisToAPIVis(ctx, s.Visibility),
 		LocalOnly:          s.IsLocalOnly(),
@@ -1394,11 +1729,17 @@ func (c *Converter) baseStatusToFrontend(
 		Mentions:           apiMentions,
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
-		Card:               nil, // TODO: implement cards
+		Card:               apiCard,
 		Text:               s.Text,
 		InteractionPolicy:  *apiInteractionPolicy,
 	}
 
+	// Apply any limits placed
+	// on the author's domain.
+	if !s.Account.IsLocal() {
+		c.applyDomainLimit(ctx, s, apiStatus)
+	}
+
 	// Nullable fields.
 	if s.InReplyToID != "" {
 		apiStatus.InReplyToID = util.Ptr(s.InReplyToID)
@@ -1412,6 +1753,10 @@ func (c *Converter) baseStatusToFrontend(
 		apiStatus.Language = util.Ptr(s.Language)
 	}
 
+	if !s.EditedAt.IsZero() {
+		apiStatus.EditedAt = util.Ptr(util.FormatISO8601(s.EditedAt))
+	}
+
 	if app := s.CreatedWithApplication; app != nil {
 		apiStatus.Application, err = c.AppToAPIAppPublic(ctx, app)
 		if err != nil {
@@ -1477,6 +1822,61 @@ func (c *Converter) baseStatusToFrontend(
 	return apiStatus, nil
 }
 
+// statusSensitive returns whether the given status should be
+// shown as sensitive to the requesting account. Statuses with
+// media from accounts that have been set to have all of their
+// media marked as sensitive are always shown as sensitive,
+// except to the author, who sees the status as they made it.
+func statusSensitive(s *gtsmodel.Status, requestingAccount *gtsmodel.Account) bool {
+	if *s.Sensitive {
+		return true
+	}
+
+	if requestingAccount != nil &&
+		requestingAccount.ID == s.AccountID {
+		return false
+	}
+
+	return s.Account.IsSensitized() &&
+		len(s.AttachmentIDs) != 0
+}
+
+// applyDomainLimit applies any domain limit covering the
+// domain of the author of the given status to the API model:
+// marking media as sensitive, and prepending a content warning.
+func (c *Converter) applyDomainLimit(
+	ctx context.Context,
+	s *gtsmodel.Status,
+	apiStatus *apimodel.Status,
+) {
+	limit, err := c.state.DB.MatchDomainLimit(ctx, s.Account.Domain)
+	if err != nil {
+		log.Errorf(ctx, "error checking domain limit for %s: %v", s.Account.Domain, err)
+		return
+	}
+
+	if limit == nil {
+		// Not limited.
+		return
+	}
+
+	if limit.ContentWarning != "" {
+		if apiStatus.SpoilerText == "" {
+			apiStatus.SpoilerText = limit.ContentWarning
+		} else {
+			apiStatus.SpoilerText = limit.ContentWarning + "; " + apiStatus.SpoilerText
+		}
+	}
+
+	if len(s.AttachmentIDs) != 0 &&
+		(*limit.MediaSensitive || limit.ContentWarning != "") {
+		// As on status creation, if a content
+		// warning is set and the status contains
+		// media, always set the sensitive flag.
+		apiStatus.Sensitive = true
+	}
+}
+
 // VisToAPIVis converts a gts visibility into its api equivalent
 func (c *Converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility {
 	switch m {
@@ -1541,8 +1941,8 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Version:              config.GetSoftwareVersion(),
 		Languages:            config.GetInstanceLanguages().TagStrs(),
 		Registrations:        config.GetAccountsRegistrationOpen(),
-		ApprovalRequired:     true,                               // approval always required
-		InvitesEnabled:       false,                              // todo: not supported yet
+		ApprovalRequired:     true, // approval always required
+		InvitesEnabled:       config.GetAccountsInvitesEnabled(),
 		MaxTootChars:         uint(config.GetStatusesMaxChars()), // #nosec G115 -- Already validated.
 		Rules:                c.InstanceRulesToAPIRules(i.Rules),
 		Terms:                i.Terms,
@@ -1727,6 +2127,14 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize()) // #nosec G115 -- Already validated.
 	instance.Configuration.OIDCEnabled = config.GetOIDCEnabled()
+	instance.Configuration.Translation.Enabled = config.GetTranslationBackend() != config.TranslationBackendDisabled
+
+	// Web Push.
+	vapidKeyPair, err := c.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("InstanceToAPIV2Instance: db error getting vapid key pair: %w", err)
+	}
+	instance.Configuration.VAPID.PublicKey = vapidKeyPair.PublicKey
 
 	// registrations
 	instance.Registrations.Enabled = config.GetAccountsRegistrationOpen()
@@ -1970,9 +2378,79 @@ func (c *Converter) DomainPermToAPIDomainPerm(
 	domainPerm.CreatedBy = d.GetCreatedByAccountID()
 	domainPerm.CreatedAt = util.FormatISO8601(d.GetCreatedAt())
 
+	// Drafts may be of either type,
+	// so indicate which one this is.
+	if _, ok := d.(*gtsmodel.DomainPermissionDraft); ok {
+		domainPerm.PermissionType = d.GetType().String()
+	}
+
+	// Limits have a few extra fields.
+	if limit, ok := d.(*gtsmodel.DomainLimit); ok {
+		domainPerm.MediaSensitive = *limit.MediaSensitive
+		domainPerm.ContentWarning = limit.ContentWarning
+		domainPerm.HideFromPublic = *limit.HideFromPublic
+	}
+
 	return domainPerm, nil
 }
 
+// DomainPermExcludeToAPIDomainPerm converts the
+// given domain permission exclude into its API model.
+func (c *Converter) DomainPermExcludeToAPIDomainPerm(
+	ctx context.Context,
+	d *gtsmodel.DomainPermissionExclude,
+) (*apimodel.DomainPermission, error) {
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	domain, err := util.DePunify(d.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying domain %s: %w", d.Domain, err)
+	}
+
+	return &apimodel.DomainPermission{
+		Domain: apimodel.Domain{
+			Domain: domain,
+		},
+		ID:             d.ID,
+		PrivateComment: d.PrivateComment,
+		CreatedBy:      d.CreatedByAccountID,
+		CreatedAt:      util.FormatISO8601(d.CreatedAt),
+	}, nil
+}
+
+// DomainPermSubToAPIDomainPermSub converts the given
+// domain permission subscription into its API model.
+func (c *Converter) DomainPermSubToAPIDomainPermSub(
+	ctx context.Context,
+	d *gtsmodel.DomainPermissionSubscription,
+) (*apimodel.DomainPermissionSubscription, error) {
+	domainPermSub := &apimodel.DomainPermissionSubscription{
+		ID:             d.ID,
+		Priority:       d.Priority,
+		Title:          d.Title,
+		PermissionType: d.PermissionType.String(),
+		AsDraft:        *d.AsDraft,
+		AdoptOrphans:   *d.AdoptOrphans,
+		CreatedBy:      d.CreatedByAccountID,
+		CreatedAt:      util.FormatISO8601(d.CreatedAt),
+		URI:            d.URI,
+		ContentType:    d.ContentType.String(),
+		FetchUsername:  d.FetchUsername,
+		FetchPassword:  d.FetchPassword,
+		Error:          d.Error,
+	}
+
+	if !d.FetchedAt.IsZero() {
+		domainPermSub.FetchedAt = util.FormatISO8601(d.FetchedAt)
+	}
+
+	if !d.SuccessfullyFetchedAt.IsZero() {
+		domainPermSub.SuccessfullyFetchedAt = util.FormatISO8601(d.SuccessfullyFetchedAt)
+	}
+
+	return domainPermSub, nil
+}
+
 // ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
@@ -2012,6 +2490,331 @@ func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (
 	return report, nil
 }
 
+// DeliveryFailureToAdminAPIDeliveryFailure converts a gts model delivery
+// failure into an admin view delivery failure, for serving at
+// /api/v1/admin/delivery_failures.
+func (c *Converter) DeliveryFailureToAdminAPIDeliveryFailure(
+	_ context.Context,
+	f *gtsmodel.DeliveryFailure,
+) (*apimodel.AdminDeliveryFailure, error) {
+	return &apimodel.AdminDeliveryFailure{
+		ID:         f.ID,
+		CreatedAt:  util.FormatISO8601(f.CreatedAt),
+		TargetHost: f.TargetHost,
+		RequestURL: f.RequestURL,
+		ActorID:    f.ActorID,
+		ObjectID:   f.ObjectID,
+		TargetID:   f.TargetID,
+		StatusCode: f.StatusCode,
+		Error:      f.Error,
+		Attempts:   f.Attempts,
+	}, nil
+}
+
+// RelayToAdminAPIRelay converts a gts model relay into an admin view relay.
+func (c *Converter) RelayToAdminAPIRelay(
+	_ context.Context,
+	r *gtsmodel.Relay,
+) (*apimodel.AdminRelay, error) {
+	return &apimodel.AdminRelay{
+		ID:        r.ID,
+		CreatedAt: util.FormatISO8601(r.CreatedAt),
+		InboxURL:  r.InboxURI,
+		ActorURI:  r.ActorURI,
+		State:     r.State.String(),
+	}, nil
+}
+
+// InviteToAPIInvite converts a gts model invite into its api (frontend) representation.
+func (c *Converter) InviteToAPIInvite(
+	_ context.Context,
+	i *gtsmodel.Invite,
+) (*apimodel.Invite, error) {
+	apiInvite := &apimodel.Invite{
+		ID:        i.ID,
+		Code:      i.Code,
+		URL:       uris.GenerateURLForInvite(i.Code),
+		CreatedAt: util.FormatISO8601(i.CreatedAt),
+		Uses:      i.Uses,
+		Usable:    i.Usable(),
+	}
+
+	if i.MaxUses != 0 {
+		apiInvite.MaxUses = util.Ptr(i.MaxUses)
+	}
+
+	if !i.ExpiresAt.IsZero() {
+		apiInvite.ExpiresAt = util.Ptr(util.FormatISO8601(i.ExpiresAt))
+	}
+
+	if i.Revoked() {
+		apiInvite.RevokedAt = util.Ptr(util.FormatISO8601(i.RevokedAt))
+	}
+
+	return apiInvite, nil
+}
+
+// ImportJobToAPIImportJob converts a gts model import job
+// into its api (frontend) representation, including the
+// given failures of the job (if any).
+func (c *Converter) ImportJobToAPIImportJob(
+	_ context.Context,
+	j *gtsmodel.ImportJob,
+	failures []*gtsmodel.ImportJobFailure,
+) *apimodel.ImportJob {
+	mode := "merge"
+	if *j.Overwrite {
+		mode = "overwrite"
+	}
+
+	apiJob := &apimodel.ImportJob{
+		ID:            j.ID,
+		CreatedAt:     util.FormatISO8601(j.CreatedAt),
+		UpdatedAt:     util.FormatISO8601(j.UpdatedAt),
+		Type:          j.Type,
+		Mode:          mode,
+		State:         j.State.String(),
+		TotalRows:     j.TotalRows,
+		ProcessedRows: j.ProcessedRows,
+		Successes:     j.Successes,
+		Failures:      j.Failures,
+		FailedRows:    make([]apimodel.ImportJobFailure, 0, len(failures)),
+	}
+
+	for _, f := range failures {
+		apiJob.FailedRows = append(apiJob.FailedRows, apimodel.ImportJobFailure{
+			Row:    f.RowNumber,
+			Reason: f.Reason,
+		})
+	}
+
+	if j.Finished() || j.Failed() {
+		apiJob.FinishedAt = util.Ptr(util.FormatISO8601(j.FinishedAt))
+	}
+
+	if j.Failed() {
+		apiJob.Error = util.Ptr(j.Error)
+	}
+
+	return apiJob
+}
+
+// TrendToAPIHistory returns the daily usage history of the given
+// trend over the last gtsmodel.TrendHistoryDays days (UTC), newest
+// day first, counting both total uses and distinct using accounts.
+func (c *Converter) TrendToAPIHistory(ctx context.Context, trend *gtsmodel.Trend) ([]apimodel.History, error) {
+	const day = 24 * time.Hour
+
+	// Start of the oldest day of history.
+	today := time.Now().UTC().Truncate(day)
+	since := today.Add(-(gtsmodel.TrendHistoryDays - 1) * day)
+
+	uses, err := c.state.DB.GetTrendUses(ctx, trend.ID, since)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting uses of trend %s: %w", trend.ID, err)
+	}
+
+	history := make([]apimodel.History, gtsmodel.TrendHistoryDays)
+	for i := range history {
+		start := today.Add(-time.Duration(i) * day)
+		end := start.Add(day)
+
+		var count int
+		accounts := make(map[string]struct{})
+		for _, use := range uses {
+			if use.CreatedAt.Before(start) || !use.CreatedAt.Before(end) {
+				continue
+			}
+			count++
+			accounts[use.AccountID] = struct{}{}
+		}
+
+		history[i] = apimodel.History{
+			Day:      strconv.FormatInt(start.Unix(), 10),
+			Uses:     strconv.Itoa(count),
+			Accounts: strconv.Itoa(len(accounts)),
+		}
+	}
+
+	return history, nil
+}
+
+// TrendToAdminAPITrend converts a gts model trend into its admin api
+// (frontend) representation, including the trending tag, status or
+// link itself, and its daily usage history.
+func (c *Converter) TrendToAdminAPITrend(
+	ctx context.Context,
+	trend *gtsmodel.Trend,
+	requester *gtsmodel.Account,
+) (*apimodel.AdminTrend, error) {
+	history, err := c.TrendToAPIHistory(ctx, trend)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTrend := &apimodel.AdminTrend{
+		ID:         trend.ID,
+		Type:       trend.Type.String(),
+		State:      trend.State.String(),
+		CreatedAt:  util.FormatISO8601(trend.CreatedAt),
+		ReviewedBy: trend.ReviewedByAccountID,
+		History:    history,
+	}
+
+	if !trend.ReviewedAt.IsZero() {
+		apiTrend.ReviewedAt = util.Ptr(util.FormatISO8601(trend.ReviewedAt))
+	}
+
+	switch trend.Type {
+	case gtsmodel.TrendTypeTag:
+		tag, err := c.state.DB.GetTag(ctx, trend.TargetID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting trend tag: %w", err)
+		}
+
+		apiTag, err := c.TagToAPITag(ctx, tag, false, nil)
+		if err != nil {
+			return nil, gtserror.Newf("error converting trend tag: %w", err)
+		}
+		apiTrend.Tag = &apiTag
+
+	case gtsmodel.TrendTypeStatus:
+		status, err := c.state.DB.GetStatusByID(ctx, trend.TargetID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting trend status: %w", err)
+		}
+
+		apiTrend.Status, err = c.StatusToAPIStatus(ctx, status, requester, statusfilter.FilterContextNone, nil, nil)
+		if err != nil {
+			return nil, gtserror.Newf("error converting trend status: %w", err)
+		}
+
+	case gtsmodel.TrendTypeLink:
+		card, err := c.state.DB.GetCardByID(ctx, trend.TargetID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting trend card: %w", err)
+		}
+
+		apiTrend.Link, err = c.CardToAPICard(ctx, card)
+		if err != nil {
+			return nil, gtserror.Newf("error converting trend card: %w", err)
+		}
+	}
+
+	return apiTrend, nil
+}
+
+// AnnouncementToAPIAnnouncement converts a gts model announcement into
+// its api (frontend) representation, from the perspective of requester.
+// Requester may be nil, in which case the announcement won't be marked
+// as read, and none of its reactions will be marked as the requester's.
+func (c *Converter) AnnouncementToAPIAnnouncement(
+	ctx context.Context,
+	a *gtsmodel.Announcement,
+	requester *gtsmodel.Account,
+) (*apimodel.Announcement, error) {
+	apiAnnouncement := &apimodel.Announcement{
+		ID:        a.ID,
+		Content:   a.Content,
+		AllDay:    util.PtrOrZero(a.AllDay),
+		UpdatedAt: util.FormatISO8601(a.UpdatedAt),
+		Published: a.IsPublished(),
+		Mentions:  make([]apimodel.Mention, 0, len(a.MentionedAccountIDs)),
+		Statuses:  []apimodel.Status{},
+	}
+
+	if !a.StartsAt.IsZero() {
+		apiAnnouncement.StartsAt = util.FormatISO8601(a.StartsAt)
+	}
+
+	if !a.EndsAt.IsZero() {
+		apiAnnouncement.EndsAt = util.FormatISO8601(a.EndsAt)
+	}
+
+	if a.IsPublished() {
+		apiAnnouncement.PublishedAt = util.FormatISO8601(a.PublishedAt)
+	} else if !a.ScheduledAt.IsZero() {
+		apiAnnouncement.ScheduledAt = util.FormatISO8601(a.ScheduledAt)
+	}
+
+	for _, accountID := range a.MentionedAccountIDs {
+		apiMention, err := c.MentionToAPIMention(ctx, &gtsmodel.Mention{
+			TargetAccountID: accountID,
+		})
+		if err != nil {
+			log.Errorf(ctx, "error converting announcement mention: %v", err)
+			continue
+		}
+		apiAnnouncement.Mentions = append(apiAnnouncement.Mentions, apiMention)
+	}
+
+	var err error
+	apiAnnouncement.Tags, err = c.convertTagsToAPITags(ctx, a.Tags, a.TagIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement tags: %v", err)
+	}
+
+	apiAnnouncement.Emojis, err = c.convertEmojisToAPIEmojis(ctx, a.Emojis, a.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting announcement emojis: %v", err)
+	}
+
+	if requester != nil {
+		apiAnnouncement.Read, err = c.state.DB.IsAnnouncementRead(ctx, a.ID, requester.ID)
+		if err != nil {
+			return nil, gtserror.Newf("error checking announcement read: %w", err)
+		}
+	}
+
+	reactions, err := c.state.DB.GetAnnouncementReactions(ctx, a.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting announcement reactions: %w", err)
+	}
+
+	apiAnnouncement.Reactions = c.announcementReactionsToAPIReactions(ctx, reactions, requester)
+	return apiAnnouncement, nil
+}
+
+// announcementReactionsToAPIReactions groups the given announcement reactions
+// by name into their api (frontend) representation, in order of first use.
+func (c *Converter) announcementReactionsToAPIReactions(
+	ctx context.Context,
+	reactions []*gtsmodel.AnnouncementReaction,
+	requester *gtsmodel.Account,
+) []apimodel.AnnouncementReaction {
+	apiReactions := make([]apimodel.AnnouncementReaction, 0, len(reactions))
+	indices := make(map[string]int, len(reactions))
+
+	for _, r := range reactions {
+		i, ok := indices[r.Name]
+		if !ok {
+			apiReaction := apimodel.AnnouncementReaction{Name: r.Name}
+
+			if r.EmojiID != "" {
+				// Custom emoji reaction, include image links.
+				emoji, err := c.state.DB.GetEmojiByID(ctx, r.EmojiID)
+				if err != nil {
+					log.Errorf(ctx, "error getting announcement reaction emoji: %v", err)
+				} else {
+					apiReaction.URL = emoji.ImageURL
+					apiReaction.StaticURL = emoji.ImageStaticURL
+				}
+			}
+
+			i = len(apiReactions)
+			indices[r.Name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+		if requester != nil && r.AccountID == requester.ID {
+			apiReactions[i].Me = true
+		}
+	}
+
+	return apiReactions
+}
+
 // ReportToAdminAPIReport converts a gts model report into an admin view report, for serving at /api/v1/admin/reports
 func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error) {
 	var (
@@ -22,6 +22,7 @@
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -463,6 +464,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
 	suite.Equal(`{
   "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "created_at": "2021-10-20T11:36:45.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -641,6 +643,7 @@ func (suite *InternalToFrontendTestSuite) TestWarnFilteredStatusToFrontend() {
 	suite.Equal(`{
   "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "created_at": "2021-10-20T11:36:45.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -807,6 +810,7 @@ func (suite *InternalToFrontendTestSuite) TestWarnFilteredBoostToFrontend() {
 	suite.Equal(`{
   "id": "01G36SF3V6Y6V5BF9P4R7PQG7G",
   "created_at": "2021-10-20T10:41:37.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -827,6 +831,7 @@ func (suite *InternalToFrontendTestSuite) TestWarnFilteredBoostToFrontend() {
   "reblog": {
     "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
     "created_at": "2021-10-20T11:36:45.000Z",
+    "edited_at": null,
     "in_reply_to_id": null,
     "in_reply_to_account_id": null,
     "sensitive": false,
@@ -1218,6 +1223,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownAttachments
 	suite.Equal(`{
   "id": "01HE7XJ1CG84TBKH5V9XKBVGF5",
   "created_at": "2023-11-02T10:44:25.000Z",
+  "edited_at": null,
   "in_reply_to_id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "in_reply_to_account_id": "01F8MH17FWEB39HZJ76B6VXSKF",
   "sensitive": true,
@@ -1350,6 +1356,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToWebStatus() {
 	suite.Equal(`{
   "id": "01HE7XJ1CG84TBKH5V9XKBVGF5",
   "created_at": "2023-11-02T10:44:25.000Z",
+  "edited_at": null,
   "in_reply_to_id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "in_reply_to_account_id": "01F8MH17FWEB39HZJ76B6VXSKF",
   "sensitive": true,
@@ -1511,6 +1518,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
 	suite.Equal(`{
   "id": "01F8MH75CBF9JFX4ZAD54N0W0R",
   "created_at": "2021-10-20T11:36:45.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -1654,6 +1662,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendPartialInteraction
 	suite.Equal(`{
   "id": "01F8MHBBN8120SYH7D5S050MGK",
   "created_at": "2021-10-20T10:40:37.000Z",
+  "edited_at": null,
   "in_reply_to_id": null,
   "in_reply_to_account_id": null,
   "sensitive": false,
@@ -1764,6 +1773,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToAPIStatusPendingApproval()
 	suite.Equal(`{
   "id": "01J5QVB9VC76NPPRQ207GG4DRZ",
   "created_at": "2024-02-20T10:41:37.000Z",
+  "edited_at": null,
   "in_reply_to_id": "01F8MHC8VWDRBQR0N1BATDDEM5",
   "in_reply_to_account_id": "01F8MH5NBDF2MV7CTC4Q5128HF",
   "sensitive": false,
@@ -2127,6 +2137,9 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
     "translation": {
       "enabled": false
     },
+    "vapid": {
+      "public_key": "BE37b1-7b4-dIKIkHYbMCcj69c0wy-QZV21GkIgbOymm3B9Jp-bYyIWoRiv1wsljCLkDyZYWC3XwxA-KhWKP0-Y"
+    },
     "emojis": {
       "emoji_size_limit": 51200
     }
@@ -2176,6 +2189,25 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontendTranslationEnabled() {
+	ctx := context.Background()
+
+	config.SetTranslationBackend(config.TranslationBackendLibreTranslate)
+	config.SetTranslationLibreTranslateURL("http://localhost:5000")
+
+	i := &gtsmodel.Instance{}
+	if err := suite.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: config.GetHost()}}, i); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	instance, err := suite.typeconverter.InstanceToAPIV2Instance(ctx, i)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(instance.Configuration.Translation.Enabled)
+}
+
 func (suite *InternalToFrontendTestSuite) TestEmojiToFrontend() {
 	emoji, err := suite.typeconverter.EmojiToAPIEmoji(context.Background(), suite.testEmojis["rainbow"])
 	suite.NoError(err)
@@ -2380,6 +2412,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
       "username": "foss_satan",
@@ -2426,6 +2459,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
       "username": "1happyturtle",
@@ -2485,6 +2519,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
       "username": "admin",
@@ -2540,6 +2575,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
       "username": "admin",
@@ -2618,6 +2654,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
       "username": "1happyturtle",
@@ -2677,6 +2714,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
       "username": "foss_satan",
@@ -2707,6 +2745,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     {
       "id": "01FVW7JHQFSFK166WWKR8CBA6M",
       "created_at": "2021-09-20T10:40:37.000Z",
+      "edited_at": null,
       "in_reply_to_id": null,
       "in_reply_to_account_id": null,
       "sensitive": false,
@@ -2884,6 +2923,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
       "username": "foss_satan",
@@ -2930,6 +2970,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "disabled": false,
     "silenced": false,
     "suspended": true,
+    "sensitized": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
       "username": "1happyturtle",
@@ -2978,6 +3019,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
       "username": "admin",
@@ -3033,6 +3075,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
     "disabled": false,
     "silenced": false,
     "suspended": false,
+    "sensitized": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
       "username": "admin",
@@ -3214,6 +3257,7 @@ func (suite *InternalToFrontendTestSuite) TestIntReqToAPI() {
   "status": {
     "id": "01F8MHC8VWDRBQR0N1BATDDEM5",
     "created_at": "2021-10-20T10:40:37.000Z",
+    "edited_at": null,
     "in_reply_to_id": null,
     "in_reply_to_account_id": null,
     "sensitive": true,
@@ -3307,6 +3351,7 @@ func (suite *InternalToFrontendTestSuite) TestIntReqToAPI() {
   "reply": {
     "id": "01J5QVB9VC76NPPRQ207GG4DRZ",
     "created_at": "2024-02-20T10:41:37.000Z",
+    "edited_at": null,
     "in_reply_to_id": "01F8MHC8VWDRBQR0N1BATDDEM5",
     "in_reply_to_account_id": "01F8MH5NBDF2MV7CTC4Q5128HF",
     "sensitive": false,
@@ -3474,6 +3519,7 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPISelfConvo() {
   "last_status": {
     "id": "01F8MHAMCHF6Y650WCRSCP4WMY",
     "created_at": "2021-10-20T10:40:37.000Z",
+    "edited_at": null,
     "in_reply_to_id": null,
     "in_reply_to_account_id": null,
     "sensitive": true,
@@ -3640,6 +3686,7 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPI() {
   "last_status": {
     "id": "01F8MHAMCHF6Y650WCRSCP4WMY",
     "created_at": "2021-10-20T10:40:37.000Z",
+    "edited_at": null,
     "in_reply_to_id": null,
     "in_reply_to_account_id": null,
     "sensitive": true,
@@ -3723,6 +3770,77 @@ func (suite *InternalToFrontendTestSuite) TestConversationToAPI() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendSensitized() {
+	ctx := context.Background()
+
+	// Status with media from an account that
+	// has all of its media marked as sensitive.
+	sensitized := new(gtsmodel.Account)
+	*sensitized = *suite.testAccounts["admin_account"]
+	sensitized.SensitizedAt = time.Now()
+
+	testStatus := new(gtsmodel.Status)
+	*testStatus = *suite.testStatuses["admin_account_status_1"]
+	testStatus.Account = sensitized
+	suite.False(*testStatus.Sensitive)
+	suite.NotEmpty(testStatus.AttachmentIDs)
+
+	// Should be sensitive for other accounts.
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(ctx, testStatus, suite.testAccounts["local_account_1"], statusfilter.FilterContextNone, nil, nil)
+	suite.NoError(err)
+	suite.True(apiStatus.Sensitive)
+
+	// Author should see the status as they made it.
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(ctx, testStatus, sensitized, statusfilter.FilterContextNone, nil, nil)
+	suite.NoError(err)
+	suite.False(apiStatus.Sensitive)
+}
+
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendDomainLimited() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["remote_account_1_status_2"]
+	requester := suite.testAccounts["local_account_1"]
+	suite.False(*testStatus.Sensitive)
+	suite.Empty(testStatus.ContentWarning)
+	suite.NotEmpty(testStatus.AttachmentIDs)
+
+	// Limit the domain of the status author.
+	limit := &gtsmodel.DomainLimit{
+		ID:                 "01JBF2KQ4T8R6V3M1X9N5C7D2W",
+		Domain:             "fossbros-anonymous.io",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		MediaSensitive:     util.Ptr(true),
+		ContentWarning:     "from a limited instance",
+	}
+	if err := suite.db.CreateDomainLimit(ctx, limit); err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer func() {
+		if err := suite.db.DeleteDomainLimit(ctx, limit.Domain); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}()
+
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(ctx, testStatus, requester, statusfilter.FilterContextNone, nil, nil)
+	suite.NoError(err)
+	suite.True(apiStatus.Sensitive)
+	suite.Equal("from a limited instance", apiStatus.SpoilerText)
+
+	// An existing content warning should be kept.
+	cwStatus := new(gtsmodel.Status)
+	*cwStatus = *testStatus
+	cwStatus.ContentWarning = "food"
+
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(ctx, cwStatus, requester, statusfilter.FilterContextNone, nil, nil)
+	suite.NoError(err)
+	suite.Equal("from a limited instance; food", apiStatus.SpoilerText)
+
+	// Statuses from local accounts aren't affected.
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(ctx, suite.testStatuses["admin_account_status_1"], requester, statusfilter.FilterContextNone, nil, nil)
+	suite.NoError(err)
+	suite.Empty(apiStatus.SpoilerText)
+}
+
 func TestInternalToFrontendTestSuite(t *testing.T) {
 	suite.Run(t, new(InternalToFrontendTestSuite))
 }
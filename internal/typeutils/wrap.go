@@ -31,7 +31,7 @@
 )
 
 // WrapPersonInUpdate ...
-func (c *Converter) WrapPersonInUpdate(person vocab.ActivityStreamsPerson, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error) {
+func (c *Converter) WrapPersonInUpdate(person ap.Accountable, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error) {
 	update := streams.NewActivityStreamsUpdate()
 
 	// set the actor
@@ -58,9 +58,11 @@ func (c *Converter) WrapPersonInUpdate(person vocab.ActivityStreamsPerson, origi
 	idProp.SetIRI(idURI)
 	update.SetJSONLDId(idProp)
 
-	// set the person as the object here
+	// set the person (or group) as the object here
 	objectProp := streams.NewActivityStreamsObjectProperty()
-	objectProp.AppendActivityStreamsPerson(person)
+	if err := objectProp.AppendType(person); err != nil {
+		return nil, gtserror.Newf("error appending actor to update: %w", err)
+	}
 	update.SetActivityStreamsObject(objectProp)
 
 	// to should be public
@@ -43,6 +43,7 @@
 	MovesPath        = "moves"         // MovesPath is used to generate the URI for a move
 	ReportsPath      = "reports"       // ReportsPath is used to generate the URI for a report/flag
 	ConfirmEmailPath = "confirm_email" // ConfirmEmailPath is used to generate the URI for an email confirmation link
+	InvitePath       = "invite"        // InvitePath is used to generate the URL for an invite link
 	FileserverPath   = "fileserver"    // FileserverPath is a path component for serving attachments + media
 	EmojiPath        = "emoji"         // EmojiPath represents the activitypub emoji location
 	TagsPath         = "tags"          // TagsPath represents the activitypub tags location
@@ -75,6 +76,8 @@ type UserURIs struct {
 	LikedURI string
 	// The activitypub URI for this user's featured collections, eg., https://example.org/users/example_user/collections/featured
 	FeaturedCollectionURI string
+	// The activitypub URI for this user's featured tags collection, eg., https://example.org/users/example_user/collections/tags
+	FeaturedTagsURI string
 	// The URI for this user's public key, eg., https://example.org/users/example_user/publickey
 	PublicKeyURI string
 }
@@ -138,6 +141,14 @@ func GenerateURIForEmailConfirm(token string) string {
 	return fmt.Sprintf("%s://%s/%s?token=%s", protocol, host, ConfirmEmailPath, token)
 }
 
+// GenerateURLForInvite returns a link for signing up with an invite -- something like:
+// https://example.org/invite/3vVuQGtGoLXkbD5cTGNeuA
+func GenerateURLForInvite(code string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s", protocol, host, InvitePath, code)
+}
+
 // GenerateURIForAccept returns the AP URI for a new Accept activity -- something like:
 // https://example.org/users/whatever_user/accepts/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForAccept(username string, thisAcceptID string) string {
@@ -173,6 +184,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 	followingURI := fmt.Sprintf("%s/%s", userURI, FollowingPath)
 	likedURI := fmt.Sprintf("%s/%s", userURI, LikedPath)
 	collectionURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, FeaturedPath)
+	featuredTagsURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, TagsPath)
 	publicKeyURI := fmt.Sprintf("%s/%s", userURI, PublicKeyPath)
 
 	return &UserURIs{
@@ -188,6 +200,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 		FollowingURI:          followingURI,
 		LikedURI:              likedURI,
 		FeaturedCollectionURI: collectionURI,
+		FeaturedTagsURI:       featuredTagsURI,
 		PublicKeyURI:          publicKeyURI,
 	}
 }
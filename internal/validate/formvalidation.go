@@ -393,7 +393,11 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 		return errors.New("form was nil")
 	}
 
-	if !config.GetAccountsRegistrationOpen() {
+	// If an invite code is given, registration doesn't
+	// need to be open; the invite itself is checked later
+	// by the processor, since that requires a db call.
+	invited := form.InviteCode != ""
+	if !invited && !config.GetAccountsRegistrationOpen() {
 		return errors.New("registration is not open for this server")
 	}
 
@@ -419,5 +423,7 @@ func CreateAccount(form *apimodel.AccountCreateRequest) error {
 	}
 	form.Locale = locale
 
-	return SignUpReason(form.Reason, config.GetAccountsReasonRequired())
+	// Invited sign-ups don't need to give a reason.
+	reasonRequired := config.GetAccountsReasonRequired() && !invited
+	return SignUpReason(form.Reason, reasonRequired)
 }
@@ -124,6 +124,13 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		}
 	}
 
+	// Get tags featured on this account's profile.
+	featuredTags, errWithCode := m.processor.Tags().FeaturedTagsGet(ctx, targetAccount.ID)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	// Get statuses from maxStatusID onwards (or from top if empty string).
 	statusResp, errWithCode := m.processor.Account().WebStatusesGet(ctx, targetAccount.ID, maxStatusID)
 	if errWithCode != nil {
@@ -172,6 +179,7 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 			"statuses":         statusResp.Items,
 			"statuses_next":    statusResp.NextLink,
 			"pinned_statuses":  pinnedStatuses,
+			"featured_tags":    featuredTags,
 			"show_back_to_top": paging,
 		},
 	}
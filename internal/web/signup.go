@@ -69,6 +69,66 @@ func (m *Module) signupGETHandler(c *gin.Context) {
 // /signup
 // 账号注册接口
 func (m *Module) signupPOSTHandler(c *gin.Context) {
+	m.signup(c, "")
+}
+
+func (m *Module) inviteGETHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// We'll need the instance later, and we can also use it
+	// before then to make it easier to return a web error.
+	instance, errWithCode := m.processor.InstanceGetV1(ctx)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Return instance we already got from the db,
+	// don't try to fetch it again when erroring.
+	instanceGet := func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	// We only serve text/html at this endpoint.
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextHTML); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), instanceGet)
+		return
+	}
+
+	// Make sure the invite is usable before
+	// showing the sign-up form, so people
+	// don't fill it in for nothing.
+	invite, errWithCode := m.processor.User().InviteGetUsable(ctx, c.Param(apiutil.WebInviteCodeKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	page := apiutil.WebPage{
+		Template: "sign-up.tmpl",
+		Instance: instance,
+		OGMeta:   apiutil.OGBase(instance),
+		Extra: map[string]any{
+			// Invited sign-ups don't need a
+			// reason, and can sign up even
+			// when registration is closed.
+			"reasonRequired":   false,
+			"registrationOpen": true,
+			"inviteCode":       invite.Code,
+		},
+	}
+
+	apiutil.TemplateWebPage(c, page)
+}
+
+// /invite/:invite_code
+func (m *Module) invitePOSTHandler(c *gin.Context) {
+	m.signup(c, c.Param(apiutil.WebInviteCodeKey))
+}
+
+// signup handles a sign-up form POST,
+// optionally using the given invite code.
+func (m *Module) signup(c *gin.Context, inviteCode string) {
 	ctx := c.Request.Context()
 
 	// We'll need the instance later, and we can also use it
@@ -97,6 +157,12 @@ func (m *Module) signupPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	// Invite code comes from the
+	// path, not the form, if set.
+	if inviteCode != "" {
+		form.InviteCode = inviteCode
+	}
+
 	if err := validate.CreateAccount(form); err != nil {
 		apiutil.WebErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), instanceGet)
 		return
@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	// recordSize is the aes128gcm record size
+	// advertised in the content coding header.
+	// Payloads are always sent as one record.
+	recordSize = 4096
+
+	// maxPayloadSize is the largest plaintext that
+	// fits in a single record, after subtracting
+	// the padding delimiter and AEAD tag.
+	maxPayloadSize = recordSize - 1 - 16
+)
+
+// encrypt encrypts the given payload for a user agent with the
+// given P-256 public key and authentication secret, using the
+// "aes128gcm" content coding of RFC 8188 as specified by RFC 8291.
+func encrypt(payload []byte, uaPublic []byte, authSecret []byte) ([]byte, error) {
+	// Generate ephemeral application server key pair.
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate random salt.
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+
+	return encryptWith(payload, uaPublic, authSecret, asPrivate, salt)
+}
+
+// encryptWith performs encryption as per encrypt(),
+// using the given application server key and salt.
+func encryptWith(
+	payload []byte,
+	uaPublic []byte,
+	authSecret []byte,
+	asPrivate *ecdh.PrivateKey,
+	salt []byte,
+) ([]byte, error) {
+	if len(payload) > maxPayloadSize {
+		return nil, errors.New("payload too large")
+	}
+
+	if len(authSecret) != 16 {
+		return nil, errors.New("invalid auth secret length")
+	}
+
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdhSecret, err := asPrivate.ECDH(uaKey)
+	if err != nil {
+		return nil, err
+	}
+
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	// Combine the ECDH shared secret with the auth secret
+	// to derive the input keying material (RFC 8291 3.3).
+	keyInfo := make([]byte, 0, 14+len(uaPublic)+len(asPublic))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm := hkdf(authSecret, ecdhSecret, keyInfo, 32)
+
+	// Derive content encryption key and nonce (RFC 8188 2.2 / 2.3).
+	cek := hkdf(salt, ikm, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce := hkdf(salt, ikm, []byte("Content-Encoding: nonce\x00"), 12)
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the content coding header:
+	// salt || rs || idlen || keyid.
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	// Single (therefore last) record,
+	// so delimit with 0x02 and no padding.
+	record := make([]byte, 0, len(payload)+1)
+	record = append(record, payload...)
+	record = append(record, 0x02)
+
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// hkdf performs HKDF-SHA-256 extract and expand (RFC 5869),
+// for output lengths up to a single hash block (32 bytes).
+func hkdf(salt, ikm, info []byte, length int) []byte {
+	extract := hmac.New(sha256.New, salt)
+	extract.Write(ikm)
+	prk := extract.Sum(nil)
+
+	expand := hmac.New(sha256.New, prk)
+	expand.Write(info)
+	expand.Write([]byte{0x01})
+	return expand.Sum(nil)[:length]
+}
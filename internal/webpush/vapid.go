@@ -0,0 +1,111 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// vapidExpiry is how long a VAPID token is valid for.
+// RFC 8292 permits at most 24 hours from time of signing.
+const vapidExpiry = 12 * time.Hour
+
+// vapidKeys wraps a parsed gtsmodel.VAPIDKeyPair{}.
+type vapidKeys struct {
+	public  string
+	private *ecdsa.PrivateKey
+}
+
+// parseVAPIDKeys parses the given stored VAPID key pair.
+func parseVAPIDKeys(kp *gtsmodel.VAPIDKeyPair) (*vapidKeys, error) {
+	pub, err := base64.RawURLEncoding.DecodeString(kp.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	priv, err := base64.RawURLEncoding.DecodeString(kp.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Expect uncompressed point
+	// form: 0x04 || X || Y.
+	if len(pub) != 65 || pub[0] != 0x04 || len(priv) != 32 {
+		return nil, errors.New("invalid vapid key pair")
+	}
+
+	return &vapidKeys{
+		public: kp.PublicKey,
+		private: &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(pub[1:33]),
+				Y:     new(big.Int).SetBytes(pub[33:]),
+			},
+			D: new(big.Int).SetBytes(priv),
+		},
+	}, nil
+}
+
+// authorization returns a value for the Authorization header of a push
+// request to the given endpoint, containing a VAPID token (RFC 8292)
+// signed by the instance, identified by the given subject URI.
+func (k *vapidKeys) authorization(endpoint string, subject string, now time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(vapidExpiry).Unix(),
+		"sub": subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	const header = `{"typ":"JWT","alg":"ES256"}`
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) +
+		"." + base64.RawURLEncoding.EncodeToString(claims)
+
+	// ES256 signature is the fixed-width
+	// concatenation of r and s (RFC 7518 3.4).
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, k.private, digest[:])
+	if err != nil {
+		return "", err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	token := unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+	return "vapid t=" + token + ", k=" + k.public, nil
+}
@@ -0,0 +1,320 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/transport/delivery"
+)
+
+const (
+	// pushTTL is how long push services should
+	// retain a message for an offline user agent.
+	pushTTL = 48 * time.Hour
+
+	// maxBodyRunes is the max length
+	// of a push message body, in runes.
+	maxBodyRunes = 256
+)
+
+// Sender encrypts notifications and queues them for
+// delivery to the Web Push subscriptions of their target
+// account, using the Web Push delivery worker pool.
+type Sender struct {
+	state *state.State
+	keys  atomic.Pointer[vapidKeys]
+}
+
+// NewSender returns a new Web Push Sender.
+func NewSender(state *state.State) *Sender {
+	return &Sender{state: state}
+}
+
+// Send queues the given notification for delivery to every Web
+// Push subscription of the notification's target account whose
+// alerts and policy permit it. The given API notification should
+// be the notification as seen by the target account.
+func (s *Sender) Send(
+	ctx context.Context,
+	notif *gtsmodel.Notification,
+	apiNotif *apimodel.Notification,
+) error {
+	subscriptions, err := s.state.DB.GetWebPushSubscriptionsByAccountID(ctx, notif.TargetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting web push subscriptions: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	keys, err := s.vapidKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the parts of the message
+	// shared between all subscriptions.
+	msg := message(notif, apiNotif)
+	msg.PreferredLocale = "en"
+	if settings, err := s.state.DB.GetAccountSettings(ctx, notif.TargetAccountID); err == nil {
+		msg.PreferredLocale = settings.Language
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.NotificationFlags.Get(notif.NotificationType) {
+			// Not interested
+			// in this type.
+			continue
+		}
+
+		ok, err := s.policyPermits(ctx, subscription.Policy, notif)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			// Not interested
+			// in this account.
+			continue
+		}
+
+		if err := s.queue(ctx, keys, subscription, msg); err != nil {
+			log.Errorf(ctx, "error queueing web push to subscription %s: %v", subscription.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// policyPermits returns whether the given push subscription
+// policy permits pushing notifications caused by the origin
+// account to the target account of the given notification.
+func (s *Sender) policyPermits(
+	ctx context.Context,
+	policy gtsmodel.WebPushSubscriptionPolicy,
+	notif *gtsmodel.Notification,
+) (bool, error) {
+	switch policy {
+	case gtsmodel.WebPushSubscriptionPolicyFollowed:
+		following, err := s.state.DB.IsFollowing(ctx, notif.TargetAccountID, notif.OriginAccountID)
+		if err != nil {
+			return false, gtserror.Newf("db error checking follow: %w", err)
+		}
+		return following || notif.TargetAccountID == notif.OriginAccountID, nil
+
+	case gtsmodel.WebPushSubscriptionPolicyFollower:
+		follower, err := s.state.DB.IsFollowing(ctx, notif.OriginAccountID, notif.TargetAccountID)
+		if err != nil {
+			return false, gtserror.Newf("db error checking follow: %w", err)
+		}
+		return follower || notif.TargetAccountID == notif.OriginAccountID, nil
+
+	case gtsmodel.WebPushSubscriptionPolicyNone:
+		return false, nil
+
+	default:
+		return true, nil
+	}
+}
+
+// queue encrypts the given message for the given
+// subscription and pushes it to the delivery queue.
+func (s *Sender) queue(
+	ctx context.Context,
+	keys *vapidKeys,
+	subscription *gtsmodel.WebPushSubscription,
+	msg apimodel.WebPushNotification,
+) error {
+	// Include the subscription's access token,
+	// so clients can tell which account it's for.
+	token, err := s.state.DB.GetTokenByID(ctx, subscription.TokenID)
+	if err != nil {
+		return gtserror.Newf("db error getting token %s: %w", subscription.TokenID, err)
+	}
+	msg.AccessToken = token.Access
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return gtserror.Newf("error marshaling message: %w", err)
+	}
+
+	p256dh, err := base64.RawURLEncoding.DecodeString(subscription.P256dh)
+	if err != nil {
+		return gtserror.Newf("error decoding p256dh key: %w", err)
+	}
+
+	auth, err := base64.RawURLEncoding.DecodeString(subscription.Auth)
+	if err != nil {
+		return gtserror.Newf("error decoding auth secret: %w", err)
+	}
+
+	body, err := encrypt(payload, p256dh, auth)
+	if err != nil {
+		return gtserror.Newf("error encrypting message: %w", err)
+	}
+
+	// Authorize each attempt with a fresh VAPID token, and
+	// rewind the request body so that retries resend it.
+	endpoint := subscription.Endpoint
+	subject := config.GetProtocol() + "://" + config.GetHost()
+	sign := func(r *http.Request) error {
+		authz, err := keys.authorization(endpoint, subject, time.Now())
+		if err != nil {
+			return err
+		}
+		r.Header.Set("Authorization", authz)
+
+		if r.GetBody != nil {
+			r.Body, err = r.GetBody()
+		}
+		return err
+	}
+	ctx = gtscontext.SetHTTPClientSignFunc(ctx, sign)
+
+	// Use *bytes.Reader for request body,
+	// as NewRequest() automatically will
+	// set .GetBody and content-length.
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return gtserror.Newf("error preparing request: %w", err)
+	}
+
+	r.Header.Set("User-Agent", "gotosocial/"+config.GetSoftwareVersion()+" (+"+subject+")")
+	r.Header.Set("Content-Type", "application/octet-stream")
+	r.Header.Set("Content-Encoding", "aes128gcm")
+	r.Header.Set("TTL", strconv.Itoa(int(pushTTL/time.Second)))
+	r.Header.Set("Urgency", "normal")
+
+	if err := httpclient.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	s.state.Workers.WebPush.Queue.Push(&delivery.Delivery{
+		Request: httpclient.WrapRequest(r),
+	})
+
+	return nil
+}
+
+// vapidKeys returns the instance VAPID keys,
+// loading them from the database if necessary.
+func (s *Sender) vapidKeys(ctx context.Context) (*vapidKeys, error) {
+	if keys := s.keys.Load(); keys != nil {
+		return keys, nil
+	}
+
+	kp, err := s.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("db error getting vapid key pair: %w", err)
+	}
+
+	keys, err := parseVAPIDKeys(kp)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing vapid key pair: %w", err)
+	}
+
+	s.keys.Store(keys)
+	return keys, nil
+}
+
+// message prepares the user-visible parts of the
+// push message for the given notification.
+func message(notif *gtsmodel.Notification, apiNotif *apimodel.Notification) apimodel.WebPushNotification {
+	msg := apimodel.WebPushNotification{
+		NotificationID:   notif.ID,
+		NotificationType: string(notif.NotificationType),
+	}
+
+	var name string
+	if acct := apiNotif.Account; acct != nil {
+		name = acct.DisplayName
+		if name == "" {
+			name = "@" + acct.Acct
+		}
+		msg.Icon = acct.Avatar
+	}
+
+	switch notif.NotificationType {
+	case gtsmodel.NotificationFollow:
+		msg.Title = name + " followed you"
+	case gtsmodel.NotificationFollowRequest:
+		msg.Title = name + " requested to follow you"
+	case gtsmodel.NotificationMention:
+		msg.Title = name + " mentioned you"
+	case gtsmodel.NotificationReblog:
+		msg.Title = name + " boosted your post"
+	case gtsmodel.NotificationFave:
+		msg.Title = name + " favourited your post"
+	case gtsmodel.NotificationPoll:
+		msg.Title = "A poll has ended"
+	case gtsmodel.NotificationStatus:
+		msg.Title = name + " just posted"
+	case gtsmodel.NotificationSignup:
+		msg.Title = name + " signed up"
+	case gtsmodel.NotificationPendingFave:
+		msg.Title = name + " favourited your post, pending your approval"
+	case gtsmodel.NotificationPendingReply:
+		msg.Title = name + " replied to your post, pending your approval"
+	case gtsmodel.NotificationPendingReblog:
+		msg.Title = name + " boosted your post, pending your approval"
+	default:
+		msg.Title = "New notification from " + name
+	}
+
+	if status := apiNotif.Status; status != nil {
+		body := status.SpoilerText
+		if body == "" {
+			body = text.SanitizeToPlaintext(status.Content)
+		}
+		msg.Body = truncate(body, maxBodyRunes)
+	}
+
+	return msg
+}
+
+// truncate truncates the given string to n runes,
+// appending an ellipsis if anything was removed.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
@@ -37,6 +37,13 @@ type Workers struct {
 	// indexed queue of Delivery{} objects.
 	Delivery delivery.WorkerPool
 
+	// WebPush provides a worker pool that
+	// handles outgoing Web Push deliveries,
+	// using the same queue + retry model as
+	// ActivityPub deliveries. Queued Web Push
+	// deliveries are not persisted on stop.
+	WebPush delivery.WorkerPool
+
 	// Client provides a worker pool that handles
 	// incoming processing jobs from the client API.
 	Client MsgWorkerPool[*messages.FromClientAPI]
@@ -75,6 +82,10 @@ func (w *Workers) Start() {
 	w.Delivery.Start(n)
 	log.Infof(nil, "started %d delivery workers", n)
 
+	n = maxprocs
+	w.WebPush.Start(n)
+	log.Infof(nil, "started %d web push workers", n)
+
 	n = 4 * maxprocs
 	w.Client.Start(n)
 	log.Infof(nil, "started %d client workers", n)
@@ -102,6 +113,9 @@ func (w *Workers) Stop() {
 	w.Delivery.Stop()
 	log.Info(nil, "stopped delivery workers")
 
+	w.WebPush.Stop()
+	log.Info(nil, "stopped web push workers")
+
 	w.Client.Stop()
 	log.Info(nil, "stopped client workers")
 
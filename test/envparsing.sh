@@ -7,8 +7,12 @@ EXPECT=$(cat << "EOF"
     "account-domain": "peepee",
     "accounts-allow-custom-css": true,
     "accounts-custom-css-length": 5000,
+    "accounts-invite-max-expiry": 172800000000000,
+    "accounts-invite-max-uses": 5,
+    "accounts-invites-enabled": true,
     "accounts-reason-required": false,
     "accounts-registration-open": true,
+    "accounts-two-factor-encryption-key": "super-secret-2fa-key",
     "advanced-cookies-samesite": "strict",
     "advanced-csp-extra-uris": [],
     "advanced-header-filter-mode": "block",
@@ -31,11 +35,13 @@ EXPECT=$(cat << "EOF"
         "block-ids-mem-ratio": 3,
         "block-mem-ratio": 2,
         "boost-of-ids-mem-ratio": 3,
+        "card-mem-ratio": 0.5,
         "client-mem-ratio": 0.1,
         "conversation-last-status-ids-mem-ratio": 2,
         "conversation-mem-ratio": 1,
         "emoji-category-mem-ratio": 0.1,
         "emoji-mem-ratio": 3,
+        "featured-tag-mem-ratio": 0.5,
         "filter-keyword-mem-ratio": 0.5,
         "filter-mem-ratio": 0.5,
         "filter-status-mem-ratio": 0.5,
@@ -60,9 +66,11 @@ EXPECT=$(cat << "EOF"
         "poll-vote-ids-mem-ratio": 2,
         "poll-vote-mem-ratio": 2,
         "report-mem-ratio": 1,
+        "scheduled-status-mem-ratio": 0.5,
         "sin-bin-status-mem-ratio": 0.5,
         "status-bookmark-ids-mem-ratio": 2,
         "status-bookmark-mem-ratio": 0.5,
+        "status-edit-mem-ratio": 2,
         "status-fave-ids-mem-ratio": 3,
         "status-fave-mem-ratio": 2,
         "status-mem-ratio": 5,
@@ -112,6 +120,8 @@ EXPECT=$(cat << "EOF"
         "nl",
         "en-GB"
     ],
+    "instance-unavailable-after": 3600000000000,
+    "instance-unavailable-failures": 5,
     "landing-page-user": "admin",
     "letsencrypt-cert-dir": "/gotosocial/storage/certs",
     "letsencrypt-email-address": "",
@@ -170,6 +180,7 @@ EXPECT=$(cat << "EOF"
     "statuses-media-max-files": 1,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-remote-prune-days": 30,
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
     "storage-s3-access-key": "minio",
@@ -188,6 +199,14 @@ EXPECT=$(cat << "EOF"
     "tracing-endpoint": "localhost:4317",
     "tracing-insecure-transport": true,
     "tracing-transport": "grpc",
+    "translation-backend": "libretranslate",
+    "translation-command": [
+        "translate",
+        "--json"
+    ],
+    "translation-libretranslate-api-key": "",
+    "translation-libretranslate-url": "http://localhost:5000",
+    "translation-timeout": 30000000000,
     "trusted-proxies": [
         "127.0.0.1/32",
         "docker.host.local"
@@ -238,10 +257,16 @@ GTS_INSTANCE_FEDERATION_SPAM_FILTER=true \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
 GTS_INSTANCE_LANGUAGES="nl,en-gb" \
+GTS_INSTANCE_UNAVAILABLE_FAILURES=5 \
+GTS_INSTANCE_UNAVAILABLE_AFTER='1h' \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_REASON_REQUIRED=false \
+GTS_ACCOUNTS_INVITES_ENABLED=true \
+GTS_ACCOUNTS_INVITE_MAX_USES=5 \
+GTS_ACCOUNTS_INVITE_MAX_EXPIRY='48h' \
+GTS_ACCOUNTS_TWO_FACTOR_ENCRYPTION_KEY='super-secret-2fa-key' \
 GTS_MEDIA_DESCRIPTION_MIN_CHARS=69 \
 GTS_MEDIA_DESCRIPTION_MAX_CHARS=5000 \
 GTS_MEDIA_LOCAL_MAX_SIZE=420 \
@@ -266,6 +291,7 @@ GTS_STATUSES_CW_MAX_CHARS=420 \
 GTS_STATUSES_POLL_MAX_OPTIONS=1 \
 GTS_STATUSES_POLL_OPTIONS_MAX_CHARS=69 \
 GTS_STATUSES_MEDIA_MAX_FILES=1 \
+GTS_STATUSES_REMOTE_PRUNE_DAYS=30 \
 GTS_LETS_ENCRYPT_ENABLED=false \
 GTS_LETS_ENCRYPT_PORT=8080 \
 GTS_LETS_ENCRYPT_CERT_DIR='/root/certs' \
@@ -289,6 +315,9 @@ GTS_SMTP_DISCLOSE_RECIPIENTS=true \
 GTS_SYSLOG_ENABLED=true \
 GTS_SYSLOG_PROTOCOL='udp' \
 GTS_SYSLOG_ADDRESS='127.0.0.1:6969' \
+GTS_TRANSLATION_BACKEND='libretranslate' \
+GTS_TRANSLATION_LIBRETRANSLATE_URL='http://localhost:5000' \
+GTS_TRANSLATION_COMMAND='translate,--json' \
 GTS_TRACING_ENDPOINT='localhost:4317' \
 GTS_TRACING_INSECURE_TRANSPORT=true \
 GTS_ADVANCED_COOKIES_SAMESITE='strict' \
@@ -91,6 +91,8 @@ func testDefaults() config.Configuration {
 		InstanceExposeSuspended:        true,
 		InstanceExposeSuspendedWeb:     true,
 		InstanceDeliverToSharedInboxes: true,
+		InstanceUnavailableFailures:    10,
+		InstanceUnavailableAfter:       24 * time.Hour,
 		InstanceLanguages: language.Languages{
 			{
 				TagStr: "nl",
@@ -105,6 +107,12 @@ func testDefaults() config.Configuration {
 		AccountsAllowCustomCSS:   true,
 		AccountsCustomCSSLength:  10000,
 
+		AccountsInvitesEnabled:  false,
+		AccountsInviteMaxUses:   10,
+		AccountsInviteMaxExpiry: 7 * 24 * time.Hour,
+
+		AccountsTwoFactorEncryptionKey: "testrig-two-factor-encryption-key",
+
 		MediaDescriptionMinChars: 0,
 		MediaDescriptionMaxChars: 500,
 		MediaRemoteCacheDays:     7,
@@ -125,6 +133,7 @@ func testDefaults() config.Configuration {
 		StatusesPollMaxOptions:     6,
 		StatusesPollOptionMaxChars: 50,
 		StatusesMediaMaxFiles:      6,
+		StatusesRemotePruneDays:    0,
 
 		LetsEncryptEnabled:      false,
 		LetsEncryptPort:         0,
@@ -161,6 +170,12 @@ func testDefaults() config.Configuration {
 		SyslogProtocol: "udp",
 		SyslogAddress:  "localhost:514",
 
+		TranslationBackend:              "",
+		TranslationLibreTranslateURL:    "",
+		TranslationLibreTranslateAPIKey: "",
+		TranslationCommand:              []string{},
+		TranslationTimeout:              30 * time.Second,
+
 		AdvancedCookiesSamesite:      "lax",
 		AdvancedRateLimitRequests:    0, // disabled
 		AdvancedThrottlingMultiplier: 0, // disabled
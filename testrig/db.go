@@ -35,6 +35,7 @@
 	&gtsmodel.Application{},
 	&gtsmodel.Block{},
 	&gtsmodel.DomainBlock{},
+	&gtsmodel.DomainLimit{},
 	&gtsmodel.EmailDomainBlock{},
 	&gtsmodel.Filter{},
 	&gtsmodel.FilterKeyword{},
@@ -54,7 +55,15 @@
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusFave{},
 	&gtsmodel.StatusBookmark{},
+	&gtsmodel.StatusEdit{},
+	&gtsmodel.ScheduledStatus{},
+	&gtsmodel.Card{},
+	&gtsmodel.DeliveryFailure{},
+	&gtsmodel.DomainPermissionDraft{},
+	&gtsmodel.DomainPermissionExclude{},
+	&gtsmodel.DomainPermissionSubscription{},
 	&gtsmodel.Tag{},
+	&gtsmodel.FeaturedTag{},
 	&gtsmodel.Thread{},
 	&gtsmodel.ThreadMute{},
 	&gtsmodel.ThreadToStatus{},
@@ -68,6 +77,20 @@
 	&gtsmodel.Client{},
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
+	&gtsmodel.VAPIDKeyPair{},
+	&gtsmodel.WebPushSubscription{},
+	&gtsmodel.Relay{},
+	&gtsmodel.Invite{},
+	&gtsmodel.ImportJob{},
+	&gtsmodel.ImportJobFailure{},
+	&gtsmodel.GroupModerator{},
+	&gtsmodel.Trend{},
+	&gtsmodel.TrendUse{},
+	&gtsmodel.FeaturedAccount{},
+	&gtsmodel.SuggestionDismissal{},
+	&gtsmodel.Announcement{},
+	&gtsmodel.AnnouncementReaction{},
+	&gtsmodel.AnnouncementRead{},
 	&gtsmodel.Report{},
 	&gtsmodel.Rule{},
 	&gtsmodel.WorkerTask{},
@@ -239,6 +262,12 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestFeaturedTags() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
 	for _, v := range NewTestMentions() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(nil, err)
@@ -353,6 +382,10 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	if err := db.Put(ctx, NewTestVAPIDKeyPair()); err != nil {
+		log.Panic(nil, err)
+	}
+
 	if err := db.CreateInstanceAccount(ctx); err != nil {
 		log.Panic(nil, err)
 	}
@@ -361,6 +394,12 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		log.Panic(nil, err)
 	}
 
+	// Test statuses are inserted without going
+	// through PutStatus, so index them for search.
+	if err := db.ReindexStatuses(ctx); err != nil {
+		log.Panic(nil, err)
+	}
+
 	log.Debug(nil, "testing db setup complete")
 }
 
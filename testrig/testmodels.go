@@ -2218,6 +2218,19 @@ func NewTestStatusToTags() map[string]*gtsmodel.StatusToTag {
 	}
 }
 
+// NewTestFeaturedTags returns a map of gts model featured tags keyed by their featurer + tag name.
+func NewTestFeaturedTags() map[string]*gtsmodel.FeaturedTag {
+	return map[string]*gtsmodel.FeaturedTag{
+		"admin_account_welcome": {
+			ID:        "01JAXTPB7QH5Y1C4QWWH8M4MNT",
+			CreatedAt: TimeMustParse("2022-05-14T13:25:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T13:25:09+02:00"),
+			AccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+			TagID:     "01F8MHA1A2NF9MJ3WCCQ3K8BSZ",
+		},
+	}
+}
+
 func NewTestThreads() map[string]*gtsmodel.Thread {
 	return map[string]*gtsmodel.Thread{
 		"admin_account_status_1": {
@@ -3490,6 +3503,17 @@ func NewTestInteractionRequests() map[string]*gtsmodel.InteractionRequest {
 	}
 }
 
+// NewTestVAPIDKeyPair returns a fixed VAPID key pair for the
+// testrig instance, so that Web Push output is deterministic.
+func NewTestVAPIDKeyPair() *gtsmodel.VAPIDKeyPair {
+	return &gtsmodel.VAPIDKeyPair{
+		ID:         "01JBMVQ5RXW9HPZ6H9XZ4D2K3M",
+		CreatedAt:  TimeMustParse("2024-11-01T12:00:00+02:00"),
+		PublicKey:  "BE37b1-7b4-dIKIkHYbMCcj69c0wy-QZV21GkIgbOymm3B9Jp-bYyIWoRiv1wsljCLkDyZYWC3XwxA-KhWKP0-Y",
+		PrivateKey: "Wrp_YAZEObvl6jdgyamVbDww7zlyuqAEd6NxundSpwM",
+	}
+}
+
 // GetSignatureForActivity prepares a mock HTTP request as if it were going to deliver activity to destination signed for privkey and pubKeyID, signs the request and returns the header values.
 func GetSignatureForActivity(activity pub.Activity, pubKeyID string, privkey *rsa.PrivateKey, destination *url.URL) (signatureHeader string, digestHeader string, dateHeader string) {
 	// convert the activity into json bytes
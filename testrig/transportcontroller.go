@@ -29,6 +29,7 @@
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -79,7 +80,7 @@ type MockHTTPClient struct {
 // to customize how the client is mocked.
 //
 // Note that you should never ever make ACTUAL http calls with this thing.
-func NewMockHTTPClient(do func(req *http.Request) (*http.Response, error), relativeMediaPath string, extraPeople ...vocab.ActivityStreamsPerson) *MockHTTPClient {
+func NewMockHTTPClient(do func(req *http.Request) (*http.Response, error), relativeMediaPath string, extraPeople ...ap.Accountable) *MockHTTPClient {
 	mockHTTPClient := &MockHTTPClient{}
 
 	if do != nil {
@@ -127,6 +128,8 @@ func NewMockHTTPClient(do func(req *http.Request) (*http.Response, error), relat
 			responseCode, responseBytes, responseContentType, responseContentLength = WebfingerResponse(req)
 		} else if strings.Contains(reqURLString, ".weird-webfinger-location/webfinger") {
 			responseCode, responseBytes, responseContentType, responseContentLength = WebfingerResponse(req)
+		} else if strings.HasPrefix(reqURLString, "https://lists.example.org/") {
+			responseCode, responseBytes, responseContentType, responseContentLength = DomainPermissionListResponse(req)
 		} else if strings.Contains(reqURLString, ".well-known/host-meta") {
 			responseCode, responseBytes, responseContentType, responseContentLength = HostMetaResponse(req)
 		} else if note, ok := mockHTTPClient.TestRemoteStatuses[reqURLString]; ok {
@@ -261,6 +264,41 @@ func (m *MockHTTPClient) DoSigned(req *http.Request, sign httpclient.SignFunc) (
 	return m.do(req)
 }
 
+// DomainPermissionListResponse returns a test domain
+// permission list for the requested URL, in the format
+// indicated by the URL path's file extension.
+func DomainPermissionListResponse(req *http.Request) (responseCode int, responseBytes []byte, responseContentType string, responseContentLength int) {
+	switch req.URL.String() {
+	case "https://lists.example.org/baddies.csv":
+		responseBytes = []byte(`#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate
+bumfaces.net,suspend,false,false,big jerks,false
+peepee.poopoo,suspend,false,false,harassment,false
+nothanks.com,suspend,false,false,,false
+quieter.example,silence,false,false,too loud,false
+`)
+		responseContentType = "text/csv"
+
+	case "https://lists.example.org/baddies.json":
+		responseBytes = []byte(`[{"domain":"bumfaces.net","public_comment":"big jerks"},{"domain":"peepee.poopoo","public_comment":"harassment"},{"domain":"nothanks.com"}]`)
+		responseContentType = applicationJSON
+
+	case "https://lists.example.org/baddies.txt":
+		responseBytes = []byte("# some baddies\nbumfaces.net\npeepee.poopoo\nnothanks.com\n")
+		responseContentType = "text/plain"
+
+	default:
+		responseCode = http.StatusNotFound
+		responseBytes = []byte(`{"error":"404 not found"}`)
+		responseContentType = applicationJSON
+		responseContentLength = len(responseBytes)
+		return
+	}
+
+	responseCode = http.StatusOK
+	responseContentLength = len(responseBytes)
+	return
+}
+
 func HostMetaResponse(req *http.Request) (responseCode int, responseBytes []byte, responseContentType string, responseContentLength int) {
 	var hm *apimodel.HostMeta
 
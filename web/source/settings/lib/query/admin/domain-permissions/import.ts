@@ -107,6 +107,10 @@ const extended = gtsApi.injectEndpoints({
 					discardEmpty: true,
 					body: {
 						import: true,
+						// Entries are already reviewed
+						// in the import form, so create
+						// them directly, not as drafts.
+						as_draft: false,
 						domains: new Blob(
 							[JSON.stringify(domains)],
 							{ type: "application/json" },
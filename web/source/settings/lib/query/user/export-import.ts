@@ -21,7 +21,7 @@ import fileDownload from "js-file-download";
 
 import { gtsApi } from "../gts-api";
 import { FetchBaseQueryError } from "@reduxjs/toolkit/query";
-import { AccountExportStats } from "../../types/account";
+import { AccountExportStats, ImportJob } from "../../types/account";
 
 const extended = gtsApi.injectEndpoints({
 	endpoints: (build) => ({
@@ -126,7 +126,7 @@ const extended = gtsApi.injectEndpoints({
 			}
 		}),
 
-		importData: build.mutation({
+		importData: build.mutation<ImportJob, any>({
 			query: (formData) => ({
 				method: "POST",
 				url: `/api/v1/import`,
@@ -135,6 +135,31 @@ const extended = gtsApi.injectEndpoints({
 				discardEmpty: true
 			}),
 		}),
+
+		importJob: build.query<ImportJob, string>({
+			query: (id) => ({
+				url: `/api/v1/import/${id}`
+			}),
+		}),
+
+		importFailures: build.mutation<string | null, string>({
+			async queryFn(id, _api, _extraOpts, fetchWithBQ) {
+				const csvRes = await fetchWithBQ({
+					url: `/api/v1/import/${id}/failures.csv`,
+					acceptContentType: "text/csv",
+				});
+				if (csvRes.error) {
+					return { error: csvRes.error as FetchBaseQueryError };
+				}
+
+				if (csvRes.meta?.response?.status !== 200) {
+					return { error: csvRes.data };
+				}
+
+				fileDownload(csvRes.data, "failures.csv", "text/csv");
+				return { data: null };
+			}
+		}),
 	})
 });
 
@@ -146,4 +171,6 @@ export const {
 	useExportBlocksMutation,
 	useExportMutesMutation,
 	useImportDataMutation,
+	useImportJobQuery,
+	useImportFailuresMutation,
 } = extended;
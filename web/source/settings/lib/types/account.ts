@@ -36,7 +36,9 @@ export interface AdminAccount {
 	disabled: boolean,
 	silenced: boolean,
 	suspended: boolean,
+	sensitized: boolean,
 	created_by_application_id: string,
+	invited_by_account_id?: string,
 	account: Account,
 }
 
@@ -109,9 +111,15 @@ export interface HandleSignupParams {
 	send_email?: boolean,
 }
 
+export type AccountAction =
+	"disable" | "reenable" |
+	"silence" | "unsilence" |
+	"sensitive" | "unsensitive" |
+	"suspend";
+
 export interface ActionAccountParams {
 	id: string;
-	action: "suspend";
+	action: AccountAction;
 	reason: string;
 }
 
@@ -124,3 +132,23 @@ export interface AccountExportStats {
 	blocks_count: number;
 	mutes_count: number;
 }
+
+export interface ImportJob {
+	id: string;
+	created_at: string;
+	updated_at: string;
+	type: string;
+	mode: string;
+	state: "queued" | "processing" | "finished";
+	total_rows: number;
+	processed_rows: number;
+	successes: number;
+	failures: number;
+	failed_rows: ImportJobFailure[];
+	finished_at: string | null;
+}
+
+export interface ImportJobFailure {
+	row: number;
+	reason: string;
+}
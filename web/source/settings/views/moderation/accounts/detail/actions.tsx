@@ -63,6 +63,7 @@ export function AccountActions({ account, backLocation }: AccountActionsProps) {
 }
 
 function ModerateAccount({ account }: { account: AdminAccount }) {
+	const local = !account.domain;
 	const form = {
 		id: useValue("id", account.id),
 		reason: useTextInput("text")
@@ -78,8 +79,48 @@ function ModerateAccount({ account }: { account: AdminAccount }) {
 		>
 			<h3 id="account-moderation-actions">Account Moderation Actions</h3>
 			<div>
-				Currently only the "suspend" action is implemented.
+				{ local && <>
+					Disabling an account prevents it from logging in
+					or using the API until it is reenabled.
+					<br/>
+				</> }
+				Silencing an account hides its posts from public timelines,
+				and from accounts that don't follow it. Accounts that don't
+				follow a silenced account won't get notifications from it.
+				<br/>
+				Marking an account as sensitive makes all of its media
+				show as sensitive, including media posted in future.
 				<br/>
+				These actions can be reversed at any time.
+			</div>
+			<TextInput
+				field={form.reason}
+				placeholder="Reason for this action"
+				autoCapitalize="sentences"
+			/>
+			<div className="action-buttons">
+				{ local &&
+					<MutationButton
+						disabled={false}
+						label={account.disabled ? "Reenable" : "Disable"}
+						name={account.disabled ? "reenable" : "disable"}
+						result={result}
+					/>
+				}
+				<MutationButton
+					disabled={false}
+					label={account.silenced ? "Unsilence" : "Silence"}
+					name={account.silenced ? "unsilence" : "silence"}
+					result={result}
+				/>
+				<MutationButton
+					disabled={false}
+					label={account.sensitized ? "Unmark sensitive" : "Mark sensitive"}
+					name={account.sensitized ? "unsensitive" : "sensitive"}
+					result={result}
+				/>
+			</div>
+			<div>
 				Suspending an account will delete it from your server,
 				and remove all of its media, posts, relationships, etc.
 				<br/>
@@ -89,11 +130,6 @@ function ModerateAccount({ account }: { account: AdminAccount }) {
 				<br/>
 				<b>Account suspension cannot be reversed.</b>
 			</div>
-			<TextInput
-				field={form.reason}
-				placeholder="Reason for this action"
-				autoCapitalize="sentences"
-			/>
 			<div className="action-buttons">
 				<MutationButton
 					disabled={account.suspended || reallySuspend.value === undefined || reallySuspend.value === false}
@@ -24,7 +24,7 @@ import FormWithData from "../../../../lib/form/form-with-data";
 import FakeProfile from "../../../../components/profile";
 import { AdminAccount } from "../../../../lib/types/account";
 import { AccountActions } from "./actions";
-import { useParams } from "wouter";
+import { Link, useParams } from "wouter";
 import { useBaseUrl } from "../../../../lib/navigation/util";
 import BackButton from "../../../../components/back-button";
 import { UseOurInstanceAccount, yesOrNo } from "../../../../lib/util";
@@ -141,6 +141,10 @@ function GeneralAccountDetails({ adminAcct } : { adminAcct: AdminAccount }) {
 					<dt>Silenced</dt>
 					<dd>{yesOrNo(adminAcct.silenced)}</dd>
 				</div>
+				<div className="info-list-entry">
+					<dt>Media marked sensitive</dt>
+					<dd>{yesOrNo(adminAcct.sensitized)}</dd>
+				</div>
 				<div className="info-list-entry">
 					<dt>Statuses</dt>
 					<dd>{adminAcct.account.statuses_count}</dd>
@@ -191,6 +195,15 @@ function LocalAccountDetails({ adminAcct }: { adminAcct: AdminAccount }) {
 					<dt>Sign-Up Reason</dt>
 					<dd>{adminAcct.invite_request ?? <i>none provided</i>}</dd>
 				</div>
+				{ adminAcct.invited_by_account_id &&
+					<div className="info-list-entry">
+						<dt>Invited By</dt>
+						<dd>
+							<Link to={`~/settings/moderation/accounts/${adminAcct.invited_by_account_id}`}>
+								{adminAcct.invited_by_account_id}
+							</Link>
+						</dd>
+					</div> }
 				{ (adminAcct.ip && adminAcct.ip !== "0.0.0.0") &&
 					<div className="info-list-entry">
 						<dt>Sign-Up IP</dt>
@@ -18,7 +18,11 @@
 */
 
 import React from "react";
-import { useImportDataMutation } from "../../../lib/query/user/export-import";
+import {
+	useImportDataMutation,
+	useImportFailuresMutation,
+	useImportJobQuery,
+} from "../../../lib/query/user/export-import";
 import MutationButton from "../../../components/form/mutation-button";
 import useFormSubmit from "../../../lib/form/submit";
 import { useFileInput, useTextInput } from "../../../lib/form";
@@ -68,6 +72,9 @@ export default function Import() {
 						<option value="">- Select import type -</option>
 						<option value="following">Following list</option>
 						<option value="blocks">Blocked accounts list</option>
+						<option value="mutes">Muted accounts list</option>
+						<option value="lists">Lists</option>
+						<option value="bookmarks">Bookmarks</option>
 					</>
 				}>
 			</Select>
@@ -93,6 +100,53 @@ export default function Import() {
 				label="Import"
 				result={result}
 			/>
+
+			{ result.data && <ImportProgress id={result.data.id} /> }
 		</form>
 	);
 }
+
+function ImportProgress({ id }: { id: string }) {
+	// Poll the import job until it's finished.
+	const [ finished, setFinished ] = React.useState(false);
+	const { data: job } = useImportJobQuery(id, {
+		pollingInterval: finished ? 0 : 2000,
+	});
+	const [ downloadFailures, downloadFailuresResult ] = useImportFailuresMutation();
+
+	React.useEffect(() => {
+		if (job?.state === "finished") {
+			setFinished(true);
+		}
+	}, [job]);
+
+	if (!job) {
+		return null;
+	}
+
+	return (
+		<div className="import-progress">
+			<p>
+				{ job.state === "finished" ? "Import finished" : "Import " + job.state }
+				: processed {job.processed_rows} of {job.total_rows} row{ job.total_rows !== 1 && "s" },
+				with {job.successes} success{ job.successes !== 1 && "es" } and {job.failures} failure{ job.failures !== 1 && "s" }.
+			</p>
+			{ job.failures > 0 &&
+				<>
+					<ul>
+						{ job.failed_rows.map((failure) => (
+							<li key={failure.row}>Row {failure.row}: {failure.reason}</li>
+						))}
+					</ul>
+					<button
+						type="button"
+						disabled={downloadFailuresResult.isLoading}
+						onClick={() => downloadFailures(id)}
+					>
+						Download failed rows
+					</button>
+				</>
+			}
+		</div>
+	);
+}